@@ -0,0 +1,16 @@
+package client
+
+import (
+	"github.com/VaalaCat/frp-panel/biz/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+)
+
+func StartFileTransfer(c *app.Context, req *pb.StartFileTransferRequest) (*pb.StartFileTransferResponse, error) {
+	return common.StartFileTransfer(c, req, &pb.FileTransferClientMessage{Base: &pb.FileTransferClientMessage_ClientBase{
+		ClientBase: &pb.ClientBase{
+			ClientId:     c.GetApp().GetConfig().Client.ID,
+			ClientSecret: c.GetApp().GetConfig().Client.Secret,
+		},
+	}})
+}
@@ -22,7 +22,7 @@ func InstallWorkerd(ctx *app.Context, req *pb.InstallWorkerdRequest) (*pb.Instal
 		logger.Logger(ctx).Errorf("failed to get current working directory: %v, will install workerd in /usr/local/bin", err)
 	}
 
-	binPath, err := workersMgr.InstallWorkerd(ctx, req.GetDownloadUrl(), cwd)
+	binPath, err := workersMgr.InstallWorkerd(ctx, req, cwd)
 	if err != nil {
 		logger.Logger(ctx).Errorf("failed to install workerd: %v", err)
 		return nil, fmt.Errorf("failed to install workerd: %v", err)
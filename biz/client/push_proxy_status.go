@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// PushProxyStatus 上报当前 client 所有 frpc 实例中 proxy 的运行状态
+func PushProxyStatus(appInstance app.Application, clientID, clientSecret string) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+	ctrl := appInstance.GetClientController()
+	if ctrl == nil {
+		return nil
+	}
+
+	statuses := []*pb.ProxyWorkingStatus{}
+	for _, id := range ctrl.List() {
+		handlers := ctrl.GetByClient(id)
+		if handlers == nil {
+			continue
+		}
+		handlers.Range(func(serverID string, handler app.ClientHandler) bool {
+			namePrefix := ""
+			if user := handler.GetCommonCfg().User; len(user) > 0 {
+				namePrefix = user + "."
+			}
+			for name := range handler.GetProxyCfgs() {
+				workingStatus, ok := handler.GetProxyStatus(name)
+				if !ok {
+					continue
+				}
+				statuses = append(statuses, &pb.ProxyWorkingStatus{
+					Name:       lo.ToPtr(strings.TrimPrefix(name, namePrefix)),
+					Type:       lo.ToPtr(workingStatus.Type),
+					Status:     lo.ToPtr(workingStatus.Phase),
+					Err:        lo.ToPtr(workingStatus.Err),
+					RemoteAddr: lo.ToPtr(workingStatus.RemoteAddr),
+					ClientId:   lo.ToPtr(id),
+					ServerId:   lo.ToPtr(serverID),
+				})
+			}
+			return true
+		})
+	}
+
+	cli := appInstance.GetMasterCli()
+	resp, err := cli.Call().PushProxyStatus(ctx, &pb.PushProxyStatusReq{
+		Base: &pb.ClientBase{
+			ClientId:     clientID,
+			ClientSecret: clientSecret,
+		},
+		Statuses: statuses,
+	})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("cannot push proxy status")
+		return err
+	}
+	if resp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		logger.Logger(ctx).Errorf("push proxy status failed, resp: [%s]", resp.GetStatus().GetMessage())
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"runtime/debug"
 
+	"github.com/VaalaCat/frp-panel/biz/common"
 	"github.com/VaalaCat/frp-panel/conf"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -18,7 +19,7 @@ func HandleServerMessage(appInstance app.Application, req *pb.ServerMessage) *pb
 			fmt.Printf("\n--------------------\ncatch panic !!! \nhandle server message error: %v, stack: %s\n--------------------\n", err, debug.Stack())
 		}
 	}()
-	c := context.Background()
+	c := logger.WithTraceID(context.Background(), req.GetTraceId())
 	logger.Logger(c).Infof("client get a server message, clientId: [%s], event: [%s], sessionId: [%s]", req.GetClientId(), req.GetEvent().String(), req.GetSessionId())
 	switch req.Event {
 	case pb.Event_EVENT_UPDATE_FRPC:
@@ -45,8 +46,20 @@ func HandleServerMessage(appInstance app.Application, req *pb.ServerMessage) *pb
 		return app.WrapperServerMsg(appInstance, req, GetWorkerStatus)
 	case pb.Event_EVENT_INSTALL_WORKERD:
 		return app.WrapperServerMsg(appInstance, req, InstallWorkerd)
+	case pb.Event_EVENT_EXEC_COMMAND:
+		return app.WrapperServerMsg(appInstance, req, common.ExecCommand)
+	case pb.Event_EVENT_START_FILE_TRANSFER:
+		return app.WrapperServerMsg(appInstance, req, StartFileTransfer)
+	case pb.Event_EVENT_LIST_DIR:
+		return app.WrapperServerMsg(appInstance, req, common.ListDir)
+	case pb.Event_EVENT_QUERY_LOGS:
+		return app.WrapperServerMsg(appInstance, req, common.QueryLogs)
 	case pb.Event_EVENT_PING:
-		rawData, _ := proto.Marshal(conf.GetVersion().ToProto())
+		version := conf.GetVersion().ToProto()
+		if workersMgr := appInstance.GetWorkersManager(); workersMgr != nil {
+			version.WorkerdVersion = workersMgr.GetWorkerdVersion(app.NewContext(c, appInstance))
+		}
+		rawData, _ := proto.Marshal(version)
 		return &pb.ClientMessage{
 			Event: pb.Event_EVENT_PONG,
 			Data:  rawData,
@@ -28,9 +28,10 @@ func initStreamLog(ctx *app.Context, h app.StreamLogHookMgr) {
 		logger.Logger(ctx).Error(err)
 	}
 
-	h.AddStream(func(msg string) {
+	h.AddStream(func(msg, pkg string) {
 		handler.Send(&pb.PushClientStreamLogReq{
 			Log: []byte(utils.EncodeBase64(msg)),
+			Pkg: &pkg,
 			Base: &pb.ClientBase{
 				ClientId:     clientID,
 				ClientSecret: clientSecret,
@@ -0,0 +1,109 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/VaalaCat/frp-panel/utils/pty"
+	"github.com/samber/lo"
+)
+
+// ExecCommand 执行非交互命令，与远程终端使用相同的开关、用户和环境变量配置
+func ExecCommand(c *app.Context, req *pb.ExecCommandRequest) (*pb.ExecCommandResponse, error) {
+	cfg := c.GetApp().GetConfig()
+	// 本地开关，master 无法绕过
+	if !cfg.Client.Features.EnableRemoteShell {
+		logger.Logger(c).Warnf("remote shell is disabled on this node, refuse exec command")
+		return nil, fmt.Errorf("remote shell is disabled on this node")
+	}
+
+	if len(req.GetCommand()) == 0 {
+		return nil, fmt.Errorf("command is required")
+	}
+
+	timeout := time.Duration(req.GetTimeoutSeconds()) * time.Second
+	if timeout <= 0 {
+		timeout = defs.ExecDefaultTimeout
+	}
+	timeout = min(timeout, defs.ExecMaxTimeout)
+
+	ctx, cancel := context.WithTimeout(c, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, req.GetCommand(), req.GetArgs()...)
+	cmd.Dir = lo.Ternary(len(req.GetWorkDir()) > 0, req.GetWorkDir(), cfg.Client.PTY.WorkDir)
+	cmd.Env = filterEnv(os.Environ(), cfg.Client.PTY.EnvAllowlist)
+	for k, v := range req.GetEnv() {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.WaitDelay = time.Second
+
+	stdout := &limitedBuffer{limit: defs.ExecOutputMaxBytes}
+	stderr := &limitedBuffer{limit: defs.ExecOutputMaxBytes}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	result := &pb.ExecResult{
+		ClientId: lo.ToPtr(cfg.Client.ID),
+		ExitCode: lo.ToPtr(int32(-1)),
+	}
+
+	logger.Logger(c).Infof("exec command: [%s], args: %v, timeout: [%s]", req.GetCommand(), req.GetArgs(), timeout)
+
+	start := time.Now()
+	err := pty.SetCommandUser(cmd, cfg.Client.PTY.User)
+	if err == nil {
+		err = cmd.Run()
+	}
+	result.DurationMs = lo.ToPtr(time.Since(start).Milliseconds())
+
+	var exitErr *exec.ExitError
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		result.TimedOut = lo.ToPtr(true)
+		result.Error = lo.ToPtr(fmt.Sprintf("command timed out after %s", timeout))
+	case err == nil:
+		result.ExitCode = lo.ToPtr(int32(0))
+	case errors.As(err, &exitErr):
+		result.ExitCode = lo.ToPtr(int32(exitErr.ExitCode()))
+	default:
+		result.Error = lo.ToPtr(err.Error())
+	}
+
+	result.Stdout = stdout.Bytes()
+	result.Stderr = stderr.Bytes()
+	result.StdoutTruncated = lo.ToPtr(stdout.truncated)
+	result.StderrTruncated = lo.ToPtr(stderr.truncated)
+
+	return &pb.ExecCommandResponse{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Results: []*pb.ExecResult{result},
+	}, nil
+}
+
+// limitedBuffer 超过 limit 的输出直接丢弃，避免命令输出过多撑爆内存
+type limitedBuffer struct {
+	bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remain := b.limit - b.Len(); remain < len(p) {
+		b.truncated = true
+		if remain > 0 {
+			b.Buffer.Write(p[:remain])
+		}
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
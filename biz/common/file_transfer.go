@@ -0,0 +1,412 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// StartFileTransfer 建立文件传输的 stream，建立成功后在后台收发文件内容
+// 下载时返回文件大小，sha256 随最后一条消息返回，上传时返回已接收的大小
+func StartFileTransfer(c *app.Context, req *pb.StartFileTransferRequest, initMsg *pb.FileTransferClientMessage) (*pb.StartFileTransferResponse, error) {
+	allowPaths := c.GetApp().GetConfig().Client.FileTransfer.AllowPaths
+	if len(allowPaths) == 0 {
+		logger.Logger(c).Warnf("file transfer is disabled on this node, refuse transfer")
+		return nil, fmt.Errorf("file transfer is disabled on this node")
+	}
+
+	transferID := req.GetTransferId()
+	if _, err := uuid.Parse(transferID); err != nil {
+		return nil, fmt.Errorf("invalid transfer id: [%s]", transferID)
+	}
+
+	var (
+		resp   *pb.StartFileTransferResponse
+		handle func(conn pb.Master_FileTransferClient)
+		err    error
+	)
+	switch req.GetOp() {
+	case pb.StartFileTransferRequest_OP_DOWNLOAD:
+		resp, handle, err = prepareDownload(c, allowPaths, req)
+	case pb.StartFileTransferRequest_OP_UPLOAD:
+		resp, handle, err = prepareUpload(c, allowPaths, req)
+	default:
+		err = fmt.Errorf("unknown file transfer op: [%s]", req.GetOp().String())
+	}
+	if err != nil {
+		logger.Logger(c).WithError(err).Warnf("prepare file transfer failed, path: [%s]", req.GetPath())
+		return nil, err
+	}
+
+	conn, err := c.GetApp().GetClientRPCHandler().GetCli().Call().FileTransfer(c)
+	if err != nil {
+		logger.Logger(c).WithError(err).Infof("rpc connect master error")
+		handle(nil)
+		return nil, err
+	}
+
+	initMsg.TransferId = transferID
+	if err := conn.Send(initMsg); err != nil {
+		logger.Logger(c).WithError(err).Infof("send client base error")
+		handle(nil)
+		return nil, err
+	}
+
+	ack, err := conn.Recv()
+	if err != nil || string(ack.GetData()) != "ok" {
+		logger.Logger(c).WithError(err).Infof("recv ack error")
+		handle(nil)
+		return nil, fmt.Errorf("ack error")
+	}
+
+	logger.Logger(c).Infof("start file transfer, transfer id: [%s], op: [%s], path: [%s], offset: [%d]",
+		transferID, req.GetOp().String(), req.GetPath(), req.GetOffset())
+
+	go handle(conn)
+
+	return resp, nil
+}
+
+// prepareDownload 打开文件并返回文件大小，sha256 在发送文件内容时计算，随最后一条消息返回，handle 传入 nil 时只释放文件
+func prepareDownload(c *app.Context, allowPaths []string, req *pb.StartFileTransferRequest) (*pb.StartFileTransferResponse, func(pb.Master_FileTransferClient), error) {
+	path, err := resolveTransferPath(allowPaths, req.GetPath(), true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	if info.IsDir() {
+		f.Close()
+		return nil, nil, fmt.Errorf("path [%s] is a directory", req.GetPath())
+	}
+
+	size := info.Size()
+	offset := req.GetOffset()
+	if offset < 0 || (offset > 0 && offset >= size) {
+		f.Close()
+		return nil, nil, fmt.Errorf("invalid offset [%d], file size: [%d]", offset, size)
+	}
+
+	handle := func(conn pb.Master_FileTransferClient) {
+		defer f.Close()
+		if conn == nil {
+			return
+		}
+		defer conn.CloseSend()
+
+		transferID := req.GetTransferId()
+		failed := func(err error) {
+			logger.Logger(c).WithError(err).Errorf("read file failed, path: [%s]", path)
+			conn.Send(&pb.FileTransferClientMessage{TransferId: transferID, Error: lo.ToPtr(err.Error())})
+		}
+
+		// 续传时 offset 之前的内容不需要发送，只计算 sha256
+		hash := sha256.New()
+		if _, err := io.CopyN(hash, f, offset); err != nil {
+			failed(err)
+			return
+		}
+
+		buf := make([]byte, defs.FileTransferChunkSize)
+		cur := offset
+		for {
+			n, err := f.Read(buf)
+			if n > 0 {
+				hash.Write(buf[:n])
+				if sendErr := conn.Send(&pb.FileTransferClientMessage{
+					TransferId: transferID,
+					Data:       buf[:n],
+					Offset:     cur,
+				}); sendErr != nil {
+					logger.Logger(c).WithError(sendErr).Warnf("send file data failed, transfer id: [%s]", transferID)
+					return
+				}
+				cur += int64(n)
+			}
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				failed(err)
+				return
+			}
+		}
+
+		conn.Send(&pb.FileTransferClientMessage{
+			TransferId: transferID,
+			Done:       true,
+			Offset:     cur,
+			Size:       lo.ToPtr(cur),
+			Sha256:     lo.ToPtr(hex.EncodeToString(hash.Sum(nil))),
+		})
+		logger.Logger(c).Infof("file download finished, transfer id: [%s], path: [%s], sent: [%d] bytes", transferID, path, cur-offset)
+	}
+
+	return &pb.StartFileTransferResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Size:   lo.ToPtr(size),
+	}, handle, nil
+}
+
+// prepareUpload 先写入临时文件，offset 必须等于临时文件当前大小，全部收到并校验通过后再替换目标文件
+func prepareUpload(c *app.Context, allowPaths []string, req *pb.StartFileTransferRequest) (*pb.StartFileTransferResponse, func(pb.Master_FileTransferClient), error) {
+	path, err := resolveTransferPath(allowPaths, req.GetPath(), false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return nil, nil, fmt.Errorf("path [%s] is a directory", req.GetPath())
+	}
+
+	partPath := path + defs.FileTransferPartSuffix
+	offset := req.GetOffset()
+
+	f, err := openPartFile(partPath, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	if info.Size() != offset {
+		f.Close()
+		return nil, nil, fmt.Errorf("offset [%d] does not match uploaded size [%d]", offset, info.Size())
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+
+	handle := func(conn pb.Master_FileTransferClient) {
+		if conn == nil {
+			f.Close()
+			return
+		}
+		defer conn.CloseSend()
+
+		transferID := req.GetTransferId()
+		failed := func(err error) {
+			logger.Logger(c).WithError(err).Warnf("file upload failed, transfer id: [%s], path: [%s]", transferID, path)
+			conn.Send(&pb.FileTransferClientMessage{TransferId: transferID, Error: lo.ToPtr(err.Error())})
+		}
+
+		// 中断时保留临时文件，用于下次续传
+		cur := offset
+		for {
+			msg, err := conn.Recv()
+			if err != nil {
+				f.Close()
+				logger.Logger(c).WithError(err).Infof("file upload interrupted, transfer id: [%s], received: [%d] bytes", transferID, cur)
+				return
+			}
+			if len(msg.GetError()) > 0 {
+				f.Close()
+				logger.Logger(c).Infof("file upload canceled by master, transfer id: [%s], reason: [%s]", transferID, msg.GetError())
+				return
+			}
+			if len(msg.GetData()) > 0 {
+				if msg.GetOffset() != cur {
+					f.Close()
+					failed(fmt.Errorf("unexpected offset [%d], want [%d]", msg.GetOffset(), cur))
+					return
+				}
+				n, err := f.Write(msg.GetData())
+				cur += int64(n)
+				if err != nil {
+					f.Close()
+					failed(err)
+					return
+				}
+			}
+			if msg.GetDone() {
+				break
+			}
+		}
+
+		// 从已打开的文件计算 sha256，不再按路径重新打开临时文件
+		sum, size, err := fileSHA256(f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			failed(err)
+			return
+		}
+		if expect := strings.ToLower(req.GetSha256()); len(expect) > 0 && expect != sum {
+			os.Remove(partPath)
+			failed(fmt.Errorf("sha256 mismatch, expect: [%s], got: [%s]", expect, sum))
+			return
+		}
+		if err := os.Rename(partPath, path); err != nil {
+			failed(err)
+			return
+		}
+
+		conn.Send(&pb.FileTransferClientMessage{
+			TransferId: transferID,
+			Done:       true,
+			Offset:     cur,
+			Size:       lo.ToPtr(size),
+			Sha256:     lo.ToPtr(sum),
+		})
+		logger.Logger(c).Infof("file upload finished, transfer id: [%s], path: [%s], size: [%d]", transferID, path, size)
+	}
+
+	return &pb.StartFileTransferResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Size:   lo.ToPtr(offset),
+	}, handle, nil
+}
+
+// ListDir 列出目录内容，path 为空时返回允许访问的目录
+func ListDir(c *app.Context, req *pb.ListDirRequest) (*pb.ListDirResponse, error) {
+	allowPaths := c.GetApp().GetConfig().Client.FileTransfer.AllowPaths
+	if len(allowPaths) == 0 {
+		return nil, fmt.Errorf("file transfer is disabled on this node")
+	}
+
+	if len(req.GetPath()) == 0 {
+		files := make([]*pb.FileInfo, 0, len(allowPaths))
+		for _, p := range allowPaths {
+			info, err := os.Stat(p)
+			if err != nil {
+				continue
+			}
+			files = append(files, toPBFileInfo(filepath.Clean(p), info))
+		}
+		return &pb.ListDirResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+			Files:  files,
+		}, nil
+	}
+
+	path, err := resolveTransferPath(allowPaths, req.GetPath(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*pb.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, toPBFileInfo(entry.Name(), info))
+	}
+
+	return &pb.ListDirResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Files:  files,
+	}, nil
+}
+
+// resolveTransferPath 解析符号链接后检查路径是否在允许的目录内
+// 上传时目标文件可能不存在，只解析其所在目录
+func resolveTransferPath(allowPaths []string, path string, mustExist bool) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("path [%s] must be absolute", path)
+	}
+
+	path = filepath.Clean(path)
+	var (
+		resolved string
+		err      error
+	)
+	if mustExist {
+		resolved, err = filepath.EvalSymlinks(path)
+	} else {
+		resolved, err = filepath.EvalSymlinks(filepath.Dir(path))
+		resolved = filepath.Join(resolved, filepath.Base(path))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	for _, allow := range allowPaths {
+		root, err := filepath.EvalSymlinks(filepath.Clean(allow))
+		if err != nil {
+			continue
+		}
+		if isSubPath(root, resolved) {
+			return resolved, nil
+		}
+	}
+	return "", fmt.Errorf("path [%s] is not allowed", path)
+}
+
+func isSubPath(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func fileSHA256(f *os.File) (string, int64, error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", 0, err
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// openPartFile 打开上传的临时文件，临时文件是符号链接或不是普通文件时拒绝，避免写到允许的目录之外
+func openPartFile(partPath string, offset int64) (*os.File, error) {
+	info, err := os.Lstat(partPath)
+	switch {
+	case err == nil && !info.Mode().IsRegular():
+		return nil, fmt.Errorf("temp file [%s] is not a regular file", partPath)
+	case err != nil && !os.IsNotExist(err):
+		return nil, err
+	case err != nil && offset > 0:
+		return nil, fmt.Errorf("no unfinished upload for [%s] to resume", partPath)
+	}
+
+	flag := os.O_RDWR | os.O_CREATE | openNoFollow
+	if offset == 0 {
+		flag |= os.O_TRUNC
+	}
+	return os.OpenFile(partPath, flag, 0644)
+}
+
+func toPBFileInfo(name string, info os.FileInfo) *pb.FileInfo {
+	return &pb.FileInfo{
+		Name:    lo.ToPtr(name),
+		Size:    lo.ToPtr(info.Size()),
+		Mode:    lo.ToPtr(info.Mode().String()),
+		ModTime: lo.ToPtr(info.ModTime().UnixMilli()),
+		IsDir:   lo.ToPtr(info.IsDir()),
+	}
+}
@@ -0,0 +1,104 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveTransferPath(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks need extra privileges on windows")
+	}
+
+	root := t.TempDir()
+	allow := filepath.Join(root, "allow")
+	outside := filepath.Join(root, "outside")
+	assert.NoError(t, os.MkdirAll(filepath.Join(allow, "sub"), 0o755))
+	assert.NoError(t, os.MkdirAll(outside, 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(allow, "sub", "a.txt"), []byte("a"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(outside, "secret.txt"), []byte("s"), 0o644))
+	assert.NoError(t, os.Symlink(filepath.Join(outside, "secret.txt"), filepath.Join(allow, "file-link")))
+	assert.NoError(t, os.Symlink(outside, filepath.Join(allow, "dir-link")))
+	assert.NoError(t, os.Symlink(filepath.Join(allow, "sub"), filepath.Join(allow, "inner-link")))
+
+	allowPaths := []string{allow}
+
+	tests := []struct {
+		name      string
+		path      string
+		mustExist bool
+		want      string
+		wantErr   bool
+	}{
+		{name: "file in allowed dir", path: filepath.Join(allow, "sub", "a.txt"), mustExist: true, want: filepath.Join(allow, "sub", "a.txt")},
+		{name: "allowed dir itself", path: allow, mustExist: true, want: allow},
+		{name: "relative path", path: "sub/a.txt", mustExist: true, wantErr: true},
+		{name: "traversal", path: filepath.Join(allow, "..", "outside", "secret.txt"), mustExist: true, wantErr: true},
+		{name: "traversal in upload", path: allow + "/sub/../../outside/new.txt", wantErr: true},
+		{name: "sibling with same prefix", path: allow + "-other/a.txt", wantErr: true},
+		{name: "symlink file escape", path: filepath.Join(allow, "file-link"), mustExist: true, wantErr: true},
+		{name: "symlink dir escape", path: filepath.Join(allow, "dir-link", "secret.txt"), mustExist: true, wantErr: true},
+		{name: "symlink dir escape in upload", path: filepath.Join(allow, "dir-link", "new.txt"), wantErr: true},
+		{name: "symlink inside allowed dir", path: filepath.Join(allow, "inner-link", "a.txt"), mustExist: true, want: filepath.Join(allow, "sub", "a.txt")},
+		{name: "new file in upload", path: filepath.Join(allow, "sub", "new.txt"), want: filepath.Join(allow, "sub", "new.txt")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveTransferPath(allowPaths, tt.path, tt.mustExist)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			want, _ := filepath.EvalSymlinks(filepath.Dir(tt.want))
+			assert.Equal(t, filepath.Join(want, filepath.Base(tt.want)), got)
+		})
+	}
+}
+
+func TestOpenPartFileRejectsSymlink(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks need extra privileges on windows")
+	}
+
+	dir := t.TempDir()
+	target := filepath.Join(dir, "target")
+	assert.NoError(t, os.WriteFile(target, []byte("keep"), 0o644))
+
+	partPath := filepath.Join(dir, "upload.part")
+	assert.NoError(t, os.Symlink(target, partPath))
+
+	_, err := openPartFile(partPath, 0)
+	assert.Error(t, err)
+
+	content, err := os.ReadFile(target)
+	assert.NoError(t, err)
+	assert.Equal(t, "keep", string(content))
+}
+
+func TestOpenPartFileResume(t *testing.T) {
+	partPath := filepath.Join(t.TempDir(), "upload.part")
+
+	_, err := openPartFile(partPath, 4)
+	assert.Error(t, err)
+
+	f, err := openPartFile(partPath, 0)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte("data"))
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	f, err = openPartFile(partPath, 4)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	sum, size, err := fileSHA256(f)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), size)
+	assert.Equal(t, "3a6eb0790f39ac87c94f3856b2dd2c5d110e6811602261a9a923d3bb23adc8b7", sum)
+}
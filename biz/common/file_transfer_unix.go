@@ -0,0 +1,8 @@
+//go:build !windows
+
+package common
+
+import "syscall"
+
+// 打开上传临时文件时不跟随符号链接
+const openNoFollow = syscall.O_NOFOLLOW
@@ -0,0 +1,6 @@
+//go:build windows
+
+package common
+
+// windows 没有 O_NOFOLLOW，只依赖打开前的 Lstat 检查
+const openNoFollow = 0
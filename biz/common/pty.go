@@ -3,16 +3,26 @@ package common
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/VaalaCat/frp-panel/utils/pty"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sourcegraph/conc"
 )
 
 func StartPTYConnect(c *app.Context, req *pb.CommonRequest, initMsg *pb.PTYClientMessage) (*pb.CommonResponse, error) {
+	// 本地开关，master 无法绕过
+	if !c.GetApp().GetConfig().Client.Features.EnableRemoteShell {
+		logger.Logger(c).Warnf("remote shell is disabled on this node, refuse pty connect")
+		return nil, fmt.Errorf("remote shell is disabled on this node")
+	}
+
 	conn, err := c.GetApp().GetClientRPCHandler().GetCli().Call().PTYConnect(c)
 	if err != nil {
 		logger.Logger(c).WithError(err).Infof("rpc connect master error")
@@ -38,18 +48,28 @@ func StartPTYConnect(c *app.Context, req *pb.CommonRequest, initMsg *pb.PTYClien
 		return nil, fmt.Errorf("ack error")
 	}
 
+	ptyCfg := c.GetApp().GetConfig().Client.PTY
+	opt := pty.Options{
+		Shell:   ptyCfg.Shell,
+		User:    ptyCfg.User,
+		WorkDir: ptyCfg.WorkDir,
+		Env:     filterEnv(os.Environ(), ptyCfg.EnvAllowlist),
+	}
+	idleTimeout := time.Duration(ptyCfg.IdleTimeoutSeconds) * time.Second
+
 	go func() {
-		HandlePTY(c, conn, sessionID)
+		HandlePTY(c, conn, sessionID, opt, idleTimeout)
 	}()
 
 	return &pb.CommonResponse{Data: &sessionID}, nil
 }
 
-func HandlePTY(c context.Context, conn pb.Master_PTYConnectClient, sessionID string) {
+// HandlePTY idleTimeout 大于 0 时，超过该时间没有收到输入就关闭终端
+func HandlePTY(c context.Context, conn pb.Master_PTYConnectClient, sessionID string, opt pty.Options, idleTimeout time.Duration) {
 	connectionErrorLimit := 10
 	maxBufferSizeBytes := 4096
 
-	ptyInstace, err := pty.Start()
+	ptyInstace, err := pty.Start(opt)
 	if err != nil {
 		msg := fmt.Sprintf("failed to start tty: %s", err)
 		logger.Logger(c).WithError(err).Warn(msg)
@@ -70,6 +90,22 @@ func HandlePTY(c context.Context, conn pb.Master_PTYConnectClient, sessionID str
 	var connectionClosed bool
 	var wg conc.WaitGroup
 
+	// 空闲超时只关闭终端，提示由读取终端的协程发送，stream 上的 Send 和 CloseSend 都只在该协程中调用
+	idleCtx, idleCancel := context.WithCancel(context.Background())
+	defer idleCancel()
+
+	var idleTimer *time.Timer
+	if idleTimeout > 0 {
+		idleTimer = time.AfterFunc(idleTimeout, func() {
+			logger.Logger(c).Infof("pty session [%s] idle for %s, closing...", sessionID, idleTimeout)
+			idleCancel()
+			if err := ptyInstace.Close(); err != nil {
+				logger.Logger(c).Warnf("failed to kill process: %s", err)
+			}
+		})
+		defer idleTimer.Stop()
+	}
+
 	// tty >> master
 	wg.Go(func() {
 		errorCounter := 0
@@ -81,7 +117,11 @@ func HandlePTY(c context.Context, conn pb.Master_PTYConnectClient, sessionID str
 			readLength, err := ptyInstace.Read(buffer)
 			if err != nil {
 				logger.Logger(c).Warnf("failed to read from tty: %s", err)
-				if err := conn.Send(&pb.PTYClientMessage{Data: []byte("bye!"), SessionId: sessionID}); err != nil {
+				bye := []byte("bye!")
+				if idleCtx.Err() != nil {
+					bye = []byte("\r\nidle timeout, bye!\r\n")
+				}
+				if err := conn.Send(&pb.PTYClientMessage{Data: bye, SessionId: sessionID}); err != nil {
 					logger.Logger(c).Warnf("failed to send termination message from tty to master: %s", err)
 				}
 				if err := conn.CloseSend(); err != nil {
@@ -100,7 +140,7 @@ func HandlePTY(c context.Context, conn pb.Master_PTYConnectClient, sessionID str
 		}
 	})
 
-	// tty << master
+	// tty << master，结束时只关闭终端，读取终端的协程随之退出并关闭 stream
 	wg.Go(func() {
 		for {
 			// data processing
@@ -109,15 +149,12 @@ func HandlePTY(c context.Context, conn pb.Master_PTYConnectClient, sessionID str
 				if !connectionClosed {
 					logger.Logger(c).Warnf("failed to get next reader: %s", err)
 				}
-				if err := conn.CloseSend(); err != nil {
-					logger.Logger(c).Warnf("failed to close grpc stream connection: %s", err)
+				if err := ptyInstace.Close(); err != nil {
+					logger.Logger(c).Warnf("failed to kill process: %s", err)
 				}
 				return
 			}
 			if msg.GetDone() {
-				if err := conn.CloseSend(); err != nil {
-					logger.Logger(c).Warnf("failed to close grpc stream connection: %s", err)
-				}
 				logger.Logger(c).Info("gracefully stopping spawned tty...")
 				if err := ptyInstace.Close(); err != nil {
 					logger.Logger(c).Warnf("failed to kill process: %s", err)
@@ -126,6 +163,10 @@ func HandlePTY(c context.Context, conn pb.Master_PTYConnectClient, sessionID str
 				return
 			}
 			data := msg.GetData()
+			// 只有真实的输入才算活跃，窗口大小变化不重置空闲计时
+			if idleTimer != nil && len(data) > 0 {
+				idleTimer.Reset(idleTimeout)
+			}
 
 			// handle resizing
 			if msg.Height != nil && msg.Width != nil {
@@ -150,3 +191,17 @@ func HandlePTY(c context.Context, conn pb.Master_PTYConnectClient, sessionID str
 	logger.Logger(c).Info("closing conn...")
 	connectionClosed = true
 }
+
+// filterEnv 只保留白名单中的环境变量，以 * 结尾的规则按前缀匹配
+func filterEnv(environ []string, allowlist []string) []string {
+	return lo.Filter(environ, func(kv string, _ int) bool {
+		key, _, _ := strings.Cut(kv, "=")
+		return lo.ContainsBy(allowlist, func(rule string) bool {
+			rule = strings.TrimSpace(rule)
+			if prefix, ok := strings.CutSuffix(rule, "*"); ok {
+				return strings.HasPrefix(key, prefix)
+			}
+			return key == rule
+		})
+	})
+}
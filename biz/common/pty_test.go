@@ -0,0 +1,82 @@
+package common
+
+import (
+	"context"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/utils/pty"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// fakePTYStream 模拟 master 的 pty stream，检测是否有多个协程同时发送
+type fakePTYStream struct {
+	grpc.ClientStream
+
+	sending    sync.Mutex
+	mu         sync.Mutex
+	sent       []string
+	concurrent bool
+	recv       chan *pb.PTYServerMessage
+	closeOnce  sync.Once
+}
+
+func (s *fakePTYStream) Send(msg *pb.PTYClientMessage) error {
+	if !s.sending.TryLock() {
+		s.mu.Lock()
+		s.concurrent = true
+		s.mu.Unlock()
+		return nil
+	}
+	defer s.sending.Unlock()
+	time.Sleep(time.Millisecond)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, string(msg.GetData()))
+	return nil
+}
+
+func (s *fakePTYStream) Recv() (*pb.PTYServerMessage, error) {
+	msg, ok := <-s.recv
+	if !ok {
+		return nil, io.EOF
+	}
+	return msg, nil
+}
+
+func (s *fakePTYStream) CloseSend() error {
+	s.closeOnce.Do(func() { close(s.recv) })
+	return nil
+}
+
+func TestHandlePTYIdleTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("pty on windows needs conpty or winpty")
+	}
+
+	stream := &fakePTYStream{recv: make(chan *pb.PTYServerMessage)}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		HandlePTY(context.Background(), stream, "session", pty.Options{Shell: "sh"}, 200*time.Millisecond)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("pty session is not closed after idle timeout")
+	}
+
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	assert.False(t, stream.concurrent, "stream is sent from multiple goroutines")
+	assert.NotEmpty(t, stream.sent)
+	assert.True(t, strings.Contains(stream.sent[len(stream.sent)-1], "idle timeout"), "last message: %q", stream.sent[len(stream.sent)-1])
+}
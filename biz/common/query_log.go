@@ -0,0 +1,63 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// QueryLogs 查询本地落盘的日志，见 logger.EnableFileLog
+func QueryLogs(c *app.Context, req *pb.QueryLogsRequest) (*pb.QueryLogsResponse, error) {
+	q := logger.LogQuery{
+		Level:   logrus.TraceLevel,
+		Pkgs:    req.GetPkgs(),
+		Keyword: req.GetKeyword(),
+		Limit:   int(req.GetLimit()),
+	}
+	if req.GetStartTime() > 0 {
+		q.Start = time.UnixMilli(req.GetStartTime())
+	}
+	if req.GetEndTime() > 0 {
+		q.End = time.UnixMilli(req.GetEndTime())
+	}
+	if len(req.GetLevel()) > 0 {
+		lv, err := logrus.ParseLevel(req.GetLevel())
+		if err != nil {
+			return nil, fmt.Errorf("invalid level: [%s]", req.GetLevel())
+		}
+		q.Level = lv
+	}
+	if q.Limit <= 0 {
+		q.Limit = defs.LogQueryDefaultLimit
+	}
+	q.Limit = min(q.Limit, defs.LogQueryMaxLimit)
+
+	records, truncated, err := logger.QueryFileLog(q)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*pb.LogEntry, 0, len(records))
+	for _, r := range records {
+		entries = append(entries, &pb.LogEntry{
+			Time:    lo.ToPtr(r.Time.UnixMilli()),
+			Level:   lo.ToPtr(r.Level),
+			Pkg:     lo.ToPtr(r.Pkg),
+			Caller:  lo.ToPtr(r.Caller),
+			Message: lo.ToPtr(r.Message),
+			Fields:  r.Fields,
+		})
+	}
+
+	return &pb.QueryLogsResponse{
+		Status:    &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Entries:   entries,
+		Truncated: lo.ToPtr(truncated),
+	}, nil
+}
@@ -25,10 +25,21 @@ func (h *HookMgr) Close() {
 		h.hook.Close()
 		h.hook = nil
 	}
-	logger.Instance().ReplaceHooks(logrus.LevelHooks{})
+
+	// 只移除实时日志的 hook，落盘等其他 hook 需要保留
+	hooks := logrus.LevelHooks{}
+	for level, levelHooks := range logger.Instance().Hooks {
+		for _, hook := range levelHooks {
+			if _, ok := hook.(*logger.StreamLogHook); ok {
+				continue
+			}
+			hooks[level] = append(hooks[level], hook)
+		}
+	}
+	logger.Instance().ReplaceHooks(hooks)
 }
 
-func (h *HookMgr) AddStream(send func(msg string), closeSend func()) {
+func (h *HookMgr) AddStream(send func(msg, pkg string), closeSend func()) {
 	if h.Mutex == nil {
 		h.Mutex = &sync.Mutex{}
 	}
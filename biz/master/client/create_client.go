@@ -1,6 +1,7 @@
 package client
 
 import (
+	"github.com/VaalaCat/frp-panel/biz/master/notify"
 	"github.com/VaalaCat/frp-panel/common"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
@@ -43,6 +44,11 @@ func InitClientHandler(c *app.Context, req *pb.InitClientRequest) (*pb.InitClien
 		return &pb.InitClientResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()}}, err
 	}
 
+	// frpp client 使用 join token 加入时创建的是 ephemeral client
+	if req.GetEphemeral() {
+		notify.ClientJoined(c, userInfo, globalClientID)
+	}
+
 	return &pb.InitClientResponse{
 		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 		ClientId: &globalClientID,
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
@@ -29,6 +30,11 @@ func DeleteClientHandler(ctx *app.Context, req *pb.DeleteClientRequest) (*pb.Del
 		}, nil
 	}
 
+	childClientIDs, err := dao.NewQuery(ctx).AdminGetClientIDsInShadowByClientID(clientID)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := dao.NewQuery(ctx).DeleteClient(userInfo, clientID); err != nil {
 		return nil, err
 	}
@@ -37,6 +43,31 @@ func DeleteClientHandler(ctx *app.Context, req *pb.DeleteClientRequest) (*pb.Del
 		return nil, err
 	}
 
+	if err := dao.NewQuery(ctx).DeleteVisitorConfigsByClientIDOrOriginClientID(userInfo, clientID); err != nil {
+		return nil, err
+	}
+
+	if err := dao.NewQuery(ctx).DeleteProxyWorkingStatusByClientIDOrOriginClientID(userInfo, clientID); err != nil {
+		return nil, err
+	}
+
+	if err := dao.NewQuery(ctx).DeleteProxyProbesByClientIDs(userInfo, append(childClientIDs, clientID)); err != nil {
+		return nil, err
+	}
+
+	// 调度器中残留的任务执行时找不到定时配置会自行移除
+	if err := dao.NewQuery(ctx).DeleteProxySchedulesByClientIDs(userInfo, append(childClientIDs, clientID)); err != nil {
+		return nil, err
+	}
+
+	if err := dao.NewQuery(ctx).DeleteLabelsByResourceIDs(userInfo, defs.LabelResourceClient, []string{clientID}); err != nil {
+		return nil, err
+	}
+
+	if err := dao.NewQuery(ctx).DeleteLabelsByResourceIDs(userInfo, defs.LabelResourceProxy, append(childClientIDs, clientID)); err != nil {
+		return nil, err
+	}
+
 	go func() {
 		resp, err := rpc.CallClient(app.NewContext(context.Background(), ctx.GetApp()), req.GetClientId(), pb.Event_EVENT_REMOVE_FRPC, req)
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -49,14 +50,15 @@ func GetClientHandler(ctx *app.Context, req *pb.GetClientRequest) (*pb.GetClient
 		}
 
 		respCli = &pb.Client{
-			Id:        lo.ToPtr(client.ClientID),
-			Secret:    lo.ToPtr(client.ConnectSecret),
-			Config:    lo.ToPtr(string(client.ConfigContent)),
-			ServerId:  lo.ToPtr(client.ServerID),
-			Stopped:   lo.ToPtr(client.Stopped),
-			Comment:   lo.ToPtr(client.Comment),
-			ClientIds: clientIDs,
-			Ephemeral: &client.Ephemeral,
+			Id:          lo.ToPtr(client.ClientID),
+			Secret:      lo.ToPtr(client.ConnectSecret),
+			Config:      lo.ToPtr(string(client.ConfigContent)),
+			ServerId:    lo.ToPtr(client.ServerID),
+			Stopped:     lo.ToPtr(client.Stopped),
+			Comment:     lo.ToPtr(client.Comment),
+			ClientIds:   clientIDs,
+			Ephemeral:   &client.Ephemeral,
+			PtyDisabled: &client.PTYDisabled,
 			// LastSeenAt: lo.ToPtr(client.LastSeenAt.UnixMilli()),
 		}
 	} else {
@@ -75,21 +77,28 @@ func GetClientHandler(ctx *app.Context, req *pb.GetClientRequest) (*pb.GetClient
 		}
 
 		respCli = &pb.Client{
-			Id:        lo.ToPtr(client.ClientID),
-			Secret:    lo.ToPtr(client.ConnectSecret),
-			Config:    lo.ToPtr(string(client.ConfigContent)),
-			ServerId:  lo.ToPtr(client.ServerID),
-			Stopped:   lo.ToPtr(client.Stopped),
-			Comment:   lo.ToPtr(client.Comment),
-			FrpsUrl:   lo.ToPtr(client.FrpsUrl),
-			Ephemeral: &client.Ephemeral,
-			ClientIds: nil,
+			Id:          lo.ToPtr(client.ClientID),
+			Secret:      lo.ToPtr(client.ConnectSecret),
+			Config:      lo.ToPtr(string(client.ConfigContent)),
+			ServerId:    lo.ToPtr(client.ServerID),
+			Stopped:     lo.ToPtr(client.Stopped),
+			Comment:     lo.ToPtr(client.Comment),
+			FrpsUrl:     lo.ToPtr(client.FrpsUrl),
+			Ephemeral:   &client.Ephemeral,
+			PtyDisabled: &client.PTYDisabled,
+			ClientIds:   nil,
 		}
 		if client.LastSeenAt != nil {
 			respCli.LastSeenAt = lo.ToPtr(client.LastSeenAt.UnixMilli())
 		}
 	}
 
+	if labels, err := dao.NewQuery(ctx).GetLabels(userInfo, defs.LabelResourceClient, models.LabelResourceRef{ID: clientID}); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get client labels, id: [%s]", clientID)
+	} else {
+		respCli.Labels = labels
+	}
+
 	return &pb.GetClientResponse{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 		Client: respCli,
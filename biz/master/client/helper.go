@@ -57,6 +57,11 @@ func MakeClientShadowed(c *app.Context, serverID string, clientEntity *models.Cl
 			logger.Logger(c).WithError(err).Errorf("cannot rebuild proxy config from client, id: [%s]", childClient.ClientID)
 			return nil, err
 		}
+
+		if err := dao.NewQuery(c).RebuildVisitorConfigFromClient(userInfo, &models.Client{ClientEntity: childClient}); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot rebuild visitor config from client, id: [%s]", childClient.ClientID)
+			return nil, err
+		}
 	}
 
 	clientEntity.IsShadow = true
@@ -72,6 +77,11 @@ func MakeClientShadowed(c *app.Context, serverID string, clientEntity *models.Cl
 		return nil, err
 	}
 
+	if err := dao.NewQuery(c).DeleteVisitorConfigsByClientID(userInfo, clientID); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot delete visitor configs, id: [%s]", clientID)
+		return nil, err
+	}
+
 	return childClient, nil
 }
 
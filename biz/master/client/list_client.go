@@ -2,6 +2,7 @@ package client
 
 import (
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -33,9 +34,17 @@ func ListClientsHandler(ctx *app.Context, req *pb.ListClientsRequest) (*pb.ListC
 		hasKeyword   = len(keyword) > 0
 	)
 
-	if hasKeyword {
+	selector, err := models.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !selector.Empty():
+		clients, err = dao.NewQuery(ctx).ListClientsWithLabelSelector(userInfo, page, pageSize, keyword, selector)
+	case hasKeyword:
 		clients, err = dao.NewQuery(ctx).ListClientsWithKeyword(userInfo, page, pageSize, keyword)
-	} else {
+	default:
 		clients, err = dao.NewQuery(ctx).ListClients(userInfo, page, pageSize)
 	}
 
@@ -43,9 +52,12 @@ func ListClientsHandler(ctx *app.Context, req *pb.ListClientsRequest) (*pb.ListC
 		return nil, err
 	}
 
-	if hasKeyword {
+	switch {
+	case !selector.Empty():
+		clientCounts, err = dao.NewQuery(ctx).CountClientsWithLabelSelector(userInfo, keyword, selector)
+	case hasKeyword:
 		clientCounts, err = dao.NewQuery(ctx).CountClientsWithKeyword(userInfo, keyword)
-	} else {
+	default:
 		clientCounts, err = dao.NewQuery(ctx).CountClients(userInfo)
 	}
 
@@ -53,6 +65,14 @@ func ListClientsHandler(ctx *app.Context, req *pb.ListClientsRequest) (*pb.ListC
 		return nil, err
 	}
 
+	labels, err := dao.NewQuery(ctx).GetLabelsByResources(userInfo, defs.LabelResourceClient,
+		lo.Map(clients, func(c *models.ClientEntity, _ int) models.LabelResourceRef {
+			return models.LabelResourceRef{ID: c.ClientID}
+		}))
+	if err != nil {
+		return nil, err
+	}
+
 	respClients := lo.Map(clients, func(c *models.ClientEntity, _ int) *pb.Client {
 		clientIDs, err := dao.NewQuery(ctx).GetClientIDsInShadowByClientID(userInfo, c.ClientID)
 		if err != nil {
@@ -60,14 +80,16 @@ func ListClientsHandler(ctx *app.Context, req *pb.ListClientsRequest) (*pb.ListC
 		}
 
 		respCli := &pb.Client{
-			Id:        lo.ToPtr(c.ClientID),
-			Secret:    lo.ToPtr(c.ConnectSecret),
-			Config:    lo.ToPtr(string(c.ConfigContent)),
-			ServerId:  lo.ToPtr(c.ServerID),
-			Stopped:   lo.ToPtr(c.Stopped),
-			Comment:   lo.ToPtr(c.Comment),
-			ClientIds: clientIDs,
-			Ephemeral: lo.ToPtr(c.Ephemeral),
+			Id:          lo.ToPtr(c.ClientID),
+			Secret:      lo.ToPtr(c.ConnectSecret),
+			Config:      lo.ToPtr(string(c.ConfigContent)),
+			ServerId:    lo.ToPtr(c.ServerID),
+			Stopped:     lo.ToPtr(c.Stopped),
+			Comment:     lo.ToPtr(c.Comment),
+			ClientIds:   clientIDs,
+			Ephemeral:   lo.ToPtr(c.Ephemeral),
+			PtyDisabled: lo.ToPtr(c.PTYDisabled),
+			Labels:      labels[models.LabelResourceRef{ID: c.ClientID}],
 		}
 		if c.LastSeenAt != nil {
 			respCli.LastSeenAt = lo.ToPtr(c.LastSeenAt.UnixMilli())
@@ -179,6 +179,11 @@ func UpdateFrpcHander(c *app.Context, req *pb.UpdateFRPCRequest) (*pb.UpdateFRPC
 		return nil, err
 	}
 
+	if err := dao.NewQuery(c).RebuildVisitorConfigFromClient(userInfo, &models.Client{ClientEntity: cli}); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot rebuild visitor config from client, id: [%s]", cli.ClientID)
+		return nil, err
+	}
+
 	cliReq := &pb.UpdateFRPCRequest{
 		ClientId: lo.ToPtr(cli.ClientID),
 		ServerId: lo.ToPtr(serverID),
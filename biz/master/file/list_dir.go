@@ -0,0 +1,24 @@
+package file
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// ListClientDir 列出节点上的目录，path 为空时返回节点允许访问的目录
+func ListClientDir(ctx *app.Context, req *pb.ListDirRequest) (*pb.ListDirResponse, error) {
+	if err := checkFilePermission(ctx, common.GetUserInfo(ctx), req.GetClientId()); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListDirResponse{}
+	if err := rpc.CallClientWrapper(ctx, req.GetClientId(), pb.Event_EVENT_LIST_DIR, &pb.ListDirRequest{Path: req.Path}, resp); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("list dir on client [%s] failed, path: [%s]", req.GetClientId(), req.GetPath())
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,42 @@
+package file
+
+import (
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/utils"
+)
+
+type FileTransferMgr struct {
+	*utils.SyncMap[string, pb.Master_FileTransferServer]                                       // transferID
+	doneMap                                              *utils.SyncMap[string, chan struct{}] // transferID
+}
+
+func (m *FileTransferMgr) IsTransferDone(transferID string) bool {
+	ch, ok := m.doneMap.Load(transferID)
+	if !ok {
+		return true
+	}
+	<-ch
+	return true
+}
+
+// SetTransferDone 可以重复调用
+func (m *FileTransferMgr) SetTransferDone(transferID string) {
+	ch, ok := m.doneMap.LoadAndDelete(transferID)
+	if !ok {
+		return
+	}
+	m.Delete(transferID)
+	close(ch)
+}
+
+func (m *FileTransferMgr) Add(transferID string, conn pb.Master_FileTransferServer) {
+	m.Store(transferID, conn)
+	m.doneMap.Store(transferID, make(chan struct{}))
+}
+
+func NewFileTransferMgr() *FileTransferMgr {
+	return &FileTransferMgr{
+		SyncMap: &utils.SyncMap[string, pb.Master_FileTransferServer]{},
+		doneMap: &utils.SyncMap[string, chan struct{}]{},
+	}
+}
@@ -0,0 +1,42 @@
+package file
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+)
+
+// checkFilePermission 只有节点的所有者和管理员可以传输文件
+// 可访问的目录由 client 侧的 AllowPaths 控制，见 biz/common.StartFileTransfer
+func checkFilePermission(ctx *app.Context, userInfo models.UserInfo, clientID string) error {
+	if userInfo == nil || !userInfo.Valid() {
+		return fmt.Errorf("invalid user")
+	}
+	if len(clientID) == 0 {
+		return fmt.Errorf("client id is required")
+	}
+
+	q := dao.NewQuery(ctx)
+
+	var err error
+	if userInfo.IsAdmin() {
+		_, err = q.AdminGetClientByClientID(clientID)
+	} else {
+		_, err = q.GetClientByClientID(userInfo, clientID)
+	}
+	if err == nil {
+		return nil
+	}
+
+	if userInfo.IsAdmin() {
+		_, err = q.AdminGetServerByServerID(clientID)
+	} else {
+		_, err = q.GetServerByServerID(userInfo, clientID)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot find client or server [%s]", clientID)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package file
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/server"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func FileTransfer(ctx *app.Context, sender pb.Master_FileTransferServer) error {
+	msg, err := sender.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	clientID := ""
+
+	if msg.GetClientBase() != nil {
+		_, err = client.ValidateClientRequest(ctx, msg.GetClientBase())
+		clientID = msg.GetClientBase().GetClientId()
+	}
+	if msg.GetServerBase() != nil {
+		_, err = server.ValidateServerRequest(ctx, msg.GetServerBase())
+		clientID = msg.GetServerBase().GetServerId()
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(clientID) == 0 || len(msg.GetTransferId()) == 0 {
+		return fmt.Errorf("invalid client connect")
+	}
+
+	logger.Logger(sender.Context()).Infof("start file transfer, client id: [%s], transfer id: [%s]", clientID, msg.GetTransferId())
+
+	ctx.GetApp().GetFileTransferMgr().Add(msg.GetTransferId(), sender)
+
+	if err := sender.Send(&pb.FileTransferServerMessage{Data: []byte("ok")}); err != nil {
+		ctx.GetApp().GetFileTransferMgr().SetTransferDone(msg.GetTransferId())
+		return err
+	}
+
+	ctx.GetApp().GetFileTransferMgr().IsTransferDone(msg.GetTransferId())
+	return nil
+}
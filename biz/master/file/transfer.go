@@ -0,0 +1,204 @@
+package file
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// 只支持 bytes=N- 形式的续传请求，其余形式按完整下载处理
+var rangeStartRegexp = regexp.MustCompile(`^bytes=(\d+)-$`)
+
+// DownloadClientFileHandler 从节点下载文件，支持 Range: bytes=N- 断点续传
+// query: client_id, path
+func DownloadClientFileHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		ctx := app.NewContext(c, appInstance)
+		clientID := c.Query("client_id")
+		path := c.Query("path")
+
+		if err := checkFilePermission(ctx, common.GetUserInfo(c), clientID); err != nil {
+			c.JSON(http.StatusForbidden, common.Err(err.Error()))
+			return
+		}
+
+		var offset int64
+		if m := rangeStartRegexp.FindStringSubmatch(c.GetHeader("Range")); m != nil {
+			offset, _ = strconv.ParseInt(m[1], 10, 64)
+		}
+
+		transferID, resp, conn, err := startTransfer(ctx, clientID, &pb.StartFileTransferRequest{
+			Op:     pb.StartFileTransferRequest_OP_DOWNLOAD.Enum(),
+			Path:   lo.ToPtr(path),
+			Offset: lo.ToPtr(offset),
+		})
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("start download failed, client id: [%s], path: [%s]", clientID, path)
+			c.JSON(http.StatusInternalServerError, common.Err(err.Error()))
+			return
+		}
+		defer appInstance.GetFileTransferMgr().SetTransferDone(transferID)
+
+		// sha256 在节点发送完文件后才知道，通过 trailer 返回，trailer 需要 chunked 编码，因此不设置 Content-Length
+		size := resp.GetSize()
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", baseName(path)))
+		c.Header("Content-Type", "application/octet-stream")
+		c.Header("Accept-Ranges", "bytes")
+		c.Header("Trailer", defs.FileTransferSHA256Header)
+		if offset > 0 {
+			c.Header("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, size-1, size))
+			c.Status(http.StatusPartialContent)
+		} else {
+			c.Status(http.StatusOK)
+		}
+		c.Writer.WriteHeaderNow()
+
+		for {
+			msg, err := conn.Recv()
+			if err != nil {
+				logger.Logger(ctx).WithError(err).Warnf("download interrupted, transfer id: [%s]", transferID)
+				return
+			}
+			if len(msg.GetError()) > 0 {
+				logger.Logger(ctx).Warnf("download failed on client, transfer id: [%s], error: [%s]", transferID, msg.GetError())
+				return
+			}
+			if len(msg.GetData()) > 0 {
+				if _, err := c.Writer.Write(msg.GetData()); err != nil {
+					logger.Logger(ctx).WithError(err).Infof("browser closed download, transfer id: [%s]", transferID)
+					return
+				}
+			}
+			if msg.GetDone() {
+				c.Writer.Header().Set(defs.FileTransferSHA256Header, msg.GetSha256())
+				return
+			}
+		}
+	}
+}
+
+// UploadClientFileHandler 上传文件到节点，请求体为文件内容
+// query: client_id, path, offset 续传的起始位置, sha256 完整文件的 sha256，可选
+func UploadClientFileHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		resp, err := uploadClientFile(app.NewContext(c, appInstance), c)
+		if err != nil {
+			common.ErrResp(c, &pb.UploadClientFileResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()}}, err.Error())
+			return
+		}
+		common.OKResp(c, resp)
+	}
+}
+
+func uploadClientFile(ctx *app.Context, c *gin.Context) (*pb.UploadClientFileResponse, error) {
+	var (
+		clientID = c.Query("client_id")
+		path     = c.Query("path")
+		sum      = strings.ToLower(strings.TrimSpace(c.Query("sha256")))
+		offset   int64
+	)
+	if s := c.Query("offset"); len(s) > 0 {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("invalid offset: [%s]", s)
+		}
+		offset = v
+	}
+
+	if err := checkFilePermission(ctx, common.GetUserInfo(ctx), clientID); err != nil {
+		return nil, err
+	}
+
+	transferID, _, conn, err := startTransfer(ctx, clientID, &pb.StartFileTransferRequest{
+		Op:     pb.StartFileTransferRequest_OP_UPLOAD.Enum(),
+		Path:   lo.ToPtr(path),
+		Offset: lo.ToPtr(offset),
+		Sha256: lo.ToPtr(sum),
+	})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("start upload failed, client id: [%s], path: [%s]", clientID, path)
+		return nil, err
+	}
+	defer ctx.GetApp().GetFileTransferMgr().SetTransferDone(transferID)
+
+	buf := make([]byte, defs.FileTransferChunkSize)
+	cur := offset
+	for {
+		n, err := io.ReadFull(c.Request.Body, buf)
+		if n > 0 {
+			if sendErr := conn.Send(&pb.FileTransferServerMessage{Data: buf[:n], Offset: cur}); sendErr != nil {
+				return nil, sendErr
+			}
+			cur += int64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			// 已发送的部分保留在节点上，可以从 cur 处续传
+			conn.Send(&pb.FileTransferServerMessage{Error: lo.ToPtr(err.Error())})
+			return nil, fmt.Errorf("read upload body failed at offset [%d]: %w", cur, err)
+		}
+	}
+
+	if err := conn.Send(&pb.FileTransferServerMessage{Done: true, Offset: cur}); err != nil {
+		return nil, err
+	}
+
+	result, err := conn.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if len(result.GetError()) > 0 {
+		return nil, fmt.Errorf("upload failed on client: %s", result.GetError())
+	}
+
+	logger.Logger(ctx).Infof("file uploaded, client id: [%s], path: [%s], size: [%d]", clientID, path, result.GetSize())
+
+	return &pb.UploadClientFileResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Size:   lo.ToPtr(result.GetSize()),
+		Sha256: lo.ToPtr(result.GetSha256()),
+	}, nil
+}
+
+// startTransfer 通知节点建立 stream，返回时 stream 已经注册到 FileTransferMgr
+func startTransfer(ctx *app.Context, clientID string, req *pb.StartFileTransferRequest) (string, *pb.StartFileTransferResponse, pb.Master_FileTransferServer, error) {
+	transferID := uuid.New().String()
+	req.TransferId = lo.ToPtr(transferID)
+
+	resp := &pb.StartFileTransferResponse{}
+	if err := rpc.CallClientWrapper(ctx, clientID, pb.Event_EVENT_START_FILE_TRANSFER, req, resp); err != nil {
+		ctx.GetApp().GetFileTransferMgr().SetTransferDone(transferID)
+		return "", nil, nil, err
+	}
+
+	conn, ok := ctx.GetApp().GetFileTransferMgr().Load(transferID)
+	if !ok {
+		ctx.GetApp().GetFileTransferMgr().SetTransferDone(transferID)
+		return "", nil, nil, fmt.Errorf("file transfer stream not found, transfer id: [%s]", transferID)
+	}
+
+	return transferID, resp, conn, nil
+}
+
+// baseName 节点可能是 windows，两种分隔符都需要处理
+func baseName(path string) string {
+	if i := strings.LastIndexAny(path, `/\`); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
@@ -5,15 +5,22 @@ import (
 
 	"github.com/VaalaCat/frp-panel/biz/master/auth"
 	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/file"
+	"github.com/VaalaCat/frp-panel/biz/master/label"
+	"github.com/VaalaCat/frp-panel/biz/master/migrate"
+	"github.com/VaalaCat/frp-panel/biz/master/notify"
 	"github.com/VaalaCat/frp-panel/biz/master/platform"
+	"github.com/VaalaCat/frp-panel/biz/master/probe"
 	"github.com/VaalaCat/frp-panel/biz/master/proxy"
 	"github.com/VaalaCat/frp-panel/biz/master/server"
 	"github.com/VaalaCat/frp-panel/biz/master/shell"
 	"github.com/VaalaCat/frp-panel/biz/master/streamlog"
 	"github.com/VaalaCat/frp-panel/biz/master/user"
+	"github.com/VaalaCat/frp-panel/biz/master/visitor"
 	"github.com/VaalaCat/frp-panel/biz/master/worker"
 	"github.com/VaalaCat/frp-panel/middleware"
 	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/telemetry"
 	"github.com/gin-gonic/gin"
 )
 
@@ -25,6 +32,7 @@ func NewRouter(fs embed.FS, appInstance app.Application) *gin.Engine {
 }
 
 func ConfigureRouter(appInstance app.Application, router *gin.Engine) {
+	router.Use(telemetry.GinMiddleware(), middleware.TraceID())
 	router.POST("/auth", auth.MakeGinHandlerFunc(appInstance, auth.HandleLogin))
 
 	api := router.Group("/api")
@@ -32,6 +40,7 @@ func ConfigureRouter(appInstance app.Application, router *gin.Engine) {
 	api.POST("/v1/auth/login", app.Wrapper(appInstance, auth.LoginHandler))
 	api.POST("/v1/auth/register", app.Wrapper(appInstance, auth.RegisterHandler))
 	api.GET("/v1/auth/logout", auth.RemoveJWTHandler(appInstance))
+	api.GET("/v1/workerd/artifact/:id", worker.DownloadWorkerdArtifactHandler(appInstance))
 
 	v1 := api.Group("/v1", middleware.JWTAuth(appInstance), middleware.AuthCtx(appInstance), middleware.RBAC(appInstance))
 	{
@@ -53,6 +62,11 @@ func ConfigureRouter(appInstance app.Application, router *gin.Engine) {
 			clientRouter.POST("/delete", app.Wrapper(appInstance, client.DeleteClientHandler))
 			clientRouter.POST("/list", app.Wrapper(appInstance, client.ListClientsHandler))
 			clientRouter.POST("/install_workerd", app.Wrapper(appInstance, worker.InstallWorkerd))
+			clientRouter.POST("/exec", app.Wrapper(appInstance, shell.ExecCommand))
+			clientRouter.POST("/list_exec_records", app.Wrapper(appInstance, shell.ListExecRecords))
+			clientRouter.POST("/file/list", app.Wrapper(appInstance, file.ListClientDir))
+			clientRouter.GET("/file/download", file.DownloadClientFileHandler(appInstance))
+			clientRouter.POST("/file/upload", file.UploadClientFileHandler(appInstance))
 		}
 		serverRouter := v1.Group("/server")
 		{
@@ -84,6 +98,26 @@ func ConfigureRouter(appInstance app.Application, router *gin.Engine) {
 			proxyRouter.POST("/get_config", app.Wrapper(appInstance, proxy.GetProxyConfig))
 			proxyRouter.POST("/start_proxy", app.Wrapper(appInstance, proxy.StartProxy))
 			proxyRouter.POST("/stop_proxy", app.Wrapper(appInstance, proxy.StopProxy))
+			proxyRouter.POST("/move_config", app.Wrapper(appInstance, proxy.MoveProxyConfig))
+			proxyRouter.POST("/clone_config", app.Wrapper(appInstance, proxy.CloneProxyConfig))
+			proxyRouter.POST("/list_status", app.Wrapper(appInstance, proxy.ListProxyStatus))
+			proxyRouter.POST("/set_probe", app.Wrapper(appInstance, probe.SetProxyProbe))
+			proxyRouter.POST("/delete_probe", app.Wrapper(appInstance, probe.DeleteProxyProbe))
+			proxyRouter.POST("/get_probe", app.Wrapper(appInstance, probe.GetProxyProbe))
+			proxyRouter.POST("/list_probes", app.Wrapper(appInstance, probe.ListProxyProbes))
+			proxyRouter.POST("/set_schedule", app.Wrapper(appInstance, proxy.SetProxySchedule))
+			proxyRouter.POST("/delete_schedule", app.Wrapper(appInstance, proxy.DeleteProxySchedule))
+		}
+		visitorRouter := v1.Group("/visitor")
+		{
+			visitorRouter.POST("/list_configs", app.Wrapper(appInstance, visitor.ListVisitorConfigs))
+			visitorRouter.POST("/create_config", app.Wrapper(appInstance, visitor.CreateVisitorConfig))
+			visitorRouter.POST("/update_config", app.Wrapper(appInstance, visitor.UpdateVisitorConfig))
+			visitorRouter.POST("/delete_config", app.Wrapper(appInstance, visitor.DeleteVisitorConfig))
+			visitorRouter.POST("/get_config", app.Wrapper(appInstance, visitor.GetVisitorConfig))
+			visitorRouter.POST("/start_visitor", app.Wrapper(appInstance, visitor.StartVisitor))
+			visitorRouter.POST("/stop_visitor", app.Wrapper(appInstance, visitor.StopVisitor))
+			visitorRouter.POST("/grant", app.Wrapper(appInstance, visitor.GrantVisitorAccess))
 		}
 		workerHandler := v1.Group("/worker")
 		{
@@ -96,8 +130,46 @@ func ConfigureRouter(appInstance app.Application, router *gin.Engine) {
 			workerHandler.POST("/redeploy", app.Wrapper(appInstance, worker.RedeployWorker))
 			workerHandler.POST("/create_ingress", app.Wrapper(appInstance, worker.CreateWorkerIngress))
 			workerHandler.POST("/get_ingress", app.Wrapper(appInstance, worker.GetWorkerIngress))
+			workerHandler.POST("/list_cron_invocations", app.Wrapper(appInstance, worker.ListWorkerCronInvocations))
+			workerHandler.POST("/upload_workerd", worker.UploadWorkerdArtifactHandler(appInstance))
+			workerHandler.POST("/list_workerd", app.Wrapper(appInstance, worker.ListWorkerdArtifacts))
+			workerHandler.POST("/delete_workerd", app.Wrapper(appInstance, worker.DeleteWorkerdArtifact))
+		}
+		ptySessionRouter := v1.Group("/pty_session")
+		{
+			ptySessionRouter.POST("/list", app.Wrapper(appInstance, shell.ListPTYSessions))
+			ptySessionRouter.POST("/terminate", app.Wrapper(appInstance, shell.TerminatePTYSession))
+			ptySessionRouter.POST("/share", app.Wrapper(appInstance, shell.UpdatePTYSessionShare))
+			ptySessionRouter.POST("/policy", app.Wrapper(appInstance, shell.SetPTYPolicy))
+			ptySessionRouter.GET("/:sessionID/join", shell.JoinPTYSessionHandler(appInstance))
+			ptySessionRouter.GET("/:sessionID/record", shell.DownloadPTYRecordHandler(appInstance))
+			ptySessionRouter.GET("/:sessionID/replay", shell.ReplayPTYRecordHandler(appInstance))
+		}
+		notifyRouter := v1.Group("/notify")
+		{
+			notifyRouter.POST("/create", app.Wrapper(appInstance, notify.CreateNotifyChannel))
+			notifyRouter.POST("/update", app.Wrapper(appInstance, notify.UpdateNotifyChannel))
+			notifyRouter.POST("/delete", app.Wrapper(appInstance, notify.DeleteNotifyChannel))
+			notifyRouter.POST("/list", app.Wrapper(appInstance, notify.ListNotifyChannels))
+			notifyRouter.POST("/test", app.Wrapper(appInstance, notify.TestNotifyChannel))
+		}
+		migrateRouter := v1.Group("/migrate")
+		{
+			migrateRouter.POST("/import", app.Wrapper(appInstance, migrate.ImportConfig))
+			migrateRouter.POST("/export", app.Wrapper(appInstance, migrate.ExportConfig))
+			migrateRouter.POST("/export_archive", app.Wrapper(appInstance, migrate.ExportArchive))
+			migrateRouter.POST("/apply", app.Wrapper(appInstance, migrate.ApplyManifest))
+		}
+		labelRouter := v1.Group("/label")
+		{
+			labelRouter.POST("/update", app.Wrapper(appInstance, label.UpdateLabels))
+			labelRouter.POST("/save_filter", app.Wrapper(appInstance, label.SaveLabelFilter))
+			labelRouter.POST("/list_filters", app.Wrapper(appInstance, label.ListLabelFilters))
+			labelRouter.POST("/delete_filter", app.Wrapper(appInstance, label.DeleteLabelFilter))
+			labelRouter.POST("/batch", app.Wrapper(appInstance, label.BatchOperate))
 		}
 		v1.GET("/pty/:clientID", shell.PTYHandler(appInstance))
 		v1.GET("/log", streamlog.GetLogHandler(appInstance))
+		v1.POST("/log/query", app.Wrapper(appInstance, streamlog.QueryLogs))
 	}
 }
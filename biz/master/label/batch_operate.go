@@ -0,0 +1,271 @@
+package label
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/proxy"
+	"github.com/VaalaCat/frp-panel/biz/master/server"
+	"github.com/VaalaCat/frp-panel/biz/master/worker"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// batchTarget 是一个被 selector 匹配到的资源，apply 为空表示已处于目标状态
+type batchTarget struct {
+	result *pb.BatchOperateResult
+	apply  func() error
+}
+
+var batchOperations = map[defs.LabelResourceType][]defs.BatchOperation{
+	defs.LabelResourceProxy:  {defs.BatchOperationStart, defs.BatchOperationStop, defs.BatchOperationDelete},
+	defs.LabelResourceClient: {defs.BatchOperationStart, defs.BatchOperationStop, defs.BatchOperationDelete},
+	defs.LabelResourceServer: {defs.BatchOperationDelete},
+	defs.LabelResourceWorker: {defs.BatchOperationDelete},
+}
+
+// BatchOperate 对所有匹配 label selector 的资源执行 start/stop/delete
+// 每个资源通过原有的接口处理，单个资源失败不影响其他资源，结果逐个返回
+func BatchOperate(ctx *app.Context, req *pb.BatchOperateRequest) (*pb.BatchOperateResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	resourceType, err := models.ParseLabelResourceType(req.GetResourceType())
+	if err != nil {
+		return nil, err
+	}
+	operation := defs.BatchOperation(req.GetOperation())
+	if !lo.Contains(batchOperations[resourceType], operation) {
+		return nil, fmt.Errorf("operation [%s] is not supported for %s", operation, resourceType)
+	}
+	selector, err := models.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+	if selector.Empty() {
+		return nil, fmt.Errorf("label selector is required")
+	}
+
+	var targets []*batchTarget
+	switch resourceType {
+	case defs.LabelResourceProxy:
+		targets, err = matchProxies(ctx, selector, operation)
+	case defs.LabelResourceClient:
+		targets, err = matchClients(ctx, selector, operation)
+	case defs.LabelResourceServer:
+		targets, err = matchServers(ctx, selector)
+	case defs.LabelResourceWorker:
+		targets, err = matchWorkers(ctx, selector)
+	}
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot match %s by label selector [%s]", resourceType, req.GetLabelSelector())
+		return nil, err
+	}
+
+	results := make([]*pb.BatchOperateResult, 0, len(targets))
+	for _, target := range targets {
+		results = append(results, target.result)
+		if req.GetDryRun() {
+			continue
+		}
+		if target.apply != nil {
+			if err := target.apply(); err != nil {
+				logger.Logger(ctx).WithError(err).Errorf("batch %s %s failed, id: [%s], name: [%s]",
+					operation, resourceType, target.result.GetResourceId(), target.result.GetProxyName())
+				target.result.Error = lo.ToPtr(err.Error())
+				continue
+			}
+		}
+		target.result.Applied = lo.ToPtr(true)
+	}
+
+	logger.Logger(ctx).Infof("batch %s %s by selector [%s], matched: [%d], dry run: [%v]",
+		operation, resourceType, req.GetLabelSelector(), len(results), req.GetDryRun())
+
+	return &pb.BatchOperateResponse{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Results: results,
+	}, nil
+}
+
+func matchProxies(ctx *app.Context, selector models.LabelSelector, operation defs.BatchOperation) ([]*batchTarget, error) {
+	userInfo := common.GetUserInfo(ctx)
+	proxyConfigs, err := dao.NewQuery(ctx).GetAllProxyConfigs(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	refOf := func(p *models.ProxyConfig) models.LabelResourceRef {
+		return models.LabelResourceRef{ID: p.ClientID, Name: p.Name}
+	}
+	labels, err := dao.NewQuery(ctx).GetLabelsByResources(userInfo, defs.LabelResourceProxy,
+		lo.Map(proxyConfigs, func(p *models.ProxyConfig, _ int) models.LabelResourceRef { return refOf(p) }))
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []*batchTarget{}
+	for _, p := range proxyConfigs {
+		if !selector.Matches(labels[refOf(p)]) {
+			continue
+		}
+		var (
+			clientID = p.ClientID
+			serverID = p.ServerID
+			name     = p.Name
+			target   = &batchTarget{result: &pb.BatchOperateResult{
+				ResourceId: lo.ToPtr(clientID),
+				ServerId:   lo.ToPtr(serverID),
+				ProxyName:  lo.ToPtr(name),
+			}}
+		)
+		switch {
+		case operation == defs.BatchOperationStart && p.Stopped:
+			target.apply = func() error {
+				resp, err := proxy.StartProxy(ctx, &pb.StartProxyRequest{ClientId: &clientID, ServerId: &serverID, Name: &name})
+				return respError(resp, err)
+			}
+		case operation == defs.BatchOperationStop && !p.Stopped:
+			target.apply = func() error {
+				resp, err := proxy.StopProxy(ctx, &pb.StopProxyRequest{ClientId: &clientID, ServerId: &serverID, Name: &name})
+				return respError(resp, err)
+			}
+		case operation == defs.BatchOperationDelete:
+			target.apply = func() error {
+				resp, err := proxy.DeleteProxyConfig(ctx, &pb.DeleteProxyConfigRequest{ClientId: &clientID, ServerId: &serverID, Name: &name})
+				return respError(resp, err)
+			}
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+func matchClients(ctx *app.Context, selector models.LabelSelector, operation defs.BatchOperation) ([]*batchTarget, error) {
+	userInfo := common.GetUserInfo(ctx)
+	clients, err := dao.NewQuery(ctx).GetAllClients(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	// 子客户端跟随原始 client，不单独匹配
+	clients = lo.Filter(clients, func(c *models.ClientEntity, _ int) bool {
+		return c.IsShadow || len(c.OriginClientID) == 0
+	})
+	labels, err := dao.NewQuery(ctx).GetLabelsByResources(userInfo, defs.LabelResourceClient,
+		lo.Map(clients, func(c *models.ClientEntity, _ int) models.LabelResourceRef {
+			return models.LabelResourceRef{ID: c.ClientID}
+		}))
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []*batchTarget{}
+	for _, c := range clients {
+		if !selector.Matches(labels[models.LabelResourceRef{ID: c.ClientID}]) {
+			continue
+		}
+		clientID := c.ClientID
+		target := &batchTarget{result: &pb.BatchOperateResult{ResourceId: lo.ToPtr(clientID)}}
+		switch {
+		case operation == defs.BatchOperationStart && c.Stopped:
+			target.apply = func() error {
+				resp, err := client.StartFRPCHandler(ctx, &pb.StartFRPCRequest{ClientId: &clientID})
+				return respError(resp, err)
+			}
+		case operation == defs.BatchOperationStop && !c.Stopped:
+			target.apply = func() error {
+				resp, err := client.StopFRPCHandler(ctx, &pb.StopFRPCRequest{ClientId: &clientID})
+				return respError(resp, err)
+			}
+		case operation == defs.BatchOperationDelete:
+			target.apply = func() error {
+				resp, err := client.DeleteClientHandler(ctx, &pb.DeleteClientRequest{ClientId: &clientID})
+				return respError(resp, err)
+			}
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+func matchServers(ctx *app.Context, selector models.LabelSelector) ([]*batchTarget, error) {
+	userInfo := common.GetUserInfo(ctx)
+	servers, err := dao.NewQuery(ctx).GetAllServers(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	// 默认 server 不能删除
+	servers = lo.Filter(servers, func(s *models.ServerEntity, _ int) bool {
+		return s.ServerID != defs.DefaultServerID
+	})
+	labels, err := dao.NewQuery(ctx).GetLabelsByResources(userInfo, defs.LabelResourceServer,
+		lo.Map(servers, func(s *models.ServerEntity, _ int) models.LabelResourceRef {
+			return models.LabelResourceRef{ID: s.ServerID}
+		}))
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []*batchTarget{}
+	for _, s := range servers {
+		if !selector.Matches(labels[models.LabelResourceRef{ID: s.ServerID}]) {
+			continue
+		}
+		serverID := s.ServerID
+		targets = append(targets, &batchTarget{
+			result: &pb.BatchOperateResult{ResourceId: lo.ToPtr(serverID)},
+			apply: func() error {
+				resp, err := server.DeleteServerHandler(ctx, &pb.DeleteServerRequest{ServerId: &serverID})
+				return respError(resp, err)
+			},
+		})
+	}
+	return targets, nil
+}
+
+func matchWorkers(ctx *app.Context, selector models.LabelSelector) ([]*batchTarget, error) {
+	userInfo := common.GetUserInfo(ctx)
+	workers, err := dao.NewQuery(ctx).GetAllWorkers(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := dao.NewQuery(ctx).GetLabelsByResources(userInfo, defs.LabelResourceWorker,
+		lo.Map(workers, func(w *models.Worker, _ int) models.LabelResourceRef { return models.LabelResourceRef{ID: w.ID} }))
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []*batchTarget{}
+	for _, w := range workers {
+		if !selector.Matches(labels[models.LabelResourceRef{ID: w.ID}]) {
+			continue
+		}
+		workerID := w.ID
+		targets = append(targets, &batchTarget{
+			result: &pb.BatchOperateResult{ResourceId: lo.ToPtr(workerID)},
+			apply: func() error {
+				resp, err := worker.RemoveWorker(ctx, &pb.RemoveWorkerRequest{WorkerId: &workerID})
+				return respError(resp, err)
+			},
+		})
+	}
+	return targets, nil
+}
+
+// respError 把非成功状态的响应也当作错误，部分 handler 只通过 status 返回失败
+func respError(resp interface{ GetStatus() *pb.Status }, err error) error {
+	if err != nil {
+		return err
+	}
+	if status := resp.GetStatus(); status != nil && status.GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		return fmt.Errorf("%s", status.GetMessage())
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package label
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// UpdateLabels 设置或删除资源上的标签
+// client 的标签设置在原始 client 上，proxy 通过所在的子 client id 和名称定位
+func UpdateLabels(ctx *app.Context, req *pb.UpdateLabelsRequest) (*pb.UpdateLabelsResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	resourceType, err := models.ParseLabelResourceType(req.GetResourceType())
+	if err != nil {
+		return nil, err
+	}
+	ref := models.LabelResourceRef{ID: req.GetResourceId(), Name: req.GetProxyName()}
+
+	for key, value := range req.GetLabels() {
+		if err := models.ValidateLabelKey(key); err != nil {
+			return nil, err
+		}
+		if err := models.ValidateLabelValue(value); err != nil {
+			return nil, err
+		}
+	}
+	for _, key := range req.GetRemoveKeys() {
+		if err := models.ValidateLabelKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkResource(ctx, resourceType, ref); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot find resource to label, type: [%s], id: [%s], name: [%s]", resourceType, ref.ID, ref.Name)
+		return nil, err
+	}
+
+	labels, err := dao.NewQuery(ctx).UpdateLabels(userInfo, resourceType, ref, req.GetLabels(), req.GetRemoveKeys(), req.GetReplace())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update labels, type: [%s], id: [%s], name: [%s]", resourceType, ref.ID, ref.Name)
+		return nil, err
+	}
+
+	return &pb.UpdateLabelsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Labels: labels,
+	}, nil
+}
+
+func checkResource(ctx *app.Context, resourceType defs.LabelResourceType, ref models.LabelResourceRef) error {
+	userInfo := common.GetUserInfo(ctx)
+
+	if len(ref.ID) == 0 {
+		return fmt.Errorf("resource id is required")
+	}
+	if resourceType != defs.LabelResourceProxy && len(ref.Name) > 0 {
+		return fmt.Errorf("proxy name is only for proxy labels")
+	}
+
+	switch resourceType {
+	case defs.LabelResourceProxy:
+		if len(ref.Name) == 0 {
+			return fmt.Errorf("proxy name is required")
+		}
+		_, err := dao.NewQuery(ctx).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{ClientID: ref.ID, Name: ref.Name})
+		return err
+	case defs.LabelResourceClient:
+		cli, err := dao.NewQuery(ctx).GetClientByClientID(userInfo, ref.ID)
+		if err != nil {
+			return err
+		}
+		if !cli.IsShadow && len(cli.OriginClientID) > 0 {
+			return fmt.Errorf("client [%s] is a child client, set labels on [%s] instead", ref.ID, cli.OriginClientID)
+		}
+		return nil
+	case defs.LabelResourceServer:
+		_, err := dao.NewQuery(ctx).GetServerByServerID(userInfo, ref.ID)
+		return err
+	case defs.LabelResourceWorker:
+		_, err := dao.NewQuery(ctx).GetWorkerByWorkerID(userInfo, ref.ID)
+		return err
+	}
+	return fmt.Errorf("invalid resource type [%s]", resourceType)
+}
+
+func SaveLabelFilter(ctx *app.Context, req *pb.SaveLabelFilterRequest) (*pb.SaveLabelFilterResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	filter := req.GetFilter()
+	if len(filter.GetName()) == 0 {
+		return nil, fmt.Errorf("filter name is required")
+	}
+	resourceType, err := models.ParseLabelResourceType(filter.GetResourceType())
+	if err != nil {
+		return nil, err
+	}
+	selector, err := models.ParseLabelSelector(filter.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	if selector.Empty() {
+		return nil, fmt.Errorf("label selector is required")
+	}
+
+	if err := dao.NewQuery(ctx).SaveLabelFilter(userInfo, &models.LabelFilterEntity{
+		Name:         filter.GetName(),
+		ResourceType: resourceType,
+		Selector:     filter.GetSelector(),
+	}); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save label filter, name: [%s]", filter.GetName())
+		return nil, err
+	}
+
+	return &pb.SaveLabelFilterResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
+
+func ListLabelFilters(ctx *app.Context, req *pb.ListLabelFiltersRequest) (*pb.ListLabelFiltersResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	var resourceType defs.LabelResourceType
+	if len(req.GetResourceType()) > 0 {
+		t, err := models.ParseLabelResourceType(req.GetResourceType())
+		if err != nil {
+			return nil, err
+		}
+		resourceType = t
+	}
+
+	filters, err := dao.NewQuery(ctx).ListLabelFilters(userInfo, resourceType)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list label filters")
+		return nil, err
+	}
+
+	return &pb.ListLabelFiltersResponse{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Filters: lo.Map(filters, func(f *models.LabelFilter, _ int) *pb.LabelFilter { return f.ToPB() }),
+	}, nil
+}
+
+func DeleteLabelFilter(ctx *app.Context, req *pb.DeleteLabelFilterRequest) (*pb.DeleteLabelFilterResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	if err := dao.NewQuery(ctx).DeleteLabelFilter(userInfo, req.GetName()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete label filter, name: [%s]", req.GetName())
+		return nil, err
+	}
+
+	return &pb.DeleteLabelFilterResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,697 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/proxy"
+	"github.com/VaalaCat/frp-panel/biz/master/server"
+	"github.com/VaalaCat/frp-panel/biz/master/visitor"
+	"github.com/VaalaCat/frp-panel/biz/master/worker"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/workerd"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+)
+
+type planStep struct {
+	item  *pb.ManifestPlanItem
+	deps  []string // 依赖的 server 和 client 步骤，依赖失败时跳过
+	prune bool
+	run   func() error // 为空表示资源无效，无法执行
+}
+
+type planner struct {
+	ctx      *app.Context
+	userInfo models.UserInfo
+	prune    bool
+
+	steps      []*planStep
+	pruneSteps []*planStep // 删除放在最后，按 worker、proxy/visitor、client、server 的顺序执行
+
+	servers  map[string]*models.ServerEntity    // 用户拥有的 server
+	clients  map[string]*models.ClientEntity    // 影子 client 和没有拆分的 client，不包含子 client
+	proxies  map[string][]*models.ProxyConfig   // 顶层 client id -> proxy，不包含 worker 的 ingress
+	visitors map[string][]*models.VisitorConfig // 顶层 client id -> visitor
+	workers  map[string][]*models.Worker        // name -> worker
+
+	knownServers map[string]bool // 数据库和 manifest 中的 server
+	knownClients map[string]bool
+}
+
+// ApplyManifest 对比 manifest 和数据库中的状态，通过已有的 handler 执行创建、更新和删除
+// dry run 时只返回执行计划，prune 时删除 manifest 中没有声明的资源
+// 有无效资源时不做任何修改，每个资源的执行结果都会在返回的计划中
+func ApplyManifest(ctx *app.Context, req *pb.ApplyManifestRequest) (*pb.ApplyManifestResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ApplyManifestResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	m, err := loadManifest(req.GetManifest())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot load manifest")
+		return nil, err
+	}
+
+	p, err := newPlanner(ctx, userInfo, req.GetPrune())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot load current state")
+		return nil, err
+	}
+
+	p.plan(m)
+
+	message := "ok"
+	if invalid := lo.CountBy(p.steps, func(step *planStep) bool { return step.run == nil }); invalid > 0 {
+		message = fmt.Sprintf("%d resources are invalid, nothing applied", invalid)
+	} else if !req.GetDryRun() {
+		p.apply()
+	}
+
+	return &pb.ApplyManifestResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: message},
+		Items: lo.Map(p.steps, func(step *planStep, _ int) *pb.ManifestPlanItem {
+			return step.item
+		}),
+	}, nil
+}
+
+func newPlanner(ctx *app.Context, userInfo models.UserInfo, prune bool) (*planner, error) {
+	q := dao.NewQuery(ctx)
+
+	srvs, err := q.GetAllServers(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	clis, err := q.GetAllClients(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	proxyCfgs, err := q.GetAllProxyConfigs(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	visitorCfgs, err := q.GetAllVisitorConfigs(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	workers, err := q.GetAllWorkers(userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &planner{
+		ctx:      ctx,
+		userInfo: userInfo,
+		prune:    prune,
+		servers: lo.SliceToMap(lo.Filter(srvs, func(srv *models.ServerEntity, _ int) bool {
+			// 默认 server 只有管理员可以修改
+			return srv.UserID == userInfo.GetUserID() ||
+				(srv.ServerID == defs.DefaultServerID && userInfo.IsAdmin())
+		}), func(srv *models.ServerEntity) (string, *models.ServerEntity) {
+			return srv.ServerID, srv
+		}),
+		clients: lo.SliceToMap(lo.Filter(clis, func(cli *models.ClientEntity, _ int) bool {
+			return len(cli.OriginClientID) == 0
+		}), func(cli *models.ClientEntity) (string, *models.ClientEntity) {
+			return cli.ClientID, cli
+		}),
+		proxies: lo.GroupBy(lo.Filter(proxyCfgs, func(item *models.ProxyConfig, _ int) bool {
+			return len(item.WorkerID) == 0
+		}), func(item *models.ProxyConfig) string {
+			return lo.Ternary(len(item.OriginClientID) > 0, item.OriginClientID, item.ClientID)
+		}),
+		visitors: lo.GroupBy(visitorCfgs, func(item *models.VisitorConfig) string {
+			return lo.Ternary(len(item.OriginClientID) > 0, item.OriginClientID, item.ClientID)
+		}),
+		workers: lo.GroupBy(workers, func(w *models.Worker) string {
+			return w.Name
+		}),
+	}
+	p.knownServers = lo.MapValues(p.servers, func(_ *models.ServerEntity, _ string) bool { return true })
+	p.knownClients = lo.MapValues(p.clients, func(_ *models.ClientEntity, _ string) bool { return true })
+	return p, nil
+}
+
+// plan 校验全部资源并生成计划，无效的资源记录在计划中，不中断后续资源的校验
+func (p *planner) plan(m *manifest) {
+	for _, spec := range m.Servers {
+		p.planServer(spec)
+	}
+	for _, spec := range m.Clients {
+		p.planClient(spec)
+	}
+	for _, spec := range m.Workers {
+		p.planWorker(spec)
+	}
+
+	if p.prune {
+		// proxy 和 visitor 的删除在规划 client 时已经生成，需要排在 worker 之后
+		configPruneSteps := p.pruneSteps
+		p.pruneSteps = nil
+		p.planPruneWorkers(m.Workers)
+		p.pruneSteps = append(p.pruneSteps, configPruneSteps...)
+		p.planPruneClients(m.Clients)
+		p.planPruneServers(m.Servers)
+		p.steps = append(p.steps, p.pruneSteps...)
+	}
+}
+
+// apply 按计划顺序执行，出错不影响无关的资源，依赖出错资源的步骤会被跳过
+// 删除是不可恢复的，只在创建和更新全部成功后执行
+func (p *planner) apply() {
+	failed := map[string]bool{}
+	for _, step := range p.steps {
+		if dep, ok := lo.Find(step.deps, func(dep string) bool { return failed[dep] }); ok {
+			step.item.Error = lo.ToPtr(fmt.Sprintf("skipped because %s failed", dep))
+			failed[stepKey(step.item.GetKind(), step.item.GetId())] = true
+			continue
+		}
+		if step.prune && len(failed) > 0 {
+			step.item.Error = lo.ToPtr("skipped because previous changes failed")
+			continue
+		}
+
+		if err := step.run(); err != nil {
+			logger.Logger(p.ctx).WithError(err).Errorf("apply manifest failed, kind: [%s], action: [%s], id: [%s]",
+				step.item.GetKind(), step.item.GetAction(), step.item.GetId())
+			step.item.Error = lo.ToPtr(err.Error())
+			if !step.prune {
+				failed[stepKey(step.item.GetKind(), step.item.GetId())] = true
+			}
+			continue
+		}
+		step.item.Applied = lo.ToPtr(true)
+	}
+}
+
+func (p *planner) add(item *pb.ManifestPlanItem, deps []string, run func() error) {
+	p.steps = append(p.steps, &planStep{item: item, deps: deps, run: run})
+}
+
+func (p *planner) addPrune(item *pb.ManifestPlanItem, run func() error) {
+	item.Action = lo.ToPtr(defs.ManifestActionDelete)
+	p.pruneSteps = append(p.pruneSteps, &planStep{item: item, prune: true, run: run})
+}
+
+// invalid 记录无法执行的资源，计划中有无效资源时不会执行
+func (p *planner) invalid(item *pb.ManifestPlanItem, err error) {
+	item.Error = lo.ToPtr(err.Error())
+	p.steps = append(p.steps, &planStep{item: item})
+}
+
+// stepKey server 和 client 的 id 全局唯一，proxy、visitor 等依赖它们的步骤按 kind/id 引用
+func stepKey(kind, id string) string {
+	return kind + "/" + id
+}
+
+// globalID manifest 中可以写页面上的 id，也可以写带用户名前缀的完整 id
+func (p *planner) globalID(clientType, id string) string {
+	prefix := app.GlobalClientID(p.userInfo.GetUserName(), clientType, "")
+	if strings.HasPrefix(id, prefix) || (clientType == "s" && id == defs.DefaultServerID) {
+		return id
+	}
+	return prefix + id
+}
+
+func (p *planner) userID(clientType, globalID string) string {
+	return strings.TrimPrefix(globalID, app.GlobalClientID(p.userInfo.GetUserName(), clientType, ""))
+}
+
+func (p *planner) planServer(spec *serverSpec) {
+	serverID := p.globalID("s", spec.ID)
+	item := &pb.ManifestPlanItem{
+		Kind:     lo.ToPtr(defs.ManifestKindServer),
+		Id:       lo.ToPtr(serverID),
+		ServerId: lo.ToPtr(serverID),
+	}
+
+	srv, ok := p.servers[serverID]
+	if !ok {
+		item.Action = lo.ToPtr(defs.ManifestActionCreate)
+		serverIP := lo.Ternary(len(spec.IP) > 0, spec.IP, serverPublicIP(spec.cfg, ""))
+		switch {
+		case serverID == defs.DefaultServerID:
+			p.invalid(item, fmt.Errorf("default server can only be managed by admin"))
+			return
+		case !utils.IsClientIDPermited(p.userID("s", serverID)):
+			p.invalid(item, fmt.Errorf("invalid server id: [%s]", spec.ID))
+			return
+		case len(serverIP) == 0:
+			p.invalid(item, fmt.Errorf("ip is required for server [%s]", spec.ID))
+			return
+		}
+
+		p.knownServers[serverID] = true
+		p.add(item, nil, func() error {
+			if err := checkResp(server.InitServerHandler(p.ctx, &pb.InitServerRequest{
+				ServerId: lo.ToPtr(p.userID("s", serverID)),
+				ServerIp: lo.ToPtr(serverIP),
+				Comment:  lo.ToPtr(spec.Comment),
+			})); err != nil {
+				return err
+			}
+			return p.updateFrps(serverID, spec)
+		})
+		return
+	}
+
+	item.Changes = serverChanges(srv, spec)
+	if len(item.Changes) == 0 {
+		return
+	}
+	item.Action = lo.ToPtr(defs.ManifestActionUpdate)
+	p.add(item, nil, func() error {
+		return p.updateFrps(serverID, spec)
+	})
+}
+
+func (p *planner) updateFrps(serverID string, spec *serverSpec) error {
+	rawCfg, err := json.Marshal(spec.cfg)
+	if err != nil {
+		return err
+	}
+	return checkResp(server.UpdateFrpsHander(p.ctx, &pb.UpdateFRPSRequest{
+		ServerId: lo.ToPtr(serverID),
+		Config:   rawCfg,
+		Comment:  lo.ToPtr(spec.Comment),
+		ServerIp: lo.ToPtr(spec.IP),
+		FrpsUrls: spec.FrpsUrls,
+	}))
+}
+
+// planClient client 无效时仍然校验它的 proxy 和 visitor，一次返回全部问题
+func (p *planner) planClient(spec *clientSpec) {
+	clientID := p.globalID("c", spec.ID)
+
+	if _, ok := p.clients[clientID]; !ok {
+		item := &pb.ManifestPlanItem{
+			Kind:     lo.ToPtr(defs.ManifestKindClient),
+			Action:   lo.ToPtr(defs.ManifestActionCreate),
+			Id:       lo.ToPtr(clientID),
+			ClientId: lo.ToPtr(clientID),
+		}
+		if !utils.IsClientIDPermited(p.userID("c", clientID)) {
+			p.invalid(item, fmt.Errorf("invalid client id: [%s]", spec.ID))
+		} else {
+			p.knownClients[clientID] = true
+			p.add(item, nil, func() error {
+				return checkResp(client.InitClientHandler(p.ctx, &pb.InitClientRequest{
+					ClientId: lo.ToPtr(p.userID("c", clientID)),
+				}))
+			})
+		}
+	}
+
+	p.planProxies(clientID, spec.Proxies)
+	p.planVisitors(clientID, spec.Visitors)
+}
+
+func (p *planner) planProxies(clientID string, specs []*proxySpec) {
+	existing := lo.KeyBy(p.proxies[clientID], func(item *models.ProxyConfig) string { return item.Name })
+
+	for _, spec := range specs {
+		var (
+			name     = spec.cfg.GetBaseConfig().Name
+			serverID = p.globalID("s", spec.Server)
+			item     = &pb.ManifestPlanItem{
+				Kind:     lo.ToPtr(defs.ManifestKindProxy),
+				Id:       lo.ToPtr(name),
+				ClientId: lo.ToPtr(clientID),
+				ServerId: lo.ToPtr(serverID),
+			}
+			deps = []string{
+				stepKey(defs.ManifestKindClient, clientID),
+				stepKey(defs.ManifestKindServer, serverID),
+			}
+		)
+
+		cur, ok := existing[name]
+		delete(existing, name)
+		switch {
+		case !p.knownServers[serverID]:
+			item.Action = lo.ToPtr(lo.Ternary(ok, defs.ManifestActionUpdate, defs.ManifestActionCreate))
+			p.invalid(item, fmt.Errorf("server [%s] of proxy [%s/%s] not found", spec.Server, clientID, name))
+		case !ok:
+			item.Action = lo.ToPtr(defs.ManifestActionCreate)
+			p.add(item, deps, func() error {
+				return checkResp(proxy.CreateProxyConfig(p.ctx, &pb.CreateProxyConfigRequest{
+					ClientId: lo.ToPtr(clientID),
+					ServerId: lo.ToPtr(serverID),
+					Config:   spec.content,
+				}))
+			})
+		case cur.ServerID != serverID || !sameProxyConfig(cur, spec.cfg):
+			// 与页面编辑相同，由 CreateProxyConfig 覆盖，换 server 时会删除旧 server 上的 proxy
+			item.Action = lo.ToPtr(defs.ManifestActionUpdate)
+			item.Changes = lo.Filter([]string{
+				lo.Ternary(cur.ServerID != serverID, "server", ""),
+				lo.Ternary(sameProxyConfig(cur, spec.cfg), "", "config"),
+			}, func(change string, _ int) bool { return len(change) > 0 })
+			p.add(item, deps, func() error {
+				return checkResp(proxy.CreateProxyConfig(p.ctx, &pb.CreateProxyConfigRequest{
+					ClientId:  lo.ToPtr(clientID),
+					ServerId:  lo.ToPtr(serverID),
+					Config:    spec.content,
+					Overwrite: lo.ToPtr(true),
+				}))
+			})
+		}
+	}
+
+	if !p.prune {
+		return
+	}
+	for _, name := range sortedKeys(existing) {
+		cur := existing[name]
+		p.addPrune(&pb.ManifestPlanItem{
+			Kind:     lo.ToPtr(defs.ManifestKindProxy),
+			Id:       lo.ToPtr(name),
+			ClientId: lo.ToPtr(clientID),
+			ServerId: lo.ToPtr(cur.ServerID),
+		}, func() error {
+			return checkResp(proxy.DeleteProxyConfig(p.ctx, &pb.DeleteProxyConfigRequest{
+				ClientId: lo.ToPtr(cur.ClientID),
+				ServerId: lo.ToPtr(cur.ServerID),
+				Name:     lo.ToPtr(name),
+			}))
+		})
+	}
+}
+
+func (p *planner) planVisitors(clientID string, specs []*visitorSpec) {
+	existing := lo.KeyBy(p.visitors[clientID], func(item *models.VisitorConfig) string { return item.Name })
+
+	for _, spec := range specs {
+		var (
+			name     = spec.cfg.GetBaseConfig().Name
+			serverID = p.globalID("s", spec.Server)
+			item     = &pb.ManifestPlanItem{
+				Kind:     lo.ToPtr(defs.ManifestKindVisitor),
+				Id:       lo.ToPtr(name),
+				ClientId: lo.ToPtr(clientID),
+				ServerId: lo.ToPtr(serverID),
+			}
+			deps = []string{
+				stepKey(defs.ManifestKindClient, clientID),
+				stepKey(defs.ManifestKindServer, serverID),
+			}
+		)
+
+		cur, ok := existing[name]
+		delete(existing, name)
+		switch {
+		case !p.knownServers[serverID]:
+			item.Action = lo.ToPtr(lo.Ternary(ok, defs.ManifestActionUpdate, defs.ManifestActionCreate))
+			p.invalid(item, fmt.Errorf("server [%s] of visitor [%s/%s] not found", spec.Server, clientID, name))
+		case !ok:
+			item.Action = lo.ToPtr(defs.ManifestActionCreate)
+			p.add(item, deps, func() error {
+				return checkResp(visitor.CreateVisitorConfig(p.ctx, &pb.CreateVisitorConfigRequest{
+					ClientId: lo.ToPtr(clientID),
+					ServerId: lo.ToPtr(serverID),
+					Config:   spec.content,
+				}))
+			})
+		case cur.ServerID != serverID:
+			item.Action = lo.ToPtr(defs.ManifestActionUpdate)
+			item.Changes = append([]string{"server"}, lo.Ternary(sameVisitorConfig(cur, spec.cfg), []string{}, []string{"config"})...)
+			p.add(item, deps, func() error {
+				return checkResp(visitor.CreateVisitorConfig(p.ctx, &pb.CreateVisitorConfigRequest{
+					ClientId:  lo.ToPtr(clientID),
+					ServerId:  lo.ToPtr(serverID),
+					Config:    spec.content,
+					Overwrite: lo.ToPtr(true),
+				}))
+			})
+		case !sameVisitorConfig(cur, spec.cfg):
+			item.Action = lo.ToPtr(defs.ManifestActionUpdate)
+			item.Changes = []string{"config"}
+			p.add(item, deps, func() error {
+				return checkResp(visitor.UpdateVisitorConfig(p.ctx, &pb.UpdateVisitorConfigRequest{
+					ClientId: lo.ToPtr(cur.ClientID),
+					ServerId: lo.ToPtr(serverID),
+					Name:     lo.ToPtr(name),
+					Config:   spec.content,
+				}))
+			})
+		}
+	}
+
+	if !p.prune {
+		return
+	}
+	for _, name := range sortedKeys(existing) {
+		cur := existing[name]
+		p.addPrune(&pb.ManifestPlanItem{
+			Kind:     lo.ToPtr(defs.ManifestKindVisitor),
+			Id:       lo.ToPtr(name),
+			ClientId: lo.ToPtr(clientID),
+			ServerId: lo.ToPtr(cur.ServerID),
+		}, func() error {
+			return checkResp(visitor.DeleteVisitorConfig(p.ctx, &pb.DeleteVisitorConfigRequest{
+				ClientId: lo.ToPtr(cur.ClientID),
+				ServerId: lo.ToPtr(cur.ServerID),
+				Name:     lo.ToPtr(name),
+			}))
+		})
+	}
+}
+
+func (p *planner) planWorker(spec *workerSpec) {
+	var (
+		name      = spec.worker.GetName()
+		clientIDs = lo.Map(spec.Clients, func(id string, _ int) string { return p.globalID("c", id) })
+		item      = &pb.ManifestPlanItem{
+			Kind: lo.ToPtr(defs.ManifestKindWorker),
+			Id:   lo.ToPtr(name),
+		}
+	)
+	existing := p.workers[name]
+	item.Action = lo.ToPtr(lo.Ternary(len(existing) == 0, defs.ManifestActionCreate, defs.ManifestActionUpdate))
+	deps := lo.Map(clientIDs, func(clientID string, _ int) string { return stepKey(defs.ManifestKindClient, clientID) })
+
+	if clientID, ok := lo.Find(clientIDs, func(clientID string) bool { return !p.knownClients[clientID] }); ok {
+		p.invalid(item, fmt.Errorf("client [%s] of worker [%s] not found", clientID, name))
+		return
+	}
+	if len(existing) > 1 {
+		p.invalid(item, fmt.Errorf("multiple workers named [%s], rename them before apply", name))
+		return
+	}
+
+	if len(existing) == 0 {
+		p.add(item, deps, func() error {
+			resp, err := worker.CreateWorker(p.ctx, &pb.CreateWorkerRequest{
+				ClientId: lo.ToPtr(clientIDs[0]),
+				Worker:   proto.Clone(spec.worker).(*pb.Worker),
+			})
+			if err := checkResp(resp, err); err != nil || len(clientIDs) == 1 {
+				return err
+			}
+			return checkResp(worker.UpdateWorker(p.ctx, &pb.UpdateWorkerRequest{
+				ClientIds: clientIDs,
+				Worker:    &pb.Worker{WorkerId: resp.WorkerId},
+			}))
+		})
+		return
+	}
+
+	cur := existing[0]
+	item.Changes = workerChanges(cur, spec.worker, clientIDs)
+	if len(item.Changes) == 0 {
+		return
+	}
+	p.add(item, deps, func() error {
+		workerToUpdate := proto.Clone(spec.worker).(*pb.Worker)
+		workerToUpdate.WorkerId = lo.ToPtr(cur.ID)
+		return checkResp(worker.UpdateWorker(p.ctx, &pb.UpdateWorkerRequest{
+			ClientIds:            clientIDs,
+			Worker:               workerToUpdate,
+			ClearCrons:           lo.ToPtr(true),
+			ClearServiceBindings: lo.ToPtr(true),
+			ClearKvNamespaces:    lo.ToPtr(true),
+		}))
+	})
+}
+
+func (p *planner) planPruneWorkers(specs []*workerSpec) {
+	declared := lo.SliceToMap(specs, func(spec *workerSpec) (string, bool) { return spec.worker.GetName(), true })
+	for _, name := range sortedKeys(p.workers) {
+		if declared[name] {
+			continue
+		}
+		for _, w := range p.workers[name] {
+			p.addPrune(&pb.ManifestPlanItem{
+				Kind: lo.ToPtr(defs.ManifestKindWorker),
+				Id:   lo.ToPtr(name),
+			}, func() error {
+				return checkResp(worker.RemoveWorker(p.ctx, &pb.RemoveWorkerRequest{
+					WorkerId: lo.ToPtr(w.ID),
+				}))
+			})
+		}
+	}
+}
+
+// planPruneClients 删除 client 时会一并删除它的子 client、proxy 和 visitor
+func (p *planner) planPruneClients(specs []*clientSpec) {
+	declared := lo.SliceToMap(specs, func(spec *clientSpec) (string, bool) { return p.globalID("c", spec.ID), true })
+	for _, clientID := range sortedKeys(p.clients) {
+		if declared[clientID] {
+			continue
+		}
+		p.addPrune(&pb.ManifestPlanItem{
+			Kind:     lo.ToPtr(defs.ManifestKindClient),
+			Id:       lo.ToPtr(clientID),
+			ClientId: lo.ToPtr(clientID),
+		}, func() error {
+			return checkResp(client.DeleteClientHandler(p.ctx, &pb.DeleteClientRequest{
+				ClientId: lo.ToPtr(clientID),
+			}))
+		})
+	}
+}
+
+func (p *planner) planPruneServers(specs []*serverSpec) {
+	declared := lo.SliceToMap(specs, func(spec *serverSpec) (string, bool) { return p.globalID("s", spec.ID), true })
+	for _, serverID := range sortedKeys(p.servers) {
+		if declared[serverID] || serverID == defs.DefaultServerID {
+			continue
+		}
+		p.addPrune(&pb.ManifestPlanItem{
+			Kind:     lo.ToPtr(defs.ManifestKindServer),
+			Id:       lo.ToPtr(serverID),
+			ServerId: lo.ToPtr(serverID),
+		}, func() error {
+			return checkResp(server.DeleteServerHandler(p.ctx, &pb.DeleteServerRequest{
+				ServerId: lo.ToPtr(serverID),
+			}))
+		})
+	}
+}
+
+type statusResponse interface {
+	GetStatus() *pb.Status
+}
+
+// checkResp 部分 handler 出错时只在 status 中返回错误
+func checkResp[T statusResponse](resp T, err error) error {
+	if err != nil {
+		return err
+	}
+	if status := resp.GetStatus(); status != nil && status.GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		return fmt.Errorf("%s", status.GetMessage())
+	}
+	return nil
+}
+
+// serverChanges 鉴权插件由 master 注入，不参与对比；frps_urls 为空时不会清空已有的值
+func serverChanges(srv *models.ServerEntity, spec *serverSpec) []string {
+	changes := []string{}
+	if len(spec.IP) > 0 && spec.IP != srv.ServerIP {
+		changes = append(changes, "ip")
+	}
+	if spec.Comment != srv.Comment {
+		changes = append(changes, "comment")
+	}
+	if len(spec.FrpsUrls) > 0 && !slices.Equal(spec.FrpsUrls, srv.FrpsUrls) {
+		changes = append(changes, "frps_urls")
+	}
+
+	curCfg, err := srv.GetConfigContent()
+	if len(srv.ConfigContent) == 0 || err != nil || !sameJSON(withoutHTTPPlugins(*curCfg), withoutHTTPPlugins(*spec.cfg)) {
+		changes = append(changes, "config")
+	}
+	return changes
+}
+
+func withoutHTTPPlugins(cfg v1.ServerConfig) v1.ServerConfig {
+	cfg.HTTPPlugins = nil
+	return cfg
+}
+
+func sameProxyConfig(cur *models.ProxyConfig, desired v1.TypedProxyConfig) bool {
+	curCfg, err := cur.GetTypedProxyConfig()
+	return err == nil && sameJSON(curCfg, desired)
+}
+
+func sameVisitorConfig(cur *models.VisitorConfig, desired v1.TypedVisitorConfig) bool {
+	curCfg, err := cur.GetTypedVisitorConfig()
+	return err == nil && sameJSON(curCfg, desired)
+}
+
+func sameJSON(a, b any) bool {
+	rawA, errA := json.Marshal(a)
+	rawB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(rawA) == string(rawB)
+}
+
+// workerChanges 没有填写的 code 和 config_template 按创建时的默认值对比，cron 的 id 由 master 生成，不参与对比
+func workerChanges(cur *models.Worker, desired *pb.Worker, clientIDs []string) []string {
+	changes := []string{}
+
+	curClientIDs := lo.Map(cur.Clients, func(c models.Client, _ int) string { return c.ClientID })
+	sort.Strings(curClientIDs)
+	desiredClientIDs := slices.Clone(clientIDs)
+	sort.Strings(desiredClientIDs)
+	if !slices.Equal(curClientIDs, desiredClientIDs) {
+		changes = append(changes, "clients")
+	}
+
+	if lo.Ternary(len(desired.GetCode()) > 0, desired.GetCode(), string(defs.DefaultCode)) != cur.Code {
+		changes = append(changes, "code")
+	}
+	if lo.Ternary(len(desired.GetConfigTemplate()) > 0, desired.GetConfigTemplate(), string(defs.DefaultConfigTemplate)) != cur.ConfigTemplate {
+		changes = append(changes, "config_template")
+	}
+	if !equalMessages(normalizeCrons(desired.GetCrons()), normalizeCrons(cur.Crons.Data)) {
+		changes = append(changes, "crons")
+	}
+	if desired.GetResourceLimits() != nil && !proto.Equal(desired.GetResourceLimits(), cur.ResourceLimits.Data) {
+		changes = append(changes, "resource_limits")
+	}
+	if !equalMessages(desired.GetServiceBindings(), cur.ServiceBindings.Data) {
+		changes = append(changes, "service_bindings")
+	}
+	if !equalMessages(normalizeKVNamespaces(desired.GetKvNamespaces()), normalizeKVNamespaces(cur.KVNamespaces.Data)) {
+		changes = append(changes, "kv_namespaces")
+	}
+	return changes
+}
+
+func normalizeCrons(crons []*pb.WorkerCron) []*pb.WorkerCron {
+	cloned := lo.Map(crons, func(c *pb.WorkerCron, _ int) *pb.WorkerCron { return proto.Clone(c).(*pb.WorkerCron) })
+	workerd.FillWorkerCronsValue(cloned)
+	for _, c := range cloned {
+		c.Id = nil
+	}
+	return cloned
+}
+
+func normalizeKVNamespaces(namespaces []*pb.WorkerKVNamespace) []*pb.WorkerKVNamespace {
+	cloned := lo.Map(namespaces, func(ns *pb.WorkerKVNamespace, _ int) *pb.WorkerKVNamespace {
+		return proto.Clone(ns).(*pb.WorkerKVNamespace)
+	})
+	workerd.FillWorkerKVNamespacesValue(cloned)
+	return cloned
+}
+
+func equalMessages[T proto.Message](a, b []T) bool {
+	return slices.EqualFunc(a, b, func(x, y T) bool { return proto.Equal(x, y) })
+}
@@ -0,0 +1,53 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlannerApplySkipsDependentSteps(t *testing.T) {
+	var (
+		p   = &planner{ctx: app.NewContext(context.Background(), nil)}
+		ran = []string{}
+		run = func(id string, err error) func() error {
+			return func() error {
+				ran = append(ran, id)
+				return err
+			}
+		}
+		item = func(kind, id string) *pb.ManifestPlanItem {
+			return &pb.ManifestPlanItem{Kind: lo.ToPtr(kind), Id: lo.ToPtr(id)}
+		}
+	)
+
+	p.add(item(defs.ManifestKindServer, "s1"), nil, run("s1", fmt.Errorf("boom")))
+	p.add(item(defs.ManifestKindClient, "c1"), nil, run("c1", nil))
+	p.add(item(defs.ManifestKindProxy, "p1"), []string{
+		stepKey(defs.ManifestKindClient, "c1"),
+		stepKey(defs.ManifestKindServer, "s1"),
+	}, run("p1", nil))
+	p.add(item(defs.ManifestKindProxy, "p2"), []string{stepKey(defs.ManifestKindClient, "c1")}, run("p2", nil))
+	p.addPrune(item(defs.ManifestKindServer, "s2"), run("s2", nil))
+	p.steps = append(p.steps, p.pruneSteps...)
+
+	p.apply()
+
+	assert.Equal(t, []string{"s1", "c1", "p2"}, ran)
+	results := lo.Map(p.steps, func(step *planStep, _ int) string {
+		return fmt.Sprintf("%s %v %s", step.item.GetId(), step.item.GetApplied(), step.item.GetError())
+	})
+	assert.Equal(t, []string{
+		"s1 false boom",
+		"c1 true ",
+		"p1 false skipped because server/s1 failed",
+		"p2 true ",
+		"s2 false skipped because previous changes failed",
+	}, results)
+}
@@ -0,0 +1,269 @@
+package migrate
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+)
+
+// ExportConfig 导出可以直接被原版 frpc/frps 运行的配置，传 client_id 时导出 frpc，否则导出 frps
+func ExportConfig(ctx *app.Context, req *pb.ExportConfigRequest) (*pb.ExportConfigResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		clientID = req.GetClientId()
+		serverID = req.GetServerId()
+		format   = lo.Ternary(len(req.GetFormat()) > 0, req.GetFormat(), defs.ConfigFormatTOML)
+	)
+
+	if !lo.Contains([]string{defs.ConfigFormatTOML, defs.ConfigFormatYAML, defs.ConfigFormatJSON}, format) {
+		return nil, fmt.Errorf("unsupported config format: [%s]", format)
+	}
+
+	var (
+		cfg      any
+		fileName string
+	)
+	if len(clientID) > 0 {
+		cli, err := exportClientEntity(ctx, userInfo, clientID, serverID)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get client to export, id: [%s], server id: [%s]", clientID, serverID)
+			return nil, err
+		}
+		if cfg, err = exportClientConfig(ctx, userInfo, cli); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot build client config, id: [%s]", cli.ClientID)
+			return nil, err
+		}
+		fileName = "frpc." + format
+	} else {
+		if len(serverID) == 0 {
+			return nil, fmt.Errorf("request invalid")
+		}
+		srv, err := dao.NewQuery(ctx).GetServerByServerID(userInfo, serverID)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get server, id: [%s]", serverID)
+			return nil, err
+		}
+		if cfg, err = exportServerConfig(ctx, srv); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot build server config, id: [%s]", serverID)
+			return nil, err
+		}
+		fileName = "frps." + format
+	}
+
+	content, err := utils.MarshalFRPConfig(cfg, format)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot marshal config")
+		return nil, err
+	}
+
+	return &pb.ExportConfigResponse{
+		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		FileName: lo.ToPtr(fileName),
+		Content:  content,
+	}, nil
+}
+
+type archiveIndexItem struct {
+	Kind     string `json:"kind"`
+	File     string `json:"file,omitempty"`
+	ServerID string `json:"server_id"`
+	ClientID string `json:"client_id,omitempty"`
+	ServerIP string `json:"server_ip,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ExportArchive 导出用户所有 server 和 client 的配置，打包为 tar.gz 用于灾备
+// servers/<server id>/frps.<format>，clients/<client id>/<server id>/frpc.<format>，index.json 记录对应关系
+func ExportArchive(ctx *app.Context, req *pb.ExportArchiveRequest) (*pb.ExportArchiveResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		format   = lo.Ternary(len(req.GetFormat()) > 0, req.GetFormat(), defs.ConfigFormatTOML)
+	)
+
+	if !userInfo.Valid() {
+		return &pb.ExportArchiveResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+	if !lo.Contains([]string{defs.ConfigFormatTOML, defs.ConfigFormatYAML, defs.ConfigFormatJSON}, format) {
+		return nil, fmt.Errorf("unsupported config format: [%s]", format)
+	}
+
+	servers, err := dao.NewQuery(ctx).GetAllServers(userInfo)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get servers")
+		return nil, err
+	}
+	clients, err := dao.NewQuery(ctx).GetAllClients(userInfo)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get clients")
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	index := []*archiveIndexItem{}
+
+	for _, srv := range servers {
+		// 默认 server 等不属于当前用户的 server 不导出
+		if srv.UserID != userInfo.GetUserID() || len(srv.ConfigContent) == 0 {
+			continue
+		}
+		item := &archiveIndexItem{
+			Kind:     defs.CliTypeServer,
+			ServerID: srv.ServerID,
+			ServerIP: srv.ServerIP,
+			Comment:  srv.Comment,
+		}
+		index = append(index, item)
+
+		cfg, err := exportServerConfig(ctx, srv)
+		if err == nil {
+			item.File = path.Join("servers", srv.ServerID, "frps."+format)
+			files[item.File], err = utils.MarshalFRPConfig(cfg, format)
+		}
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot export server, id: [%s]", srv.ServerID)
+			item.File, item.Error = "", err.Error()
+		}
+	}
+
+	for _, cli := range clients {
+		// 影子 client 的配置在各个子 client 上
+		if cli.IsShadow || len(cli.ServerID) == 0 || len(cli.ConfigContent) == 0 {
+			continue
+		}
+		clientID := lo.Ternary(len(cli.OriginClientID) > 0, cli.OriginClientID, cli.ClientID)
+		item := &archiveIndexItem{
+			Kind:     defs.CliTypeClient,
+			ServerID: cli.ServerID,
+			ClientID: clientID,
+			Comment:  cli.Comment,
+		}
+		index = append(index, item)
+
+		cfg, err := exportClientConfig(ctx, userInfo, cli)
+		if err == nil {
+			item.File = path.Join("clients", clientID, cli.ServerID, "frpc."+format)
+			files[item.File], err = utils.MarshalFRPConfig(cfg, format)
+		}
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot export client, id: [%s]", cli.ClientID)
+			item.File, item.Error = "", err.Error()
+		}
+	}
+
+	if files["index.json"], err = json.MarshalIndent(index, "", "  "); err != nil {
+		return nil, err
+	}
+
+	content, err := tarGzFiles(files)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot pack export archive")
+		return nil, err
+	}
+
+	return &pb.ExportArchiveResponse{
+		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		FileName: lo.ToPtr(fmt.Sprintf("frp-panel-export-%s.tar.gz", time.Now().Format("20060102150405"))),
+		Content:  content,
+	}, nil
+}
+
+// exportClientEntity 影子 client 需要通过 server id 找到对应的子 client
+func exportClientEntity(ctx *app.Context, userInfo models.UserInfo, clientID, serverID string) (*models.ClientEntity, error) {
+	cli, err := dao.NewQuery(ctx).GetClientByClientID(userInfo, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !cli.IsShadow {
+		if len(serverID) > 0 && cli.ServerID != serverID {
+			return nil, fmt.Errorf("client and server not match")
+		}
+		return cli.ClientEntity, nil
+	}
+
+	if len(serverID) == 0 {
+		return nil, fmt.Errorf("server id is required for shadow client")
+	}
+	return dao.NewQuery(ctx).GetClientByFilter(userInfo, &models.ClientEntity{
+		OriginClientID: clientID,
+		ServerID:       serverID,
+	}, nil)
+}
+
+// exportClientConfig 用 ProxyConfig 记录组装 frpc 配置，已停止的 proxy 不导出
+func exportClientConfig(ctx *app.Context, userInfo models.UserInfo, cli *models.ClientEntity) (*v1.ClientConfig, error) {
+	proxyCfgs, err := dao.NewQuery(ctx).GetProxyConfigsByClientID(userInfo, cli.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	built, err := models.BuildClientConfigFromProxyConfig(&models.Client{ClientEntity: cli},
+		lo.FilterMap(proxyCfgs, func(item *models.ProxyConfigEntity, _ int) (*models.ProxyConfig, bool) {
+			return &models.ProxyConfig{ProxyConfigEntity: item}, !item.Stopped
+		}))
+	if err != nil {
+		return nil, err
+	}
+	return built.GetConfigContent()
+}
+
+// exportServerConfig 去掉依赖 master 的鉴权插件，master 不可用时 frps 也能独立运行
+func exportServerConfig(ctx *app.Context, srv *models.ServerEntity) (*v1.ServerConfig, error) {
+	cfg, err := srv.GetConfigContent()
+	if err != nil {
+		return nil, err
+	}
+
+	authPluginName := conf.FRPsAuthOption(ctx.GetApp().GetConfig(), false).Name
+	cfg.HTTPPlugins = lo.Filter(cfg.HTTPPlugins, func(p v1.HTTPPluginOptions, _ int) bool {
+		return p.Name != authPluginName
+	})
+	return cfg, nil
+}
+
+func tarGzFiles(files map[string][]byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+
+	now := time.Now()
+	for _, name := range sortedKeys(files) {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0600,
+			Size:    int64(len(files[name])),
+			ModTime: now,
+		}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
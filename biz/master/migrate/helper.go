@@ -0,0 +1,169 @@
+package migrate
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/utils"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+)
+
+// loadConfigFile 按 kind 解析配置文件，kind 为空时依次尝试 frpc 和 frps 的严格解析
+func loadConfigFile(kind string, content []byte) (string, *v1.ClientConfig, *v1.ServerConfig, error) {
+	isINI := utils.IsLegacyINIConfig(content)
+
+	if len(kind) == 0 {
+		if isINI {
+			kind = lo.Ternary(utils.IsLegacyServerINIConfig(content), defs.CliTypeServer, defs.CliTypeClient)
+		} else {
+			if cliCfg, err := utils.LoadClientConfigNormal(content, true); err == nil {
+				return defs.CliTypeClient, cliCfg, nil, nil
+			}
+			if srvCfg, err := utils.LoadServerConfig(content, true); err == nil {
+				return defs.CliTypeServer, nil, srvCfg, nil
+			}
+			return "", nil, nil, fmt.Errorf("cannot recognize file as frpc or frps config")
+		}
+	}
+
+	switch kind {
+	case defs.CliTypeClient:
+		var (
+			cliCfg *v1.ClientConfig
+			err    error
+		)
+		if isINI {
+			cliCfg, err = utils.LoadLegacyClientConfig(content)
+		} else {
+			cliCfg, err = utils.LoadClientConfigNormal(content, true)
+		}
+		return kind, cliCfg, nil, err
+	case defs.CliTypeServer:
+		var (
+			srvCfg *v1.ServerConfig
+			err    error
+		)
+		if isINI {
+			srvCfg, err = utils.LoadLegacyServerConfig(content)
+		} else {
+			srvCfg, err = utils.LoadServerConfig(content, true)
+		}
+		return kind, nil, srvCfg, err
+	default:
+		return "", nil, nil, fmt.Errorf("unknown config kind: [%s]", kind)
+	}
+}
+
+// idFromFileName 去掉扩展名，目录分隔符和不允许的字符替换为 -
+func idFromFileName(name string) string {
+	name = strings.TrimSuffix(name, path.Ext(name))
+	name = strings.Trim(strings.ReplaceAll(name, "\\", "/"), "/")
+	return utils.MakeClientIDPermited(name)
+}
+
+// serverPublicIP frps 监听了具体地址时直接使用，否则需要在请求中指定
+func serverPublicIP(srvCfg *v1.ServerConfig, fallback string) string {
+	for _, addr := range []string{srvCfg.ProxyBindAddr, srvCfg.BindAddr} {
+		if len(addr) > 0 && addr != "0.0.0.0" && addr != "::" {
+			return addr
+		}
+	}
+	return fallback
+}
+
+func bindPortForProtocol(srvCfg *v1.ServerConfig, protocol string) int {
+	switch protocol {
+	case "kcp":
+		return srvCfg.KCPBindPort
+	case "quic":
+		return srvCfg.QUICBindPort
+	default:
+		return srvCfg.BindPort
+	}
+}
+
+type knownServer struct {
+	serverID string
+	serverIP string
+	frpsUrls []string
+	cfg      *v1.ServerConfig
+}
+
+func newKnownServer(srv *models.ServerEntity) (*knownServer, bool) {
+	if len(srv.ConfigContent) == 0 {
+		return nil, false
+	}
+	srvCfg, err := srv.GetConfigContent()
+	if err != nil {
+		return nil, false
+	}
+	return &knownServer{
+		serverID: srv.ServerID,
+		serverIP: srv.ServerIP,
+		frpsUrls: srv.FrpsUrls,
+		cfg:      srvCfg,
+	}, true
+}
+
+// match 判断 frpc 的 serverAddr/serverPort 是否指向该 server
+func (s *knownServer) match(cliCfg *v1.ClientCommonConfig) bool {
+	if cliCfg.ServerAddr == s.serverIP && cliCfg.ServerPort == bindPortForProtocol(s.cfg, cliCfg.Transport.Protocol) {
+		return true
+	}
+	return lo.ContainsBy(s.frpsUrls, func(rawURL string) bool {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return false
+		}
+		return u.Hostname() == cliCfg.ServerAddr && u.Port() == strconv.Itoa(cliCfg.ServerPort)
+	})
+}
+
+// proxyEndpoints 返回 proxy 在 frps 上占用的公网入口，用于检测端口和域名冲突
+func proxyEndpoints(cfg v1.ProxyConfigurer) []string {
+	switch p := cfg.(type) {
+	case *v1.TCPProxyConfig:
+		if p.RemotePort > 0 {
+			return []string{fmt.Sprintf("tcp port %d", p.RemotePort)}
+		}
+	case *v1.UDPProxyConfig:
+		if p.RemotePort > 0 {
+			return []string{fmt.Sprintf("udp port %d", p.RemotePort)}
+		}
+	case *v1.HTTPProxyConfig:
+		return domainEndpoints(string(v1.ProxyTypeHTTP), p.DomainConfig, p.Locations)
+	case *v1.HTTPSProxyConfig:
+		return domainEndpoints(string(v1.ProxyTypeHTTPS), p.DomainConfig, nil)
+	case *v1.TCPMuxProxyConfig:
+		return domainEndpoints(string(v1.ProxyTypeTCPMUX), p.DomainConfig, nil)
+	}
+	return nil
+}
+
+func domainEndpoints(proxyType string, domainCfg v1.DomainConfig, locations []string) []string {
+	domains := append([]string{}, domainCfg.CustomDomains...)
+	if len(domainCfg.SubDomain) > 0 {
+		domains = append(domains, domainCfg.SubDomain+".*")
+	}
+	if len(locations) == 0 {
+		locations = []string{""}
+	}
+	return lo.FlatMap(domains, func(domain string, _ int) []string {
+		return lo.Map(locations, func(location string, _ int) string {
+			return fmt.Sprintf("%s domain %s%s", proxyType, domain, location)
+		})
+	})
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,361 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/server"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+)
+
+type importItem struct {
+	result *pb.ImportConfigResult
+	id     string
+	cliCfg *v1.ClientConfig
+	srvCfg *v1.ServerConfig
+	srvIP  string
+}
+
+type importer struct {
+	ctx      *app.Context
+	userInfo models.UserInfo
+	req      *pb.ImportConfigRequest
+
+	servers   []*knownServer
+	endpoints map[string]map[string]string // serverID -> 入口 -> 占用者
+	ids       map[string]bool              // 本次导入已占用的 client/server id
+}
+
+// ImportConfig 把已有的 frpc/frps 配置文件导入为 server、client 和 proxy 记录
+// 有冲突的文件会被跳过，dry run 时只返回导入计划
+func ImportConfig(ctx *app.Context, req *pb.ImportConfigRequest) (*pb.ImportConfigResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ImportConfigResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+	if len(req.GetFiles()) == 0 {
+		return nil, fmt.Errorf("no file to import")
+	}
+
+	srvs, err := dao.NewQuery(ctx).GetAllServers(userInfo)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get servers")
+		return nil, err
+	}
+
+	imp := &importer{
+		ctx:       ctx,
+		userInfo:  userInfo,
+		req:       req,
+		endpoints: map[string]map[string]string{},
+		ids:       map[string]bool{},
+		servers: lo.FilterMap(srvs, func(srv *models.ServerEntity, _ int) (*knownServer, bool) {
+			return newKnownServer(srv)
+		}),
+	}
+
+	items := lo.Map(req.GetFiles(), func(file *pb.ImportConfigFile, _ int) *importItem {
+		return imp.load(file)
+	})
+
+	// server 先于 client 处理，同一批导入的 client 可以匹配到新导入的 server
+	ordered := append([]*importItem{}, items...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].srvCfg != nil && ordered[j].srvCfg == nil
+	})
+
+	for _, item := range ordered {
+		if len(item.result.GetError()) > 0 {
+			continue
+		}
+		switch {
+		case item.srvCfg != nil:
+			imp.importServer(item)
+		case item.cliCfg != nil:
+			imp.importClient(item)
+		}
+	}
+
+	return &pb.ImportConfigResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Results: lo.Map(items, func(item *importItem, _ int) *pb.ImportConfigResult {
+			return item.result
+		}),
+	}, nil
+}
+
+func (imp *importer) load(file *pb.ImportConfigFile) *importItem {
+	item := &importItem{
+		result: &pb.ImportConfigResult{FileName: lo.ToPtr(file.GetName())},
+		id:     idFromFileName(file.GetName()),
+	}
+
+	kind, cliCfg, srvCfg, err := loadConfigFile(file.GetKind(), file.GetContent())
+	if err != nil {
+		item.result.Error = lo.ToPtr(err.Error())
+		return item
+	}
+	item.result.Kind = lo.ToPtr(kind)
+	item.cliCfg, item.srvCfg = cliCfg, srvCfg
+
+	if len(item.id) == 0 {
+		item.result.Error = lo.ToPtr("cannot get id from file name")
+	}
+	return item
+}
+
+func (imp *importer) importServer(item *importItem) {
+	var (
+		result   = item.result
+		serverID = app.GlobalClientID(imp.userInfo.GetUserName(), "s", item.id)
+	)
+	result.ServerId = lo.ToPtr(serverID)
+
+	if imp.ids[serverID] {
+		result.Conflicts = append(result.Conflicts, fmt.Sprintf("server [%s] is duplicated in this import", serverID))
+	} else if _, err := dao.NewQuery(imp.ctx).GetServerByServerID(imp.userInfo, serverID); err == nil {
+		result.Conflicts = append(result.Conflicts, fmt.Sprintf("server [%s] already exists", serverID))
+	}
+	imp.ids[serverID] = true
+
+	item.srvIP = serverPublicIP(item.srvCfg, imp.req.GetServerIp())
+	if len(item.srvIP) == 0 {
+		result.Conflicts = append(result.Conflicts, "cannot get public ip of server, set server ip in request")
+	}
+
+	if len(result.Conflicts) > 0 || imp.req.GetDryRun() {
+		imp.addServer(serverID, item)
+		return
+	}
+
+	if err := imp.createServer(serverID, item); err != nil {
+		logger.Logger(imp.ctx).WithError(err).Errorf("cannot import server, file: [%s]", result.GetFileName())
+		result.Error = lo.ToPtr(err.Error())
+		return
+	}
+	result.Imported = lo.ToPtr(true)
+	imp.addServer(serverID, item)
+}
+
+// addServer 没有冲突的 server 才能被后续 client 匹配
+func (imp *importer) addServer(serverID string, item *importItem) {
+	if len(item.result.Conflicts) > 0 {
+		return
+	}
+	imp.servers = append(imp.servers, &knownServer{
+		serverID: serverID,
+		serverIP: item.srvIP,
+		cfg:      item.srvCfg,
+	})
+	imp.endpoints[serverID] = map[string]string{}
+}
+
+func (imp *importer) createServer(serverID string, item *importItem) error {
+	initResp, err := server.InitServerHandler(imp.ctx, &pb.InitServerRequest{
+		ServerId: lo.ToPtr(item.id),
+		ServerIp: lo.ToPtr(item.srvIP),
+	})
+	if err != nil {
+		return err
+	}
+	if initResp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		return fmt.Errorf("%s", initResp.GetStatus().GetMessage())
+	}
+
+	rawCfg, err := json.Marshal(item.srvCfg)
+	if err != nil {
+		return err
+	}
+
+	resp, err := server.UpdateFrpsHander(imp.ctx, &pb.UpdateFRPSRequest{
+		ServerId: lo.ToPtr(serverID),
+		Config:   rawCfg,
+		ServerIp: lo.ToPtr(item.srvIP),
+		Comment:  lo.ToPtr("imported from " + item.result.GetFileName()),
+	})
+	if err == nil && resp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		err = fmt.Errorf("%s", resp.GetStatus().GetMessage())
+	}
+	if err != nil {
+		imp.rollbackServer(serverID)
+		return err
+	}
+	return nil
+}
+
+// rollbackServer 配置写入失败时删除已创建的 server，避免留下没有配置的记录
+func (imp *importer) rollbackServer(serverID string) {
+	if _, err := server.DeleteServerHandler(imp.ctx, &pb.DeleteServerRequest{ServerId: lo.ToPtr(serverID)}); err != nil {
+		logger.Logger(imp.ctx).WithError(err).Errorf("cannot roll back imported server, id: [%s]", serverID)
+	}
+}
+
+func (imp *importer) importClient(item *importItem) {
+	var (
+		result   = item.result
+		clientID = app.GlobalClientID(imp.userInfo.GetUserName(), "c", item.id)
+	)
+	result.ClientId = lo.ToPtr(clientID)
+	result.ProxyNames = lo.Map(item.cliCfg.Proxies, func(p v1.TypedProxyConfig, _ int) string {
+		return p.GetBaseConfig().Name
+	})
+	result.VisitorNames = lo.Map(item.cliCfg.Visitors, func(v v1.TypedVisitorConfig, _ int) string {
+		return v.GetBaseConfig().Name
+	})
+
+	if imp.ids[clientID] {
+		result.Conflicts = append(result.Conflicts, fmt.Sprintf("client [%s] is duplicated in this import", clientID))
+	} else if _, err := dao.NewQuery(imp.ctx).GetClientByClientID(imp.userInfo, clientID); err == nil {
+		result.Conflicts = append(result.Conflicts, fmt.Sprintf("client [%s] already exists", clientID))
+	}
+	imp.ids[clientID] = true
+
+	srv, err := imp.matchServer(&item.cliCfg.ClientCommonConfig)
+	if err != nil {
+		result.Conflicts = append(result.Conflicts, err.Error())
+		return
+	}
+	result.ServerId = lo.ToPtr(srv.serverID)
+
+	occupied, conflicts := imp.checkEndpoints(srv.serverID, clientID, item.cliCfg.Proxies)
+	result.Conflicts = append(result.Conflicts, conflicts...)
+	if len(result.Conflicts) > 0 {
+		return
+	}
+	for endpoint, owner := range occupied {
+		imp.endpoints[srv.serverID][endpoint] = owner
+	}
+	if imp.req.GetDryRun() {
+		return
+	}
+
+	if err := imp.createClient(clientID, srv.serverID, item); err != nil {
+		logger.Logger(imp.ctx).WithError(err).Errorf("cannot import client, file: [%s]", result.GetFileName())
+		result.Error = lo.ToPtr(err.Error())
+		return
+	}
+	result.Imported = lo.ToPtr(true)
+}
+
+// matchServer 根据 serverAddr 和 serverPort 匹配 server，匹配不到时使用请求中指定的 server
+func (imp *importer) matchServer(cliCfg *v1.ClientCommonConfig) (*knownServer, error) {
+	matched := lo.Filter(imp.servers, func(s *knownServer, _ int) bool {
+		return s.match(cliCfg)
+	})
+	if len(matched) > 1 {
+		return nil, fmt.Errorf("server address [%s:%d] matches multiple servers: %v", cliCfg.ServerAddr, cliCfg.ServerPort,
+			lo.Map(matched, func(s *knownServer, _ int) string { return s.serverID }))
+	}
+	if len(matched) == 1 {
+		return matched[0], nil
+	}
+
+	if fallbackID := imp.req.GetServerId(); len(fallbackID) > 0 {
+		if srv, ok := lo.Find(imp.servers, func(s *knownServer) bool { return s.serverID == fallbackID }); ok {
+			return srv, nil
+		}
+		return nil, fmt.Errorf("server [%s] not found or not configured", fallbackID)
+	}
+	return nil, fmt.Errorf("server address [%s:%d] does not match any known server", cliCfg.ServerAddr, cliCfg.ServerPort)
+}
+
+// checkEndpoints 检查 proxy 占用的端口和域名是否已被 server 上的其他 proxy 使用，返回本次将占用的入口和冲突
+func (imp *importer) checkEndpoints(serverID, clientID string, proxies []v1.TypedProxyConfig) (map[string]string, []string) {
+	used, err := imp.usedEndpoints(serverID)
+	if err != nil {
+		logger.Logger(imp.ctx).WithError(err).Errorf("cannot list proxy configs of server, id: [%s]", serverID)
+		return nil, []string{fmt.Sprintf("cannot list proxies of server [%s]", serverID)}
+	}
+
+	occupied := map[string]string{}
+	conflicts := []string{}
+	for _, p := range proxies {
+		name := p.GetBaseConfig().Name
+		for _, endpoint := range proxyEndpoints(p.ProxyConfigurer) {
+			owner, ok := used[endpoint]
+			if !ok {
+				owner, ok = occupied[endpoint]
+			}
+			if ok {
+				conflicts = append(conflicts, fmt.Sprintf("proxy [%s] uses %s which is already used by [%s] on server [%s]", name, endpoint, owner, serverID))
+				continue
+			}
+			occupied[endpoint] = fmt.Sprintf("%s/%s", clientID, name)
+		}
+	}
+	return occupied, conflicts
+}
+
+func (imp *importer) usedEndpoints(serverID string) (map[string]string, error) {
+	if used, ok := imp.endpoints[serverID]; ok {
+		return used, nil
+	}
+
+	proxyCfgs, err := dao.NewQuery(imp.ctx).AdminListProxyConfigsWithFilters(&models.ProxyConfigEntity{ServerID: serverID})
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]string{}
+	for _, proxyCfg := range proxyCfgs {
+		typedCfg, err := proxyCfg.GetTypedProxyConfig()
+		if err != nil {
+			continue
+		}
+		for _, endpoint := range proxyEndpoints(typedCfg.ProxyConfigurer) {
+			used[endpoint] = fmt.Sprintf("%s/%s", proxyCfg.ClientID, proxyCfg.Name)
+		}
+	}
+	imp.endpoints[serverID] = used
+	return used, nil
+}
+
+func (imp *importer) createClient(clientID, serverID string, item *importItem) error {
+	initResp, err := client.InitClientHandler(imp.ctx, &pb.InitClientRequest{
+		ClientId: lo.ToPtr(item.id),
+	})
+	if err != nil {
+		return err
+	}
+	if initResp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		return fmt.Errorf("%s", initResp.GetStatus().GetMessage())
+	}
+
+	rawCfg, err := json.Marshal(item.cliCfg)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.UpdateFrpcHander(imp.ctx, &pb.UpdateFRPCRequest{
+		ClientId: lo.ToPtr(clientID),
+		ServerId: lo.ToPtr(serverID),
+		Config:   rawCfg,
+		Comment:  lo.ToPtr("imported from " + item.result.GetFileName()),
+	})
+	if err == nil && resp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		err = fmt.Errorf("%s", resp.GetStatus().GetMessage())
+	}
+	if err != nil {
+		imp.rollbackClient(clientID)
+		return err
+	}
+	return nil
+}
+
+// rollbackClient 配置写入失败时删除已创建的 client 及其可能已写入的 proxy
+func (imp *importer) rollbackClient(clientID string) {
+	if _, err := client.DeleteClientHandler(imp.ctx, &pb.DeleteClientRequest{ClientId: lo.ToPtr(clientID)}); err != nil {
+		logger.Logger(imp.ctx).WithError(err).Errorf("cannot roll back imported client, id: [%s]", clientID)
+	}
+}
@@ -0,0 +1,198 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/utils"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+)
+
+// manifest 声明式描述用户的 server、client、proxy、visitor 和 worker
+//
+//	servers:
+//	  - id: s1
+//	    ip: 1.2.3.4
+//	    config: { bindPort: 7000 }
+//	clients:
+//	  - id: c1
+//	    proxies:
+//	      - { server: s1, name: ssh, type: tcp, localPort: 22, remotePort: 6000 }
+//	workers:
+//	  - { name: hello, clients: [c1], code: "..." }
+//
+// id 可以是页面上填写的 id，也可以是带用户名前缀的完整 id
+type manifest struct {
+	Servers []*serverSpec `json:"servers,omitempty"`
+	Clients []*clientSpec `json:"clients,omitempty"`
+	Workers []*workerSpec `json:"workers,omitempty"`
+}
+
+type serverSpec struct {
+	ID       string          `json:"id"`
+	IP       string          `json:"ip,omitempty"` // 为空时使用 frps 监听的具体地址
+	Comment  string          `json:"comment,omitempty"`
+	FrpsUrls []string        `json:"frps_urls,omitempty"`
+	Config   json.RawMessage `json:"config"` // frps 配置
+
+	cfg *v1.ServerConfig
+}
+
+type clientSpec struct {
+	ID       string         `json:"id"`
+	Proxies  []*proxySpec   `json:"proxies,omitempty"`
+	Visitors []*visitorSpec `json:"visitors,omitempty"`
+}
+
+// proxySpec 在 frp 的 proxy 配置中增加 server 字段，表示 proxy 所在的 server
+type proxySpec struct {
+	Server string
+
+	content []byte // 可以直接传给 CreateProxyConfig 的配置
+	cfg     v1.TypedProxyConfig
+}
+
+// visitorSpec 在 frp 的 visitor 配置中增加 server 字段
+type visitorSpec struct {
+	Server string
+
+	content []byte
+	cfg     v1.TypedVisitorConfig
+}
+
+// workerSpec 在 worker 的定义中增加部署的 client 列表，其余字段与 common.Worker 相同
+type workerSpec struct {
+	Clients []string
+
+	worker *pb.Worker
+}
+
+func (s *proxySpec) UnmarshalJSON(data []byte) error {
+	rawCfg, err := splitInlineField(data, "server", &s.Server)
+	if err != nil {
+		return err
+	}
+	s.content = []byte(`{"proxies":[` + string(rawCfg) + `]}`)
+	return nil
+}
+
+func (s *visitorSpec) UnmarshalJSON(data []byte) error {
+	rawCfg, err := splitInlineField(data, "server", &s.Server)
+	if err != nil {
+		return err
+	}
+	s.content = []byte(`{"visitors":[` + string(rawCfg) + `]}`)
+	return nil
+}
+
+func (s *workerSpec) UnmarshalJSON(data []byte) error {
+	rawWorker, err := splitInlineField(data, "clients", &s.Clients)
+	if err != nil {
+		return err
+	}
+	s.worker = &pb.Worker{}
+	return protojson.Unmarshal(rawWorker, s.worker)
+}
+
+// splitInlineField 从 frp 配置中拆出 manifest 额外增加的字段，返回剩余的配置
+func splitInlineField(data []byte, key string, value any) (json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if raw, ok := fields[key]; ok {
+		if err := json.Unmarshal(raw, value); err != nil {
+			return nil, fmt.Errorf("invalid field [%s]: %w", key, err)
+		}
+		delete(fields, key)
+	}
+	return json.Marshal(fields)
+}
+
+// loadManifest 解析 yaml 或 json 格式的 manifest，并校验其中的 frp 配置
+func loadManifest(content []byte) (*manifest, error) {
+	rawJSON, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &manifest{}
+	decoder := json.NewDecoder(bytes.NewReader(rawJSON))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	if dup := lo.FindDuplicates(lo.Map(m.Servers, func(s *serverSpec, _ int) string { return s.ID })); len(dup) > 0 {
+		return nil, fmt.Errorf("duplicated server id: %v", dup)
+	}
+	for _, spec := range m.Servers {
+		if len(spec.ID) == 0 || len(spec.Config) == 0 {
+			return nil, fmt.Errorf("server id and config are required")
+		}
+		if spec.cfg, err = utils.LoadServerConfig(spec.Config, true); err != nil {
+			return nil, fmt.Errorf("invalid config of server [%s]: %w", spec.ID, err)
+		}
+	}
+
+	if dup := lo.FindDuplicates(lo.Map(m.Clients, func(c *clientSpec, _ int) string { return c.ID })); len(dup) > 0 {
+		return nil, fmt.Errorf("duplicated client id: %v", dup)
+	}
+	for _, spec := range m.Clients {
+		if len(spec.ID) == 0 {
+			return nil, fmt.Errorf("client id is required")
+		}
+		if err := spec.loadConfigs(); err != nil {
+			return nil, fmt.Errorf("invalid config of client [%s]: %w", spec.ID, err)
+		}
+	}
+
+	if dup := lo.FindDuplicates(lo.Map(m.Workers, func(w *workerSpec, _ int) string { return w.worker.GetName() })); len(dup) > 0 {
+		return nil, fmt.Errorf("duplicated worker name: %v", dup)
+	}
+	for _, spec := range m.Workers {
+		if len(spec.worker.GetName()) == 0 || len(spec.Clients) == 0 {
+			return nil, fmt.Errorf("worker name and clients are required")
+		}
+		if len(spec.worker.GetWorkerId()) > 0 {
+			return nil, fmt.Errorf("worker [%s]: worker_id is managed by master, remove it from manifest", spec.worker.GetName())
+		}
+	}
+	return m, nil
+}
+
+func (spec *clientSpec) loadConfigs() error {
+	for _, p := range spec.Proxies {
+		proxyCfgs, err := utils.LoadProxiesFromContent(p.content)
+		if err != nil {
+			return err
+		}
+		if len(proxyCfgs) != 1 || len(p.Server) == 0 {
+			return fmt.Errorf("each proxy requires server and a valid frp proxy config")
+		}
+		p.cfg = proxyCfgs[0]
+	}
+	if dup := lo.FindDuplicates(lo.Map(spec.Proxies, func(p *proxySpec, _ int) string { return p.cfg.GetBaseConfig().Name })); len(dup) > 0 {
+		return fmt.Errorf("duplicated proxy name: %v", dup)
+	}
+
+	for _, v := range spec.Visitors {
+		visitorCfgs, err := utils.LoadVisitorsFromContent(v.content)
+		if err != nil {
+			return err
+		}
+		if len(visitorCfgs) != 1 || visitorCfgs[0].VisitorConfigurer == nil || len(v.Server) == 0 {
+			return fmt.Errorf("each visitor requires server and a valid frp visitor config")
+		}
+		v.cfg = visitorCfgs[0]
+	}
+	if dup := lo.FindDuplicates(lo.Map(spec.Visitors, func(v *visitorSpec, _ int) string { return v.cfg.GetBaseConfig().Name })); len(dup) > 0 {
+		return fmt.Errorf("duplicated visitor name: %v", dup)
+	}
+	return nil
+}
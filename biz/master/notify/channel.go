@@ -0,0 +1,145 @@
+package notify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func CreateNotifyChannel(ctx *app.Context, req *pb.CreateNotifyChannelRequest) (*pb.CreateNotifyChannelResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	if err := validateChannel(req.GetChannel()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("invalid notify channel")
+		return nil, err
+	}
+
+	channel := (&models.NotifyChannel{}).FromPB(req.GetChannel())
+	if err := dao.NewQuery(ctx).CreateNotifyChannel(userInfo, channel); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot create notify channel, name: [%s]", channel.Name)
+		return nil, err
+	}
+
+	return &pb.CreateNotifyChannelResponse{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Channel: channel.ToSafePB(),
+	}, nil
+}
+
+func UpdateNotifyChannel(ctx *app.Context, req *pb.UpdateNotifyChannelRequest) (*pb.UpdateNotifyChannelResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	id := req.GetChannel().GetId()
+	channel, err := dao.NewQuery(ctx).GetNotifyChannel(userInfo, uint(id))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get notify channel, id: [%d]", id)
+		return nil, err
+	}
+
+	newChannel := req.GetChannel()
+	keepSecrets(channel.ToPB(), newChannel)
+	if err := validateChannel(newChannel); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("invalid notify channel, id: [%d]", id)
+		return nil, err
+	}
+
+	channel.FromPB(newChannel)
+	if err := dao.NewQuery(ctx).UpdateNotifyChannel(userInfo, channel); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update notify channel, id: [%d]", id)
+		return nil, err
+	}
+
+	return &pb.UpdateNotifyChannelResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
+
+func DeleteNotifyChannel(ctx *app.Context, req *pb.DeleteNotifyChannelRequest) (*pb.DeleteNotifyChannelResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	if err := dao.NewQuery(ctx).DeleteNotifyChannel(userInfo, uint(req.GetId())); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete notify channel, id: [%d]", req.GetId())
+		return nil, err
+	}
+
+	return &pb.DeleteNotifyChannelResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
+
+func ListNotifyChannels(ctx *app.Context, req *pb.ListNotifyChannelsRequest) (*pb.ListNotifyChannelsResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	channels, err := dao.NewQuery(ctx).ListNotifyChannels(userInfo)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list notify channels")
+		return nil, err
+	}
+
+	return &pb.ListNotifyChannelsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Channels: lo.Map(channels, func(item *models.NotifyChannel, _ int) *pb.NotifyChannel {
+			return item.ToSafePB()
+		}),
+	}, nil
+}
+
+// TestNotifyChannel 同步发送一条测试消息，不受冷却时间和订阅事件限制
+func TestNotifyChannel(ctx *app.Context, req *pb.TestNotifyChannelRequest) (*pb.TestNotifyChannelResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	channel, err := dao.NewQuery(ctx).GetNotifyChannel(userInfo, uint(req.GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get notify channel, id: [%d]", req.GetId())
+		return nil, err
+	}
+
+	sendErr := Send(ctx, channel.ToPB(), &pb.NotifyEvent{
+		Type:     lo.ToPtr(pb.NotifyEventType_NOTIFY_EVENT_TYPE_UNSPECIFIED),
+		UserId:   lo.ToPtr(uint32(userInfo.GetUserID())),
+		TenantId: lo.ToPtr(uint32(userInfo.GetTenantID())),
+		Subject:  lo.ToPtr(channel.Name),
+		Title:    lo.ToPtr("test notification"),
+		Message:  lo.ToPtr(fmt.Sprintf("this is a test notification sent by %s", userInfo.GetUserName())),
+		Time:     lo.ToPtr(time.Now().UnixMilli()),
+	})
+
+	lastError := ""
+	if sendErr != nil {
+		lastError = sendErr.Error()
+	}
+	if err := dao.NewQuery(ctx).AdminUpdateNotifyChannelResult(channel.ID, time.Now(), lastError); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update notify channel result, id: [%d]", channel.ID)
+	}
+
+	if sendErr != nil {
+		logger.Logger(ctx).WithError(sendErr).Errorf("send test notification failed, channel id: [%d]", channel.ID)
+		return nil, sendErr
+	}
+
+	return &pb.TestNotifyChannelResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,147 @@
+package notify
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// NodeOffline client 或 server 与 master 的 ServerSend 连接结束时调用
+func NodeOffline(ctx *app.Context, cliType, id string) {
+	var (
+		userID, tenantID int
+		eventType        pb.NotifyEventType
+	)
+
+	switch cliType {
+	case defs.CliTypeClient:
+		cli, err := dao.NewQuery(ctx).AdminGetClientByClientID(id)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get offline client, id: [%s]", id)
+			return
+		}
+		userID, tenantID = cli.UserID, cli.TenantID
+		eventType = pb.NotifyEventType_NOTIFY_EVENT_TYPE_CLIENT_OFFLINE
+	case defs.CliTypeServer:
+		srv, err := dao.NewQuery(ctx).AdminGetServerByServerID(id)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get offline server, id: [%s]", id)
+			return
+		}
+		userID, tenantID = srv.UserID, srv.TenantID
+		eventType = pb.NotifyEventType_NOTIFY_EVENT_TYPE_SERVER_OFFLINE
+	default:
+		return
+	}
+
+	Emit(ctx, &pb.NotifyEvent{
+		Type:     lo.ToPtr(eventType),
+		UserId:   lo.ToPtr(uint32(userID)),
+		TenantId: lo.ToPtr(uint32(tenantID)),
+		Subject:  lo.ToPtr(id),
+		Title:    lo.ToPtr(fmt.Sprintf("%s [%s] is offline", cliType, id)),
+		Message:  lo.ToPtr(fmt.Sprintf("the connection between %s [%s] and master is closed", cliType, id)),
+		Fields:   map[string]string{"id": id, "type": cliType},
+	})
+}
+
+// ProxyError proxy 的运行状态变为错误时调用，只由 client 上报状态的 PushProxyStatus 触发，读取 proxy 的接口不应调用
+func ProxyError(ctx *app.Context, proxy *models.ProxyConfigEntity, reason string) {
+	subject := fmt.Sprintf("%s/%s", proxy.ClientID, proxy.Name)
+	Emit(ctx, &pb.NotifyEvent{
+		Type:     lo.ToPtr(pb.NotifyEventType_NOTIFY_EVENT_TYPE_PROXY_ERROR),
+		UserId:   lo.ToPtr(uint32(proxy.UserID)),
+		TenantId: lo.ToPtr(uint32(proxy.TenantID)),
+		Subject:  lo.ToPtr(subject),
+		Title:    lo.ToPtr(fmt.Sprintf("proxy [%s] is in error status", proxy.Name)),
+		Message:  lo.ToPtr(reason),
+		Fields: map[string]string{
+			"proxy_name": proxy.Name,
+			"client_id":  proxy.ClientID,
+			"server_id":  proxy.ServerID,
+		},
+	})
+}
+
+func WorkerCrashLoop(ctx *app.Context, clientID, workerID string, userID, tenantID uint32, restarts int32, reason string) {
+	Emit(ctx, &pb.NotifyEvent{
+		Type:     lo.ToPtr(pb.NotifyEventType_NOTIFY_EVENT_TYPE_WORKER_CRASH_LOOP),
+		UserId:   lo.ToPtr(userID),
+		TenantId: lo.ToPtr(tenantID),
+		Subject:  lo.ToPtr(fmt.Sprintf("%s/%s", clientID, workerID)),
+		Title:    lo.ToPtr(fmt.Sprintf("worker [%s] is crash looping on client [%s]", workerID, clientID)),
+		Message:  lo.ToPtr(reason),
+		Fields: map[string]string{
+			"worker_id":     workerID,
+			"client_id":     clientID,
+			"restart_count": strconv.Itoa(int(restarts)),
+		},
+	})
+}
+
+func WorkerOOMKilled(ctx *app.Context, clientID, workerID string, userID, tenantID uint32, reason string) {
+	Emit(ctx, &pb.NotifyEvent{
+		Type:     lo.ToPtr(pb.NotifyEventType_NOTIFY_EVENT_TYPE_WORKER_OOM_KILLED),
+		UserId:   lo.ToPtr(userID),
+		TenantId: lo.ToPtr(tenantID),
+		Subject:  lo.ToPtr(fmt.Sprintf("%s/%s", clientID, workerID)),
+		Title:    lo.ToPtr(fmt.Sprintf("worker [%s] is killed by oom on client [%s]", workerID, clientID)),
+		Message:  lo.ToPtr(reason),
+		Fields: map[string]string{
+			"worker_id": workerID,
+			"client_id": clientID,
+		},
+	})
+}
+
+func TrafficQuotaExceeded(ctx *app.Context, srv *models.ServerEntity, proxyName string, usedBytes, quotaBytes int64) {
+	Emit(ctx, &pb.NotifyEvent{
+		Type:     lo.ToPtr(pb.NotifyEventType_NOTIFY_EVENT_TYPE_TRAFFIC_QUOTA_EXCEEDED),
+		UserId:   lo.ToPtr(uint32(srv.UserID)),
+		TenantId: lo.ToPtr(uint32(srv.TenantID)),
+		Subject:  lo.ToPtr(fmt.Sprintf("%s/%s", srv.ServerID, proxyName)),
+		Title:    lo.ToPtr(fmt.Sprintf("proxy [%s] exceeded its daily traffic quota", proxyName)),
+		Message:  lo.ToPtr(fmt.Sprintf("today traffic of proxy [%s] on server [%s] is %d bytes, quota is %d bytes", proxyName, srv.ServerID, usedBytes, quotaBytes)),
+		Fields: map[string]string{
+			"proxy_name":  proxyName,
+			"server_id":   srv.ServerID,
+			"used_bytes":  strconv.FormatInt(usedBytes, 10),
+			"quota_bytes": strconv.FormatInt(quotaBytes, 10),
+		},
+	})
+}
+
+func ClientJoined(ctx *app.Context, userInfo models.UserInfo, clientID string) {
+	Emit(ctx, &pb.NotifyEvent{
+		Type:     lo.ToPtr(pb.NotifyEventType_NOTIFY_EVENT_TYPE_CLIENT_JOINED),
+		UserId:   lo.ToPtr(uint32(userInfo.GetUserID())),
+		TenantId: lo.ToPtr(uint32(userInfo.GetTenantID())),
+		Subject:  lo.ToPtr(clientID),
+		Title:    lo.ToPtr(fmt.Sprintf("client [%s] joined", clientID)),
+		Message:  lo.ToPtr(fmt.Sprintf("a new client [%s] joined with the token of user [%s]", clientID, userInfo.GetUserName())),
+		Fields:   map[string]string{"client_id": clientID},
+	})
+}
+
+func ProxyUnreachable(ctx *app.Context, probe *models.ProxyProbeEntity, reason string) {
+	Emit(ctx, &pb.NotifyEvent{
+		Type:     lo.ToPtr(pb.NotifyEventType_NOTIFY_EVENT_TYPE_PROXY_UNREACHABLE),
+		UserId:   lo.ToPtr(uint32(probe.UserID)),
+		TenantId: lo.ToPtr(uint32(probe.TenantID)),
+		Subject:  lo.ToPtr(fmt.Sprintf("%s/%s", probe.ClientID, probe.ProxyName)),
+		Title:    lo.ToPtr(fmt.Sprintf("proxy [%s] is unreachable", probe.ProxyName)),
+		Message:  lo.ToPtr(fmt.Sprintf("probe of proxy [%s] from server [%s] failed: %s", probe.ProxyName, probe.ServerID, reason)),
+		Fields: map[string]string{
+			"proxy_name": probe.ProxyName,
+			"client_id":  probe.ClientID,
+			"server_id":  probe.ServerID,
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package notify
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/VaalaCat/frp-panel/pb"
+)
+
+func validateChannel(channel *pb.NotifyChannel) error {
+	if channel == nil {
+		return fmt.Errorf("notify channel is empty")
+	}
+	if len(channel.GetName()) == 0 {
+		return fmt.Errorf("notify channel name is empty")
+	}
+
+	switch channel.GetType() {
+	case pb.NotifyChannel_TYPE_WEBHOOK:
+		return validateURL(channel.GetWebhook().GetUrl())
+	case pb.NotifyChannel_TYPE_CHAT:
+		return validateURL(channel.GetChat().GetUrl())
+	case pb.NotifyChannel_TYPE_EMAIL:
+		email := channel.GetEmail()
+		if len(email.GetSmtpHost()) == 0 || email.GetSmtpPort() <= 0 || email.GetSmtpPort() > 65535 {
+			return fmt.Errorf("invalid smtp address")
+		}
+		if len(email.GetFrom()) == 0 || len(email.GetTo()) == 0 {
+			return fmt.Errorf("email sender and receivers can not be empty")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported notify channel type: [%s]", channel.GetType().String())
+	}
+}
+
+func validateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return fmt.Errorf("invalid url: [%s], only http and https are supported", rawURL)
+	}
+	return nil
+}
+
+// keepSecrets 更新时未填写的密码和签名密钥保持原值，列表接口不会返回它们
+func keepSecrets(old, new *pb.NotifyChannel) {
+	if new == nil {
+		return
+	}
+	if new.GetWebhook() != nil && len(new.GetWebhook().GetSecret()) == 0 {
+		new.Webhook.Secret = old.GetWebhook().Secret
+	}
+	if new.GetEmail() != nil && len(new.GetEmail().GetPassword()) == 0 {
+		new.Email.Password = old.GetEmail().Password
+	}
+}
@@ -0,0 +1,118 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+const lastSentPruneSize = 1024
+
+type NotifyMgr struct {
+	mu       sync.Mutex
+	lastSent map[string]time.Time // userID/eventType/subject
+	stopped  atomic.Bool
+}
+
+func NewNotifyMgr() *NotifyMgr {
+	return &NotifyMgr{
+		lastSent: map[string]time.Time{},
+	}
+}
+
+// Notify 异步把事件发送到资源所属用户订阅了该事件的渠道，冷却时间内的重复事件会被忽略
+func (m *NotifyMgr) Notify(ctx *app.Context, event *pb.NotifyEvent) {
+	if m.stopped.Load() {
+		logger.Logger(ctx).Debugf("master is stopping, skip notify event [%s] of [%s]", event.GetType().String(), event.GetSubject())
+		return
+	}
+
+	if event.Time == nil {
+		event.Time = lo.ToPtr(time.Now().UnixMilli())
+	}
+
+	if !m.allow(event) {
+		logger.Logger(ctx).Debugf("notify event [%s] of [%s] is in cooldown, skip", event.GetType().String(), event.GetSubject())
+		return
+	}
+
+	appInstance := ctx.GetApp()
+	c := app.NewContext(context.WithoutCancel(ctx), appInstance)
+	go m.dispatch(c, event)
+}
+
+// Stop master 退出时调用，之后的事件都会被忽略，避免所有节点断开连接时发出大量下线通知
+func (m *NotifyMgr) Stop() {
+	m.stopped.Store(true)
+}
+
+func (m *NotifyMgr) dispatch(ctx *app.Context, event *pb.NotifyEvent) {
+	channels, err := dao.NewQuery(ctx).AdminListEnabledNotifyChannels(event.GetUserId(), event.GetTenantId())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list notify channels, userID: [%d]", event.GetUserId())
+		return
+	}
+
+	for _, channel := range channels {
+		if !channel.Subscribed(event.GetType()) {
+			continue
+		}
+
+		lastError := ""
+		if err := Send(ctx, channel.ToPB(), event); err != nil {
+			lastError = err.Error()
+			logger.Logger(ctx).WithError(err).Errorf("send notify event [%s] to channel [%d] failed", event.GetType().String(), channel.ID)
+		} else {
+			logger.Logger(ctx).Infof("send notify event [%s] of [%s] to channel [%d] success", event.GetType().String(), event.GetSubject(), channel.ID)
+		}
+
+		if err := dao.NewQuery(ctx).AdminUpdateNotifyChannelResult(channel.ID, time.Now(), lastError); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot update notify channel result, id: [%d]", channel.ID)
+		}
+	}
+}
+
+func (m *NotifyMgr) allow(event *pb.NotifyEvent) bool {
+	cooldown := defs.NotifyCooldown
+	if event.GetType() == pb.NotifyEventType_NOTIFY_EVENT_TYPE_TRAFFIC_QUOTA_EXCEEDED {
+		cooldown = defs.NotifyTrafficQuotaCooldown
+	}
+
+	key := fmt.Sprintf("%d/%d/%s", event.GetUserId(), event.GetType(), event.GetSubject())
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if last, ok := m.lastSent[key]; ok && now.Sub(last) < cooldown {
+		return false
+	}
+	m.lastSent[key] = now
+
+	if len(m.lastSent) > lastSentPruneSize {
+		for k, t := range m.lastSent {
+			if now.Sub(t) >= defs.NotifyTrafficQuotaCooldown {
+				delete(m.lastSent, k)
+			}
+		}
+	}
+	return true
+}
+
+// Emit 发送事件，NotifyMgr 未初始化时忽略
+func Emit(ctx *app.Context, event *pb.NotifyEvent) {
+	mgr := ctx.GetApp().GetNotifyMgr()
+	if mgr == nil {
+		return
+	}
+	mgr.Notify(ctx, event)
+}
@@ -0,0 +1,256 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/smtp"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+)
+
+const smtpsPort = 465
+
+// 运营商级 NAT 地址，netip 不把它算作内网地址
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+type webhookPayload struct {
+	Event   string            `json:"event"`
+	Subject string            `json:"subject"`
+	Title   string            `json:"title"`
+	Message string            `json:"message"`
+	Time    int64             `json:"time"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+type chatPayload struct {
+	Text   string `json:"text"`
+	ChatID string `json:"chat_id,omitempty"`
+}
+
+// Send 同步向渠道发送一条事件
+func Send(ctx *app.Context, channel *pb.NotifyChannel, event *pb.NotifyEvent) error {
+	c, cancel := context.WithTimeout(ctx, defs.NotifySendTimeout)
+	defer cancel()
+
+	dialer := newDialer(ctx.GetApp().GetConfig().Master.NotifyAllowPrivateTarget)
+	switch channel.GetType() {
+	case pb.NotifyChannel_TYPE_WEBHOOK:
+		return sendWebhook(c, dialer, channel.GetWebhook(), event)
+	case pb.NotifyChannel_TYPE_EMAIL:
+		return sendEmail(c, dialer, channel.GetEmail(), event)
+	case pb.NotifyChannel_TYPE_CHAT:
+		return sendChat(c, dialer, channel.GetChat(), event)
+	default:
+		return fmt.Errorf("unsupported notify channel type: [%s]", channel.GetType().String())
+	}
+}
+
+// newDialer 渠道地址由用户填写，默认在连接前检查解析后的地址，拒绝访问 master 所在的内网
+// 在建立连接时检查，重定向和 DNS 重新解析到内网地址同样会被拒绝
+func newDialer(allowPrivate bool) *net.Dialer {
+	dialer := &net.Dialer{}
+	if allowPrivate {
+		return dialer
+	}
+	dialer.Control = func(_, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		ip, err := netip.ParseAddr(host)
+		if err != nil {
+			return err
+		}
+		if !isPublicAddr(ip.Unmap()) {
+			return fmt.Errorf("notify target [%s] is not a public address", ip.String())
+		}
+		return nil
+	}
+	return dialer
+}
+
+func isPublicAddr(ip netip.Addr) bool {
+	return ip.IsValid() && !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() && !sharedAddressSpace.Contains(ip)
+}
+
+// EventName 返回事件的小写名称，如 client_offline
+func EventName(t pb.NotifyEventType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "NOTIFY_EVENT_TYPE_"))
+}
+
+func sendWebhook(ctx context.Context, dialer *net.Dialer, cfg *pb.NotifyWebhookConfig, event *pb.NotifyEvent) error {
+	body, err := json.Marshal(&webhookPayload{
+		Event:   EventName(event.GetType()),
+		Subject: event.GetSubject(),
+		Title:   event.GetTitle(),
+		Message: event.GetMessage(),
+		Time:    event.GetTime(),
+		Fields:  event.GetFields(),
+	})
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{defs.NotifyEventHeader: EventName(event.GetType())}
+	for k, v := range cfg.GetHeaders() {
+		headers[k] = v
+	}
+	if len(cfg.GetSecret()) > 0 {
+		mac := hmac.New(sha256.New, []byte(cfg.GetSecret()))
+		mac.Write(body)
+		headers[defs.NotifySignatureHeader] = "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	return postJSON(ctx, dialer, cfg.GetUrl(), body, headers)
+}
+
+func sendChat(ctx context.Context, dialer *net.Dialer, cfg *pb.NotifyChatConfig, event *pb.NotifyEvent) error {
+	body, err := json.Marshal(&chatPayload{
+		Text:   formatText(event),
+		ChatID: cfg.GetChatId(),
+	})
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, dialer, cfg.GetUrl(), body, nil)
+}
+
+// postJSON 只返回状态码，不把响应内容带回给调用方，避免渠道地址被用来读取其他服务的响应
+func postJSON(ctx context.Context, dialer *net.Dialer, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: defs.NotifySendTimeout,
+	}
+	// 经过代理时无法检查目标地址，只有允许访问内网时才使用环境变量中的代理
+	if dialer.Control == nil {
+		transport.Proxy = http.ProxyFromEnvironment
+	}
+	cli := &http.Client{Transport: transport}
+	defer cli.CloseIdleConnections()
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code [%d]", resp.StatusCode)
+	}
+	return nil
+}
+
+func sendEmail(ctx context.Context, dialer *net.Dialer, cfg *pb.NotifyEmailConfig, event *pb.NotifyEvent) error {
+	host := cfg.GetSmtpHost()
+	addr := net.JoinHostPort(host, strconv.Itoa(int(cfg.GetSmtpPort())))
+
+	var conn net.Conn
+	var err error
+	if cfg.GetSmtpPort() == smtpsPort {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	cli, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	if ok, _ := cli.Extension("STARTTLS"); ok && cfg.GetSmtpPort() != smtpsPort {
+		if err := cli.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if len(cfg.GetUsername()) > 0 {
+		if err := cli.Auth(smtp.PlainAuth("", cfg.GetUsername(), cfg.GetPassword(), host)); err != nil {
+			return err
+		}
+	}
+
+	if err := cli.Mail(cfg.GetFrom()); err != nil {
+		return err
+	}
+	for _, to := range cfg.GetTo() {
+		if err := cli.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := cli.Data()
+	if err != nil {
+		return err
+	}
+	msg := &bytes.Buffer{}
+	fmt.Fprintf(msg, "From: %s\r\n", cfg.GetFrom())
+	fmt.Fprintf(msg, "To: %s\r\n", strings.Join(cfg.GetTo(), ", "))
+	fmt.Fprintf(msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", "[frp-panel] "+event.GetTitle()))
+	fmt.Fprintf(msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(formatText(event), "\n", "\r\n"))
+	if _, err := w.Write(msg.Bytes()); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return cli.Quit()
+}
+
+func formatText(event *pb.NotifyEvent) string {
+	sb := &strings.Builder{}
+	sb.WriteString("[frp-panel] " + event.GetTitle() + "\n")
+	if len(event.GetMessage()) > 0 {
+		sb.WriteString(event.GetMessage() + "\n")
+	}
+
+	keys := make([]string, 0, len(event.GetFields()))
+	for k := range event.GetFields() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(sb, "%s: %s\n", k, event.GetFields()[k])
+	}
+	sb.WriteString("time: " + time.UnixMilli(event.GetTime()).Format(time.RFC3339))
+	return sb.String()
+}
@@ -0,0 +1,139 @@
+package probe
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func SetProxyProbe(ctx *app.Context, req *pb.SetProxyProbeRequest) (*pb.SetProxyProbeResponse, error) {
+	var (
+		userInfo  = common.GetUserInfo(ctx)
+		clientID  = req.GetClientId()
+		serverID  = req.GetServerId()
+		proxyName = req.GetName()
+	)
+
+	if len(clientID) == 0 || len(serverID) == 0 || len(proxyName) == 0 || req.GetProbe() == nil {
+		return nil, fmt.Errorf("request invalid")
+	}
+	if req.GetProbe().GetType() == pb.ProxyProbe_TYPE_UNSPECIFIED {
+		return nil, fmt.Errorf("probe type is required")
+	}
+	if req.GetProbe().GetTimeoutMs() < 0 {
+		return nil, fmt.Errorf("invalid probe timeout")
+	}
+
+	proxyConfig, err := dao.NewQuery(ctx).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{
+		ClientID: clientID,
+		ServerID: serverID,
+		Name:     proxyName,
+	})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get proxy config, client: [%s], server: [%s], proxy name: [%s]", clientID, serverID, proxyName)
+		return nil, err
+	}
+
+	srv, err := dao.NewQuery(ctx).GetServerByServerID(userInfo, serverID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get server, id: [%s]", serverID)
+		return nil, err
+	}
+
+	probe := (&models.ProxyProbeEntity{
+		ServerID:  proxyConfig.ServerID,
+		ClientID:  proxyConfig.ClientID,
+		ProxyName: proxyConfig.Name,
+	}).FromPB(req.GetProbe())
+
+	// 提前推导一次，配置错误时直接返回给用户
+	if _, _, err := resolveTarget(probe, proxyConfig, srv); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot resolve probe target, proxy name: [%s]", proxyName)
+		return nil, err
+	}
+
+	item, err := dao.NewQuery(ctx).UpsertProxyProbe(userInfo, probe)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save proxy probe, proxy name: [%s]", proxyName)
+		return nil, err
+	}
+
+	return &pb.SetProxyProbeResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Probe:  item.ToPB(),
+	}, nil
+}
+
+func DeleteProxyProbe(ctx *app.Context, req *pb.DeleteProxyProbeRequest) (*pb.DeleteProxyProbeResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+
+	if err := dao.NewQuery(ctx).DeleteProxyProbe(userInfo, req.GetServerId(), req.GetClientId(), req.GetName()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete proxy probe, client: [%s], server: [%s], proxy name: [%s]", req.GetClientId(), req.GetServerId(), req.GetName())
+		return nil, err
+	}
+
+	return &pb.DeleteProxyProbeResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
+
+func GetProxyProbe(ctx *app.Context, req *pb.GetProxyProbeRequest) (*pb.GetProxyProbeResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+
+	item, err := dao.NewQuery(ctx).GetProxyProbe(userInfo, req.GetServerId(), req.GetClientId(), req.GetName())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get proxy probe, client: [%s], server: [%s], proxy name: [%s]", req.GetClientId(), req.GetServerId(), req.GetName())
+		return nil, err
+	}
+
+	limit := int(req.GetHistoryLimit())
+	if limit <= 0 || limit > defs.ProxyProbeHistoryLimit {
+		limit = defs.ProxyProbeHistoryLimit
+	}
+	history, err := dao.NewQuery(ctx).ListProxyProbeResults(item.ID, limit)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list proxy probe results, probe id: [%d]", item.ID)
+		return nil, err
+	}
+
+	return &pb.GetProxyProbeResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Probe:  item.ToPB(),
+		History: lo.Map(history, func(r *models.ProxyProbeResult, _ int) *pb.ProxyProbeResult {
+			return r.ToPB()
+		}),
+	}, nil
+}
+
+func ListProxyProbes(ctx *app.Context, req *pb.ListProxyProbesRequest) (*pb.ListProxyProbesResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return &pb.ListProxyProbesResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	probes, total, err := dao.NewQuery(ctx).ListProxyProbes(userInfo, int(req.GetPage()), int(req.GetPageSize()), &models.ProxyProbeEntity{
+		ClientID: req.GetClientId(),
+		ServerID: req.GetServerId(),
+	})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list proxy probes")
+		return nil, err
+	}
+
+	return &pb.ListProxyProbesResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:  lo.ToPtr(int32(total)),
+		Probes: lo.Map(probes, func(item *models.ProxyProbe, _ int) *pb.ProxyProbe {
+			return item.ToPB()
+		}),
+	}, nil
+}
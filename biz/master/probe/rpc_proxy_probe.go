@@ -0,0 +1,98 @@
+package probe
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/biz/master/notify"
+	masterserver "github.com/VaalaCat/frp-panel/biz/master/server"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// PullProxyProbes frps 拉取需要自己执行的探测，目标地址在 master 侧推导
+func PullProxyProbes(ctx *app.Context, req *pb.PullProxyProbesReq) (*pb.PullProxyProbesResp, error) {
+	srv, err := masterserver.ValidateServerRequest(ctx, req.GetBase())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot validate server request")
+		return nil, err
+	}
+
+	probes, err := dao.NewQuery(ctx).AdminListEnabledProxyProbesByServerID(srv.ServerID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list proxy probes, serverID: [%s]", srv.ServerID)
+		return nil, err
+	}
+
+	resp := []*pb.ProxyProbe{}
+	for _, probe := range probes {
+		proxyConfig, err := dao.NewQuery(ctx).AdminGetProxyConfigByClientIDAndName(probe.ClientID, probe.ProxyName)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Warnf("cannot get proxy config of probe, clientID: [%s], proxy name: [%s], skip", probe.ClientID, probe.ProxyName)
+			continue
+		}
+		if proxyConfig.Stopped {
+			continue
+		}
+
+		target, dialAddr, err := resolveTarget(probe.ProxyProbeEntity, proxyConfig, srv)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Warnf("cannot resolve probe target, clientID: [%s], proxy name: [%s], skip", probe.ClientID, probe.ProxyName)
+			continue
+		}
+
+		item := probe.ToPB()
+		item.Target = lo.ToPtr(target)
+		item.DialAddr = lo.ToPtr(dialAddr)
+		if item.GetTimeoutMs() <= 0 {
+			item.TimeoutMs = lo.ToPtr(int32(defs.ProxyProbeDefaultTimeout.Milliseconds()))
+		}
+		resp = append(resp, item)
+	}
+
+	return &pb.PullProxyProbesResp{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Probes: resp,
+	}, nil
+}
+
+// PushProxyProbeResults frps 上报探测结果，探测由成功转为失败时通知隧道所属用户
+func PushProxyProbeResults(ctx *app.Context, req *pb.PushProxyProbeResultsReq) (*pb.PushProxyProbeResultsResp, error) {
+	srv, err := masterserver.ValidateServerRequest(ctx, req.GetBase())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot validate server request")
+		return nil, err
+	}
+
+	for _, result := range req.GetResults() {
+		item := &models.ProxyProbeResult{
+			ProbeID:   uint(result.GetProbeId()),
+			Success:   result.GetSuccess(),
+			LatencyMs: result.GetLatencyMs(),
+			Error:     result.GetError(),
+			CreatedAt: time.Now(),
+		}
+		if result.GetTime() > 0 {
+			item.CreatedAt = time.UnixMilli(result.GetTime())
+		}
+
+		old, err := dao.NewQuery(ctx).AdminSaveProxyProbeResult(srv.ServerID, item)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot save proxy probe result, serverID: [%s], probeID: [%d]", srv.ServerID, item.ProbeID)
+			continue
+		}
+
+		// 第一次探测或上一次成功时才通知，避免持续失败时重复告警
+		if !item.Success && (old.LastCheckAt == nil || old.LastSuccess) {
+			notify.ProxyUnreachable(ctx, old.ProxyProbeEntity, item.Error)
+		}
+	}
+
+	return &pb.PushProxyProbeResultsResp{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
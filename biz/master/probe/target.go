@@ -0,0 +1,105 @@
+package probe
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+)
+
+// resolveTarget 根据隧道和 frps 的配置推导公网探测地址，不接受用户指定的地址，避免 frps 节点被用来访问任意地址
+// tcp/udp 探测返回 host:port，http 探测返回 url，dialAddr 为实际连接的 frps 地址，http 探测按 url 中的域名发送请求
+func resolveTarget(probe *models.ProxyProbeEntity, proxyCfg *models.ProxyConfig, srv *models.ServerEntity) (target string, dialAddr string, err error) {
+	probeType := pb.ProxyProbe_Type(probe.Type)
+
+	typedProxyCfg, err := proxyCfg.GetTypedProxyConfig()
+	if err != nil {
+		return "", "", err
+	}
+
+	var (
+		scheme string
+		host   string
+	)
+	switch p := typedProxyCfg.ProxyConfigurer.(type) {
+	case *v1.TCPProxyConfig:
+		scheme = "http"
+		dialAddr, err = remoteAddr(srv, p.RemotePort)
+		host = dialAddr
+	case *v1.UDPProxyConfig:
+		if probeType != pb.ProxyProbe_TYPE_UDP {
+			return "", "", fmt.Errorf("udp proxy only supports udp probe")
+		}
+		dialAddr, err = remoteAddr(srv, p.RemotePort)
+		return dialAddr, dialAddr, err
+	case *v1.HTTPProxyConfig:
+		scheme = "http"
+		host, dialAddr, err = vhostAddr(srv, p.DomainConfig, func(cfg *v1.ServerConfig) int { return cfg.VhostHTTPPort }, 80)
+	case *v1.HTTPSProxyConfig:
+		scheme = "https"
+		host, dialAddr, err = vhostAddr(srv, p.DomainConfig, func(cfg *v1.ServerConfig) int { return cfg.VhostHTTPSPort }, 443)
+	default:
+		return "", "", fmt.Errorf("proxy type [%s] does not support probe", typedProxyCfg.Type)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	switch probeType {
+	case pb.ProxyProbe_TYPE_TCP:
+		return dialAddr, dialAddr, nil
+	case pb.ProxyProbe_TYPE_HTTP:
+		path := lo.Ternary(len(probe.HTTPPath) > 0, probe.HTTPPath, "/")
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		return scheme + "://" + host + path, dialAddr, nil
+	default:
+		return "", "", fmt.Errorf("probe type [%s] does not match proxy type [%s]", probeType.String(), typedProxyCfg.Type)
+	}
+}
+
+func remoteAddr(srv *models.ServerEntity, remotePort int) (string, error) {
+	if len(srv.ServerIP) == 0 {
+		return "", fmt.Errorf("server [%s] has no public ip, cannot probe", srv.ServerID)
+	}
+	if remotePort <= 0 {
+		return "", fmt.Errorf("proxy has no fixed remote port, cannot probe")
+	}
+	return net.JoinHostPort(srv.ServerIP, strconv.Itoa(remotePort)), nil
+}
+
+// vhostAddr 返回请求使用的 domain 或 domain:port，端口为协议默认端口时省略，以及 frps 的 vhost 地址
+func vhostAddr(srv *models.ServerEntity, domainCfg v1.DomainConfig, port func(*v1.ServerConfig) int, defaultPort int) (string, string, error) {
+	srvCfg, err := srv.GetConfigContent()
+	if err != nil {
+		return "", "", err
+	}
+	vhostPort := port(srvCfg)
+	if vhostPort <= 0 {
+		return "", "", fmt.Errorf("server [%s] does not enable vhost port, cannot probe", srv.ServerID)
+	}
+
+	dialAddr, err := remoteAddr(srv, vhostPort)
+	if err != nil {
+		return "", "", err
+	}
+
+	domain, ok := lo.Find(domainCfg.CustomDomains, func(d string) bool { return !strings.Contains(d, "*") })
+	if !ok && len(domainCfg.SubDomain) > 0 && len(srvCfg.SubDomainHost) > 0 {
+		domain, ok = domainCfg.SubDomain+"."+srvCfg.SubDomainHost, true
+	}
+	if !ok {
+		return "", "", fmt.Errorf("proxy has no probeable domain, cannot probe")
+	}
+
+	if vhostPort == defaultPort {
+		return domain, dialAddr, nil
+	}
+	return net.JoinHostPort(domain, strconv.Itoa(vhostPort)), dialAddr, nil
+}
@@ -0,0 +1,118 @@
+package probe
+
+import (
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveTarget(t *testing.T) {
+	srv := &models.ServerEntity{
+		ServerID:      "s1",
+		ServerIP:      "203.0.113.10",
+		ConfigContent: []byte(`{"vhostHTTPPort":8080,"vhostHTTPSPort":443,"subDomainHost":"example.com"}`),
+	}
+
+	tests := []struct {
+		name         string
+		proxy        string
+		probeType    pb.ProxyProbe_Type
+		httpPath     string
+		wantTarget   string
+		wantDialAddr string
+		wantErr      bool
+	}{
+		{
+			name:         "tcp proxy with tcp probe",
+			proxy:        `{"type":"tcp","name":"p","remotePort":6000}`,
+			probeType:    pb.ProxyProbe_TYPE_TCP,
+			wantTarget:   "203.0.113.10:6000",
+			wantDialAddr: "203.0.113.10:6000",
+		},
+		{
+			name:         "tcp proxy with http probe",
+			proxy:        `{"type":"tcp","name":"p","remotePort":6000}`,
+			probeType:    pb.ProxyProbe_TYPE_HTTP,
+			httpPath:     "health",
+			wantTarget:   "http://203.0.113.10:6000/health",
+			wantDialAddr: "203.0.113.10:6000",
+		},
+		{
+			name:      "tcp proxy without remote port",
+			proxy:     `{"type":"tcp","name":"p"}`,
+			probeType: pb.ProxyProbe_TYPE_TCP,
+			wantErr:   true,
+		},
+		{
+			name:         "udp proxy",
+			proxy:        `{"type":"udp","name":"p","remotePort":7000}`,
+			probeType:    pb.ProxyProbe_TYPE_UDP,
+			wantTarget:   "203.0.113.10:7000",
+			wantDialAddr: "203.0.113.10:7000",
+		},
+		{
+			name:      "udp proxy with tcp probe",
+			proxy:     `{"type":"udp","name":"p","remotePort":7000}`,
+			probeType: pb.ProxyProbe_TYPE_TCP,
+			wantErr:   true,
+		},
+		{
+			name:         "http proxy dials frps with domain in url",
+			proxy:        `{"type":"http","name":"p","customDomains":["*.a.com","app.a.com"]}`,
+			probeType:    pb.ProxyProbe_TYPE_HTTP,
+			wantTarget:   "http://app.a.com:8080/",
+			wantDialAddr: "203.0.113.10:8080",
+		},
+		{
+			name:         "https proxy with subdomain",
+			proxy:        `{"type":"https","name":"p","subdomain":"app"}`,
+			probeType:    pb.ProxyProbe_TYPE_HTTP,
+			wantTarget:   "https://app.example.com/",
+			wantDialAddr: "203.0.113.10:443",
+		},
+		{
+			name:         "http proxy with tcp probe",
+			proxy:        `{"type":"http","name":"p","customDomains":["app.a.com"]}`,
+			probeType:    pb.ProxyProbe_TYPE_TCP,
+			wantTarget:   "203.0.113.10:8080",
+			wantDialAddr: "203.0.113.10:8080",
+		},
+		{
+			name:      "http proxy without domain",
+			proxy:     `{"type":"http","name":"p","customDomains":["*.a.com"]}`,
+			probeType: pb.ProxyProbe_TYPE_HTTP,
+			wantErr:   true,
+		},
+		{
+			name:      "stcp proxy",
+			proxy:     `{"type":"stcp","name":"p"}`,
+			probeType: pb.ProxyProbe_TYPE_TCP,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxyCfg := &models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{Content: []byte(tt.proxy)}}
+			probe := &models.ProxyProbeEntity{Type: int32(tt.probeType), HTTPPath: tt.httpPath}
+
+			target, dialAddr, err := resolveTarget(probe, proxyCfg, srv)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTarget, target)
+			assert.Equal(t, tt.wantDialAddr, dialAddr)
+		})
+	}
+
+	t.Run("server without public ip", func(t *testing.T) {
+		proxyCfg := &models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{Content: []byte(`{"type":"http","name":"p","customDomains":["app.a.com"]}`)}}
+		_, _, err := resolveTarget(&models.ProxyProbeEntity{Type: int32(pb.ProxyProbe_TYPE_HTTP)}, proxyCfg,
+			&models.ServerEntity{ServerID: "s2", ConfigContent: srv.ConfigContent})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,24 @@
+package probe
+
+import (
+	"context"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// CleanProxyProbeResults 清理超过保留期限的探测历史
+func CleanProxyProbeResults(appInstance app.Application) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	if err := dao.NewQuery(ctx).AdminDeleteProxyProbeResultsBefore(time.Now().Add(-defs.ProxyProbeResultRetention)); err != nil {
+		logger.Logger(ctx).WithError(err).Error("CleanProxyProbeResults cannot delete expired results")
+		return err
+	}
+
+	logger.Logger(ctx).Infof("CleanProxyProbeResults success")
+	return nil
+}
@@ -0,0 +1,107 @@
+package proxy
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+)
+
+// CloneProxyConfig 把 proxy 复制到其他客户端或 server，可以重命名
+// 复制出的 proxy 保持原 proxy 的启停状态和标签，流量统计从 0 开始
+func CloneProxyConfig(c *app.Context, req *pb.CloneProxyConfigRequest) (*pb.CloneProxyConfigResponse, error) {
+	if len(req.GetClientId()) == 0 || len(req.GetServerId()) == 0 || len(req.GetName()) == 0 {
+		return nil, fmt.Errorf("request invalid")
+	}
+
+	var (
+		userInfo = common.GetUserInfo(c)
+		name     = req.GetName()
+	)
+
+	src, err := getRelocateSource(c, req.GetClientId(), req.GetServerId(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	targetClientID := lo.CoalesceOrEmpty(req.GetTargetClientId(), src.originClientID)
+	targetServerID := lo.CoalesceOrEmpty(req.GetTargetServerId(), src.proxyConfig.ServerID)
+	targetName := lo.CoalesceOrEmpty(req.GetTargetName(), name)
+
+	if targetClientID == src.originClientID && targetServerID == src.proxyConfig.ServerID && targetName == name {
+		return nil, fmt.Errorf("clone target is the same as source, pls set target client, server or name")
+	}
+	if len(src.proxyConfig.WorkerID) != 0 && targetClientID != src.originClientID {
+		return nil, fmt.Errorf("proxy [%s] is worker ingress, cannot clone to another client", name)
+	}
+
+	dstClient, _, err := prepareRelocateTarget(c, targetClientID, targetServerID, targetName, 0)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot prepare clone target, client: [%s], server: [%s]", targetClientID, targetServerID)
+		return nil, err
+	}
+
+	typedProxyCfg := src.typedProxyCfg
+	typedProxyCfg.GetBaseConfig().Name = targetName
+
+	if src.proxyConfig.Stopped {
+		proxyCfg := &models.ProxyConfigEntity{Stopped: true}
+		if err := proxyCfg.FillClientConfig(dstClient); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot fill client config, id: [%s]", dstClient.ClientID)
+			return nil, err
+		}
+		if err := proxyCfg.FillTypedProxyConfig(typedProxyCfg); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot fill typed proxy config")
+			return nil, err
+		}
+		if err := dao.NewQuery(c).CreateProxyConfig(userInfo, proxyCfg); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot create proxy config, proxy name: [%s]", targetName)
+			return nil, err
+		}
+	} else {
+		dstProxies, err := clientProxies(c, dstClient)
+		if err != nil {
+			return nil, err
+		}
+		dstProxies = lo.Filter(dstProxies, func(proxy v1.TypedProxyConfig, _ int) bool {
+			return proxy.GetBaseConfig().Name != targetName
+		})
+		if err := pushClientProxies(c, dstClient, append(dstProxies, typedProxyCfg)); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot add proxy to target client, id: [%s]", dstClient.ClientID)
+			return nil, err
+		}
+	}
+
+	labels, err := dao.NewQuery(c).GetLabels(userInfo, defs.LabelResourceProxy, proxyLabelRef(src.proxyConfig, 0))
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get proxy labels, proxy name: [%s]", name)
+		return nil, err
+	}
+	if _, err := dao.NewQuery(c).UpdateLabels(userInfo, defs.LabelResourceProxy,
+		models.LabelResourceRef{ID: dstClient.ClientID, Name: targetName}, labels, nil, true); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot copy proxy labels, proxy name: [%s]", targetName)
+		return nil, err
+	}
+
+	proxyConfig, err := dao.NewQuery(c).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{
+		ClientID: dstClient.ClientID,
+		ServerID: dstClient.ServerID,
+		Name:     targetName,
+	})
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get cloned proxy config, client: [%s], proxy name: [%s]", dstClient.ClientID, targetName)
+		return nil, err
+	}
+
+	return &pb.CloneProxyConfigResponse{
+		Status:      &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		ProxyConfig: proxyConfig.ToPB(),
+	}, nil
+}
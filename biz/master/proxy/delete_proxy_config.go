@@ -1,16 +1,20 @@
 package proxy
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/VaalaCat/frp-panel/biz/master/client"
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	v1 "github.com/fatedier/frp/pkg/config/v1"
 	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 func DeleteProxyConfig(c *app.Context, req *pb.DeleteProxyConfigRequest) (*pb.DeleteProxyConfigResponse, error) {
@@ -77,6 +81,22 @@ func DeleteProxyConfig(c *app.Context, req *pb.DeleteProxyConfigRequest) (*pb.De
 		return nil, err
 	}
 
+	if err := dao.NewQuery(c).DeleteLabels(userInfo, defs.LabelResourceProxy, models.LabelResourceRef{ID: clientID, Name: proxyName}); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot delete proxy labels, id: [%s]", clientID)
+		return nil, err
+	}
+
+	if err := dao.NewQuery(c).DeleteProxyProbe(userInfo, serverID, clientID, proxyName); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Logger(c).WithError(err).Errorf("cannot delete proxy probe, id: [%s], name: [%s]", clientID, proxyName)
+		return nil, err
+	}
+
+	if err := dao.NewQuery(c).DeleteProxySchedule(userInfo, clientID, proxyName); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Logger(c).WithError(err).Errorf("cannot delete proxy schedule, id: [%s], name: [%s]", clientID, proxyName)
+		return nil, err
+	}
+	UnscheduleProxy(c.GetApp(), clientID, proxyName)
+
 	logger.Logger(c).Infof("delete proxy config, id: [%s], name: [%s]", clientID, proxyName)
 
 	return &pb.DeleteProxyConfigResponse{}, nil
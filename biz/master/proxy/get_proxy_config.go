@@ -1,9 +1,11 @@
 package proxy
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -11,6 +13,7 @@ import (
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 func GetProxyConfig(c *app.Context, req *pb.GetProxyConfigRequest) (*pb.GetProxyConfigResponse, error) {
@@ -42,6 +45,7 @@ func GetProxyConfig(c *app.Context, req *pb.GetProxyConfigRequest) (*pb.GetProxy
 		}, resp); err != nil {
 			resp.WorkingStatus = &pb.ProxyWorkingStatus{
 				Status: lo.ToPtr("error"),
+				Err:    lo.ToPtr(err.Error()),
 			}
 			logger.Logger(c).WithError(err).Errorf("cannot get proxy config, client: [%s], server: [%s], proxy name: [%s]", proxyConfig.OriginClientID, proxyConfig.ServerID, proxyConfig.Name)
 		}
@@ -53,6 +57,22 @@ func GetProxyConfig(c *app.Context, req *pb.GetProxyConfigRequest) (*pb.GetProxy
 		}
 	}
 
+	labels, err := dao.NewQuery(c).GetLabels(userInfo, defs.LabelResourceProxy, proxyLabelRef(proxyConfig, 0))
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get proxy labels, client: [%s], proxy name: [%s]", proxyConfig.ClientID, proxyConfig.Name)
+		return nil, err
+	}
+
+	var schedulePB *pb.ProxySchedule
+	schedule, err := dao.NewQuery(c).GetProxySchedule(userInfo, proxyConfig.ClientID, proxyConfig.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Logger(c).WithError(err).Errorf("cannot get proxy schedule, client: [%s], proxy name: [%s]", proxyConfig.ClientID, proxyConfig.Name)
+		return nil, err
+	}
+	if err == nil {
+		schedulePB = proxyScheduleToPB(c.GetApp(), schedule)
+	}
+
 	return &pb.GetProxyConfigResponse{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"},
 		ProxyConfig: &pb.ProxyConfig{
@@ -64,7 +84,9 @@ func GetProxyConfig(c *app.Context, req *pb.GetProxyConfigRequest) (*pb.GetProxy
 			Config:         lo.ToPtr(string(proxyConfig.Content)),
 			OriginClientId: lo.ToPtr(proxyConfig.OriginClientID),
 			Stopped:        lo.ToPtr(proxyConfig.Stopped),
+			Labels:         labels,
 		},
 		WorkingStatus: resp.GetWorkingStatus(),
+		Schedule:      schedulePB,
 	}, nil
 }
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/VaalaCat/frp-panel/biz/master/client"
 	"github.com/VaalaCat/frp-panel/common"
@@ -10,6 +11,7 @@ import (
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
 	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
@@ -65,3 +67,118 @@ func GetClientWithMakeShadow(c *app.Context, clientID, serverID string) (*models
 
 	return clientEntity, nil
 }
+
+// relocateSource 待迁移或复制的 proxy 及其所在的子客户端
+type relocateSource struct {
+	proxyConfig    *models.ProxyConfig
+	typedProxyCfg  v1.TypedProxyConfig
+	clientEntity   *models.ClientEntity
+	originClientID string
+}
+
+func getRelocateSource(c *app.Context, clientID, serverID, name string) (*relocateSource, error) {
+	userInfo := common.GetUserInfo(c)
+
+	proxyConfig, err := dao.NewQuery(c).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{
+		ClientID: clientID,
+		ServerID: serverID,
+		Name:     name,
+	})
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get proxy config, client: [%s], server: [%s], proxy name: [%s]", clientID, serverID, name)
+		return nil, err
+	}
+
+	typedProxyCfg, err := proxyConfig.GetTypedProxyConfig()
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get typed proxy config, proxy name: [%s]", name)
+		return nil, err
+	}
+
+	cli, err := dao.NewQuery(c).GetClientByClientID(userInfo, proxyConfig.ClientID)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get client, id: [%s]", proxyConfig.ClientID)
+		return nil, err
+	}
+
+	return &relocateSource{
+		proxyConfig:    proxyConfig,
+		typedProxyCfg:  typedProxyCfg,
+		clientEntity:   cli.ClientEntity,
+		originClientID: lo.CoalesceOrEmpty(proxyConfig.OriginClientID, proxyConfig.ClientID),
+	}, nil
+}
+
+// prepareRelocateTarget 检查目标 server 已就绪且没有同名 proxy，然后获取（必要时创建）目标子客户端
+// ignoreID 为目标 server 上可以忽略的同名 proxy，用于同一 server 内换客户端
+func prepareRelocateTarget(c *app.Context, originClientID, serverID, name string, ignoreID uint) (*models.ClientEntity, *models.ServerEntity, error) {
+	userInfo := common.GetUserInfo(c)
+
+	srv, err := dao.NewQuery(c).GetServerByServerID(userInfo, serverID)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get server, id: [%s]", serverID)
+		return nil, nil, err
+	}
+	if len(srv.ServerIP) == 0 || len(srv.ConfigContent) == 0 {
+		return nil, nil, fmt.Errorf("server [%s] is not prepared, pls update server config first", serverID)
+	}
+
+	existed, err := dao.NewQuery(c).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{ServerID: serverID, Name: name})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Logger(c).WithError(err).Errorf("cannot get proxy config, server: [%s], proxy name: [%s]", serverID, name)
+		return nil, nil, err
+	}
+	if err == nil && existed.ID != ignoreID {
+		return nil, nil, fmt.Errorf("proxy [%s] already exists on server [%s]", name, serverID)
+	}
+
+	clientEntity, err := GetClientWithMakeShadow(c, originClientID, serverID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return clientEntity, srv, nil
+}
+
+// pushClientProxies 用 proxies 替换子客户端的 proxy 列表并下发，会重建该客户端的 proxy 配置
+func pushClientProxies(c *app.Context, clientEntity *models.ClientEntity, proxies []v1.TypedProxyConfig) error {
+	cliCfg, err := clientEntity.GetConfigContent()
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get client config, id: [%s]", clientEntity.ClientID)
+		return err
+	}
+	cliCfg.Proxies = proxies
+
+	if err := clientEntity.SetConfigContent(*cliCfg); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot set client config, id: [%s]", clientEntity.ClientID)
+		return err
+	}
+
+	rawCfg, err := clientEntity.MarshalJSONConfig()
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot marshal client config, id: [%s]", clientEntity.ClientID)
+		return err
+	}
+
+	_, err = client.UpdateFrpcHander(c, &pb.UpdateFRPCRequest{
+		ClientId: &clientEntity.ClientID,
+		ServerId: &clientEntity.ServerID,
+		Config:   rawCfg,
+		Comment:  &clientEntity.Comment,
+		FrpsUrl:  &clientEntity.FrpsUrl,
+	})
+	return err
+}
+
+func clientProxies(c *app.Context, clientEntity *models.ClientEntity) ([]v1.TypedProxyConfig, error) {
+	cliCfg, err := clientEntity.GetConfigContent()
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get client config, id: [%s]", clientEntity.ClientID)
+		return nil, err
+	}
+	return cliCfg.Proxies, nil
+}
+
+// proxyLabelRef proxy 的标签通过所在的 client id 和 proxy 名称关联
+func proxyLabelRef(item *models.ProxyConfig, _ int) models.LabelResourceRef {
+	return models.LabelResourceRef{ID: item.ClientID, Name: item.Name}
+}
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -45,9 +46,17 @@ func ListProxyConfigs(ctx *app.Context, req *pb.ListProxyConfigsRequest) (*pb.Li
 		filter.ServerID = serverID
 	}
 
-	if hasKeyword {
+	selector, err := models.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !selector.Empty():
+		proxyConfigs, err = dao.NewQuery(ctx).ListProxyConfigsWithLabelSelector(userInfo, page, pageSize, filter, keyword, selector)
+	case hasKeyword:
 		proxyConfigs, err = dao.NewQuery(ctx).ListProxyConfigsWithFiltersAndKeyword(userInfo, page, pageSize, filter, keyword)
-	} else {
+	default:
 		proxyConfigs, err = dao.NewQuery(ctx).ListProxyConfigsWithFilters(userInfo, page, pageSize, filter)
 	}
 
@@ -55,9 +64,12 @@ func ListProxyConfigs(ctx *app.Context, req *pb.ListProxyConfigsRequest) (*pb.Li
 		return nil, err
 	}
 
-	if hasKeyword {
+	switch {
+	case !selector.Empty():
+		proxyCounts, err = dao.NewQuery(ctx).CountProxyConfigsWithLabelSelector(userInfo, filter, keyword, selector)
+	case hasKeyword:
 		proxyCounts, err = dao.NewQuery(ctx).CountProxyConfigsWithFiltersAndKeyword(userInfo, filter, keyword)
-	} else {
+	default:
 		proxyCounts, err = dao.NewQuery(ctx).CountProxyConfigsWithFilters(userInfo, filter)
 	}
 
@@ -65,6 +77,11 @@ func ListProxyConfigs(ctx *app.Context, req *pb.ListProxyConfigsRequest) (*pb.Li
 		return nil, err
 	}
 
+	labels, err := dao.NewQuery(ctx).GetLabelsByResources(userInfo, defs.LabelResourceProxy, lo.Map(proxyConfigs, proxyLabelRef))
+	if err != nil {
+		return nil, err
+	}
+
 	respProxyConfigs := lo.Map(proxyConfigs, func(item *models.ProxyConfig, _ int) *pb.ProxyConfig {
 		return &pb.ProxyConfig{
 			Id:             lo.ToPtr(uint32(item.ID)),
@@ -75,6 +92,7 @@ func ListProxyConfigs(ctx *app.Context, req *pb.ListProxyConfigsRequest) (*pb.Li
 			Config:         lo.ToPtr(string(item.Content)),
 			OriginClientId: lo.ToPtr(item.OriginClientID),
 			Stopped:        lo.ToPtr(item.Stopped),
+			Labels:         labels[proxyLabelRef(item, 0)],
 		}
 	})
 
@@ -0,0 +1,64 @@
+package proxy
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// ListProxyStatus 列出 client 上报的 proxy 运行状态，status_counts 用于总览各状态的数量
+func ListProxyStatus(ctx *app.Context, req *pb.ListProxyStatusRequest) (*pb.ListProxyStatusResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+	)
+
+	if !userInfo.Valid() {
+		return &pb.ListProxyStatusResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	var (
+		page     = int(req.GetPage())
+		pageSize = int(req.GetPageSize())
+		keyword  = req.GetKeyword()
+		filter   = func(status string) *models.ProxyWorkingStatusEntity {
+			return &models.ProxyWorkingStatusEntity{
+				OriginClientID: req.GetClientId(),
+				ServerID:       req.GetServerId(),
+				Status:         status,
+			}
+		}
+	)
+
+	statuses, err := dao.NewQuery(ctx).ListProxyWorkingStatusWithFilters(userInfo, page, pageSize, filter(req.GetStatus()), keyword)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list proxy working status")
+		return nil, err
+	}
+
+	total, err := dao.NewQuery(ctx).CountProxyWorkingStatusWithFilters(userInfo, filter(req.GetStatus()), keyword)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot count proxy working status")
+		return nil, err
+	}
+
+	statusCounts, err := dao.NewQuery(ctx).CountProxyWorkingStatusByStatus(userInfo, filter(""), keyword)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot count proxy working status by status")
+		return nil, err
+	}
+
+	return &pb.ListProxyStatusResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"},
+		Total:  lo.ToPtr(int32(total)),
+		Statuses: lo.Map(statuses, func(item *models.ProxyWorkingStatus, _ int) *pb.ProxyWorkingStatus {
+			return item.ToPB()
+		}),
+		StatusCounts: statusCounts,
+	}, nil
+}
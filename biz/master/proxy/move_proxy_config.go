@@ -0,0 +1,126 @@
+package proxy
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+)
+
+// MoveProxyConfig 把 proxy 迁移到其他客户端或 server
+// 1. 运行中的 proxy 先加到目标子客户端，再从原子客户端移除，移除失败时回滚目标客户端
+// 2. 停止的 proxy 只迁移配置记录
+// 3. 数据库记录在推送成功后迁移，迁移失败时把两个客户端恢复到迁移前的配置
+// 4. 流量统计、探测配置和定时配置随 proxy 迁移
+func MoveProxyConfig(c *app.Context, req *pb.MoveProxyConfigRequest) (*pb.MoveProxyConfigResponse, error) {
+	if len(req.GetClientId()) == 0 || len(req.GetServerId()) == 0 || len(req.GetName()) == 0 {
+		return nil, fmt.Errorf("request invalid")
+	}
+	if len(req.GetTargetClientId()) == 0 && len(req.GetTargetServerId()) == 0 {
+		return nil, fmt.Errorf("target client or target server is required")
+	}
+
+	var (
+		userInfo = common.GetUserInfo(c)
+		name     = req.GetName()
+	)
+
+	src, err := getRelocateSource(c, req.GetClientId(), req.GetServerId(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	targetClientID := lo.CoalesceOrEmpty(req.GetTargetClientId(), src.originClientID)
+	targetServerID := lo.CoalesceOrEmpty(req.GetTargetServerId(), src.proxyConfig.ServerID)
+
+	if targetClientID == src.originClientID && targetServerID == src.proxyConfig.ServerID {
+		return nil, fmt.Errorf("proxy is already on client [%s] and server [%s]", targetClientID, targetServerID)
+	}
+	if len(src.proxyConfig.WorkerID) != 0 && targetClientID != src.originClientID {
+		return nil, fmt.Errorf("proxy [%s] is worker ingress, cannot move to another client", name)
+	}
+
+	dstClient, dstServer, err := prepareRelocateTarget(c, targetClientID, targetServerID, name, src.proxyConfig.ID)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot prepare move target, client: [%s], server: [%s]", targetClientID, targetServerID)
+		return nil, err
+	}
+
+	// 记录迁移失败时用于把两个客户端恢复到迁移前的配置
+	var restoreClients func()
+
+	if !src.proxyConfig.Stopped {
+		dstProxies, err := clientProxies(c, dstClient)
+		if err != nil {
+			return nil, err
+		}
+		srcProxies, err := clientProxies(c, src.clientEntity)
+		if err != nil {
+			return nil, err
+		}
+
+		withoutName := func(proxy v1.TypedProxyConfig, _ int) bool { return proxy.GetBaseConfig().Name != name }
+
+		if err := pushClientProxies(c, dstClient, append(lo.Filter(dstProxies, withoutName), src.typedProxyCfg)); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot add proxy to target client, id: [%s]", dstClient.ClientID)
+			return nil, err
+		}
+
+		if err := pushClientProxies(c, src.clientEntity, lo.Filter(srcProxies, withoutName)); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot remove proxy from source client, rollback target client, id: [%s]", src.clientEntity.ClientID)
+			if rollbackErr := pushClientProxies(c, dstClient, dstProxies); rollbackErr != nil {
+				logger.Logger(c).WithError(rollbackErr).Errorf("cannot rollback target client, id: [%s]", dstClient.ClientID)
+			}
+			return nil, err
+		}
+
+		restoreClients = func() {
+			if err := pushClientProxies(c, src.clientEntity, srcProxies); err != nil {
+				logger.Logger(c).WithError(err).Errorf("cannot restore source client, id: [%s]", src.clientEntity.ClientID)
+			}
+			if err := pushClientProxies(c, dstClient, dstProxies); err != nil {
+				logger.Logger(c).WithError(err).Errorf("cannot restore target client, id: [%s]", dstClient.ClientID)
+			}
+		}
+	}
+
+	if err := dao.NewQuery(c).MoveProxyRecords(userInfo, name, src.clientEntity, dstClient, dstServer); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot move proxy records, restore clients, proxy name: [%s]", name)
+		if restoreClients != nil {
+			restoreClients()
+		}
+		return nil, err
+	}
+
+	// 定时配置已随记录迁移，调度器中的任务按新的 client 重新注册
+	UnscheduleProxy(c.GetApp(), src.clientEntity.ClientID, name)
+	if schedule, err := dao.NewQuery(c).GetProxySchedule(userInfo, dstClient.ClientID, name); err == nil {
+		if err := ScheduleProxy(c.GetApp(), schedule); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot reschedule moved proxy, client: [%s], proxy name: [%s]", dstClient.ClientID, name)
+		}
+	}
+
+	proxyConfig, err := dao.NewQuery(c).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{
+		ClientID: dstClient.ClientID,
+		ServerID: dstClient.ServerID,
+		Name:     name,
+	})
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get moved proxy config, client: [%s], proxy name: [%s]", dstClient.ClientID, name)
+		return nil, err
+	}
+
+	logger.Logger(c).Infof("proxy moved, name: [%s], from client: [%s] server: [%s], to client: [%s] server: [%s]",
+		name, src.clientEntity.ClientID, src.proxyConfig.ServerID, dstClient.ClientID, dstClient.ServerID)
+
+	return &pb.MoveProxyConfigResponse{
+		Status:      &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		ProxyConfig: proxyConfig.ToPB(),
+	}, nil
+}
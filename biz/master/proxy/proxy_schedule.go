@@ -0,0 +1,147 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// SetProxySchedule 设置 proxy 的定时开放 windows 和 TTL
+// 设置 TTL 时立即启动 proxy，到期后由调度器自动停止
+func SetProxySchedule(ctx *app.Context, req *pb.SetProxyScheduleRequest) (*pb.SetProxyScheduleResponse, error) {
+	var (
+		userInfo  = common.GetUserInfo(ctx)
+		clientID  = req.GetClientId()
+		serverID  = req.GetServerId()
+		proxyName = req.GetName()
+		ttl       = time.Duration(req.GetTtlSeconds()) * time.Second
+	)
+
+	if len(clientID) == 0 || len(serverID) == 0 || len(proxyName) == 0 {
+		return nil, fmt.Errorf("request invalid")
+	}
+	if req.GetKeepWindows() && len(req.GetWindows()) > 0 {
+		return nil, fmt.Errorf("windows and keep windows cannot be set at the same time")
+	}
+	if len(req.GetWindows()) > defs.ProxyScheduleMaxWindows {
+		return nil, fmt.Errorf("too many schedule windows, max is %d", defs.ProxyScheduleMaxWindows)
+	}
+	for _, window := range req.GetWindows() {
+		if len(window.GetStartCron()) == 0 && len(window.GetStopCron()) == 0 {
+			return nil, fmt.Errorf("schedule window needs start cron or stop cron")
+		}
+	}
+	if ttl < 0 || ttl > defs.ProxyScheduleMaxTTL {
+		return nil, fmt.Errorf("invalid ttl, should be between 0 and %s", defs.ProxyScheduleMaxTTL)
+	}
+	if ttl > 0 && req.GetClearTtl() {
+		return nil, fmt.Errorf("ttl and clear ttl cannot be set at the same time")
+	}
+
+	proxyConfig, err := dao.NewQuery(ctx).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{
+		ClientID: clientID,
+		ServerID: serverID,
+		Name:     proxyName,
+	})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get proxy config, client: [%s], server: [%s], proxy name: [%s]", clientID, serverID, proxyName)
+		return nil, err
+	}
+
+	old, err := dao.NewQuery(ctx).GetProxySchedule(userInfo, clientID, proxyName)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get proxy schedule, client: [%s], proxy name: [%s]", clientID, proxyName)
+		return nil, err
+	}
+
+	schedule := &models.ProxyScheduleEntity{
+		ServerID:  proxyConfig.ServerID,
+		ClientID:  proxyConfig.ClientID,
+		ProxyName: proxyConfig.Name,
+		Windows: models.JSON[[]models.ProxyScheduleWindow]{
+			Data: lo.Map(req.GetWindows(), func(w *pb.ProxyScheduleWindow, _ int) models.ProxyScheduleWindow {
+				return models.ProxyScheduleWindow{StartCron: w.GetStartCron(), StopCron: w.GetStopCron()}
+			}),
+		},
+	}
+	if req.GetKeepWindows() && old != nil {
+		schedule.Windows = old.Windows
+	}
+	switch {
+	case ttl > 0:
+		schedule.ExpireAt = lo.ToPtr(time.Now().Add(ttl))
+	case !req.GetClearTtl() && old != nil:
+		schedule.ExpireAt = old.ExpireAt
+	}
+	if schedule.Empty() {
+		return nil, fmt.Errorf("schedule windows or ttl is required, use delete to remove the schedule")
+	}
+
+	// 先注册到调度器，cron 表达式错误时不保存
+	if err := ScheduleProxy(ctx.GetApp(), &models.ProxySchedule{ProxyScheduleEntity: schedule}); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot schedule proxy, client: [%s], proxy name: [%s]", clientID, proxyName)
+		restoreProxySchedule(ctx, old, clientID, proxyName)
+		return nil, err
+	}
+
+	item, err := dao.NewQuery(ctx).UpsertProxySchedule(userInfo, schedule)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save proxy schedule, client: [%s], proxy name: [%s]", clientID, proxyName)
+		restoreProxySchedule(ctx, old, clientID, proxyName)
+		return nil, err
+	}
+
+	if ttl > 0 && proxyConfig.Stopped {
+		if _, err := StartProxy(ctx, &pb.StartProxyRequest{
+			ClientId: lo.ToPtr(clientID),
+			ServerId: lo.ToPtr(serverID),
+			Name:     lo.ToPtr(proxyName),
+		}); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot start proxy for ttl, client: [%s], proxy name: [%s]", clientID, proxyName)
+			return nil, err
+		}
+	}
+
+	logger.Logger(ctx).Infof("proxy schedule saved, client: [%s], proxy name: [%s], windows: [%d], ttl: [%s]",
+		clientID, proxyName, len(schedule.Windows.Data), ttl)
+
+	return &pb.SetProxyScheduleResponse{
+		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Schedule: proxyScheduleToPB(ctx.GetApp(), item),
+	}, nil
+}
+
+func restoreProxySchedule(ctx *app.Context, old *models.ProxySchedule, clientID, proxyName string) {
+	if old == nil {
+		UnscheduleProxy(ctx.GetApp(), clientID, proxyName)
+		return
+	}
+	if err := ScheduleProxy(ctx.GetApp(), old); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot restore proxy schedule, client: [%s], proxy name: [%s]", clientID, proxyName)
+	}
+}
+
+// DeleteProxySchedule 删除定时配置，proxy 保持当前的启停状态
+func DeleteProxySchedule(ctx *app.Context, req *pb.DeleteProxyScheduleRequest) (*pb.DeleteProxyScheduleResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+
+	if err := dao.NewQuery(ctx).DeleteProxySchedule(userInfo, req.GetClientId(), req.GetName()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot delete proxy schedule, client: [%s], proxy name: [%s]", req.GetClientId(), req.GetName())
+		return nil, err
+	}
+	UnscheduleProxy(ctx.GetApp(), req.GetClientId(), req.GetName())
+
+	return &pb.DeleteProxyScheduleResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
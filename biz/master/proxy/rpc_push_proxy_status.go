@@ -0,0 +1,74 @@
+package proxy
+
+import (
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/notify"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	frpproxy "github.com/fatedier/frp/client/proxy"
+	"github.com/samber/lo"
+)
+
+// PushProxyStatus client 定时上报所有 proxy 的运行状态，状态变为错误时通知 proxy 所属用户
+func PushProxyStatus(ctx *app.Context, req *pb.PushProxyStatusReq) (*pb.PushProxyStatusResp, error) {
+	cli, err := client.ValidateClientRequest(ctx, req.GetBase())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot validate client request")
+		return nil, err
+	}
+
+	childClientIDs, err := dao.NewQuery(ctx).AdminGetClientIDsInShadowByClientID(cli.ClientID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get child clients, clientID: [%s]", cli.ClientID)
+		return nil, err
+	}
+	clientIDs := append(childClientIDs, cli.ClientID)
+
+	inputs := []*models.ProxyWorkingStatusEntity{}
+	for _, status := range req.GetStatuses() {
+		if !lo.Contains(clientIDs, status.GetClientId()) {
+			logger.Logger(ctx).Warnf("client [%s] reported proxy status of unknown client [%s], skip", cli.ClientID, status.GetClientId())
+			continue
+		}
+		inputs = append(inputs, &models.ProxyWorkingStatusEntity{
+			ClientID:       status.GetClientId(),
+			Name:           status.GetName(),
+			ServerID:       status.GetServerId(),
+			OriginClientID: lo.Ternary(status.GetClientId() == cli.ClientID, cli.OriginClientID, cli.ClientID),
+			Type:           status.GetType(),
+			UserID:         cli.UserID,
+			TenantID:       cli.TenantID,
+			Status:         status.GetStatus(),
+			Err:            status.GetErr(),
+			RemoteAddr:     status.GetRemoteAddr(),
+		})
+	}
+
+	changed, err := dao.NewQuery(ctx).AdminSyncProxyWorkingStatus(clientIDs, inputs)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save proxy working status, clientID: [%s]", cli.ClientID)
+		return nil, err
+	}
+
+	for _, item := range changed {
+		if item.Status != frpproxy.ProxyPhaseStartErr && item.Status != frpproxy.ProxyPhaseCheckFailed {
+			continue
+		}
+		notify.ProxyError(ctx, &models.ProxyConfigEntity{
+			ServerID:       item.ServerID,
+			ClientID:       item.ClientID,
+			Name:           item.Name,
+			Type:           item.Type,
+			UserID:         item.UserID,
+			TenantID:       item.TenantID,
+			OriginClientID: item.OriginClientID,
+		}, item.Err)
+	}
+
+	return &pb.PushProxyStatusResp{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
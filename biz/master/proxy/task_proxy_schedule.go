@@ -0,0 +1,213 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+const (
+	scheduleActionStart  = "start"
+	scheduleActionStop   = "stop"
+	scheduleActionExpire = "expire"
+)
+
+func scheduleTaskTag(action, clientID, proxyName string) string {
+	return defs.ProxyScheduleTaskTagPrefix + action + "-" + clientID + "/" + proxyName
+}
+
+// InitProxySchedules master 启动时把所有定时配置注册到调度器，已过期的 TTL 会立即执行
+func InitProxySchedules(appInstance app.Application) {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	schedules, err := dao.NewQuery(ctx).AdminListProxySchedules()
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list proxy schedules")
+		return
+	}
+
+	for _, schedule := range schedules {
+		if err := ScheduleProxy(appInstance, schedule); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot schedule proxy, client: [%s], proxy name: [%s]", schedule.ClientID, schedule.ProxyName)
+		}
+	}
+	logger.Logger(ctx).Infof("proxy schedules loaded, count: [%d]", len(schedules))
+}
+
+// ScheduleProxy 把 proxy 的定时配置注册到调度器，重复调用会覆盖之前的注册，任意一项注册失败时全部撤销
+func ScheduleProxy(appInstance app.Application, schedule *models.ProxySchedule) error {
+	scheduler := appInstance.GetTaskManager()
+	if scheduler == nil {
+		return nil
+	}
+
+	clientID, proxyName := schedule.ClientID, schedule.ProxyName
+	UnscheduleProxy(appInstance, clientID, proxyName)
+
+	addCron := func(action, cron string) error {
+		if len(cron) == 0 {
+			return nil
+		}
+		return scheduler.AddTaggedCronTask(scheduleTaskTag(action, clientID, proxyName), cron,
+			RunProxySchedule, appInstance, clientID, proxyName, action)
+	}
+
+	for _, window := range schedule.Windows.Data {
+		if err := addCron(scheduleActionStart, window.StartCron); err != nil {
+			UnscheduleProxy(appInstance, clientID, proxyName)
+			return err
+		}
+		if err := addCron(scheduleActionStop, window.StopCron); err != nil {
+			UnscheduleProxy(appInstance, clientID, proxyName)
+			return err
+		}
+	}
+
+	if schedule.ExpireAt != nil {
+		if err := scheduler.AddTaggedOneTimeTask(scheduleTaskTag(scheduleActionExpire, clientID, proxyName), *schedule.ExpireAt,
+			RunProxySchedule, appInstance, clientID, proxyName, scheduleActionExpire); err != nil {
+			UnscheduleProxy(appInstance, clientID, proxyName)
+			return err
+		}
+	}
+	return nil
+}
+
+func UnscheduleProxy(appInstance app.Application, clientID, proxyName string) {
+	scheduler := appInstance.GetTaskManager()
+	if scheduler == nil {
+		return
+	}
+	for _, action := range []string{scheduleActionStart, scheduleActionStop, scheduleActionExpire} {
+		scheduler.RemoveTaggedTasks(scheduleTaskTag(action, clientID, proxyName))
+	}
+}
+
+// RunProxySchedule 由调度器调用，以定时配置所有者的身份通过 StartProxy/StopProxy 启停 proxy
+// 每次执行都重新读取配置，定时配置或 proxy 已被删除时移除调度器中的任务
+func RunProxySchedule(appInstance app.Application, clientID, proxyName, action string) {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	schedule, err := dao.NewQuery(ctx).AdminGetProxySchedule(clientID, proxyName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Logger(ctx).Infof("proxy schedule not found, unschedule it, client: [%s], proxy name: [%s]", clientID, proxyName)
+		UnscheduleProxy(appInstance, clientID, proxyName)
+		return
+	}
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get proxy schedule, client: [%s], proxy name: [%s]", clientID, proxyName)
+		return
+	}
+
+	userInfo, err := dao.NewQuery(ctx).GetUserByUserID(schedule.UserID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get proxy schedule owner, user id: [%d]", schedule.UserID)
+		return
+	}
+	ctx = app.NewContext(context.WithValue(context.Background(), defs.UserInfoKey, userInfo), appInstance)
+
+	proxyConfig, err := dao.NewQuery(ctx).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{
+		ClientID: clientID,
+		Name:     proxyName,
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Logger(ctx).Infof("scheduled proxy not found, remove its schedule, client: [%s], proxy name: [%s]", clientID, proxyName)
+		if err := dao.NewQuery(ctx).DeleteProxySchedule(userInfo, clientID, proxyName); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot delete proxy schedule, client: [%s], proxy name: [%s]", clientID, proxyName)
+		}
+		UnscheduleProxy(appInstance, clientID, proxyName)
+		return
+	}
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get scheduled proxy config, client: [%s], proxy name: [%s]", clientID, proxyName)
+		return
+	}
+
+	switch {
+	case action == scheduleActionStart && proxyConfig.Stopped:
+		_, err = StartProxy(ctx, &pb.StartProxyRequest{
+			ClientId: lo.ToPtr(clientID),
+			ServerId: lo.ToPtr(proxyConfig.ServerID),
+			Name:     lo.ToPtr(proxyName),
+		})
+	case action != scheduleActionStart && !proxyConfig.Stopped:
+		_, err = StopProxy(ctx, &pb.StopProxyRequest{
+			ClientId: lo.ToPtr(clientID),
+			ServerId: lo.ToPtr(proxyConfig.ServerID),
+			Name:     lo.ToPtr(proxyName),
+		})
+	}
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("scheduled %s proxy failed, client: [%s], proxy name: [%s]", action, clientID, proxyName)
+	} else {
+		logger.Logger(ctx).Infof("scheduled %s proxy done, client: [%s], proxy name: [%s]", action, clientID, proxyName)
+	}
+
+	if action != scheduleActionExpire {
+		return
+	}
+
+	// 停止失败时保留 TTL 并稍后重试，否则 proxy 会一直运行下去
+	if err != nil {
+		retryExpire(appInstance, clientID, proxyName)
+		return
+	}
+
+	// TTL 只执行一次，proxy 停止后清除，没有 window 的定时配置直接删除
+	schedule.ExpireAt = nil
+	if schedule.Empty() {
+		err = dao.NewQuery(ctx).DeleteProxySchedule(userInfo, clientID, proxyName)
+	} else {
+		_, err = dao.NewQuery(ctx).UpsertProxySchedule(userInfo, schedule.ProxyScheduleEntity)
+	}
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot clear proxy schedule ttl, client: [%s], proxy name: [%s]", clientID, proxyName)
+	}
+}
+
+// retryExpire 替换已经执行过的 TTL 任务，在 defs.ProxyScheduleExpireRetryInterval 后重新执行
+func retryExpire(appInstance app.Application, clientID, proxyName string) {
+	scheduler := appInstance.GetTaskManager()
+	if scheduler == nil {
+		return
+	}
+	scheduler.RemoveTaggedTasks(scheduleTaskTag(scheduleActionExpire, clientID, proxyName))
+
+	ctx := app.NewContext(context.Background(), appInstance)
+	retryAt := time.Now().Add(defs.ProxyScheduleExpireRetryInterval)
+	if err := scheduler.AddTaggedOneTimeTask(scheduleTaskTag(scheduleActionExpire, clientID, proxyName), retryAt,
+		RunProxySchedule, appInstance, clientID, proxyName, scheduleActionExpire); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot retry proxy schedule ttl, client: [%s], proxy name: [%s]", clientID, proxyName)
+		return
+	}
+	logger.Logger(ctx).Infof("proxy schedule ttl will be retried at [%s], client: [%s], proxy name: [%s]", retryAt.Format(defs.TimeLayout), clientID, proxyName)
+}
+
+// proxyScheduleToPB 附带调度器中下一次启动和停止的时间
+func proxyScheduleToPB(appInstance app.Application, schedule *models.ProxySchedule) *pb.ProxySchedule {
+	resp := schedule.ToPB()
+	scheduler := appInstance.GetTaskManager()
+	if scheduler == nil {
+		return resp
+	}
+
+	if next, ok := scheduler.NextTaggedRun(scheduleTaskTag(scheduleActionStart, schedule.ClientID, schedule.ProxyName)); ok {
+		resp.NextStartAt = lo.ToPtr(next.UnixMilli())
+	}
+	for _, action := range []string{scheduleActionStop, scheduleActionExpire} {
+		next, ok := scheduler.NextTaggedRun(scheduleTaskTag(action, schedule.ClientID, schedule.ProxyName))
+		if ok && (resp.NextStopAt == nil || next.UnixMilli() < resp.GetNextStopAt()) {
+			resp.NextStopAt = lo.ToPtr(next.UnixMilli())
+		}
+	}
+	return resp
+}
@@ -0,0 +1,100 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/dao/daotest"
+	"github.com/VaalaCat/frp-panel/services/watcher"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestRunProxyScheduleExpireClearsTTL(t *testing.T) {
+	appInstance, _ := daotest.NewApp(t)
+	q := dao.NewQuery(app.NewContext(context.Background(), appInstance))
+
+	userInfo := &models.UserEntity{UserID: 2, UserName: "u", Email: "u@example.com", TenantID: 1}
+	assert.NoError(t, q.CreateUser(userInfo))
+
+	expireAt := time.Now().Add(-time.Minute)
+	for _, name := range []string{"ttl-only", "with-window"} {
+		// proxy 已经停止，到期时只清除 TTL，不会调用 client
+		assert.NoError(t, q.CreateProxyConfig(userInfo, &models.ProxyConfigEntity{
+			ClientID: "c1", ServerID: "s1", Name: name, Stopped: true,
+		}))
+	}
+	_, err := q.UpsertProxySchedule(userInfo, &models.ProxyScheduleEntity{
+		ClientID: "c1", ServerID: "s1", ProxyName: "ttl-only", ExpireAt: lo.ToPtr(expireAt),
+	})
+	assert.NoError(t, err)
+	window := models.ProxyScheduleWindow{StartCron: "0 0 9 * * *", StopCron: "0 0 18 * * *"}
+	_, err = q.UpsertProxySchedule(userInfo, &models.ProxyScheduleEntity{
+		ClientID: "c1", ServerID: "s1", ProxyName: "with-window", ExpireAt: lo.ToPtr(expireAt),
+		Windows: models.JSON[[]models.ProxyScheduleWindow]{Data: []models.ProxyScheduleWindow{window}},
+	})
+	assert.NoError(t, err)
+
+	// stop 不是 TTL，不清除过期时间
+	RunProxySchedule(appInstance, "c1", "with-window", scheduleActionStop)
+	schedule, err := q.AdminGetProxySchedule("c1", "with-window")
+	assert.NoError(t, err)
+	assert.NotNil(t, schedule.ExpireAt)
+
+	RunProxySchedule(appInstance, "c1", "ttl-only", scheduleActionExpire)
+	_, err = q.AdminGetProxySchedule("c1", "ttl-only")
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound), "schedule without window should be deleted, err: %v", err)
+
+	RunProxySchedule(appInstance, "c1", "with-window", scheduleActionExpire)
+	schedule, err = q.AdminGetProxySchedule("c1", "with-window")
+	assert.NoError(t, err)
+	assert.Nil(t, schedule.ExpireAt)
+	assert.Equal(t, []models.ProxyScheduleWindow{window}, schedule.Windows.Data)
+}
+
+func TestRunProxyScheduleExpireRetriesWhenStopFails(t *testing.T) {
+	appInstance, _ := daotest.NewApp(t)
+	scheduler := watcher.NewClient()
+	scheduler.Run()
+	defer scheduler.Stop()
+	appInstance.SetTaskManager(scheduler)
+	q := dao.NewQuery(app.NewContext(context.Background(), appInstance))
+
+	userInfo := &models.UserEntity{UserID: 2, UserName: "u", Email: "u@example.com", TenantID: 1}
+	assert.NoError(t, q.CreateUser(userInfo))
+
+	// proxy 还在运行，但所属的 client 不存在，StopProxy 会失败
+	assert.NoError(t, q.CreateProxyConfig(userInfo, &models.ProxyConfigEntity{
+		ClientID: "missing", ServerID: "s1", Name: "running",
+	}))
+	expireAt := time.Now().Add(-time.Minute)
+	_, err := q.UpsertProxySchedule(userInfo, &models.ProxyScheduleEntity{
+		ClientID: "missing", ServerID: "s1", ProxyName: "running", ExpireAt: lo.ToPtr(expireAt),
+	})
+	assert.NoError(t, err)
+
+	before := time.Now()
+	RunProxySchedule(appInstance, "missing", "running", scheduleActionExpire)
+
+	schedule, err := q.AdminGetProxySchedule("missing", "running")
+	assert.NoError(t, err)
+	if assert.NotNil(t, schedule.ExpireAt) {
+		assert.WithinDuration(t, expireAt, *schedule.ExpireAt, time.Second)
+	}
+
+	// 运行中的调度器异步计算下一次执行时间
+	var next time.Time
+	assert.Eventually(t, func() bool {
+		var ok bool
+		next, ok = scheduler.NextTaggedRun(scheduleTaskTag(scheduleActionExpire, "missing", "running"))
+		return ok
+	}, 5*time.Second, 10*time.Millisecond, "expire task should be registered again")
+	assert.WithinDuration(t, before.Add(defs.ProxyScheduleExpireRetryInterval), next, 5*time.Second)
+}
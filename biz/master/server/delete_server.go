@@ -2,6 +2,7 @@ package server
 
 import (
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
@@ -29,6 +30,10 @@ func DeleteServerHandler(c *app.Context, req *pb.DeleteServerRequest) (*pb.Delet
 		return nil, err
 	}
 
+	if err := dao.NewQuery(c).DeleteLabelsByResourceIDs(userInfo, defs.LabelResourceServer, []string{userServerID}); err != nil {
+		return nil, err
+	}
+
 	return &pb.DeleteServerResponse{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 	}, nil
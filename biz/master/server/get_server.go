@@ -2,6 +2,8 @@ package server
 
 import (
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
@@ -31,6 +33,11 @@ func GetServerHandler(c *app.Context, req *pb.GetServerRequest) (*pb.GetServerRe
 		return nil, err
 	}
 
+	labels, err := dao.NewQuery(c).GetLabels(userInfo, defs.LabelResourceServer, models.LabelResourceRef{ID: serverEntity.ServerID})
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.GetServerResponse{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 		Server: &pb.Server{
@@ -40,6 +47,7 @@ func GetServerHandler(c *app.Context, req *pb.GetServerRequest) (*pb.GetServerRe
 			Comment:  lo.ToPtr(serverEntity.Comment),
 			Ip:       lo.ToPtr(serverEntity.ServerIP),
 			FrpsUrls: serverEntity.FrpsUrls,
+			Labels:   labels,
 		},
 	}, nil
 }
@@ -2,6 +2,7 @@ package server
 
 import (
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -27,24 +28,43 @@ func ListServersHandler(c *app.Context, req *pb.ListServersRequest) (*pb.ListSer
 		}, nil
 	}
 
-	if hasKeyword {
+	selector, err := models.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !selector.Empty():
+		servers, err = dao.NewQuery(c).ListServersWithLabelSelector(userInfo, page, pageSize, keyword, selector)
+	case hasKeyword:
 		servers, err = dao.NewQuery(c).ListServersWithKeyword(userInfo, page, pageSize, keyword)
-	} else {
+	default:
 		servers, err = dao.NewQuery(c).ListServers(userInfo, page, pageSize)
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	if hasKeyword {
+	switch {
+	case !selector.Empty():
+		serverCounts, err = dao.NewQuery(c).CountServersWithLabelSelector(userInfo, keyword, selector)
+	case hasKeyword:
 		serverCounts, err = dao.NewQuery(c).CountServersWithKeyword(userInfo, keyword)
-	} else {
+	default:
 		serverCounts, err = dao.NewQuery(c).CountServers(userInfo)
 	}
 	if err != nil {
 		return nil, err
 	}
 
+	labels, err := dao.NewQuery(c).GetLabelsByResources(userInfo, defs.LabelResourceServer,
+		lo.Map(servers, func(s *models.ServerEntity, _ int) models.LabelResourceRef {
+			return models.LabelResourceRef{ID: s.ServerID}
+		}))
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.ListServersResponse{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 		Servers: lo.Map(servers, func(c *models.ServerEntity, _ int) *pb.Server {
@@ -55,6 +75,7 @@ func ListServersHandler(c *app.Context, req *pb.ListServersRequest) (*pb.ListSer
 				Ip:       lo.ToPtr(c.ServerIP),
 				Comment:  lo.ToPtr(c.Comment),
 				FrpsUrls: c.FrpsUrls,
+				Labels:   labels[models.LabelResourceRef{ID: c.ServerID}],
 			}
 		}),
 		Total: lo.ToPtr(int32(serverCounts)),
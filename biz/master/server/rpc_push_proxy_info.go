@@ -1,10 +1,14 @@
 package server
 
 import (
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/biz/master/notify"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
 )
 
 func PushProxyInfo(ctx *app.Context, req *pb.PushProxyInfoReq) (*pb.PushProxyInfoResp, error) {
@@ -18,7 +22,36 @@ func PushProxyInfo(ctx *app.Context, req *pb.PushProxyInfoReq) (*pb.PushProxyInf
 	if err = dao.NewQuery(ctx).AdminUpdateProxyStats(srv, req.GetProxyInfos()); err != nil {
 		return nil, err
 	}
+
+	checkTrafficQuota(ctx, srv, req.GetProxyInfos())
 	return &pb.PushProxyInfoResp{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 	}, nil
 }
+
+// checkTrafficQuota 隧道当日流量超过配额时通知隧道所属用户
+func checkTrafficQuota(ctx *app.Context, srv *models.ServerEntity, proxyInfos []*pb.ProxyInfo) {
+	quotaMB := ctx.GetApp().GetConfig().Master.TrafficQuotaDailyMB
+	if quotaMB <= 0 {
+		return
+	}
+	quota := quotaMB << 20
+
+	var userName string
+	for _, proxyInfo := range proxyInfos {
+		used := proxyInfo.GetTodayTrafficIn() + proxyInfo.GetTodayTrafficOut()
+		if used <= quota {
+			continue
+		}
+
+		if len(userName) == 0 {
+			user, err := dao.NewQuery(ctx).GetUserByUserID(srv.UserID)
+			if err != nil {
+				logger.Logger(ctx).WithError(err).Errorf("cannot get server owner, serverID: [%s]", srv.ServerID)
+				return
+			}
+			userName = user.GetUserName()
+		}
+		notify.TrafficQuotaExceeded(ctx, srv, strings.TrimPrefix(proxyInfo.GetName(), userName+"."), used, quota)
+	}
+}
@@ -0,0 +1,142 @@
+package shell
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+	"github.com/sourcegraph/conc/pool"
+	"google.golang.org/protobuf/proto"
+)
+
+// 超时由 client 控制，master 多等一会儿用于传输结果
+const execResponseGracePeriod = 10 * time.Second
+
+// ExecCommand 在多个节点上执行非交互命令，每个节点都需要通过远程终端的权限检查
+func ExecCommand(ctx *app.Context, req *pb.ExecCommandRequest) (*pb.ExecCommandResponse, error) {
+	var (
+		userInfo  = common.GetUserInfo(ctx)
+		clientIds = lo.Uniq(req.GetClientIds())
+	)
+
+	if len(clientIds) == 0 {
+		return nil, fmt.Errorf("client ids are required")
+	}
+	if len(req.GetCommand()) == 0 {
+		return nil, fmt.Errorf("command is required")
+	}
+
+	timeout := time.Duration(req.GetTimeoutSeconds()) * time.Second
+	if timeout <= 0 {
+		timeout = defs.ExecDefaultTimeout
+	}
+	timeout = min(timeout, defs.ExecMaxTimeout)
+
+	clientReq := proto.Clone(req).(*pb.ExecCommandRequest)
+	clientReq.ClientIds = nil
+	clientReq.TimeoutSeconds = lo.ToPtr(int32(timeout / time.Second))
+
+	logger.Logger(ctx).Infof("user [%s] exec command [%s], args: %v, clients: %v",
+		userInfo.GetUserName(), req.GetCommand(), req.GetArgs(), clientIds)
+
+	results := make([]*pb.ExecResult, len(clientIds))
+	p := pool.New().WithMaxGoroutines(defs.ExecConcurrency)
+	for i, clientId := range clientIds {
+		p.Go(func() {
+			startedAt := time.Now()
+			results[i] = execOnClient(ctx, userInfo, clientId, clientReq, timeout+execResponseGracePeriod)
+			saveExecRecord(ctx, userInfo, clientReq, results[i], startedAt)
+		})
+	}
+	p.Wait()
+
+	return &pb.ExecCommandResponse{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Results: results,
+	}, nil
+}
+
+func execOnClient(ctx *app.Context, userInfo models.UserInfo, clientId string, req *pb.ExecCommandRequest, wait time.Duration) *pb.ExecResult {
+	failed := func(err error) *pb.ExecResult {
+		logger.Logger(ctx).WithError(err).Errorf("exec command on client [%s] failed", clientId)
+		return &pb.ExecResult{
+			ClientId: lo.ToPtr(clientId),
+			ExitCode: lo.ToPtr(int32(-1)),
+			Error:    lo.ToPtr(err.Error()),
+		}
+	}
+
+	if err := checkPTYPermission(ctx, userInfo, clientId); err != nil {
+		return failed(err)
+	}
+
+	type callResult struct {
+		resp *pb.ExecCommandResponse
+		err  error
+	}
+
+	ch := make(chan callResult, 1)
+	go func() {
+		resp := &pb.ExecCommandResponse{}
+		err := rpc.CallClientWrapper(ctx, clientId, pb.Event_EVENT_EXEC_COMMAND, req, resp)
+		ch <- callResult{resp: resp, err: err}
+	}()
+
+	var r callResult
+	select {
+	case r = <-ch:
+	case <-time.After(wait):
+		return failed(fmt.Errorf("wait for client response timeout"))
+	}
+
+	if r.err != nil {
+		return failed(r.err)
+	}
+	if len(r.resp.GetResults()) == 0 {
+		return failed(fmt.Errorf("client returned no result"))
+	}
+
+	result := r.resp.GetResults()[0]
+	result.ClientId = lo.ToPtr(clientId)
+	return result
+}
+
+// saveExecRecord 每个节点的执行结果都保存一条审计记录，输出只保留开头部分，保存失败不影响返回结果
+func saveExecRecord(ctx *app.Context, userInfo models.UserInfo, req *pb.ExecCommandRequest, result *pb.ExecResult, startedAt time.Time) {
+	record := &models.ExecRecord{
+		ClientID:   result.GetClientId(),
+		UserID:     uint32(userInfo.GetUserID()),
+		TenantID:   uint32(userInfo.GetTenantID()),
+		UserName:   userInfo.GetUserName(),
+		Command:    req.GetCommand(),
+		Args:       models.JSON[[]string]{Data: req.GetArgs()},
+		WorkDir:    req.GetWorkDir(),
+		ExitCode:   result.GetExitCode(),
+		TimedOut:   result.GetTimedOut(),
+		Stdout:     truncateExecOutput(result.GetStdout()),
+		Stderr:     truncateExecOutput(result.GetStderr()),
+		Error:      result.GetError(),
+		StartedAt:  startedAt,
+		DurationMs: time.Since(startedAt).Milliseconds(),
+	}
+
+	if err := dao.NewQuery(ctx).AdminCreateExecRecord(record); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save exec record, client id: [%s], command: [%s]",
+			record.ClientID, record.Command)
+	}
+}
+
+func truncateExecOutput(output []byte) []byte {
+	if len(output) <= defs.ExecRecordOutputMaxBytes {
+		return output
+	}
+	return output[:defs.ExecRecordOutputMaxBytes]
+}
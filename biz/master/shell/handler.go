@@ -1,22 +1,17 @@
 package shell
 
 import (
-	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/VaalaCat/frp-panel/common"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/utils/logger"
-	"github.com/fatedier/golib/log"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"github.com/samber/lo"
-	"github.com/sourcegraph/conc"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -27,9 +22,6 @@ func PTYHandler(appInstance app.Application) func(*gin.Context) {
 }
 
 func ptyHandler(c *gin.Context, appInstance app.Application) {
-	connectionErrorLimit := 10
-	keepalivePingTimeout := 10 * time.Second
-
 	upgrader := getUpgrader(c)
 	webConn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
@@ -70,6 +62,13 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 		}
 	}
 
+	if err := checkPTYPermission(app.NewContext(c, appInstance), common.GetUserInfo(c), clientID); err != nil {
+		logger.Logger(c).WithError(err).Errorf("pty permission denied, client id: [%s]", clientID)
+		webConn.WriteMessage(websocket.BinaryMessage, []byte(err.Error()))
+		webConn.Close()
+		return
+	}
+
 	cliMsg, err := rpc.CallClient(app.NewContext(c, appInstance), clientID, pb.Event_EVENT_START_PTY_CONNECT, &pb.CommonRequest{})
 	if err != nil {
 		logger.Logger(c).WithError(err).Errorf("start pty connect error")
@@ -93,142 +92,69 @@ func ptyHandler(c *gin.Context, appInstance app.Application) {
 		return
 	}
 
-	cliConn.Send(&pb.PTYServerMessage{
+	var (
+		appCtx   = app.NewContext(c, appInstance)
+		userInfo = common.GetUserInfo(c)
+	)
+
+	ptySession, recorder, err := startPTYRecording(appCtx, userInfo, clientID, sessionID, initWidthInt, initHeightInt)
+	if err != nil {
+		// 无法录像时不允许打开终端
+		logger.Logger(c).WithError(err).Errorf("cannot record pty session, session id: [%s]", sessionID)
+		webConn.WriteMessage(websocket.BinaryMessage, []byte("cannot record session, refused"))
+		cliConn.Send(&pb.PTYServerMessage{Data: []byte("bye!"), Done: true})
+		appInstance.GetShellPTYMgr().SetSessionDone(sessionID)
+		webConn.Close()
+		return
+	}
+
+	session := newLiveSession(appInstance, userInfo, clientID, sessionID, cliConn, ptySession, recorder,
+		parseShareMode(c.Query("share")), initWidthInt, initHeightInt)
+	session.start()
+
+	if err := session.send(&pb.PTYServerMessage{
 		Height: lo.ToPtr(int32(initHeightInt)),
 		Width:  lo.ToPtr(int32(initWidthInt)),
-	})
+	}); err != nil {
+		logger.Logger(c).WithError(err).Warnf("failed to send init size to client")
+	}
 
-	defer func() {
-		logger.Logger(c).Info("gracefully stopping spawned tty...")
-		if err := cliConn.Send(&pb.PTYServerMessage{Data: []byte("bye!"), Done: true}); err != nil {
-			logger.Logger(c).Warnf("failed to send close message: %s", err)
-		}
+	// 浏览器断开后会话继续保留，可以通过 JoinPTYSessionHandler 重新加入
+	session.attach(webConn, userInfo, true)
+	logger.Logger(c).Infof("websocket of pty session [%s] closed", sessionID)
+}
 
-		appInstance.GetShellPTYMgr().SetSessionDone(sessionID)
-		if err := webConn.Close(); err != nil {
-			logger.Logger(c).Warnf("failed to close webscoket connection: %s", err)
-		}
-	}()
-
-	var connectionClosed bool
-	var wg conc.WaitGroup
-
-	// this is a keep-alive loop that ensures connection does not hang-up itself
-	lastPongTime := time.Now()
-	webConn.SetPongHandler(func(msg string) error {
-		lastPongTime = time.Now()
-		return nil
-	})
-
-	wg.Go(func() {
-		defer func() {
-			if err := cliConn.Send(&pb.PTYServerMessage{Data: []byte("bye!"), Done: true}); err != nil {
-				logger.Logger(c).Warnf("failed to send close message: %s", err)
-			}
-
-			appInstance.GetShellPTYMgr().SetSessionDone(sessionID)
-			if err := webConn.Close(); err != nil {
-				logger.Logger(c).Warnf("failed to close webscoket connection: %s", err)
-			}
-		}()
-		for {
-			if err := webConn.WriteMessage(websocket.PingMessage, []byte("keepalive")); err != nil {
-				logger.Logger(c).Warn("failed to write ping message")
-				return
-			}
-			time.Sleep(keepalivePingTimeout / 2)
-			if time.Since(lastPongTime) > keepalivePingTimeout {
-				logger.Logger(c).Warn("failed to get response from ping, triggering disconnect now...")
-				return
-			}
-			logger.Logger(c).Debug("received response from ping successfully")
+// JoinPTYSessionHandler 加入正在运行的会话，query: mode=write 时可以输入，否则只读
+func JoinPTYSessionHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		var (
+			sessionID = c.Param("sessionID")
+			writable  = c.Query("mode") == "write"
+			userInfo  = common.GetUserInfo(c)
+		)
+
+		session, ok := getLiveSession(appInstance, sessionID)
+		if !ok {
+			c.JSON(http.StatusNotFound, common.Err("session not found"))
+			return
 		}
-	})
-
-	// client >> xterm.js
-	wg.Go(func() {
-		errorCounter := 0
-		for {
-			// consider the connection closed/errored out so that the socket handler
-			// can be terminated - this frees up memory so the service doesn't get
-			// overloaded
-			if errorCounter > connectionErrorLimit {
-				break
-			}
-			cliMsg, err := cliConn.Recv()
-			if err != nil {
-				logger.Logger(c).Warnf("failed to read from client sender: %s", err)
-				if err := webConn.WriteMessage(websocket.BinaryMessage, []byte("bye!")); err != nil {
-					logger.Logger(c).Warnf("failed to send termination message from client sender to xterm.js: %s", err)
-				}
-				if err := cliConn.Send(&pb.PTYServerMessage{Data: []byte("bye!"), Done: true}); err != nil {
-					logger.Logger(c).Warnf("failed to send termination message from client sender to client: %s", err)
-				}
-				if err := webConn.Close(); err != nil {
-					logger.Logger(c).Warnf("failed to close webscoket connection: %s", err)
-				}
-				return
-			}
-
-			readLength := len(cliMsg.GetData())
-
-			if err := webConn.WriteMessage(websocket.BinaryMessage, []byte(cliMsg.GetData())); err != nil {
-				logger.Logger(c).Warnf("failed to send %v bytes from client sender to xterm.js", readLength)
-				errorCounter++
-				continue
-			}
-			logger.Logger(c).Tracef("sent message of size %v bytes from client sender to xterm.js", readLength)
-			errorCounter = 0
+
+		if userInfo.GetPTYDisabled() || !session.canView(userInfo) || (writable && !session.canWrite(userInfo)) {
+			logger.Logger(c).Errorf("user [%s] has no permission to join pty session [%s], writable: [%v]",
+				userInfo.GetUserName(), sessionID, writable)
+			c.JSON(http.StatusForbidden, common.Err("permission denied"))
+			return
 		}
-	})
-
-	// client << xterm.js
-	wg.Go(func() {
-		for {
-			// data processing
-			messageType, data, err := webConn.ReadMessage()
-			if err != nil {
-				if !connectionClosed {
-					logger.Logger(c).Warnf("failed to get next reader: %s", err)
-				}
-				if err := cliConn.Send(&pb.PTYServerMessage{Data: []byte("bye!"), Done: true}); err != nil {
-					logger.Logger(c).Warnf("failed to send termination message from xterm.js to client: %s", err)
-				}
-				if err := webConn.Close(); err != nil {
-					logger.Logger(c).Warnf("failed to close webscoket connection: %s", err)
-				}
-				return
-			}
-			payload := struct {
-				Data   *string `json:"data,omitempty"`
-				Height *uint16 `json:"height,omitempty"`
-				Width  *uint16 `json:"width,omitempty"`
-			}{}
-			json.Unmarshal(data, &payload)
-
-			msg := &pb.PTYServerMessage{}
-			if payload.Data != nil {
-				msg.Data = []byte(*payload.Data)
-			}
-			if payload.Height != nil {
-				msg.Height = lo.ToPtr(int32(*payload.Height))
-			}
-			if payload.Width != nil {
-				msg.Width = lo.ToPtr(int32(*payload.Width))
-			}
-
-			err = cliConn.Send(msg)
-			if err != nil {
-				logger.Logger(c).Warn(fmt.Sprintf("failed to write bytes to client: %s", err))
-				continue
-			}
-			logger.Logger(c).Tracef("messageType [%v] bytes written to client...", messageType)
+
+		upgrader := getUpgrader(c)
+		webConn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			logger.Logger(c).WithError(err).Infof("websocket connect error")
+			return
 		}
-	})
 
-	wg.Wait()
-	log.Info("closing conn...")
-	connectionClosed = true
+		session.attach(webConn, userInfo, writable)
+	}
 }
 
 func getUpgrader(c *gin.Context) websocket.Upgrader {
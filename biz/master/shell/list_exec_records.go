@@ -0,0 +1,61 @@
+package shell
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// ListExecRecords 普通用户只能看到自己执行的命令，管理员可以看到全部
+func ListExecRecords(ctx *app.Context, req *pb.ListExecRecordsRequest) (*pb.ListExecRecordsResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		clientId = req.GetClientId()
+		page     = int(req.GetPage())
+		pageSize = int(req.GetPageSize())
+		records  []*models.ExecRecord
+		total    int64
+		err      error
+	)
+
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = 10
+	}
+
+	q := dao.NewQuery(ctx)
+	if userInfo.GetRole() == defs.UserRole_Admin {
+		records, err = q.AdminListExecRecords(clientId, page, pageSize)
+		if err == nil {
+			total, err = q.AdminCountExecRecords(clientId)
+		}
+	} else {
+		records, err = q.ListExecRecords(userInfo, clientId, page, pageSize)
+		if err == nil {
+			total, err = q.CountExecRecords(userInfo, clientId)
+		}
+	}
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list exec records, client id: [%s]", clientId)
+		return nil, err
+	}
+
+	return &pb.ListExecRecordsResponse{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:   lo.ToPtr(int32(total)),
+		Records: lo.Map(records, func(item *models.ExecRecord, _ int) *pb.ExecRecord { return item.ToPB() }),
+	}, nil
+}
@@ -0,0 +1,151 @@
+package shell
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// ListPTYSessions 普通用户只能看到自己发起的会话，管理员可以看到全部
+func ListPTYSessions(ctx *app.Context, req *pb.ListPTYSessionsRequest) (*pb.ListPTYSessionsResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		clientId = req.GetClientId()
+		page     = int(req.GetPage())
+		pageSize = int(req.GetPageSize())
+		sessions []*models.PTYSession
+		total    int64
+		err      error
+	)
+
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	if req.GetLiveOnly() {
+		return listLivePTYSessions(ctx, userInfo, clientId), nil
+	}
+
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = 10
+	}
+
+	q := dao.NewQuery(ctx)
+	if userInfo.GetRole() == defs.UserRole_Admin {
+		sessions, err = q.AdminListPTYSessions(clientId, page, pageSize)
+		if err == nil {
+			total, err = q.AdminCountPTYSessions(clientId)
+		}
+	} else {
+		sessions, err = q.ListPTYSessions(userInfo, clientId, page, pageSize)
+		if err == nil {
+			total, err = q.CountPTYSessions(userInfo, clientId)
+		}
+	}
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list pty sessions, client id: [%s]", clientId)
+		return nil, err
+	}
+
+	return &pb.ListPTYSessionsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:  lo.ToPtr(int32(total)),
+		Sessions: lo.Map(sessions, func(item *models.PTYSession, _ int) *pb.PTYSession {
+			info := item.ToPB()
+			if _, ok := getLiveSession(ctx.GetApp(), item.SessionID); ok {
+				info.Live = lo.ToPtr(true)
+			}
+			return info
+		}),
+	}, nil
+}
+
+func getPTYSession(ctx *app.Context, userInfo models.UserInfo, sessionID string) (*models.PTYSession, error) {
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+	if userInfo.GetRole() == defs.UserRole_Admin {
+		return dao.NewQuery(ctx).AdminGetPTYSession(sessionID)
+	}
+	return dao.NewQuery(ctx).GetPTYSession(userInfo, sessionID)
+}
+
+// listLivePTYSessions 返回用户可以加入的正在运行的会话
+func listLivePTYSessions(ctx *app.Context, userInfo models.UserInfo, clientId string) *pb.ListPTYSessionsResponse {
+	sessions := []*pb.PTYSession{}
+	ctx.GetApp().GetShellPTYMgr().LiveSessions().Range(func(_ string, v app.PTYLiveSession) bool {
+		s, ok := v.(*liveSession)
+		if !ok || (len(clientId) > 0 && s.clientID != clientId) || !s.canView(userInfo) {
+			return true
+		}
+		sessions = append(sessions, s.Info())
+		return true
+	})
+
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].GetStartedAt() > sessions[j].GetStartedAt() })
+
+	return &pb.ListPTYSessionsResponse{
+		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:    lo.ToPtr(int32(len(sessions))),
+		Sessions: sessions,
+	}
+}
+
+// TerminatePTYSession 发起者和管理员可以强制结束会话
+func TerminatePTYSession(ctx *app.Context, req *pb.TerminatePTYSessionRequest) (*pb.TerminatePTYSessionResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	session, ok := getLiveSession(ctx.GetApp(), req.GetSessionId())
+	if !ok {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	if !session.canManage(userInfo) {
+		logger.Logger(ctx).Errorf("user [%s] has no permission to terminate pty session [%s]", userInfo.GetUserName(), req.GetSessionId())
+		return nil, fmt.Errorf("permission denied")
+	}
+
+	session.Terminate(fmt.Sprintf("session terminated by %s", userInfo.GetUserName()))
+
+	return &pb.TerminatePTYSessionResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
+
+func UpdatePTYSessionShare(ctx *app.Context, req *pb.UpdatePTYSessionShareRequest) (*pb.UpdatePTYSessionShareResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	session, ok := getLiveSession(ctx.GetApp(), req.GetSessionId())
+	if !ok {
+		return nil, fmt.Errorf("session not found")
+	}
+
+	if !session.canManage(userInfo) {
+		logger.Logger(ctx).Errorf("user [%s] has no permission to share pty session [%s]", userInfo.GetUserName(), req.GetSessionId())
+		return nil, fmt.Errorf("permission denied")
+	}
+
+	session.setShareMode(req.GetShareMode())
+	logger.Logger(ctx).Infof("pty session [%s] share mode changed to [%s] by [%s]", req.GetSessionId(), req.GetShareMode(), userInfo.GetUserName())
+
+	return &pb.UpdatePTYSessionShareResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
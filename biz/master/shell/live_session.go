@@ -0,0 +1,452 @@
+package shell
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/samber/lo"
+	"github.com/sourcegraph/conc"
+)
+
+const (
+	keepalivePingTimeout  = 10 * time.Second
+	ptyViewerWriteTimeout = 10 * time.Second
+)
+
+type ptyViewer struct {
+	id       string
+	userID   int
+	userName string
+	writable bool
+	conn     *websocket.Conn
+	// gorilla websocket 不支持并发写
+	writeMu sync.Mutex
+	// 待发送的输出，由 writeLoop 写入连接，慢的连接不会阻塞会话
+	queue chan []byte
+}
+
+func (v *ptyViewer) write(messageType int, data []byte) error {
+	v.writeMu.Lock()
+	defer v.writeMu.Unlock()
+
+	v.conn.SetWriteDeadline(time.Now().Add(ptyViewerWriteTimeout))
+	return v.conn.WriteMessage(messageType, data)
+}
+
+// enqueue 不阻塞，队列已满时返回 false
+func (v *ptyViewer) enqueue(data []byte) bool {
+	select {
+	case v.queue <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+func (v *ptyViewer) writeLoop(stop <-chan struct{}) error {
+	for {
+		select {
+		case <-stop:
+			return nil
+		case data := <-v.queue:
+			if err := v.write(websocket.BinaryMessage, data); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// liveSession client 上的一个终端会话，可以同时被多个浏览器连接
+// 浏览器全部断开后会话保留 defs.PTYSessionDetachTimeout，client 断开或被终止时结束
+type liveSession struct {
+	ctx       *app.Context
+	sessionID string
+	clientID  string
+	ownerID   int
+	tenantID  int
+	ownerName string
+	startedAt time.Time
+
+	cliConn pb.Master_PTYConnectServer
+	sendMu  sync.Mutex
+
+	record   *models.PTYSession
+	recorder *ptyRecorder
+
+	mu          sync.Mutex
+	shareMode   pb.PTYSession_ShareMode
+	width       int
+	height      int
+	viewers     map[string]*ptyViewer
+	scrollback  []byte
+	detachTimer *time.Timer
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newLiveSession(appInstance app.Application, owner models.UserInfo, clientID, sessionID string,
+	cliConn pb.Master_PTYConnectServer, record *models.PTYSession, recorder *ptyRecorder,
+	shareMode pb.PTYSession_ShareMode, width, height int) *liveSession {
+	return &liveSession{
+		ctx:       app.NewContext(context.Background(), appInstance),
+		sessionID: sessionID,
+		clientID:  clientID,
+		ownerID:   owner.GetUserID(),
+		tenantID:  owner.GetTenantID(),
+		ownerName: owner.GetUserName(),
+		startedAt: time.Now(),
+		cliConn:   cliConn,
+		record:    record,
+		recorder:  recorder,
+		shareMode: shareMode,
+		width:     width,
+		height:    height,
+		viewers:   map[string]*ptyViewer{},
+		done:      make(chan struct{}),
+	}
+}
+
+// start 注册会话并开始转发 client 的输出
+func (s *liveSession) start() {
+	s.ctx.GetApp().GetShellPTYMgr().LiveSessions().Store(s.sessionID, s)
+
+	s.mu.Lock()
+	s.detachTimer = time.AfterFunc(defs.PTYSessionDetachTimeout, func() { s.Terminate("no viewer left") })
+	s.mu.Unlock()
+
+	go s.pump()
+}
+
+func (s *liveSession) send(msg *pb.PTYServerMessage) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.cliConn.Send(msg)
+}
+
+// pump client >> xterm.js，输出广播给所有连接的浏览器
+func (s *liveSession) pump() {
+	for {
+		cliMsg, err := s.cliConn.Recv()
+		if err != nil {
+			logger.Logger(s.ctx).Warnf("failed to read from client sender: %s, session id: [%s]", err, s.sessionID)
+			s.Terminate("bye!")
+			return
+		}
+
+		data := cliMsg.GetData()
+		if err := s.recorder.Output(data); err != nil {
+			logger.Logger(s.ctx).WithError(err).Warnf("failed to record pty output")
+		}
+
+		s.mu.Lock()
+		s.scrollback = append(s.scrollback, data...)
+		if over := len(s.scrollback) - defs.PTYSessionScrollbackBytes; over > 0 {
+			s.scrollback = append([]byte(nil), s.scrollback[over:]...)
+		}
+		viewers := lo.Values(s.viewers)
+		s.mu.Unlock()
+
+		for _, v := range viewers {
+			if !v.enqueue(data) {
+				logger.Logger(s.ctx).Warnf("viewer [%s] of pty session [%s] falls behind, disconnect it", v.userName, s.sessionID)
+				// 读循环会随之退出并移除该 viewer
+				v.conn.Close()
+			}
+		}
+	}
+}
+
+// attach 将浏览器连接加入会话，阻塞到连接断开
+func (s *liveSession) attach(conn *websocket.Conn, userInfo models.UserInfo, writable bool) {
+	userName := userInfo.GetUserName()
+	viewer := &ptyViewer{
+		id:       uuid.New().String(),
+		userID:   userInfo.GetUserID(),
+		userName: userName,
+		writable: writable,
+		conn:     conn,
+		queue:    make(chan []byte, defs.PTYViewerSendQueueSize),
+	}
+
+	s.mu.Lock()
+	if s.isDone() {
+		s.mu.Unlock()
+		viewer.write(websocket.BinaryMessage, []byte("bye!"))
+		conn.Close()
+		return
+	}
+	if s.detachTimer != nil {
+		s.detachTimer.Stop()
+		s.detachTimer = nil
+	}
+	// 持有锁放入队列，保证回放内容在之后的输出之前，实际写入在 writeLoop 中进行
+	if len(s.scrollback) > 0 {
+		viewer.enqueue(append([]byte(nil), s.scrollback...))
+	}
+	s.viewers[viewer.id] = viewer
+	s.mu.Unlock()
+
+	defer s.detach(viewer)
+
+	logger.Logger(s.ctx).Infof("user [%s] attached to pty session [%s], writable: [%v]", userName, s.sessionID, writable)
+
+	var (
+		wg           conc.WaitGroup
+		stop         = make(chan struct{})
+		lastPongTime atomic.Int64
+	)
+
+	// this is a keep-alive loop that ensures connection does not hang-up itself
+	lastPongTime.Store(time.Now().UnixNano())
+	conn.SetPongHandler(func(string) error {
+		lastPongTime.Store(time.Now().UnixNano())
+		return nil
+	})
+
+	// client >> xterm.js
+	wg.Go(func() {
+		if err := viewer.writeLoop(stop); err != nil {
+			logger.Logger(s.ctx).Warnf("failed to send pty output to viewer [%s]: %s", userName, err)
+			conn.Close()
+		}
+	})
+
+	wg.Go(func() {
+		ticker := time.NewTicker(keepalivePingTimeout / 2)
+		defer ticker.Stop()
+		for {
+			if err := viewer.write(websocket.PingMessage, []byte("keepalive")); err != nil {
+				logger.Logger(s.ctx).Warn("failed to write ping message")
+				conn.Close()
+				return
+			}
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+			if time.Since(time.Unix(0, lastPongTime.Load())) > keepalivePingTimeout {
+				logger.Logger(s.ctx).Warn("failed to get response from ping, triggering disconnect now...")
+				conn.Close()
+				return
+			}
+		}
+	})
+
+	// client << xterm.js
+	wg.Go(func() {
+		defer close(stop)
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				if !s.isDone() {
+					logger.Logger(s.ctx).Infof("viewer [%s] disconnected from pty session [%s]: %s", userName, s.sessionID, err)
+				}
+				return
+			}
+			s.handleInput(viewer, data)
+		}
+	})
+
+	wg.Wait()
+}
+
+func (s *liveSession) detach(viewer *ptyViewer) {
+	viewer.conn.Close()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.viewers, viewer.id)
+	if len(s.viewers) == 0 && !s.isDone() && s.detachTimer == nil {
+		logger.Logger(s.ctx).Infof("no viewer left in pty session [%s], keep it for [%s]", s.sessionID, defs.PTYSessionDetachTimeout)
+		s.detachTimer = time.AfterFunc(defs.PTYSessionDetachTimeout, func() { s.Terminate("no viewer left") })
+	}
+}
+
+// detachUser 断开指定用户在会话中的所有浏览器连接，会话本身不受影响
+func (s *liveSession) detachUser(userID int, reason string) {
+	s.mu.Lock()
+	viewers := lo.Filter(lo.Values(s.viewers), func(v *ptyViewer, _ int) bool { return v.userID == userID })
+	s.mu.Unlock()
+
+	for _, v := range viewers {
+		logger.Logger(s.ctx).Infof("detach user [%s] from pty session [%s], reason: [%s]", v.userName, s.sessionID, reason)
+		go func(v *ptyViewer) {
+			v.write(websocket.BinaryMessage, []byte(reason))
+			// 读循环会随之退出并移除该 viewer
+			v.conn.Close()
+		}(v)
+	}
+}
+
+func (s *liveSession) handleInput(viewer *ptyViewer, data []byte) {
+	// 只读用户的输入和窗口大小变化都不转发
+	if !viewer.writable {
+		return
+	}
+
+	payload := struct {
+		Data   *string `json:"data,omitempty"`
+		Height *uint16 `json:"height,omitempty"`
+		Width  *uint16 `json:"width,omitempty"`
+	}{}
+	json.Unmarshal(data, &payload)
+
+	msg := &pb.PTYServerMessage{}
+	if payload.Data != nil {
+		msg.Data = []byte(*payload.Data)
+		if err := s.recorder.Input(msg.Data); err != nil {
+			logger.Logger(s.ctx).WithError(err).Warnf("failed to record pty input")
+		}
+	}
+	if payload.Height != nil {
+		msg.Height = lo.ToPtr(int32(*payload.Height))
+	}
+	if payload.Width != nil {
+		msg.Width = lo.ToPtr(int32(*payload.Width))
+	}
+	if payload.Height != nil && payload.Width != nil {
+		s.mu.Lock()
+		s.width, s.height = int(*payload.Width), int(*payload.Height)
+		s.mu.Unlock()
+		if err := s.recorder.Resize(int(*payload.Width), int(*payload.Height)); err != nil {
+			logger.Logger(s.ctx).WithError(err).Warnf("failed to record pty resize")
+		}
+	}
+
+	if err := s.send(msg); err != nil {
+		logger.Logger(s.ctx).Warnf("failed to write bytes to client: %s", err)
+	}
+}
+
+// Terminate 结束会话，关闭 client 上的终端和所有浏览器连接
+func (s *liveSession) Terminate(reason string) {
+	s.closeOnce.Do(func() {
+		logger.Logger(s.ctx).Infof("terminate pty session [%s], reason: [%s]", s.sessionID, reason)
+
+		mgr := s.ctx.GetApp().GetShellPTYMgr()
+		mgr.LiveSessions().Delete(s.sessionID)
+		if err := s.send(&pb.PTYServerMessage{Data: []byte("bye!"), Done: true}); err != nil {
+			logger.Logger(s.ctx).Warnf("failed to send close message: %s", err)
+		}
+		mgr.SetSessionDone(s.sessionID)
+
+		s.mu.Lock()
+		close(s.done)
+		if s.detachTimer != nil {
+			s.detachTimer.Stop()
+			s.detachTimer = nil
+		}
+		viewers := lo.Values(s.viewers)
+		s.mu.Unlock()
+
+		for _, v := range viewers {
+			go func(v *ptyViewer) {
+				v.write(websocket.BinaryMessage, []byte(reason))
+				v.conn.Close()
+			}(v)
+		}
+
+		finishPTYRecording(s.ctx, s.record, s.recorder)
+	})
+}
+
+func (s *liveSession) isDone() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *liveSession) Info() *pb.PTYSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info := &pb.PTYSession{
+		SessionId: lo.ToPtr(s.sessionID),
+		ClientId:  lo.ToPtr(s.clientID),
+		UserId:    lo.ToPtr(uint32(s.ownerID)),
+		UserName:  lo.ToPtr(s.ownerName),
+		StartedAt: lo.ToPtr(s.startedAt.UnixMilli()),
+		EndedAt:   lo.ToPtr(int64(0)),
+		Width:     lo.ToPtr(int32(s.width)),
+		Height:    lo.ToPtr(int32(s.height)),
+		Live:      lo.ToPtr(true),
+		ShareMode: s.shareMode.Enum(),
+		Viewers:   lo.Map(lo.Values(s.viewers), func(v *ptyViewer, _ int) string { return v.userName }),
+	}
+	if s.record != nil {
+		info.Id = lo.ToPtr(uint32(s.record.ID))
+	}
+	return info
+}
+
+func (s *liveSession) setShareMode(mode pb.PTYSession_ShareMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shareMode = mode
+}
+
+func (s *liveSession) isOwner(userInfo models.UserInfo) bool {
+	return userInfo.GetUserID() == s.ownerID && userInfo.GetTenantID() == s.tenantID
+}
+
+// canManage 发起者和管理员可以修改共享方式和终止会话
+func (s *liveSession) canManage(userInfo models.UserInfo) bool {
+	return userInfo.GetRole() == defs.UserRole_Admin || s.isOwner(userInfo)
+}
+
+func (s *liveSession) canView(userInfo models.UserInfo) bool {
+	if s.canManage(userInfo) {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return userInfo.GetTenantID() == s.tenantID &&
+		(s.shareMode == pb.PTYSession_SHARE_MODE_READ || s.shareMode == pb.PTYSession_SHARE_MODE_WRITE)
+}
+
+func (s *liveSession) canWrite(userInfo models.UserInfo) bool {
+	if s.canManage(userInfo) {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return userInfo.GetTenantID() == s.tenantID && s.shareMode == pb.PTYSession_SHARE_MODE_WRITE
+}
+
+func getLiveSession(appInstance app.Application, sessionID string) (*liveSession, bool) {
+	v, ok := appInstance.GetShellPTYMgr().LiveSessions().Load(sessionID)
+	if !ok {
+		return nil, false
+	}
+	s, ok := v.(*liveSession)
+	return s, ok
+}
+
+func parseShareMode(mode string) pb.PTYSession_ShareMode {
+	switch mode {
+	case "read":
+		return pb.PTYSession_SHARE_MODE_READ
+	case "write":
+		return pb.PTYSession_SHARE_MODE_WRITE
+	default:
+		return pb.PTYSession_SHARE_MODE_PRIVATE
+	}
+}
@@ -0,0 +1,71 @@
+package shell
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestViewerConn 返回一对 websocket 连接，server 端交给 viewer，client 端模拟浏览器
+func newTestViewerConn(t *testing.T) (server, client *websocket.Conn) {
+	t.Helper()
+
+	conns := make(chan *websocket.Conn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("upgrade: %v", err)
+			return
+		}
+		conns <- conn
+	}))
+	t.Cleanup(srv.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	server = <-conns
+	t.Cleanup(func() { server.Close() })
+	return server, client
+}
+
+func TestLiveSessionDetachUser(t *testing.T) {
+	disabledServer, disabledClient := newTestViewerConn(t)
+	otherServer, otherClient := newTestViewerConn(t)
+
+	s := &liveSession{
+		ctx:       app.NewContext(context.Background(), nil),
+		sessionID: "session",
+		ownerID:   1,
+		viewers: map[string]*ptyViewer{
+			"disabled": {id: "disabled", userID: 2, userName: "disabled", conn: disabledServer},
+			"other":    {id: "other", userID: 3, userName: "other", conn: otherServer},
+		},
+	}
+
+	s.detachUser(2, "remote shell is disabled by admin")
+
+	// 被禁用用户先收到原因，随后连接被关闭
+	disabledClient.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, data, err := disabledClient.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, "remote shell is disabled by admin", string(data))
+	_, _, err = disabledClient.ReadMessage()
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "timeout")
+
+	// 其他用户的连接不受影响
+	otherClient.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+	_, _, err = otherClient.ReadMessage()
+	assert.ErrorContains(t, err, "timeout")
+}
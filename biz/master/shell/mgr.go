@@ -2,12 +2,14 @@ package shell
 
 import (
 	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/utils"
 )
 
 type PTYMgr struct {
-	*utils.SyncMap[string, pb.Master_PTYConnectServer]                                   // sessionID
-	doneMap                                            *utils.SyncMap[string, chan bool] // sessionID
+	*utils.SyncMap[string, pb.Master_PTYConnectServer]                                            // sessionID
+	doneMap                                            *utils.SyncMap[string, chan struct{}]      // sessionID
+	liveSessions                                       *utils.SyncMap[string, app.PTYLiveSession] // sessionID
 }
 
 func (m *PTYMgr) IsSessionDone(sessionID string) bool {
@@ -15,26 +17,33 @@ func (m *PTYMgr) IsSessionDone(sessionID string) bool {
 	if !ok {
 		return true
 	}
-	return <-ch
+	<-ch
+	return true
 }
 
+// SetSessionDone 可以重复调用
 func (m *PTYMgr) SetSessionDone(sessionID string) {
-	ch, ok := m.doneMap.Load(sessionID)
+	ch, ok := m.doneMap.LoadAndDelete(sessionID)
 	if !ok {
 		return
 	}
-	ch <- true
+	m.Delete(sessionID)
+	close(ch)
 }
 
 func (m *PTYMgr) Add(sessionID string, conn pb.Master_PTYConnectServer) {
 	m.Store(sessionID, conn)
-	m.doneMap.Store(sessionID, make(chan bool))
+	m.doneMap.Store(sessionID, make(chan struct{}))
+}
+
+func (m *PTYMgr) LiveSessions() app.SyncMap[string, app.PTYLiveSession] {
+	return m.liveSessions
 }
 
 func NewPTYMgr() *PTYMgr {
 	return &PTYMgr{
-		SyncMap: &utils.SyncMap[string, pb.Master_PTYConnectServer]{},
-		doneMap: &utils.SyncMap[string, chan bool]{},
+		SyncMap:      &utils.SyncMap[string, pb.Master_PTYConnectServer]{},
+		doneMap:      &utils.SyncMap[string, chan struct{}]{},
+		liveSessions: &utils.SyncMap[string, app.PTYLiveSession]{},
 	}
 }
-
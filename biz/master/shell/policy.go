@@ -0,0 +1,113 @@
+package shell
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// checkPTYPermission 检查用户是否可以打开节点的远程终端
+// 节点本身的开关在 client 侧检查，见 biz/common.StartPTYConnect
+func checkPTYPermission(ctx *app.Context, userInfo models.UserInfo, clientID string) error {
+	if userInfo == nil || !userInfo.Valid() {
+		return fmt.Errorf("invalid user")
+	}
+	if userInfo.GetPTYDisabled() {
+		return fmt.Errorf("remote shell is disabled for user [%s]", userInfo.GetUserName())
+	}
+
+	q := dao.NewQuery(ctx)
+
+	var (
+		cli *models.Client
+		err error
+	)
+	if userInfo.IsAdmin() {
+		cli, err = q.AdminGetClientByClientID(clientID)
+	} else {
+		cli, err = q.GetClientByClientID(userInfo, clientID)
+	}
+	if err == nil {
+		if cli.PTYDisabled {
+			return fmt.Errorf("remote shell is disabled for client [%s]", clientID)
+		}
+		return nil
+	}
+
+	// frps 节点同样可以打开终端
+	if userInfo.IsAdmin() {
+		_, err = q.AdminGetServerByServerID(clientID)
+	} else {
+		_, err = q.GetServerByServerID(userInfo, clientID)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot find client or server [%s]", clientID)
+	}
+	return nil
+}
+
+func SetPTYPolicy(ctx *app.Context, req *pb.SetPTYPolicyRequest) (*pb.SetPTYPolicyResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() || !userInfo.IsAdmin() {
+		return nil, fmt.Errorf("permission denied")
+	}
+
+	var (
+		q        = dao.NewQuery(ctx)
+		clientId = req.GetClientId()
+		userId   = int(req.GetUserId())
+		disabled = !req.GetEnabled()
+	)
+
+	if (len(clientId) == 0) == (userId == 0) {
+		return nil, fmt.Errorf("one of client_id and user_id is required")
+	}
+
+	if len(clientId) > 0 {
+		if _, err := q.AdminGetClientByClientID(clientId); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get client, id: [%s]", clientId)
+			return nil, err
+		}
+		if err := q.AdminUpdateClientPTYDisabled(clientId, disabled); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot update client pty policy, id: [%s]", clientId)
+			return nil, err
+		}
+	} else {
+		if _, err := q.GetUserByUserID(userId); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get user, id: [%d]", userId)
+			return nil, err
+		}
+		if err := q.AdminUpdateUserPTYDisabled(userId, disabled); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot update user pty policy, id: [%d]", userId)
+			return nil, err
+		}
+	}
+
+	if disabled {
+		// 禁用后立即结束已经打开的会话，被禁用的用户加入的其他人的会话也要断开
+		ctx.GetApp().GetShellPTYMgr().LiveSessions().Range(func(_ string, v app.PTYLiveSession) bool {
+			s, ok := v.(*liveSession)
+			if !ok {
+				return true
+			}
+			if (len(clientId) > 0 && s.clientID == clientId) || (userId != 0 && s.ownerID == userId) {
+				s.Terminate("remote shell is disabled by admin")
+			} else if userId != 0 {
+				s.detachUser(userId, "remote shell is disabled by admin")
+			}
+			return true
+		})
+	}
+
+	logger.Logger(ctx).Infof("admin [%s] set pty policy, client id: [%s], user id: [%d], enabled: [%v]",
+		userInfo.GetUserName(), clientId, userId, req.GetEnabled())
+
+	return &pb.SetPTYPolicyResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
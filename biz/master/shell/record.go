@@ -0,0 +1,138 @@
+package shell
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// 回放时两帧之间最长等待时间，跳过长时间无操作的片段
+	replayMaxIdle = 2 * time.Second
+	replayMaxLine = 1024 * 1024
+)
+
+// DownloadPTYRecordHandler 下载 asciicast v2 格式的录像，可直接用 asciinema play 播放
+func DownloadPTYRecordHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		ctx := app.NewContext(c, appInstance)
+		sessionID := c.Param("sessionID")
+
+		session, err := getPTYSession(ctx, common.GetUserInfo(c), sessionID)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get pty session, session id: [%s]", sessionID)
+			c.JSON(http.StatusNotFound, common.Err("session not found"))
+			return
+		}
+
+		if _, err := os.Stat(session.FilePath); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("pty recording not found, path: [%s]", session.FilePath)
+			c.JSON(http.StatusNotFound, common.Err("recording not found"))
+			return
+		}
+
+		c.Header("Content-Type", "application/x-asciicast")
+		c.FileAttachment(session.FilePath, fmt.Sprintf("%s-%s.cast", session.ClientID, session.SessionID))
+	}
+}
+
+// ReplayPTYRecordHandler 通过 websocket 按原始节奏回放终端输出，数据格式与 PTYHandler 相同
+// query: speed 回放倍速，默认 1
+func ReplayPTYRecordHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		ctx := app.NewContext(c, appInstance)
+		sessionID := c.Param("sessionID")
+
+		speed := 1.0
+		if s := c.Query("speed"); s != "" {
+			if v, err := strconv.ParseFloat(s, 64); err == nil && v > 0 {
+				speed = v
+			}
+		}
+
+		session, err := getPTYSession(ctx, common.GetUserInfo(c), sessionID)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot get pty session, session id: [%s]", sessionID)
+			c.JSON(http.StatusNotFound, common.Err("session not found"))
+			return
+		}
+
+		file, err := os.Open(session.FilePath)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("cannot open pty recording, path: [%s]", session.FilePath)
+			c.JSON(http.StatusNotFound, common.Err("recording not found"))
+			return
+		}
+		defer file.Close()
+
+		upgrader := getUpgrader(c)
+		webConn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Infof("websocket connect error")
+			return
+		}
+		defer webConn.Close()
+
+		// 浏览器关闭时停止回放
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for {
+				if _, _, err := webConn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 0, 64*1024), replayMaxLine)
+
+		// 第一行为 header
+		if !scanner.Scan() {
+			return
+		}
+
+		lastAt := 0.0
+		for scanner.Scan() {
+			var event []any
+			if err := json.Unmarshal(scanner.Bytes(), &event); err != nil || len(event) != 3 {
+				continue
+			}
+			at, ok1 := event[0].(float64)
+			kind, ok2 := event[1].(string)
+			data, ok3 := event[2].(string)
+			if !ok1 || !ok2 || !ok3 || kind != asciicastEventOutput {
+				continue
+			}
+
+			wait := time.Duration((at - lastAt) / speed * float64(time.Second))
+			lastAt = at
+			if wait > replayMaxIdle {
+				wait = replayMaxIdle
+			}
+
+			select {
+			case <-closed:
+				return
+			case <-time.After(wait):
+			}
+
+			if err := webConn.WriteMessage(websocket.BinaryMessage, []byte(data)); err != nil {
+				logger.Logger(ctx).WithError(err).Warnf("failed to send replay data, session id: [%s]", sessionID)
+				return
+			}
+		}
+
+		webConn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "replay finished"))
+	}
+}
@@ -0,0 +1,166 @@
+package shell
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	asciicastVersion = 2
+
+	asciicastEventOutput = "o"
+	asciicastEventInput  = "i"
+	asciicastEventResize = "r"
+
+	defaultPTYWidth  = 80
+	defaultPTYHeight = 24
+)
+
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// ptyRecorder 将终端会话写入 asciicast v2 文件
+// 格式说明: https://docs.asciinema.org/manual/asciicast/v2/
+// 方法均可在 nil 上调用，未开启录像时不做任何事
+type ptyRecorder struct {
+	mu      sync.Mutex
+	file    *os.File
+	startAt time.Time
+	size    int64
+	closed  bool
+	// 不完整的 utf8 字节留到下一次写入，避免多字节字符被截断
+	pending map[string][]byte
+}
+
+func newPTYRecorder(path string, width, height int, title string) (*ptyRecorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	if err != nil {
+		return nil, err
+	}
+
+	if width <= 0 {
+		width = defaultPTYWidth
+	}
+	if height <= 0 {
+		height = defaultPTYHeight
+	}
+
+	r := &ptyRecorder{
+		file:    file,
+		startAt: time.Now(),
+		pending: map[string][]byte{},
+	}
+
+	header, err := json.Marshal(asciicastHeader{
+		Version:   asciicastVersion,
+		Width:     width,
+		Height:    height,
+		Timestamp: r.startAt.Unix(),
+		Title:     title,
+		Env:       map[string]string{"TERM": "xterm-256color"},
+	})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if err := r.writeLine(header); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *ptyRecorder) Output(data []byte) error {
+	return r.event(asciicastEventOutput, data)
+}
+
+func (r *ptyRecorder) Input(data []byte) error {
+	return r.event(asciicastEventInput, data)
+}
+
+func (r *ptyRecorder) Resize(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	return r.event(asciicastEventResize, []byte(fmt.Sprintf("%dx%d", width, height)))
+}
+
+// Close 结束录像，返回文件大小
+func (r *ptyRecorder) Close() (int64, error) {
+	if r == nil {
+		return 0, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return r.size, nil
+	}
+	r.closed = true
+	return r.size, r.file.Close()
+}
+
+func (r *ptyRecorder) event(kind string, data []byte) error {
+	if r == nil || len(data) == 0 {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return nil
+	}
+
+	buf := append(r.pending[kind], data...)
+	complete, rest := splitIncompleteUTF8(buf)
+	r.pending[kind] = append([]byte(nil), rest...)
+	if len(complete) == 0 {
+		return nil
+	}
+
+	elapsed := float64(time.Since(r.startAt).Microseconds()) / float64(time.Second/time.Microsecond)
+	line, err := json.Marshal([]any{elapsed, kind, string(complete)})
+	if err != nil {
+		return err
+	}
+	return r.writeLine(line)
+}
+
+func (r *ptyRecorder) writeLine(line []byte) error {
+	n, err := r.file.Write(append(line, '\n'))
+	r.size += int64(n)
+	return err
+}
+
+// splitIncompleteUTF8 把末尾未完整的 utf8 字符切分出来
+func splitIncompleteUTF8(b []byte) (complete, rest []byte) {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(b[i]) {
+			continue
+		}
+		if !utf8.FullRune(b[i:]) {
+			return b[:i], b[i:]
+		}
+		break
+	}
+	return b, nil
+}
@@ -0,0 +1,94 @@
+package shell
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitIncompleteUTF8(t *testing.T) {
+	zh := []byte("你好") // 每个字 3 字节
+
+	tests := []struct {
+		name         string
+		input        []byte
+		wantComplete []byte
+		wantRest     []byte
+	}{
+		{name: "ascii", input: []byte("abc"), wantComplete: []byte("abc")},
+		{name: "complete multibyte", input: zh, wantComplete: zh},
+		{name: "one byte of last rune", input: zh[:4], wantComplete: zh[:3], wantRest: zh[3:4]},
+		{name: "two bytes of last rune", input: zh[:5], wantComplete: zh[:3], wantRest: zh[3:5]},
+		{name: "only partial rune", input: zh[:1], wantComplete: []byte{}, wantRest: zh[:1]},
+		{name: "invalid byte is not held back", input: []byte{'a', 0xff}, wantComplete: []byte{'a', 0xff}},
+		{name: "empty", input: []byte{}, wantComplete: []byte{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			complete, rest := splitIncompleteUTF8(tt.input)
+			assert.Equal(t, string(tt.wantComplete), string(complete))
+			assert.Equal(t, string(tt.wantRest), string(rest))
+		})
+	}
+}
+
+func TestPTYRecorderUTF8SplitAcrossChunks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.cast")
+	r, err := newPTYRecorder(path, 0, 0, "test")
+	assert.NoError(t, err)
+
+	text := []byte("ls 你好世界\r\n")
+	// 逐字节写入，每个多字节字符都被切开
+	for i := range text {
+		assert.NoError(t, r.Output(text[i:i+1]))
+	}
+	// 输入和输出各自缓存未完成的字符，互不影响
+	assert.NoError(t, r.Input([]byte("好")[:2]))
+	assert.NoError(t, r.Output([]byte("!")))
+	assert.NoError(t, r.Input([]byte("好")[2:]))
+	assert.NoError(t, r.Resize(120, 40))
+
+	size, err := r.Close()
+	assert.NoError(t, err)
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, info.Size(), size)
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	assert.True(t, scanner.Scan())
+	header := asciicastHeader{}
+	assert.NoError(t, json.Unmarshal(scanner.Bytes(), &header))
+	assert.Equal(t, asciicastVersion, header.Version)
+	assert.Equal(t, defaultPTYWidth, header.Width)
+
+	var output, input strings.Builder
+	resized := false
+	for scanner.Scan() {
+		event := []any{}
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		assert.Len(t, event, 3)
+		data := event[2].(string)
+		assert.NotContains(t, data, "�", "multibyte rune must not be split into replacement chars")
+		switch event[1] {
+		case asciicastEventOutput:
+			output.WriteString(data)
+		case asciicastEventInput:
+			input.WriteString(data)
+		case asciicastEventResize:
+			resized = data == "120x40"
+		}
+	}
+	assert.Equal(t, string(text)+"!", output.String())
+	assert.Equal(t, "好", input.String())
+	assert.True(t, resized)
+}
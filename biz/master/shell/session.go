@@ -0,0 +1,73 @@
+package shell
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/google/uuid"
+)
+
+// startPTYRecording 创建会话记录和录像文件，未开启录像时返回 nil
+func startPTYRecording(ctx *app.Context, userInfo models.UserInfo, clientID, sessionID string, width, height int) (*models.PTYSession, *ptyRecorder, error) {
+	cfg := ctx.GetApp().GetConfig()
+	if !cfg.Master.PTYRecordEnable {
+		return nil, nil, nil
+	}
+
+	// session id 由 client 生成，作为文件名前需要校验
+	if _, err := uuid.Parse(sessionID); err != nil {
+		return nil, nil, fmt.Errorf("invalid session id: [%s]", sessionID)
+	}
+
+	session := &models.PTYSession{
+		SessionID: sessionID,
+		ClientID:  clientID,
+		StartedAt: time.Now(),
+		Width:     int32(width),
+		Height:    int32(height),
+		FilePath:  filepath.Join(cfg.Master.PTYRecordDir, sessionID+".cast"),
+	}
+	if userInfo != nil {
+		session.UserID = uint32(userInfo.GetUserID())
+		session.TenantID = uint32(userInfo.GetTenantID())
+		session.UserName = userInfo.GetUserName()
+	}
+
+	recorder, err := newPTYRecorder(session.FilePath, width, height, fmt.Sprintf("%s@%s", session.UserName, clientID))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("create pty recording failed, path: [%s]", session.FilePath)
+		return nil, nil, err
+	}
+
+	if err := dao.NewQuery(ctx).AdminCreatePTYSession(session); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("create pty session record failed, session id: [%s]", sessionID)
+		recorder.Close()
+		return nil, nil, err
+	}
+
+	logger.Logger(ctx).Infof("start recording pty session, session id: [%s], client id: [%s], user: [%s]",
+		sessionID, clientID, session.UserName)
+	return session, recorder, nil
+}
+
+func finishPTYRecording(ctx *app.Context, session *models.PTYSession, recorder *ptyRecorder) {
+	if session == nil {
+		return
+	}
+
+	size, err := recorder.Close()
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("close pty recording failed, session id: [%s]", session.SessionID)
+	}
+
+	session.EndedAt = time.Now()
+	session.Size = size
+	if err := dao.NewQuery(ctx).AdminUpdatePTYSession(session); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("update pty session record failed, session id: [%s]", session.SessionID)
+	}
+}
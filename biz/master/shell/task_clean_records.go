@@ -0,0 +1,63 @@
+package shell
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// CleanPTYRecords 删除超过保留天数的终端录像
+func CleanPTYRecords(appInstance app.Application) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	retentionDays := appInstance.GetConfig().Master.PTYRecordRetentionDays
+	if retentionDays <= 0 {
+		return nil
+	}
+
+	q := dao.NewQuery(ctx)
+	sessions, err := q.AdminListPTYSessionsBefore(time.Now().AddDate(0, 0, -retentionDays))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("CleanPTYRecords cannot list expired sessions")
+		return err
+	}
+
+	for _, session := range sessions {
+		if err := os.Remove(session.FilePath); err != nil && !os.IsNotExist(err) {
+			logger.Logger(ctx).WithError(err).Errorf("CleanPTYRecords cannot remove recording, path: [%s]", session.FilePath)
+		}
+	}
+
+	if err := q.AdminDeletePTYSessions(lo.Map(sessions, func(s *models.PTYSession, _ int) uint { return s.ID })); err != nil {
+		logger.Logger(ctx).WithError(err).Error("CleanPTYRecords cannot delete expired sessions")
+		return err
+	}
+
+	logger.Logger(ctx).Infof("CleanPTYRecords success, removed [%d] sessions", len(sessions))
+	return nil
+}
+
+// CleanExecRecords 删除超过保留天数的命令执行记录，与终端录像使用同一个保留天数
+func CleanExecRecords(appInstance app.Application) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	retentionDays := appInstance.GetConfig().Master.PTYRecordRetentionDays
+	if retentionDays <= 0 {
+		return nil
+	}
+
+	count, err := dao.NewQuery(ctx).AdminDeleteExecRecordsBefore(time.Now().AddDate(0, 0, -retentionDays))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("CleanExecRecords cannot delete expired records")
+		return err
+	}
+
+	logger.Logger(ctx).Infof("CleanExecRecords success, removed [%d] records", count)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 
 	"github.com/VaalaCat/frp-panel/biz/master/client"
@@ -12,14 +13,23 @@ import (
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 const (
 	CacheBufSize = 4096
 )
 
+type logSubscriber struct {
+	ch   chan string
+	pkgs map[string]bool // 为空时接收全部日志
+}
+
+// ClientLogManager 把节点推送的日志分发给所有正在查看的用户
 type ClientLogManager struct {
-	*utils.SyncMap[string, chan string]
+	lock           *sync.RWMutex
+	subscribers    map[string]map[string]*logSubscriber // clientID -> subID
 	clientLocksMap *utils.SyncMap[string, *sync.Mutex]
 }
 
@@ -28,9 +38,86 @@ func (c *ClientLogManager) GetClientLock(clientId string) *sync.Mutex {
 	return lock
 }
 
+// Subscribe 返回订阅 id、日志 channel 和该节点当前的订阅者数量
+func (c *ClientLogManager) Subscribe(clientId string, pkgs []string) (string, <-chan string, int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	sub := &logSubscriber{
+		ch: make(chan string, CacheBufSize),
+		pkgs: lo.SliceToMap(lo.Compact(pkgs), func(p string) (string, bool) {
+			return p, true
+		}),
+	}
+	subId := uuid.New().String()
+
+	if _, ok := c.subscribers[clientId]; !ok {
+		c.subscribers[clientId] = map[string]*logSubscriber{}
+	}
+	c.subscribers[clientId][subId] = sub
+	return subId, sub.ch, len(c.subscribers[clientId])
+}
+
+// Unsubscribe 关闭订阅者的 channel，返回该节点剩余的订阅者数量
+func (c *ClientLogManager) Unsubscribe(clientId string, subId string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	subs := c.subscribers[clientId]
+	if sub, ok := subs[subId]; ok {
+		close(sub.ch)
+		delete(subs, subId)
+	}
+	if len(subs) == 0 {
+		delete(c.subscribers, clientId)
+	}
+	return len(subs)
+}
+
+// Publish 没有订阅者时返回 false，pkg 为 nil 表示节点未上报所属包，此时不做过滤
+// 订阅者处理不过来时丢弃日志，避免拖慢其他订阅者
+func (c *ClientLogManager) Publish(clientId string, pkg *string, log string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	subs, ok := c.subscribers[clientId]
+	if !ok || len(subs) == 0 {
+		return false
+	}
+
+	for _, sub := range subs {
+		if pkg != nil && len(sub.pkgs) > 0 && !sub.pkgs[*pkg] {
+			continue
+		}
+		select {
+		case sub.ch <- log:
+		default:
+		}
+	}
+	return true
+}
+
+// SubscribedPkgs 返回所有订阅者 pkgs 的并集，为空表示需要全部日志
+func (c *ClientLogManager) SubscribedPkgs(clientId string) []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	pkgs := []string{}
+	for _, sub := range c.subscribers[clientId] {
+		if len(sub.pkgs) == 0 {
+			return []string{}
+		}
+		pkgs = append(pkgs, lo.Keys(sub.pkgs)...)
+	}
+	pkgs = lo.Uniq(pkgs)
+	sort.Strings(pkgs)
+	return pkgs
+}
+
 func NewClientLogManager() *ClientLogManager {
 	return &ClientLogManager{
-		SyncMap:        &utils.SyncMap[string, chan string]{},
+		lock:           &sync.RWMutex{},
+		subscribers:    map[string]map[string]*logSubscriber{},
 		clientLocksMap: &utils.SyncMap[string, *sync.Mutex]{},
 	}
 }
@@ -52,12 +139,9 @@ func PushClientStreamLog(ctx *app.Context, sender pb.Master_PushClientStreamLogS
 			return err
 		}
 
-		ch, ok := ctx.GetApp().GetClientLogManager().Load(req.GetBase().GetClientId())
-		if !ok {
+		if !ctx.GetApp().GetClientLogManager().Publish(req.GetBase().GetClientId(), req.Pkg, string(req.GetLog())) {
 			return fmt.Errorf("push client stream log cannot find client, id: [%s]", req.GetBase().GetClientId())
 		}
-
-		ch <- string(req.GetLog())
 	}
 	return nil
 }
@@ -79,11 +163,9 @@ func PushServerStreamLog(ctx *app.Context, sender pb.Master_PushServerStreamLogS
 			return err
 		}
 
-		ch, ok := ctx.GetApp().GetClientLogManager().Load(req.GetBase().GetServerId())
-		if !ok {
+		if !ctx.GetApp().GetClientLogManager().Publish(req.GetBase().GetServerId(), req.Pkg, string(req.GetLog())) {
 			return fmt.Errorf("push server stream log cannot find server, id: [%s]", req.GetBase().GetServerId())
 		}
-		ch <- string(req.GetLog())
 	}
 	return nil
 }
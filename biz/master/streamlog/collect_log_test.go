@@ -0,0 +1,71 @@
+package streamlog
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+// drain 取出 channel 中已有的日志，不阻塞
+func drain(ch <-chan string) []string {
+	logs := []string{}
+	for {
+		select {
+		case log, ok := <-ch:
+			if !ok {
+				return logs
+			}
+			logs = append(logs, log)
+		default:
+			return logs
+		}
+	}
+}
+
+func TestClientLogManagerSubscribe(t *testing.T) {
+	mgr := NewClientLogManager()
+
+	assert.False(t, mgr.Publish("c1", nil, "nobody is watching"))
+
+	frpcID, frpcCh, total := mgr.Subscribe("c1", []string{"frpc", ""})
+	assert.Equal(t, 1, total)
+	workerID, workerCh, total := mgr.Subscribe("c1", []string{"workerd", "frpc"})
+	assert.Equal(t, 2, total)
+	_, otherCh, total := mgr.Subscribe("c2", nil)
+	assert.Equal(t, 1, total)
+
+	assert.True(t, mgr.Publish("c1", lo.ToPtr("frpc"), "frpc log"))
+	assert.True(t, mgr.Publish("c1", lo.ToPtr("workerd"), "workerd log"))
+	// 节点未上报 pkg 时所有订阅者都能收到
+	assert.True(t, mgr.Publish("c1", nil, "legacy log"))
+
+	assert.Equal(t, []string{"frpc log", "legacy log"}, drain(frpcCh))
+	assert.Equal(t, []string{"frpc log", "workerd log", "legacy log"}, drain(workerCh))
+	assert.Empty(t, drain(otherCh))
+
+	assert.Equal(t, 1, mgr.Unsubscribe("c1", frpcID))
+	_, ok := <-frpcCh
+	assert.False(t, ok, "channel should be closed after unsubscribe")
+	// 重复取消订阅不会 panic
+	assert.Equal(t, 1, mgr.Unsubscribe("c1", frpcID))
+
+	assert.Equal(t, 0, mgr.Unsubscribe("c1", workerID))
+	assert.False(t, mgr.Publish("c1", nil, "nobody is watching"))
+}
+
+func TestClientLogManagerSubscribedPkgs(t *testing.T) {
+	mgr := NewClientLogManager()
+	assert.Empty(t, mgr.SubscribedPkgs("c1"))
+
+	mgr.Subscribe("c1", []string{"workerd", "frpc"})
+	mgr.Subscribe("c1", []string{"frpc", "frp-panel"})
+	assert.Equal(t, []string{"frp-panel", "frpc", "workerd"}, mgr.SubscribedPkgs("c1"))
+
+	// 任意订阅者需要全部日志时返回空
+	allID, _, _ := mgr.Subscribe("c1", nil)
+	assert.Empty(t, mgr.SubscribedPkgs("c1"))
+
+	mgr.Unsubscribe("c1", allID)
+	assert.Equal(t, []string{"frp-panel", "frpc", "workerd"}, mgr.SubscribedPkgs("c1"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/VaalaCat/frp-panel/common"
@@ -38,25 +39,41 @@ func getLogHander(c *gin.Context, appInstance app.Application) {
 		}
 	}
 
-	appInstance.GetClientLogManager().GetClientLock(id).Lock()
-	defer appInstance.GetClientLogManager().GetClientLock(id).Unlock()
+	var (
+		clientLogMgr = appInstance.GetClientLogManager()
+		clientLock   = clientLogMgr.GetClientLock(id)
+	)
 
-	ch := make(chan string, CacheBufSize)
-	if oldCh, ok := appInstance.GetClientLogManager().LoadAndDelete(id); ok {
-		close(oldCh)
-	}
-	appInstance.GetClientLogManager().Store(id, ch)
-
-	_, err := rpc.CallClient(app.NewContext(c, appInstance), id, pb.Event_EVENT_START_STREAM_LOG, &pb.StartSteamLogRequest{Pkgs: pkgs})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, common.Err(err.Error()))
-		return
+	// 订阅者变化时按 pkgs 的并集重新开启节点的日志流
+	clientLock.Lock()
+	oldPkgs := clientLogMgr.SubscribedPkgs(id)
+	subId, ch, total := clientLogMgr.Subscribe(id, pkgs)
+	newPkgs := clientLogMgr.SubscribedPkgs(id)
+	if total == 1 || !slices.Equal(oldPkgs, newPkgs) {
+		_, err := rpc.CallClient(app.NewContext(c, appInstance), id, pb.Event_EVENT_START_STREAM_LOG, &pb.StartSteamLogRequest{Pkgs: newPkgs})
+		if err != nil {
+			clientLogMgr.Unsubscribe(id, subId)
+			clientLock.Unlock()
+			c.JSON(http.StatusInternalServerError, common.Err(err.Error()))
+			return
+		}
 	}
+	clientLock.Unlock()
 
 	defer func() {
-		appInstance.GetClientLogManager().Delete(id)
-		close(ch)
-		rpc.CallClient(app.NewContext(context.Background(), appInstance), id, pb.Event_EVENT_STOP_STREAM_LOG, &pb.CommonRequest{})
+		clientLock.Lock()
+		defer clientLock.Unlock()
+
+		oldPkgs := clientLogMgr.SubscribedPkgs(id)
+		remain := clientLogMgr.Unsubscribe(id, subId)
+		ctx := app.NewContext(context.Background(), appInstance)
+		if remain == 0 {
+			rpc.CallClient(ctx, id, pb.Event_EVENT_STOP_STREAM_LOG, &pb.CommonRequest{})
+			return
+		}
+		if newPkgs := clientLogMgr.SubscribedPkgs(id); !slices.Equal(oldPkgs, newPkgs) {
+			rpc.CallClient(ctx, id, pb.Event_EVENT_START_STREAM_LOG, &pb.StartSteamLogRequest{Pkgs: newPkgs})
+		}
 	}()
 
 	c.Writer.Header().Set("Content-Type", "text/event-stream")
@@ -0,0 +1,42 @@
+package streamlog
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// QueryLogs 查询 client 或 server 落盘的历史日志
+func QueryLogs(ctx *app.Context, req *pb.QueryLogsRequest) (*pb.QueryLogsResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	clientID := req.GetClientId()
+	if len(clientID) == 0 {
+		return nil, fmt.Errorf("client id is required")
+	}
+
+	if !userInfo.IsAdmin() {
+		q := dao.NewQuery(ctx)
+		if _, err := q.GetClientByClientID(userInfo, clientID); err != nil {
+			if _, err := q.GetServerByServerID(userInfo, clientID); err != nil {
+				return nil, fmt.Errorf("cannot find client or server [%s]", clientID)
+			}
+		}
+	}
+
+	resp := &pb.QueryLogsResponse{}
+	if err := rpc.CallClientWrapper(ctx, clientID, pb.Event_EVENT_QUERY_LOGS, req, resp); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("query logs on client [%s] failed", clientID)
+		return nil, err
+	}
+
+	return resp, nil
+}
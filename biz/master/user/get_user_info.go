@@ -14,13 +14,14 @@ func GetUserInfoHandler(c *app.Context, req *pb.GetUserInfoRequest) (*pb.GetUser
 	return &pb.GetUserInfoResponse{
 		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
 		UserInfo: &pb.User{
-			UserID:   lo.ToPtr(int64(userInfo.GetUserID())),
-			TenantID: lo.ToPtr(int64(userInfo.GetTenantID())),
-			UserName: lo.ToPtr(userInfo.GetUserName()),
-			Email:    lo.ToPtr(userInfo.GetEmail()),
-			Status:   lo.ToPtr(fmt.Sprint(userInfo.GetStatus())),
-			Role:     lo.ToPtr(userInfo.GetRole()),
-			Token:    lo.ToPtr(userInfo.GetToken()),
+			UserID:      lo.ToPtr(int64(userInfo.GetUserID())),
+			TenantID:    lo.ToPtr(int64(userInfo.GetTenantID())),
+			UserName:    lo.ToPtr(userInfo.GetUserName()),
+			Email:       lo.ToPtr(userInfo.GetEmail()),
+			Status:      lo.ToPtr(fmt.Sprint(userInfo.GetStatus())),
+			Role:        lo.ToPtr(userInfo.GetRole()),
+			Token:       lo.ToPtr(userInfo.GetToken()),
+			PTYDisabled: lo.ToPtr(userInfo.GetPTYDisabled()),
 		},
 	}, nil
 }
@@ -0,0 +1,104 @@
+package visitor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/biz/master/proxy"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"gorm.io/gorm"
+)
+
+func CreateVisitorConfig(c *app.Context, req *pb.CreateVisitorConfigRequest) (*pb.CreateVisitorConfigResponse, error) {
+	if len(req.GetClientId()) == 0 || len(req.GetServerId()) == 0 || len(req.GetConfig()) == 0 {
+		return nil, fmt.Errorf("request invalid")
+	}
+
+	var (
+		userInfo = common.GetUserInfo(c)
+		clientID = req.GetClientId()
+		serverID = req.GetServerId()
+	)
+
+	clientEntity, err := proxy.GetClientWithMakeShadow(c, clientID, serverID)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get client, id: [%s]", clientID)
+		return nil, err
+	}
+
+	if _, err := dao.NewQuery(c).GetServerByServerID(userInfo, serverID); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get server, id: [%s]", serverID)
+		return nil, err
+	}
+
+	typedVisitorCfg, err := loadVisitorFromContent(c, req.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CreateVisitorConfigWithTypedConfig(c, CreateVisitorConfigWithTypedConfigParam{
+		ClientID:     clientID,
+		ServerID:     serverID,
+		VisitorCfg:   typedVisitorCfg,
+		ClientEntity: clientEntity,
+		Overwrite:    req.GetOverwrite(),
+	}); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot create visitor config")
+		return nil, err
+	}
+
+	return &pb.CreateVisitorConfigResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
+
+type CreateVisitorConfigWithTypedConfigParam struct {
+	ClientID     string
+	ServerID     string
+	VisitorCfg   v1.TypedVisitorConfig
+	ClientEntity *models.ClientEntity
+	Overwrite    bool
+}
+
+func CreateVisitorConfigWithTypedConfig(c *app.Context, param CreateVisitorConfigWithTypedConfigParam) error {
+	var (
+		userInfo    = common.GetUserInfo(c)
+		clientID    = param.ClientID
+		visitorName = param.VisitorCfg.GetBaseConfig().Name
+	)
+
+	existedVisitorCfg, err := dao.NewQuery(c).GetVisitorConfigByOriginClientIDAndName(userInfo, clientID, visitorName)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Logger(c).WithError(err).Errorf("cannot get visitor config, id: [%s]", clientID)
+		return err
+	}
+
+	if !param.Overwrite && err == nil {
+		logger.Logger(c).Errorf("visitor config already exist, client: [%s], name: [%s]", clientID, visitorName)
+		return fmt.Errorf("visitor config already exist")
+	}
+
+	if err := updateClientVisitors(c, param.ClientEntity, param.ServerID, upsertVisitor(param.VisitorCfg)); err != nil {
+		return err
+	}
+
+	if existedVisitorCfg != nil && existedVisitorCfg.ServerID != param.ServerID {
+		logger.Logger(c).Warnf("client and server not match, delete old visitor, client: [%s], server: [%s], visitor: [%s]", clientID, param.ServerID, visitorName)
+		if _, err := DeleteVisitorConfig(c, &pb.DeleteVisitorConfigRequest{
+			ClientId: &existedVisitorCfg.ClientID,
+			ServerId: &existedVisitorCfg.ServerID,
+			Name:     &visitorName,
+		}); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot delete old visitor, client: [%s], server: [%s], visitor: [%s]", existedVisitorCfg.ClientID, existedVisitorCfg.ServerID, visitorName)
+			return err
+		}
+	}
+
+	return nil
+}
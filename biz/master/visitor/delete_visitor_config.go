@@ -0,0 +1,48 @@
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func DeleteVisitorConfig(c *app.Context, req *pb.DeleteVisitorConfigRequest) (*pb.DeleteVisitorConfigResponse, error) {
+	var (
+		userInfo    = common.GetUserInfo(c)
+		clientID    = req.GetClientId()
+		serverID    = req.GetServerId()
+		visitorName = req.GetName()
+	)
+
+	if len(clientID) == 0 || len(serverID) == 0 || len(visitorName) == 0 {
+		return nil, fmt.Errorf("request invalid")
+	}
+
+	cli, err := dao.NewQuery(c).GetClientByClientID(userInfo, clientID)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get client, id: [%s]", clientID)
+		return nil, err
+	}
+	if cli.ServerID != serverID {
+		return nil, fmt.Errorf("client and server not match")
+	}
+
+	if err := updateClientVisitors(c, cli.ClientEntity, serverID, removeVisitor(visitorName)); err != nil {
+		return nil, err
+	}
+
+	if err := dao.NewQuery(c).DeleteVisitorConfig(userInfo, clientID, visitorName); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot delete visitor config, id: [%s]", clientID)
+		return nil, err
+	}
+
+	logger.Logger(c).Infof("delete visitor config, id: [%s], name: [%s]", clientID, visitorName)
+
+	return &pb.DeleteVisitorConfigResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
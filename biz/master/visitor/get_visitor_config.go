@@ -0,0 +1,34 @@
+package visitor
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func GetVisitorConfig(c *app.Context, req *pb.GetVisitorConfigRequest) (*pb.GetVisitorConfigResponse, error) {
+	var (
+		userInfo    = common.GetUserInfo(c)
+		clientID    = req.GetClientId()
+		serverID    = req.GetServerId()
+		visitorName = req.GetName()
+	)
+
+	visitorCfg, err := dao.NewQuery(c).GetVisitorConfigByFilter(userInfo, &models.VisitorConfigEntity{
+		ClientID: clientID,
+		ServerID: serverID,
+		Name:     visitorName,
+	})
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get visitor config, client: [%s], server: [%s], visitor name: [%s]", clientID, serverID, visitorName)
+		return nil, err
+	}
+
+	return &pb.GetVisitorConfigResponse{
+		Status:        &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"},
+		VisitorConfig: visitorCfg.ToPB(),
+	}, nil
+}
@@ -0,0 +1,167 @@
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/biz/master/proxy"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+)
+
+// GrantVisitorAccess 让 visitor client 可以访问 proxy client 上的 stcp/xtcp/sudp proxy
+// proxy 没有 secretKey 时会生成一个并下发，visitor 使用相同的 secretKey 和 serverName
+func GrantVisitorAccess(c *app.Context, req *pb.GrantVisitorAccessRequest) (*pb.GrantVisitorAccessResponse, error) {
+	if len(req.GetClientId()) == 0 || len(req.GetServerId()) == 0 || len(req.GetProxyName()) == 0 ||
+		len(req.GetVisitorClientId()) == 0 || req.GetBindPort() == 0 {
+		return nil, fmt.Errorf("request invalid")
+	}
+
+	var (
+		userInfo        = common.GetUserInfo(c)
+		clientID        = req.GetClientId()
+		serverID        = req.GetServerId()
+		proxyName       = req.GetProxyName()
+		visitorClientID = req.GetVisitorClientId()
+		visitorName     = lo.Ternary(len(req.GetVisitorName()) > 0, req.GetVisitorName(), proxyName+"-visitor")
+	)
+
+	proxyCfg, err := dao.NewQuery(c).GetProxyConfigByFilter(userInfo, &models.ProxyConfigEntity{
+		ClientID: clientID,
+		ServerID: serverID,
+		Name:     proxyName,
+	})
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get proxy config, client: [%s], server: [%s], proxy name: [%s]", clientID, serverID, proxyName)
+		return nil, err
+	}
+
+	typedProxyCfg, err := proxyCfg.GetTypedProxyConfig()
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get typed proxy config, proxy name: [%s]", proxyName)
+		return nil, err
+	}
+
+	secretKey, err := proxySecretKey(typedProxyCfg)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("proxy cannot be visited, proxy name: [%s]", proxyName)
+		return nil, err
+	}
+
+	// 1. proxy 没有 secretKey 时生成并更新 proxy
+	if len(*secretKey) == 0 {
+		*secretKey = utils.GenerateUUIDWithoutSeperator()
+		if err := saveProxySecretKey(c, proxyCfg, typedProxyCfg); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. 在 visitor client 上创建同类型的 visitor
+	visitorClient, err := proxy.GetClientWithMakeShadow(c, visitorClientID, serverID)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get visitor client, id: [%s]", visitorClientID)
+		return nil, err
+	}
+
+	visitorConfigurer := v1.NewVisitorConfigurerByType(v1.VisitorType(proxyCfg.Type))
+	if visitorConfigurer == nil {
+		return nil, fmt.Errorf("unknown visitor type: [%s]", proxyCfg.Type)
+	}
+	base := visitorConfigurer.GetBaseConfig()
+	base.Name = visitorName
+	base.Type = proxyCfg.Type
+	base.SecretKey = *secretKey
+	base.ServerName = proxyName
+	base.BindAddr = req.GetBindAddr()
+	base.BindPort = int(req.GetBindPort())
+
+	typedVisitorCfg := v1.TypedVisitorConfig{Type: proxyCfg.Type, VisitorConfigurer: visitorConfigurer}
+	if err := validateVisitor(typedVisitorCfg); err != nil {
+		logger.Logger(c).WithError(err).Errorf("invalid visitor config")
+		return nil, err
+	}
+
+	if err := CreateVisitorConfigWithTypedConfig(c, CreateVisitorConfigWithTypedConfigParam{
+		ClientID:     visitorClientID,
+		ServerID:     serverID,
+		VisitorCfg:   typedVisitorCfg,
+		ClientEntity: visitorClient,
+		Overwrite:    true,
+	}); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot create visitor config, client: [%s], visitor name: [%s]", visitorClientID, visitorName)
+		return nil, err
+	}
+
+	visitorCfg, err := dao.NewQuery(c).GetVisitorConfigByFilter(userInfo, &models.VisitorConfigEntity{
+		ClientID: visitorClient.ClientID,
+		ServerID: serverID,
+		Name:     visitorName,
+	})
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get visitor config, client: [%s], visitor name: [%s]", visitorClient.ClientID, visitorName)
+		return nil, err
+	}
+
+	logger.Logger(c).Infof("grant visitor access, proxy: [%s/%s], visitor: [%s/%s]", clientID, proxyName, visitorClient.ClientID, visitorName)
+
+	return &pb.GrantVisitorAccessResponse{
+		Status:        &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		VisitorConfig: visitorCfg.ToPB(),
+	}, nil
+}
+
+// proxySecretKey 返回 proxy 配置中 secretKey 字段的指针，只有 stcp/xtcp/sudp 支持
+func proxySecretKey(cfg v1.TypedProxyConfig) (*string, error) {
+	switch p := cfg.ProxyConfigurer.(type) {
+	case *v1.STCPProxyConfig:
+		return &p.Secretkey, nil
+	case *v1.XTCPProxyConfig:
+		return &p.Secretkey, nil
+	case *v1.SUDPProxyConfig:
+		return &p.Secretkey, nil
+	default:
+		return nil, fmt.Errorf("proxy type [%s] does not support visitor", cfg.Type)
+	}
+}
+
+func saveProxySecretKey(c *app.Context, proxyCfg *models.ProxyConfig, typedProxyCfg v1.TypedProxyConfig) error {
+	userInfo := common.GetUserInfo(c)
+
+	// 停止的 proxy 不在 client 配置中，只更新记录
+	if proxyCfg.Stopped {
+		if err := proxyCfg.FillTypedProxyConfig(typedProxyCfg); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot fill typed proxy config")
+			return err
+		}
+		if err := dao.NewQuery(c).UpdateProxyConfig(userInfo, proxyCfg); err != nil {
+			logger.Logger(c).WithError(err).Errorf("cannot update proxy config, proxy name: [%s]", proxyCfg.Name)
+			return err
+		}
+		return nil
+	}
+
+	originClientID := lo.Ternary(len(proxyCfg.OriginClientID) > 0, proxyCfg.OriginClientID, proxyCfg.ClientID)
+	proxyClient, err := proxy.GetClientWithMakeShadow(c, originClientID, proxyCfg.ServerID)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get client, id: [%s]", originClientID)
+		return err
+	}
+
+	if err := proxy.CreateProxyConfigWithTypedConfig(c, proxy.CreateProxyConfigWithTypedConfigParam{
+		ClientID:     originClientID,
+		ServerID:     proxyCfg.ServerID,
+		ProxyCfg:     typedProxyCfg,
+		ClientEntity: proxyClient,
+		Overwrite:    true,
+	}); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot update proxy secret key, proxy name: [%s]", proxyCfg.Name)
+		return err
+	}
+	return nil
+}
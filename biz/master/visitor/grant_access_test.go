@@ -0,0 +1,129 @@
+package visitor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/dao/daotest"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGrantTestContext(t *testing.T) *app.Context {
+	t.Helper()
+
+	appInstance, _ := daotest.NewApp(t)
+	q := dao.NewQuery(app.NewContext(context.Background(), appInstance))
+
+	assert.NoError(t, q.CreateUser(&models.UserEntity{UserID: 2, UserName: "u", Email: "u@example.com", TenantID: 1, Token: "token"}))
+	userInfo, err := q.GetUserByUserID(2)
+	assert.NoError(t, err)
+
+	srvCfg, err := json.Marshal(v1.ServerConfig{BindPort: 7000})
+	assert.NoError(t, err)
+	assert.NoError(t, q.CreateServer(userInfo, &models.ServerEntity{ServerID: "s1", ServerIP: "127.0.0.1", ConfigContent: srvCfg}))
+	// client 已停止，更新配置时不会通知 client
+	assert.NoError(t, q.CreateClient(userInfo, &models.ClientEntity{ClientID: "visitor", ConfigContent: []byte("{}"), Stopped: true}))
+
+	return app.NewContext(context.WithValue(context.Background(), defs.UserInfoKey, userInfo), appInstance)
+}
+
+// createStoppedProxy 停止的 proxy 不在 client 配置中，生成 secretKey 时只更新记录
+func createStoppedProxy(t *testing.T, ctx *app.Context, cfg v1.ProxyConfigurer) {
+	t.Helper()
+
+	base := cfg.GetBaseConfig()
+	proxyCfg := &models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{ClientID: "c1", ServerID: "s1", Stopped: true}}
+	assert.NoError(t, proxyCfg.FillTypedProxyConfig(v1.TypedProxyConfig{Type: base.Type, ProxyConfigurer: cfg}))
+	assert.NoError(t, dao.NewQuery(ctx).CreateProxyConfig(ctx.Value(defs.UserInfoKey).(models.UserInfo), proxyCfg.ProxyConfigEntity))
+}
+
+func grantRequest(proxyName string) *pb.GrantVisitorAccessRequest {
+	return &pb.GrantVisitorAccessRequest{
+		ClientId:        lo.ToPtr("c1"),
+		ServerId:        lo.ToPtr("s1"),
+		ProxyName:       lo.ToPtr(proxyName),
+		VisitorClientId: lo.ToPtr("visitor"),
+		BindPort:        lo.ToPtr(int32(6000)),
+	}
+}
+
+func proxySecret(t *testing.T, ctx *app.Context, name string) string {
+	t.Helper()
+
+	proxyCfg, err := dao.NewQuery(ctx).GetProxyConfigByFilter(ctx.Value(defs.UserInfoKey).(models.UserInfo),
+		&models.ProxyConfigEntity{ClientID: "c1", ServerID: "s1", Name: name})
+	assert.NoError(t, err)
+	typedCfg, err := proxyCfg.GetTypedProxyConfig()
+	assert.NoError(t, err)
+	secretKey, err := proxySecretKey(typedCfg)
+	assert.NoError(t, err)
+	return *secretKey
+}
+
+func visitorSecret(t *testing.T, resp *pb.GrantVisitorAccessResponse) (string, string) {
+	t.Helper()
+
+	var visitorCfg v1.TypedVisitorConfig
+	assert.NoError(t, visitorCfg.UnmarshalJSON([]byte(resp.GetVisitorConfig().GetConfig())))
+	return visitorCfg.GetBaseConfig().SecretKey, visitorCfg.GetBaseConfig().ServerName
+}
+
+func TestGrantVisitorAccessGeneratesSecretKey(t *testing.T) {
+	ctx := newGrantTestContext(t)
+	createStoppedProxy(t, ctx, &v1.STCPProxyConfig{
+		ProxyBaseConfig: v1.ProxyBaseConfig{Name: "ssh", Type: string(v1.ProxyTypeSTCP),
+			ProxyBackend: v1.ProxyBackend{LocalIP: "127.0.0.1", LocalPort: 22}},
+	})
+
+	resp, err := GrantVisitorAccess(ctx, grantRequest("ssh"))
+	assert.NoError(t, err)
+
+	generated := proxySecret(t, ctx, "ssh")
+	assert.Len(t, generated, 32)
+	secretKey, serverName := visitorSecret(t, resp)
+	assert.Equal(t, generated, secretKey)
+	assert.Equal(t, "ssh", serverName)
+	assert.Equal(t, "ssh-visitor", resp.GetVisitorConfig().GetName())
+
+	// 再次授权复用已经生成的 secretKey
+	resp, err = GrantVisitorAccess(ctx, grantRequest("ssh"))
+	assert.NoError(t, err)
+	assert.Equal(t, generated, proxySecret(t, ctx, "ssh"))
+	secretKey, _ = visitorSecret(t, resp)
+	assert.Equal(t, generated, secretKey)
+}
+
+func TestGrantVisitorAccessKeepsExistingSecretKey(t *testing.T) {
+	ctx := newGrantTestContext(t)
+	createStoppedProxy(t, ctx, &v1.XTCPProxyConfig{
+		ProxyBaseConfig: v1.ProxyBaseConfig{Name: "p2p", Type: string(v1.ProxyTypeXTCP),
+			ProxyBackend: v1.ProxyBackend{LocalIP: "127.0.0.1", LocalPort: 22}},
+		Secretkey: "existing",
+	})
+
+	resp, err := GrantVisitorAccess(ctx, grantRequest("p2p"))
+	assert.NoError(t, err)
+	assert.Equal(t, "existing", proxySecret(t, ctx, "p2p"))
+	secretKey, _ := visitorSecret(t, resp)
+	assert.Equal(t, "existing", secretKey)
+}
+
+func TestGrantVisitorAccessRejectsUnsupportedProxy(t *testing.T) {
+	ctx := newGrantTestContext(t)
+	createStoppedProxy(t, ctx, &v1.TCPProxyConfig{
+		ProxyBaseConfig: v1.ProxyBaseConfig{Name: "web", Type: string(v1.ProxyTypeTCP),
+			ProxyBackend: v1.ProxyBackend{LocalIP: "127.0.0.1", LocalPort: 80}},
+		RemotePort: 8080,
+	})
+
+	_, err := GrantVisitorAccess(ctx, grantRequest("web"))
+	assert.ErrorContains(t, err, "does not support visitor")
+}
@@ -0,0 +1,102 @@
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+)
+
+// loadVisitorFromContent 从请求中解析唯一的 visitor 配置
+func loadVisitorFromContent(c *app.Context, content []byte) (v1.TypedVisitorConfig, error) {
+	typedVisitorCfgs, err := utils.LoadVisitorsFromContent(content)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot load visitors from content")
+		return v1.TypedVisitorConfig{}, err
+	}
+	if len(typedVisitorCfgs) != 1 {
+		logger.Logger(c).Errorf("invalid config, cfg len: [%d]", len(typedVisitorCfgs))
+		return v1.TypedVisitorConfig{}, fmt.Errorf("invalid config")
+	}
+
+	if err := validateVisitor(typedVisitorCfgs[0]); err != nil {
+		logger.Logger(c).WithError(err).Errorf("invalid visitor config")
+		return v1.TypedVisitorConfig{}, err
+	}
+	return typedVisitorCfgs[0], nil
+}
+
+func validateVisitor(cfg v1.TypedVisitorConfig) error {
+	if cfg.VisitorConfigurer == nil {
+		return fmt.Errorf("unknown visitor type")
+	}
+
+	base := cfg.GetBaseConfig()
+	if !lo.Contains([]v1.VisitorType{v1.VisitorTypeSTCP, v1.VisitorTypeXTCP, v1.VisitorTypeSUDP}, v1.VisitorType(base.Type)) {
+		return fmt.Errorf("unknown visitor type: [%s]", base.Type)
+	}
+	if len(base.Name) == 0 {
+		return fmt.Errorf("visitor name is required")
+	}
+	if len(base.ServerName) == 0 {
+		return fmt.Errorf("visitor server name is required")
+	}
+	if base.BindPort == 0 {
+		return fmt.Errorf("visitor bind port is required")
+	}
+	return nil
+}
+
+// updateClientVisitors 修改 client 配置中的 visitors 并下发到 client
+func updateClientVisitors(c *app.Context, clientEntity *models.ClientEntity, serverID string,
+	modify func(visitors []v1.TypedVisitorConfig) []v1.TypedVisitorConfig) error {
+	oldCfg, err := clientEntity.GetConfigContent()
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get client config, id: [%s]", clientEntity.ClientID)
+		return err
+	}
+
+	oldCfg.Visitors = modify(oldCfg.Visitors)
+	if err := clientEntity.SetConfigContent(*oldCfg); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot set client config, id: [%s]", clientEntity.ClientID)
+		return err
+	}
+
+	rawCfg, err := clientEntity.MarshalJSONConfig()
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot marshal client config, id: [%s]", clientEntity.ClientID)
+		return err
+	}
+
+	if _, err := client.UpdateFrpcHander(c, &pb.UpdateFRPCRequest{
+		ClientId: &clientEntity.ClientID,
+		ServerId: &serverID,
+		Config:   rawCfg,
+		Comment:  &clientEntity.Comment,
+		FrpsUrl:  &clientEntity.FrpsUrl,
+	}); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot update frpc, id: [%s]", clientEntity.ClientID)
+		return err
+	}
+	return nil
+}
+
+func removeVisitor(name string) func(visitors []v1.TypedVisitorConfig) []v1.TypedVisitorConfig {
+	return func(visitors []v1.TypedVisitorConfig) []v1.TypedVisitorConfig {
+		return lo.Filter(visitors, func(v v1.TypedVisitorConfig, _ int) bool {
+			return v.GetBaseConfig().Name != name
+		})
+	}
+}
+
+func upsertVisitor(cfg v1.TypedVisitorConfig) func(visitors []v1.TypedVisitorConfig) []v1.TypedVisitorConfig {
+	return func(visitors []v1.TypedVisitorConfig) []v1.TypedVisitorConfig {
+		return append(removeVisitor(cfg.GetBaseConfig().Name)(visitors), cfg)
+	}
+}
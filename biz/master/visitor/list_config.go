@@ -0,0 +1,58 @@
+package visitor
+
+import (
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/samber/lo"
+)
+
+func ListVisitorConfigs(ctx *app.Context, req *pb.ListVisitorConfigsRequest) (*pb.ListVisitorConfigsResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+	)
+
+	if !userInfo.Valid() {
+		return &pb.ListVisitorConfigsResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: "invalid user"},
+		}, nil
+	}
+
+	var (
+		page           = int(req.GetPage())
+		pageSize       = int(req.GetPageSize())
+		keyword        = req.GetKeyword()
+		hasKeyword     = len(keyword) > 0
+		visitorConfigs []*models.VisitorConfig
+		visitorCounts  int64
+		err            error
+		filter         = &models.VisitorConfigEntity{
+			OriginClientID: req.GetClientId(),
+			ServerID:       req.GetServerId(),
+		}
+	)
+
+	if hasKeyword {
+		visitorConfigs, err = dao.NewQuery(ctx).ListVisitorConfigsWithFiltersAndKeyword(userInfo, page, pageSize, filter, keyword)
+	} else {
+		visitorConfigs, err = dao.NewQuery(ctx).ListVisitorConfigsWithFilters(userInfo, page, pageSize, filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	visitorCounts, err = dao.NewQuery(ctx).CountVisitorConfigsWithFiltersAndKeyword(userInfo, filter, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListVisitorConfigsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "success"},
+		VisitorConfigs: lo.Map(visitorConfigs, func(item *models.VisitorConfig, _ int) *pb.VisitorConfig {
+			return item.ToPB()
+		}),
+		Total: lo.ToPtr(int32(visitorCounts)),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package visitor
+
+import (
+	"github.com/VaalaCat/frp-panel/biz/master/proxy"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func StartVisitor(ctx *app.Context, req *pb.StartVisitorRequest) (*pb.StartVisitorResponse, error) {
+	var (
+		userInfo    = common.GetUserInfo(ctx)
+		clientID    = req.GetClientId()
+		serverID    = req.GetServerId()
+		visitorName = req.GetName()
+	)
+
+	clientEntity, err := proxy.GetClientWithMakeShadow(ctx, clientID, serverID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get client, id: [%s]", clientID)
+		return nil, err
+	}
+
+	if _, err := dao.NewQuery(ctx).GetServerByServerID(userInfo, serverID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get server, id: [%s]", serverID)
+		return nil, err
+	}
+
+	visitorCfg, err := dao.NewQuery(ctx).GetVisitorConfigByFilter(userInfo, &models.VisitorConfigEntity{
+		ClientID: clientID,
+		ServerID: serverID,
+		Name:     visitorName,
+	})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get visitor config, client: [%s], server: [%s], visitor name: [%s]", clientID, serverID, visitorName)
+		return nil, err
+	}
+
+	typedVisitorCfg, err := visitorCfg.GetTypedVisitorConfig()
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get typed visitor config, client: [%s], server: [%s], visitor name: [%s]", clientID, serverID, visitorName)
+		return nil, err
+	}
+
+	// 1. 更新visitor状态
+	visitorCfg.Stopped = false
+	if err := dao.NewQuery(ctx).UpdateVisitorConfig(userInfo, visitorCfg); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot update visitor config, client: [%s], server: [%s], visitor name: [%s]", clientID, serverID, visitorName)
+		return nil, err
+	}
+
+	// 2. 添加visitor到client并下发
+	if err := updateClientVisitors(ctx, clientEntity, serverID, upsertVisitor(typedVisitorCfg)); err != nil {
+		return nil, err
+	}
+
+	return &pb.StartVisitorResponse{
+		Status: &pb.Status{
+			Code:    pb.RespCode_RESP_CODE_SUCCESS,
+			Message: "start visitor success",
+		},
+	}, nil
+}
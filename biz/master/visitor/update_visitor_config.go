@@ -0,0 +1,74 @@
+package visitor
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func UpdateVisitorConfig(c *app.Context, req *pb.UpdateVisitorConfigRequest) (*pb.UpdateVisitorConfigResponse, error) {
+	if len(req.GetClientId()) == 0 || len(req.GetServerId()) == 0 || len(req.GetName()) == 0 || len(req.GetConfig()) == 0 {
+		return nil, fmt.Errorf("request invalid")
+	}
+
+	var (
+		userInfo    = common.GetUserInfo(c)
+		clientID    = req.GetClientId()
+		serverID    = req.GetServerId()
+		visitorName = req.GetName()
+	)
+
+	visitorCfg, err := dao.NewQuery(c).GetVisitorConfigByFilter(userInfo, &models.VisitorConfigEntity{
+		ClientID: clientID,
+		ServerID: serverID,
+		Name:     visitorName,
+	})
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get visitor config, client: [%s], server: [%s], visitor name: [%s]", clientID, serverID, visitorName)
+		return nil, err
+	}
+
+	typedVisitorCfg, err := loadVisitorFromContent(c, req.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+	if typedVisitorCfg.GetBaseConfig().Name != visitorName {
+		return nil, fmt.Errorf("visitor name cannot be changed")
+	}
+
+	if err := visitorCfg.FillTypedVisitorConfig(typedVisitorCfg); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot fill typed visitor config")
+		return nil, err
+	}
+
+	if err := dao.NewQuery(c).UpdateVisitorConfig(userInfo, visitorCfg); err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot update visitor config, client: [%s], server: [%s], visitor name: [%s]", clientID, serverID, visitorName)
+		return nil, err
+	}
+
+	// 停止的 visitor 不在 client 配置中，只更新记录
+	if visitorCfg.Stopped {
+		return &pb.UpdateVisitorConfigResponse{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		}, nil
+	}
+
+	cli, err := dao.NewQuery(c).GetClientByClientID(userInfo, visitorCfg.ClientID)
+	if err != nil {
+		logger.Logger(c).WithError(err).Errorf("cannot get client, id: [%s]", visitorCfg.ClientID)
+		return nil, err
+	}
+
+	if err := updateClientVisitors(c, cli.ClientEntity, serverID, upsertVisitor(typedVisitorCfg)); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateVisitorConfigResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
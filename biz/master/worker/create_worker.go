@@ -11,6 +11,7 @@ import (
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/services/workerd"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
 )
 
 func CreateWorker(ctx *app.Context, req *pb.CreateWorkerRequest) (*pb.CreateWorkerResponse, error) {
@@ -32,6 +33,13 @@ func CreateWorker(ctx *app.Context, req *pb.CreateWorkerRequest) (*pb.CreateWork
 	}
 
 	workerd.FillWorkerValue(reqWorker, uint(userInfo.GetUserID()))
+	reqWorker.TenantId = lo.ToPtr(uint32(userInfo.GetTenantID()))
+
+	if err := validateWorkerBindings(ctx, userInfo, reqWorker.GetWorkerId(), []string{clientId},
+		reqWorker.GetServiceBindings(), reqWorker.GetKvNamespaces()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("invalid worker bindings, workerName: [%s]", reqWorker.GetName())
+		return nil, err
+	}
 
 	workerToCreate := (&models.Worker{}).FromPB(reqWorker)
 	workerToCreate.WorkerModel = nil
@@ -68,5 +76,9 @@ func validateCreateWorker(req *pb.CreateWorkerRequest) error {
 		return fmt.Errorf("invalid worker")
 	}
 
-	return nil
+	if err := validateWorkerResourceLimits(req.GetWorker().GetResourceLimits()); err != nil {
+		return err
+	}
+
+	return validateWorkerCrons(req.GetWorker().GetCrons(), req.GetWorker().GetConfigTemplate())
 }
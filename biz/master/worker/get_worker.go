@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -30,12 +31,20 @@ func GetWorker(ctx *app.Context, req *pb.GetWorkerRequest) (*pb.GetWorkerRespons
 		return nil, err
 	}
 
+	labels, err := dao.NewQuery(ctx).GetLabels(userInfo, defs.LabelResourceWorker, models.LabelResourceRef{ID: workerRecord.ID})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("get worker labels failed")
+		return nil, err
+	}
+	workerPB := workerRecord.ToPB()
+	workerPB.Labels = labels
+
 	return &pb.GetWorkerResponse{
 		Status: &pb.Status{
 			Code:    pb.RespCode_RESP_CODE_SUCCESS,
 			Message: "ok",
 		},
-		Worker: workerRecord.ToPB(),
+		Worker: workerPB,
 		Clients: lo.Map(workerRecord.Clients, func(client models.Client, index int) *pb.Client {
 			c := client.ToPB()
 			c.Config = nil
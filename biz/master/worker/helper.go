@@ -0,0 +1,109 @@
+package worker
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/workerd"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
+)
+
+// validateWorkerCrons 只接受标准 5 位 cron，client 按同样的格式注册
+// scheduled 触发依赖默认配置模板中注入的 shim，自定义模板只能使用 http 触发
+func validateWorkerCrons(crons []*pb.WorkerCron, configTemplate string) error {
+	for _, c := range crons {
+		if _, err := cron.ParseStandard(c.GetCron()); err != nil {
+			return fmt.Errorf("invalid cron expression: [%s], should be a standard 5 field cron, err: %v", c.GetCron(), err)
+		}
+
+		switch c.GetType() {
+		case pb.WorkerCron_TRIGGER_TYPE_UNSPECIFIED, pb.WorkerCron_TRIGGER_TYPE_SCHEDULED:
+			if !workerd.IsDefaultConfigTemplate(configTemplate) {
+				return fmt.Errorf("scheduled cron trigger is not supported with custom config template, use http trigger instead")
+			}
+		case pb.WorkerCron_TRIGGER_TYPE_HTTP:
+		default:
+			return fmt.Errorf("invalid cron trigger type: [%d]", c.GetType())
+		}
+
+		if c.GetTimeoutSeconds() < 0 {
+			return fmt.Errorf("invalid cron timeout: [%d]", c.GetTimeoutSeconds())
+		}
+	}
+	return nil
+}
+
+func validateWorkerResourceLimits(limits *pb.WorkerResourceLimits) error {
+	if limits == nil {
+		return nil
+	}
+	if limits.GetCpuMillicores() < 0 {
+		return fmt.Errorf("invalid cpu limit: [%d]", limits.GetCpuMillicores())
+	}
+	if limits.GetMemoryBytes() < 0 {
+		return fmt.Errorf("invalid memory limit: [%d]", limits.GetMemoryBytes())
+	}
+	if limits.GetRunAsGid() > 0 && limits.GetRunAsUid() == 0 {
+		return fmt.Errorf("run_as_gid requires run_as_uid")
+	}
+	return nil
+}
+
+var bindingNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+var kvNamespaceRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// validateWorkerBindings 校验服务绑定和 kv 绑定，被绑定的 worker 需要部署在 clientIds 的每个 client 上
+func validateWorkerBindings(ctx *app.Context, userInfo models.UserInfo, workerId string, clientIds []string,
+	bindings []*pb.WorkerServiceBinding, namespaces []*pb.WorkerKVNamespace) error {
+	names := map[string]bool{}
+	checkName := func(name string) error {
+		if !bindingNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid binding name: [%s]", name)
+		}
+		if names[name] {
+			return fmt.Errorf("duplicated binding name: [%s]", name)
+		}
+		names[name] = true
+		return nil
+	}
+
+	for _, b := range bindings {
+		if err := checkName(b.GetName()); err != nil {
+			return err
+		}
+		if b.GetWorkerId() == workerId {
+			return fmt.Errorf("worker cannot bind itself")
+		}
+
+		target, err := dao.NewQuery(ctx).GetWorkerByWorkerID(userInfo, b.GetWorkerId())
+		if err != nil {
+			return fmt.Errorf("cannot get bound worker, id: [%s]", b.GetWorkerId())
+		}
+
+		targetClients := lo.Map(target.Clients, func(c models.Client, _ int) string { return c.ClientID })
+		if missing, _ := lo.Difference(clientIds, targetClients); len(missing) > 0 {
+			return fmt.Errorf("bound worker [%s] is not deployed on clients: %v", b.GetWorkerId(), missing)
+		}
+	}
+
+	for _, ns := range namespaces {
+		if err := checkName(ns.GetBinding()); err != nil {
+			return err
+		}
+		if len(ns.GetNamespace()) > 0 && !kvNamespaceRegexp.MatchString(ns.GetNamespace()) {
+			return fmt.Errorf("invalid kv namespace: [%s]", ns.GetNamespace())
+		}
+		switch ns.GetScope() {
+		case pb.WorkerKVNamespace_SCOPE_UNSPECIFIED, pb.WorkerKVNamespace_SCOPE_WORKER, pb.WorkerKVNamespace_SCOPE_TENANT:
+		default:
+			return fmt.Errorf("invalid kv scope: [%d]", ns.GetScope())
+		}
+	}
+
+	return nil
+}
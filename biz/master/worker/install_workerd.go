@@ -1,12 +1,17 @@
 package worker
 
 import (
+	"strings"
+
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
 )
 
 func InstallWorkerd(ctx *app.Context, req *pb.InstallWorkerdRequest) (*pb.InstallWorkerdResponse, error) {
@@ -22,6 +27,11 @@ func InstallWorkerd(ctx *app.Context, req *pb.InstallWorkerdRequest) (*pb.Instal
 		return nil, err
 	}
 
+	if err := fillWorkerdArtifact(ctx, req); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("failed to find workerd artifact for clientId: %s", clientId)
+		return nil, err
+	}
+
 	resp := &pb.InstallWorkerdResponse{}
 	if err := rpc.CallClientWrapper(ctx, clientId, pb.Event_EVENT_INSTALL_WORKERD, req, resp); err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("failed to call install workerd with clientId: %s", clientId)
@@ -36,3 +46,52 @@ func InstallWorkerd(ctx *app.Context, req *pb.InstallWorkerdRequest) (*pb.Instal
 		},
 	}, nil
 }
+
+// fillWorkerdArtifact 指定了 artifact 或未指定下载地址时，使用 master 托管的 workerd 并附带校验和
+func fillWorkerdArtifact(ctx *app.Context, req *pb.InstallWorkerdRequest) error {
+	var (
+		artifact *models.WorkerdArtifact
+		err      error
+	)
+
+	switch {
+	case req.GetArtifactId() > 0:
+		if artifact, err = dao.NewQuery(ctx).AdminGetWorkerdArtifact(uint(req.GetArtifactId())); err != nil {
+			return err
+		}
+	case len(req.GetDownloadUrl()) == 0:
+		platform, err := getClientPlatform(ctx, req.GetClientId())
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Warnf("cannot get client platform, client will download workerd by itself")
+			return nil
+		}
+		osArch := strings.SplitN(platform, "/", 2)
+		if len(osArch) != 2 {
+			return nil
+		}
+		if artifact, err = dao.NewQuery(ctx).AdminGetLatestWorkerdArtifact(osArch[0], osArch[1]); err != nil {
+			logger.Logger(ctx).Infof("no workerd artifact for platform [%s], client will download workerd by itself", platform)
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	req.ArtifactId = lo.ToPtr(uint32(artifact.ID))
+	req.Sha256 = lo.ToPtr(artifact.SHA256)
+	req.Version = lo.ToPtr(artifact.Version)
+	return nil
+}
+
+func getClientPlatform(ctx *app.Context, clientId string) (string, error) {
+	resp, err := rpc.CallClient(ctx, clientId, pb.Event_EVENT_PING, &pb.CommonRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	clientVersion := &pb.ClientVersion{}
+	if err := proto.Unmarshal(resp.GetData(), clientVersion); err != nil {
+		return "", err
+	}
+	return clientVersion.GetPlatform(), nil
+}
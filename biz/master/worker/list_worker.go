@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -31,9 +32,17 @@ func ListWorkers(ctx *app.Context, req *pb.ListWorkersRequest) (*pb.ListWorkersR
 		pageSize = 10
 	}
 
-	if hasKeyword {
+	selector, err := models.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !selector.Empty():
+		workers, err = dao.NewQuery(ctx).ListWorkersWithLabelSelector(userInfo, page, pageSize, keyword, selector)
+	case hasKeyword:
 		workers, err = dao.NewQuery(ctx).ListWorkersWithKeyword(userInfo, page, pageSize, keyword)
-	} else {
+	default:
 		workers, err = dao.NewQuery(ctx).ListWorkers(userInfo, page, pageSize)
 	}
 	if err != nil {
@@ -43,9 +52,12 @@ func ListWorkers(ctx *app.Context, req *pb.ListWorkersRequest) (*pb.ListWorkersR
 		}, fmt.Errorf("cannot list workers, page: [%d], pageSize: [%d], keyword: [%s]", page, pageSize, keyword)
 	}
 
-	if hasKeyword {
+	switch {
+	case !selector.Empty():
+		workerCounts, err = dao.NewQuery(ctx).CountWorkersWithLabelSelector(userInfo, keyword, selector)
+	case hasKeyword:
 		workerCounts, err = dao.NewQuery(ctx).CountWorkersWithKeyword(userInfo, keyword)
-	} else {
+	default:
 		workerCounts, err = dao.NewQuery(ctx).CountWorkers(userInfo)
 	}
 	if err != nil {
@@ -55,6 +67,13 @@ func ListWorkers(ctx *app.Context, req *pb.ListWorkersRequest) (*pb.ListWorkersR
 		}, fmt.Errorf("cannot count workers, keyword: [%s]", keyword)
 	}
 
+	labels, err := dao.NewQuery(ctx).GetLabelsByResources(userInfo, defs.LabelResourceWorker,
+		lo.Map(workers, func(w *models.Worker, _ int) models.LabelResourceRef { return models.LabelResourceRef{ID: w.ID} }))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get worker labels")
+		return nil, err
+	}
+
 	return &pb.ListWorkersResponse{
 		Status: &pb.Status{
 			Code:    pb.RespCode_RESP_CODE_SUCCESS,
@@ -65,6 +84,7 @@ func ListWorkers(ctx *app.Context, req *pb.ListWorkersRequest) (*pb.ListWorkersR
 			k := w.ToPB()
 			k.Code = nil
 			k.ConfigTemplate = nil
+			k.Labels = labels[models.LabelResourceRef{ID: w.ID}]
 			return k
 		}),
 	}, nil
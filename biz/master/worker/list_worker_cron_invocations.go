@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func ListWorkerCronInvocations(ctx *app.Context, req *pb.ListWorkerCronInvocationsRequest) (*pb.ListWorkerCronInvocationsResponse, error) {
+	var (
+		userInfo = common.GetUserInfo(ctx)
+		workerId = req.GetWorkerId()
+		cronId   = req.GetCronId()
+		page     = int(req.GetPage())
+		pageSize = int(req.GetPageSize())
+	)
+
+	if len(workerId) == 0 {
+		logger.Logger(ctx).Errorf("worker id is empty")
+		return nil, fmt.Errorf("worker id is empty")
+	}
+
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = 10
+	}
+
+	if _, err := dao.NewQuery(ctx).GetWorkerByWorkerID(userInfo, workerId); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot get worker, id: [%s]", workerId)
+		return nil, err
+	}
+
+	invocations, err := dao.NewQuery(ctx).ListWorkerCronInvocations(userInfo, workerId, cronId, page, pageSize)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list worker cron invocations, worker id: [%s], cron id: [%s]", workerId, cronId)
+		return nil, err
+	}
+
+	total, err := dao.NewQuery(ctx).CountWorkerCronInvocations(userInfo, workerId, cronId)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot count worker cron invocations, worker id: [%s], cron id: [%s]", workerId, cronId)
+		return nil, err
+	}
+
+	return &pb.ListWorkerCronInvocationsResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Total:  lo.ToPtr(int32(total)),
+		Invocations: lo.Map(invocations, func(item *models.WorkerCronInvocation, _ int) *pb.WorkerCronInvocation {
+			return item.ToPB()
+		}),
+	}, nil
+}
@@ -3,6 +3,7 @@ package worker
 import (
 	"github.com/VaalaCat/frp-panel/biz/master/proxy"
 	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
@@ -49,6 +50,11 @@ func RemoveWorker(ctx *app.Context, req *pb.RemoveWorkerRequest) (*pb.RemoveWork
 		return nil, err
 	}
 
+	if err := dao.NewQuery(ctx).DeleteLabelsByResourceIDs(userInfo, defs.LabelResourceWorker, []string{workerId}); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot remove worker labels, id: [%s]", workerId)
+		return nil, err
+	}
+
 	go func() {
 		bgCtx := ctx.Background()
 		hasErr := false
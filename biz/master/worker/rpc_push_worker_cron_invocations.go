@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func PushWorkerCronInvocations(ctx *app.Context, req *pb.PushWorkerCronInvocationsReq) (*pb.PushWorkerCronInvocationsResp, error) {
+	cli, err := client.ValidateClientRequest(ctx, req.GetBase())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot validate client request")
+		return nil, err
+	}
+
+	workers, err := dao.NewQuery(ctx).AdminListWorkersByClientID(cli.ClientID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list workers, clientId: [%s]", cli.ClientID)
+		return nil, err
+	}
+	workerMap := lo.SliceToMap(workers, func(w *models.Worker) (string, *models.Worker) { return w.ID, w })
+
+	invocations := []*models.WorkerCronInvocation{}
+	for _, i := range req.GetInvocations() {
+		w, ok := workerMap[i.GetWorkerId()]
+		if !ok {
+			logger.Logger(ctx).Warnf("worker [%s] is not deployed on client [%s], ignore its cron invocation", i.GetWorkerId(), cli.ClientID)
+			continue
+		}
+
+		invocation := (&models.WorkerCronInvocation{}).FromPB(i)
+		invocation.ClientID = cli.ClientID
+		invocation.UserID = w.UserId
+		invocation.TenantID = w.TenantId
+		invocations = append(invocations, invocation)
+	}
+
+	if err := dao.NewQuery(ctx).AdminCreateWorkerCronInvocations(invocations); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save worker cron invocations, clientId: [%s]", cli.ClientID)
+		return nil, fmt.Errorf("cannot save worker cron invocations")
+	}
+
+	return &pb.PushWorkerCronInvocationsResp{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
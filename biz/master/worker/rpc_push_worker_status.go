@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/notify"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// PushWorkerStatus client 上的 workerd 进程状态变化时上报，进入 crash loop 或被 OOM 杀死时通知 worker 所属用户
+func PushWorkerStatus(ctx *app.Context, req *pb.PushWorkerStatusReq) (*pb.PushWorkerStatusResp, error) {
+	cli, err := client.ValidateClientRequest(ctx, req.GetBase())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot validate client request")
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("worker status changed, clientId: [%s], workerId: [%s], status: [%s], restarts: [%d], err: [%s]",
+		cli.ClientID, req.GetWorkerId(), req.GetStatus(), req.GetRestartCount(), req.GetError())
+
+	status := defs.WorkerStatus(req.GetStatus())
+	if status != defs.WorkerStatus_CrashLoop && status != defs.WorkerStatus_OOMKilled {
+		return &pb.PushWorkerStatusResp{
+			Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		}, nil
+	}
+
+	workers, err := dao.NewQuery(ctx).AdminListWorkersByClientID(cli.ClientID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list workers, clientId: [%s]", cli.ClientID)
+		return nil, err
+	}
+
+	// 共享进程崩溃时影响 client 上所有共享的 worker，通知 client 所属用户
+	userID, tenantID := uint32(cli.UserID), uint32(cli.TenantID)
+	if w, ok := lo.Find(workers, func(w *models.Worker) bool { return w.ID == req.GetWorkerId() }); ok {
+		userID, tenantID = w.UserId, w.TenantId
+	}
+
+	if status == defs.WorkerStatus_OOMKilled {
+		notify.WorkerOOMKilled(ctx, cli.ClientID, req.GetWorkerId(), userID, tenantID, req.GetError())
+	} else {
+		notify.WorkerCrashLoop(ctx, cli.ClientID, req.GetWorkerId(), userID, tenantID, req.GetRestartCount(), req.GetError())
+	}
+
+	return &pb.PushWorkerStatusResp{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/services/workerd"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func PushWorkerKV(ctx *app.Context, req *pb.PushWorkerKVReq) (*pb.PushWorkerKVResp, error) {
+	worker, ns, err := getClientWorkerKVNamespace(ctx, req.GetBase(), req.GetWorkerId(), req.GetBinding())
+	if err != nil {
+		return nil, err
+	}
+
+	nsID := workerd.KVNamespaceID(worker.ToPB(), ns)
+	entries := lo.Map(req.GetEntries(), func(e *pb.WorkerKVEntry, _ int) *models.WorkerKVEntry {
+		return &models.WorkerKVEntry{
+			Namespace: nsID,
+			Key:       e.GetKey(),
+			Value:     e.GetValue(),
+			Revision:  e.GetRevision(),
+			Deleted:   e.GetDeleted(),
+			UserID:    worker.UserId,
+			TenantID:  worker.TenantId,
+		}
+	})
+
+	newer, err := dao.NewQuery(ctx).AdminMergeWorkerKVEntries(nsID, entries)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot save worker kv, workerId: [%s], namespace: [%s]", worker.ID, nsID)
+		return nil, fmt.Errorf("cannot save worker kv")
+	}
+
+	return &pb.PushWorkerKVResp{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Entries: lo.Map(newer, func(e *models.WorkerKVEntry, _ int) *pb.WorkerKVEntry { return e.ToPB() }),
+	}, nil
+}
+
+func PullWorkerKV(ctx *app.Context, req *pb.PullWorkerKVReq) (*pb.PullWorkerKVResp, error) {
+	worker, ns, err := getClientWorkerKVNamespace(ctx, req.GetBase(), req.GetWorkerId(), req.GetBinding())
+	if err != nil {
+		return nil, err
+	}
+
+	nsID := workerd.KVNamespaceID(worker.ToPB(), ns)
+	entries, err := dao.NewQuery(ctx).AdminListWorkerKVEntries(nsID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list worker kv, workerId: [%s], namespace: [%s]", worker.ID, nsID)
+		return nil, fmt.Errorf("cannot list worker kv")
+	}
+
+	return &pb.PullWorkerKVResp{
+		Status:  &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Entries: lo.Map(entries, func(e *models.WorkerKVEntry, _ int) *pb.WorkerKVEntry { return e.ToPB() }),
+	}, nil
+}
+
+// getClientWorkerKVNamespace client 只能同步部署在自己上面的 worker 声明过的 namespace
+func getClientWorkerKVNamespace(ctx *app.Context, base *pb.ClientBase, workerId, binding string) (*models.Worker, *pb.WorkerKVNamespace, error) {
+	cli, err := client.ValidateClientRequest(ctx, base)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot validate client request")
+		return nil, nil, err
+	}
+
+	workers, err := dao.NewQuery(ctx).AdminListWorkersByClientID(cli.ClientID)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("cannot list workers, clientId: [%s]", cli.ClientID)
+		return nil, nil, err
+	}
+
+	worker, ok := lo.Find(workers, func(w *models.Worker) bool { return w.ID == workerId })
+	if !ok {
+		return nil, nil, fmt.Errorf("worker [%s] is not deployed on client [%s]", workerId, cli.ClientID)
+	}
+
+	ns, ok := lo.Find(worker.KVNamespaces.Data, func(ns *pb.WorkerKVNamespace) bool {
+		return ns.GetBinding() == binding && ns.GetSyncToMaster()
+	})
+	if !ok {
+		return nil, nil, fmt.Errorf("worker [%s] has no synced kv binding [%s]", workerId, binding)
+	}
+
+	return worker, ns, nil
+}
@@ -0,0 +1,23 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+func CleanWorkerCronInvocations(appInstance app.Application) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	if err := dao.NewQuery(ctx).AdminDeleteWorkerCronInvocationsBefore(time.Now().Add(-defs.WorkerCronInvocationRetention)); err != nil {
+		logger.Logger(ctx).WithError(err).Error("CleanWorkerCronInvocations cannot delete expired invocations")
+		return err
+	}
+
+	logger.Logger(ctx).Infof("CleanWorkerCronInvocations success")
+	return nil
+}
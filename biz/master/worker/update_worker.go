@@ -9,6 +9,7 @@ import (
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/dao"
 	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/services/workerd"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/samber/lo"
@@ -64,6 +65,54 @@ func UpdateWorker(ctx *app.Context, req *pb.UpdateWorkerRequest) (*pb.UpdateWork
 		updatedFields = append(updatedFields, "config_template")
 	}
 
+	if len(wrokerReq.GetCrons()) != 0 {
+		workerd.FillWorkerCronsValue(wrokerReq.GetCrons())
+		workerToUpdate.Crons = models.JSON[[]*pb.WorkerCron]{Data: wrokerReq.GetCrons()}
+		updatedFields = append(updatedFields, "crons")
+	} else if req.GetClearCrons() {
+		workerToUpdate.Crons = models.JSON[[]*pb.WorkerCron]{}
+		updatedFields = append(updatedFields, "crons")
+	}
+
+	// 模板和 cron 可以分开更新，按更新后的结果校验
+	if err := validateWorkerCrons(workerToUpdate.Crons.Data, workerToUpdate.ConfigTemplate); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("invalid worker crons, id: [%s]", wrokerReq.GetWorkerId())
+		return nil, err
+	}
+
+	if len(wrokerReq.GetServiceBindings()) != 0 {
+		workerToUpdate.ServiceBindings = models.JSON[[]*pb.WorkerServiceBinding]{Data: wrokerReq.GetServiceBindings()}
+		updatedFields = append(updatedFields, "service_bindings")
+	} else if req.GetClearServiceBindings() {
+		workerToUpdate.ServiceBindings = models.JSON[[]*pb.WorkerServiceBinding]{}
+		updatedFields = append(updatedFields, "service_bindings")
+	}
+
+	if len(wrokerReq.GetKvNamespaces()) != 0 {
+		workerd.FillWorkerKVNamespacesValue(wrokerReq.GetKvNamespaces())
+		workerToUpdate.KVNamespaces = models.JSON[[]*pb.WorkerKVNamespace]{Data: wrokerReq.GetKvNamespaces()}
+		updatedFields = append(updatedFields, "kv_namespaces")
+	} else if req.GetClearKvNamespaces() {
+		workerToUpdate.KVNamespaces = models.JSON[[]*pb.WorkerKVNamespace]{}
+		updatedFields = append(updatedFields, "kv_namespaces")
+	}
+
+	if err := validateWorkerBindings(ctx, userInfo, workerToUpdate.ID,
+		lo.Map(workerToUpdate.Clients, func(c models.Client, _ int) string { return c.ClientID }),
+		workerToUpdate.ServiceBindings.Data, workerToUpdate.KVNamespaces.Data); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("invalid worker bindings, id: [%s]", wrokerReq.GetWorkerId())
+		return nil, err
+	}
+
+	if wrokerReq.GetResourceLimits() != nil {
+		if err := validateWorkerResourceLimits(wrokerReq.GetResourceLimits()); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("invalid worker resource limits, id: [%s]", wrokerReq.GetWorkerId())
+			return nil, err
+		}
+		workerToUpdate.ResourceLimits = models.JSON[*pb.WorkerResourceLimits]{Data: wrokerReq.GetResourceLimits()}
+		updatedFields = append(updatedFields, "resource_limits")
+	}
+
 	if err := dao.NewQuery(ctx).UpdateWorker(userInfo, workerToUpdate); err != nil {
 		logger.Logger(ctx).WithError(err).Errorf("cannot update worker, id: [%s]", wrokerReq.GetWorkerId())
 		return nil, fmt.Errorf("cannot update worker, id: [%s]", wrokerReq.GetWorkerId())
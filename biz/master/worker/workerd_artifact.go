@@ -0,0 +1,219 @@
+package worker
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/common"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// UploadWorkerdArtifactHandler 管理员上传 workerd 的 gz 包，表单字段: file, version, os, arch, sha256(可选)
+func UploadWorkerdArtifactHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		resp, err := uploadWorkerdArtifact(app.NewContext(c, appInstance), c)
+		if err != nil {
+			common.ErrResp(c, &pb.UploadWorkerdArtifactResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_INVALID, Message: err.Error()}}, err.Error())
+			return
+		}
+		common.OKResp(c, resp)
+	}
+}
+
+func uploadWorkerdArtifact(ctx *app.Context, c *gin.Context) (*pb.UploadWorkerdArtifactResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() || userInfo.GetRole() != defs.UserRole_Admin {
+		return nil, fmt.Errorf("permission denied")
+	}
+
+	var (
+		version   = strings.TrimSpace(c.PostForm("version"))
+		targetOS  = strings.TrimSpace(c.PostForm("os"))
+		arch      = strings.TrimSpace(c.PostForm("arch"))
+		expectSum = strings.ToLower(strings.TrimSpace(c.PostForm("sha256")))
+	)
+	if len(version) == 0 || len(targetOS) == 0 || len(arch) == 0 {
+		return nil, fmt.Errorf("version, os and arch are required")
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return nil, fmt.Errorf("file is required: %w", err)
+	}
+	if fileHeader.Size > defs.WorkerdArtifactMaxBytes {
+		return nil, fmt.Errorf("file is too large, max: [%d] bytes", defs.WorkerdArtifactMaxBytes)
+	}
+
+	src, err := fileHeader.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	artifactDir := ctx.GetApp().GetConfig().Master.WorkerdArtifactDir
+	if err := os.MkdirAll(artifactDir, 0755); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("create workerd artifact dir failed, path: [%s]", artifactDir)
+		return nil, err
+	}
+
+	tmpFile, err := os.CreateTemp(artifactDir, "upload-*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmpFile, hash), src)
+	if err != nil {
+		return nil, err
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+
+	if len(expectSum) > 0 && expectSum != sum {
+		return nil, fmt.Errorf("sha256 mismatch, expect: [%s], actual: [%s]", expectSum, sum)
+	}
+
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if zr, err := gzip.NewReader(tmpFile); err != nil {
+		return nil, fmt.Errorf("file is not a valid gzip file: %w", err)
+	} else {
+		zr.Close()
+	}
+	tmpFile.Close()
+
+	finalPath := filepath.Join(artifactDir, sum+".gz")
+	if err := os.Rename(tmpFile.Name(), finalPath); err != nil {
+		return nil, err
+	}
+
+	artifact := &models.WorkerdArtifact{
+		Version:  version,
+		OS:       targetOS,
+		Arch:     arch,
+		SHA256:   sum,
+		Size:     size,
+		FilePath: finalPath,
+	}
+	if err := dao.NewQuery(ctx).AdminCreateWorkerdArtifact(artifact); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("create workerd artifact failed, version: [%s]", version)
+		removeUnreferencedArtifactFile(ctx, finalPath)
+		return nil, err
+	}
+
+	logger.Logger(ctx).Infof("upload workerd artifact success, id: [%d], version: [%s], platform: [%s/%s], sha256: [%s]",
+		artifact.ID, version, targetOS, arch, sum)
+
+	return &pb.UploadWorkerdArtifactResponse{
+		Status:   &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Artifact: artifact.ToPB(),
+	}, nil
+}
+
+func ListWorkerdArtifacts(ctx *app.Context, req *pb.ListWorkerdArtifactsRequest) (*pb.ListWorkerdArtifactsResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	artifacts, err := dao.NewQuery(ctx).AdminListWorkerdArtifacts(req.GetOs(), req.GetArch())
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("list workerd artifacts failed")
+		return nil, err
+	}
+
+	return &pb.ListWorkerdArtifactsResponse{
+		Status:    &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+		Artifacts: lo.Map(artifacts, func(a *models.WorkerdArtifact, _ int) *pb.WorkerdArtifact { return a.ToPB() }),
+	}, nil
+}
+
+func DeleteWorkerdArtifact(ctx *app.Context, req *pb.DeleteWorkerdArtifactRequest) (*pb.DeleteWorkerdArtifactResponse, error) {
+	userInfo := common.GetUserInfo(ctx)
+	if !userInfo.Valid() || userInfo.GetRole() != defs.UserRole_Admin {
+		return nil, fmt.Errorf("permission denied")
+	}
+
+	q := dao.NewQuery(ctx)
+	artifact, err := q.AdminGetWorkerdArtifact(uint(req.GetId()))
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("get workerd artifact failed, id: [%d]", req.GetId())
+		return nil, err
+	}
+
+	if err := q.AdminDeleteWorkerdArtifact(artifact.ID); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("delete workerd artifact failed, id: [%d]", req.GetId())
+		return nil, err
+	}
+
+	removeUnreferencedArtifactFile(ctx, artifact.FilePath)
+
+	return &pb.DeleteWorkerdArtifactResponse{
+		Status: &pb.Status{Code: pb.RespCode_RESP_CODE_SUCCESS, Message: "ok"},
+	}, nil
+}
+
+// removeUnreferencedArtifactFile 相同内容的文件可能被多条记录引用，没有记录引用时才删除
+func removeUnreferencedArtifactFile(ctx *app.Context, filePath string) {
+	others, err := dao.NewQuery(ctx).AdminListWorkerdArtifacts("", "")
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("list workerd artifacts failed, keep file: [%s]", filePath)
+		return
+	}
+	if lo.ContainsBy(others, func(a *models.WorkerdArtifact) bool { return a.FilePath == filePath }) {
+		return
+	}
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		logger.Logger(ctx).WithError(err).Errorf("remove workerd artifact file failed, path: [%s]", filePath)
+	}
+}
+
+// DownloadWorkerdArtifactHandler 供 client 下载 workerd，使用 client id 和 secret 鉴权
+func DownloadWorkerdArtifactHandler(appInstance app.Application) func(*gin.Context) {
+	return func(c *gin.Context) {
+		ctx := app.NewContext(c, appInstance)
+
+		if _, err := client.ValidateClientRequest(ctx, &pb.ClientBase{
+			ClientId:     c.GetHeader(defs.HeaderClientID),
+			ClientSecret: c.GetHeader(defs.HeaderClientSecret),
+		}); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("invalid client request when download workerd artifact")
+			c.JSON(http.StatusUnauthorized, common.UnAuth("client id or secret invalid"))
+			return
+		}
+
+		id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, common.Err("invalid artifact id"))
+			return
+		}
+
+		artifact, err := dao.NewQuery(ctx).AdminGetWorkerdArtifact(uint(id))
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("get workerd artifact failed, id: [%d]", id)
+			c.JSON(http.StatusNotFound, common.Err("artifact not found"))
+			return
+		}
+
+		logger.Logger(ctx).Infof("client [%s] download workerd artifact, id: [%d]", c.GetHeader(defs.HeaderClientID), id)
+		c.FileAttachment(artifact.FilePath, fmt.Sprintf("workerd-%s-%s-%s.gz", artifact.OS, artifact.Arch, artifact.Version))
+	}
+}
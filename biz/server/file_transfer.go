@@ -0,0 +1,16 @@
+package server
+
+import (
+	"github.com/VaalaCat/frp-panel/biz/common"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+)
+
+func StartFileTransfer(c *app.Context, req *pb.StartFileTransferRequest) (*pb.StartFileTransferResponse, error) {
+	return common.StartFileTransfer(c, req, &pb.FileTransferClientMessage{Base: &pb.FileTransferClientMessage_ServerBase{
+		ServerBase: &pb.ServerBase{
+			ServerId:     c.GetApp().GetConfig().Client.ID,
+			ServerSecret: c.GetApp().GetConfig().Client.Secret,
+		},
+	}})
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+	"github.com/sourcegraph/conc/pool"
+)
+
+// RunProxyProbes 从 master 拉取本节点负责的探测，从节点所在网络访问隧道的公网地址并上报结果
+func RunProxyProbes(appInstance app.Application, serverID, serverSecret string) error {
+	ctx := app.NewContext(context.Background(), appInstance)
+	base := &pb.ServerBase{
+		ServerId:     serverID,
+		ServerSecret: serverSecret,
+	}
+
+	resp, err := appInstance.GetMasterCli().Call().PullProxyProbes(ctx, &pb.PullProxyProbesReq{Base: base})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Error("cannot pull proxy probes")
+		return err
+	}
+	if len(resp.GetProbes()) == 0 {
+		return nil
+	}
+
+	results := make([]*pb.ProxyProbeResult, len(resp.GetProbes()))
+	p := pool.New().WithMaxGoroutines(defs.ProxyProbeConcurrency)
+	for i, probe := range resp.GetProbes() {
+		p.Go(func() {
+			results[i] = runProxyProbe(ctx, probe)
+		})
+	}
+	p.Wait()
+
+	if _, err := appInstance.GetMasterCli().Call().PushProxyProbeResults(ctx, &pb.PushProxyProbeResultsReq{
+		Base:    base,
+		Results: results,
+	}); err != nil {
+		logger.Logger(ctx).WithError(err).Error("cannot push proxy probe results")
+		return err
+	}
+	return nil
+}
+
+func runProxyProbe(ctx context.Context, probe *pb.ProxyProbe) *pb.ProxyProbeResult {
+	timeout := time.Duration(probe.GetTimeoutMs()) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defs.ProxyProbeDefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	var err error
+	switch probe.GetType() {
+	case pb.ProxyProbe_TYPE_TCP:
+		err = probeTCP(ctx, probe.GetTarget())
+	case pb.ProxyProbe_TYPE_HTTP:
+		err = probeHTTP(ctx, probe)
+	case pb.ProxyProbe_TYPE_UDP:
+		err = probeUDP(ctx, probe.GetTarget(), lo.Ternary(len(probe.GetUdpPayload()) > 0, probe.GetUdpPayload(), defs.ProxyProbeDefaultUDPPayload))
+	default:
+		err = fmt.Errorf("unsupported probe type: %s", probe.GetType().String())
+	}
+	latency := time.Since(start)
+
+	result := &pb.ProxyProbeResult{
+		ProbeId:   lo.ToPtr(probe.GetId()),
+		Success:   lo.ToPtr(err == nil),
+		LatencyMs: lo.ToPtr(latency.Milliseconds()),
+		Time:      lo.ToPtr(start.UnixMilli()),
+	}
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Debugf("proxy probe failed, proxy name: [%s], target: [%s]", probe.GetProxyName(), probe.GetTarget())
+		result.Error = lo.ToPtr(err.Error())
+	}
+	return result
+}
+
+func probeTCP(ctx context.Context, target string) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", target)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// probeHTTP 连接 master 推导的 frps 地址，按 url 中的域名发送请求，不跟随重定向
+func probeHTTP(ctx context.Context, probe *pb.ProxyProbe) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.GetTarget(), nil)
+	if err != nil {
+		return err
+	}
+
+	dialer := &net.Dialer{}
+	transport := &http.Transport{
+		TLSClientConfig:   &tls.Config{InsecureSkipVerify: probe.GetInsecureSkipVerify()},
+		DisableKeepAlives: true,
+		DialContext:       dialer.DialContext,
+	}
+	if dialAddr := probe.GetDialAddr(); len(dialAddr) > 0 {
+		transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, dialAddr)
+		}
+	}
+	cli := &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if expectStatus := probe.GetExpectStatus(); expectStatus > 0 {
+		if resp.StatusCode != int(expectStatus) {
+			return fmt.Errorf("unexpected status code: %d, expect: %d", resp.StatusCode, expectStatus)
+		}
+		return nil
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// probeUDP 发送 payload 并等待任意回包，udp 无连接，收不到回包即视为不可达
+func probeUDP(ctx context.Context, target, payload string) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "udp", target)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	if _, err := conn.Write([]byte(payload)); err != nil {
+		return err
+	}
+	buf := make([]byte, 1500)
+	if _, err := conn.Read(buf); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeHTTPDialsResolvedAddr(t *testing.T) {
+	var host string
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.Host
+		http.Redirect(w, r, "http://127.0.0.1:1/", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	probe := &pb.ProxyProbe{
+		Target:   lo.ToPtr("https://app.example.com/health"),
+		DialAddr: lo.ToPtr(strings.TrimPrefix(srv.URL, "https://")),
+	}
+
+	// 默认校验证书，自签证书探测失败
+	assert.Error(t, probeHTTP(context.Background(), probe))
+
+	// 允许跳过校验后按 url 中的域名请求 frps 地址，且不跟随重定向
+	probe.InsecureSkipVerify = lo.ToPtr(true)
+	assert.NoError(t, probeHTTP(context.Background(), probe))
+	assert.Equal(t, "app.example.com", host)
+}
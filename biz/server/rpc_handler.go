@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"runtime/debug"
 
+	"github.com/VaalaCat/frp-panel/biz/common"
 	"github.com/VaalaCat/frp-panel/conf"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
@@ -19,7 +20,7 @@ func HandleServerMessage(appInstance app.Application, req *pb.ServerMessage) *pb
 		}
 	}()
 
-	ctx := context.Background()
+	ctx := logger.WithTraceID(context.Background(), req.GetTraceId())
 	logger.Logger(ctx).Infof("client get a server message, origin is: [%+v]", req)
 
 	switch req.Event {
@@ -33,6 +34,14 @@ func HandleServerMessage(appInstance app.Application, req *pb.ServerMessage) *pb
 		return app.WrapperServerMsg(appInstance, req, StopSteamLogHandler)
 	case pb.Event_EVENT_START_PTY_CONNECT:
 		return app.WrapperServerMsg(appInstance, req, StartPTYConnect)
+	case pb.Event_EVENT_EXEC_COMMAND:
+		return app.WrapperServerMsg(appInstance, req, common.ExecCommand)
+	case pb.Event_EVENT_START_FILE_TRANSFER:
+		return app.WrapperServerMsg(appInstance, req, StartFileTransfer)
+	case pb.Event_EVENT_LIST_DIR:
+		return app.WrapperServerMsg(appInstance, req, common.ListDir)
+	case pb.Event_EVENT_QUERY_LOGS:
+		return app.WrapperServerMsg(appInstance, req, common.QueryLogs)
 	case pb.Event_EVENT_PING:
 		rawData, _ := proto.Marshal(conf.GetVersion().ToProto())
 		return &pb.ClientMessage{
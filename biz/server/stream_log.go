@@ -28,9 +28,10 @@ func initStreamLog(ctx *app.Context, h app.StreamLogHookMgr) {
 		logger.Logger(ctx).Error(err)
 	}
 
-	h.AddStream(func(msg string) {
+	h.AddStream(func(msg, pkg string) {
 		handler.Send(&pb.PushServerStreamLogReq{
 			Log: []byte(utils.EncodeBase64(msg)),
+			Pkg: &pkg,
 			Base: &pb.ServerBase{
 				ServerId:     clientID,
 				ServerSecret: clientSecret,
@@ -36,6 +36,7 @@ func runClient(param runClientParam) {
 		appInstance  = param.AppInstance
 	)
 	logger.Logger(ctx).Infof("start to run client")
+	enablePersistLog(ctx, param.Cfg, clientID)
 	if len(clientSecret) == 0 {
 		logger.Logger(ctx).Fatal("client secret cannot be empty")
 	}
@@ -48,6 +49,8 @@ func runClient(param runClientParam) {
 		bizclient.PullConfig, appInstance, clientID, clientSecret)
 	param.TaskManager.AddDurationTask(defs.PullClientWorkersDuration,
 		bizclient.PullWorkers, appInstance, clientID, clientSecret)
+	param.TaskManager.AddDurationTask(defs.PushProxyStatusDuration,
+		bizclient.PushProxyStatus, appInstance, clientID, clientSecret)
 
 	var wg conc.WaitGroup
 	param.Lc.Append(fx.Hook{
@@ -5,6 +5,7 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/VaalaCat/frp-panel/conf"
@@ -42,12 +43,14 @@ func BuildCommand(fs embed.FS) *cobra.Command {
 		cfg.Logger.FRPLoggerLevel,
 		cfg.Logger.DefaultLoggerLevel,
 	)
+	logger.SetLogFormat(cfg.Logger.Format)
 
 	return NewRootCmd(
 		NewMasterCmd(cfg, fs),
 		NewClientCmd(cfg),
 		NewServerCmd(cfg),
 		NewJoinCmd(),
+		NewCtlCmd(),
 		NewInstallServiceCmd(),
 		NewUninstallServiceCmd(),
 		NewStartServiceCmd(),
@@ -512,6 +515,14 @@ func saveConfig(ctx context.Context, cli *pb.Client, joinArgs CommonArgs) {
 		defs.SysEnvPath, envMap)
 }
 
+// readFileOrStdin 读取文件内容，- 表示 stdin
+func readFileOrStdin(file string) ([]byte, error) {
+	if file == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(file)
+}
+
 func checkPullParams(joinArgs CommonArgs) error {
 	if joinToken := joinArgs.JoinToken; joinToken != nil && len(*joinToken) == 0 {
 		return errors.New("join token is empty")
@@ -543,3 +554,13 @@ func NewRootCmd(cmds ...*cobra.Command) *cobra.Command {
 
 	return rootCmd
 }
+
+// enablePersistLog 开启日志落盘，供 master 查询历史日志，失败时只记录不影响启动
+func enablePersistLog(ctx context.Context, cfg conf.Config, nodeID string) {
+	if cfg.Logger.PersistMaxSizeMB <= 0 || len(cfg.Logger.PersistDir) == 0 {
+		return
+	}
+	if err := logger.EnableFileLog(cfg.Logger.PersistDir, nodeID, int64(cfg.Logger.PersistMaxSizeMB)<<20); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("enable log persistence failed, dir: [%s]", cfg.Logger.PersistDir)
+	}
+}
@@ -0,0 +1,798 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ctlOutputTable = "table"
+	ctlOutputJSON  = "json"
+	ctlOutputYAML  = "yaml"
+)
+
+// ctlOptions frpp ctl 的全局参数，--api-url 和 --token 会覆盖 context 中的值
+type ctlOptions struct {
+	configPath string
+	context    string
+	apiURL     string
+	token      string
+	output     string
+}
+
+// ctlTarget 一次调用的 master 地址和 token
+type ctlTarget struct {
+	cfg   conf.Config
+	token string
+}
+
+func (o *ctlOptions) target() (*ctlTarget, error) {
+	apiURL, token := o.apiURL, o.token
+
+	if len(apiURL) == 0 || len(token) == 0 {
+		ctlCfg, err := loadCtlConfig(o.configPath)
+		if err != nil {
+			return nil, err
+		}
+		name := lo.Ternary(len(o.context) > 0, o.context, ctlCfg.CurrentContext)
+		if len(name) > 0 {
+			ctx, ok := ctlCfg.get(name)
+			if !ok {
+				return nil, fmt.Errorf("context [%s] not found", name)
+			}
+			apiURL = lo.Ternary(len(apiURL) > 0, apiURL, ctx.APIUrl)
+			token = lo.Ternary(len(token) > 0, token, ctx.Token)
+		}
+	}
+
+	if len(apiURL) == 0 || len(token) == 0 {
+		return nil, errors.New("api url or token is empty, set a context with 'frpp ctl context set' or use --api-url and --token")
+	}
+
+	cfg := conf.NewConfig()
+	cfg.Client.APIUrl = strings.TrimSuffix(apiURL, "/")
+	return &ctlTarget{cfg: cfg, token: token}, nil
+}
+
+func (t *ctlTarget) call(path string, req proto.Message, resp interface {
+	proto.Message
+	GetStatus() *pb.Status
+}) error {
+	return rpc.CallMasterAPI(t.cfg, t.token, "/api/v1"+path, req, resp)
+}
+
+// ctlRun 出错时打印到 stderr 并以非 0 退出，方便脚本判断
+func ctlRun(fn func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		if err := fn(cmd, args); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			os.Exit(1)
+		}
+	}
+}
+
+func NewCtlCmd() *cobra.Command {
+	opts := &ctlOptions{}
+
+	ctlCmd := &cobra.Command{
+		Use:   "ctl",
+		Short: "manage clients, servers, proxies and workers through master api",
+		PersistentPreRun: ctlRun(func(cmd *cobra.Command, args []string) error {
+			if !lo.Contains([]string{ctlOutputTable, ctlOutputJSON, ctlOutputYAML}, opts.output) {
+				return fmt.Errorf("invalid output format [%s], should be table, json or yaml", opts.output)
+			}
+			return nil
+		}),
+	}
+
+	ctlCmd.PersistentFlags().StringVar(&opts.configPath, "config", "", "ctl config file, default is "+defaultCtlConfigPath())
+	ctlCmd.PersistentFlags().StringVar(&opts.context, "context", "", "context to use, default is current context")
+	ctlCmd.PersistentFlags().StringVar(&opts.apiURL, "api-url", "", "api url, master api url, scheme can be http/https://hostname:port")
+	ctlCmd.PersistentFlags().StringVar(&opts.token, "token", "", "api token signed in master webui")
+	ctlCmd.PersistentFlags().StringVarP(&opts.output, "output", "o", ctlOutputTable, "output format, table/json/yaml")
+
+	ctlCmd.AddCommand(
+		newCtlContextCmd(opts),
+		newCtlClientCmd(opts),
+		newCtlServerCmd(opts),
+		newCtlProxyCmd(opts),
+		newCtlWorkerCmd(opts),
+		newCtlLabelCmd(opts),
+		newCtlBatchCmd(opts),
+		newCtlLogCmd(opts),
+		newCtlPTYCmd(opts),
+		newCtlImportCmd(opts),
+		newCtlExportCmd(opts),
+		newCtlApplyCmd(opts),
+	)
+	return ctlCmd
+}
+
+func newCtlContextCmd(opts *ctlOptions) *cobra.Command {
+	contextCmd := &cobra.Command{
+		Use:   "context",
+		Short: "manage masters saved in ctl config",
+	}
+
+	setCmd := &cobra.Command{
+		Use:   "set <name> [--api-url api url] [--token token]",
+		Short: "create or update a context, first context becomes current",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			ctlCfg, err := loadCtlConfig(opts.configPath)
+			if err != nil {
+				return err
+			}
+			if _, ok := ctlCfg.get(args[0]); !ok && (len(opts.apiURL) == 0 || len(opts.token) == 0) {
+				return errors.New("new context requires --api-url and --token")
+			}
+			ctlCfg.set(args[0], opts.apiURL, opts.token)
+			return ctlCfg.save()
+		}),
+	}
+
+	useCmd := &cobra.Command{
+		Use:   "use <name>",
+		Short: "switch current context",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			ctlCfg, err := loadCtlConfig(opts.configPath)
+			if err != nil {
+				return err
+			}
+			if _, ok := ctlCfg.get(args[0]); !ok {
+				return fmt.Errorf("context [%s] not found", args[0])
+			}
+			ctlCfg.CurrentContext = args[0]
+			return ctlCfg.save()
+		}),
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "list contexts, token is not printed",
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			ctlCfg, err := loadCtlConfig(opts.configPath)
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CURRENT\tNAME\tAPI URL")
+			for _, ctx := range ctlCfg.Contexts {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", lo.Ternary(ctx.Name == ctlCfg.CurrentContext, "*", ""), ctx.Name, ctx.APIUrl)
+			}
+			return w.Flush()
+		}),
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "delete a context",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			ctlCfg, err := loadCtlConfig(opts.configPath)
+			if err != nil {
+				return err
+			}
+			if !ctlCfg.delete(args[0]) {
+				return fmt.Errorf("context [%s] not found", args[0])
+			}
+			return ctlCfg.save()
+		}),
+	}
+
+	contextCmd.AddCommand(setCmd, useCmd, listCmd, deleteCmd)
+	return contextCmd
+}
+
+func newCtlClientCmd(opts *ctlOptions) *cobra.Command {
+	clientCmd := &cobra.Command{
+		Use:   "client",
+		Short: "list, get and delete clients",
+	}
+
+	columns := []string{"ID", "SERVER", "CHILDREN", "STOPPED", "LAST SEEN", "LABELS", "COMMENT"}
+	row := func(c *pb.Client) []string {
+		return []string{c.GetId(), c.GetServerId(), strconv.Itoa(len(c.GetClientIds())),
+			strconv.FormatBool(c.GetStopped()), formatUnixMilli(c.GetLastSeenAt()), formatLabels(c.GetLabels()), c.GetComment()}
+	}
+
+	listCmd := newCtlListCmd(opts, string(defs.LabelResourceClient), func(t *ctlTarget, page, pageSize int32, keyword, selector string) error {
+		resp := &pb.ListClientsResponse{}
+		if err := t.call("/client/list", &pb.ListClientsRequest{
+			Page: &page, PageSize: &pageSize, Keyword: &keyword, LabelSelector: &selector,
+		}, resp); err != nil {
+			return err
+		}
+		return printMessages(opts.output, resp.GetClients(), columns, row)
+	})
+
+	getCmd := &cobra.Command{
+		Use:   "get <client-id>",
+		Short: "get a client",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			resp := &pb.GetClientResponse{}
+			if err := t.call("/client/get", &pb.GetClientRequest{ClientId: &args[0]}, resp); err != nil {
+				return err
+			}
+			return printMessage(opts.output, resp.GetClient(), columns, row)
+		}),
+	}
+
+	deleteCmd := newCtlDeleteCmd(opts, "client", func(t *ctlTarget, id string) error {
+		return t.call("/client/delete", &pb.DeleteClientRequest{ClientId: &id}, &pb.DeleteClientResponse{})
+	})
+
+	clientCmd.AddCommand(listCmd, getCmd, deleteCmd)
+	return clientCmd
+}
+
+func newCtlServerCmd(opts *ctlOptions) *cobra.Command {
+	serverCmd := &cobra.Command{
+		Use:   "server",
+		Short: "list, get and delete servers",
+	}
+
+	columns := []string{"ID", "IP", "FRPS URLS", "LABELS", "COMMENT"}
+	row := func(s *pb.Server) []string {
+		return []string{s.GetId(), s.GetIp(), strings.Join(s.GetFrpsUrls(), ","), formatLabels(s.GetLabels()), s.GetComment()}
+	}
+
+	listCmd := newCtlListCmd(opts, string(defs.LabelResourceServer), func(t *ctlTarget, page, pageSize int32, keyword, selector string) error {
+		resp := &pb.ListServersResponse{}
+		if err := t.call("/server/list", &pb.ListServersRequest{
+			Page: &page, PageSize: &pageSize, Keyword: &keyword, LabelSelector: &selector,
+		}, resp); err != nil {
+			return err
+		}
+		return printMessages(opts.output, resp.GetServers(), columns, row)
+	})
+
+	getCmd := &cobra.Command{
+		Use:   "get <server-id>",
+		Short: "get a server",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			resp := &pb.GetServerResponse{}
+			if err := t.call("/server/get", &pb.GetServerRequest{ServerId: &args[0]}, resp); err != nil {
+				return err
+			}
+			return printMessage(opts.output, resp.GetServer(), columns, row)
+		}),
+	}
+
+	deleteCmd := newCtlDeleteCmd(opts, "server", func(t *ctlTarget, id string) error {
+		return t.call("/server/delete", &pb.DeleteServerRequest{ServerId: &id}, &pb.DeleteServerResponse{})
+	})
+
+	serverCmd.AddCommand(listCmd, getCmd, deleteCmd)
+	return serverCmd
+}
+
+func newCtlProxyCmd(opts *ctlOptions) *cobra.Command {
+	proxyCmd := &cobra.Command{
+		Use:   "proxy",
+		Short: "manage proxy configs of clients",
+	}
+
+	columns := []string{"NAME", "TYPE", "CLIENT", "ORIGIN CLIENT", "SERVER", "STOPPED", "LABELS"}
+	row := func(p *pb.ProxyConfig) []string {
+		return []string{p.GetName(), p.GetType(), p.GetClientId(), p.GetOriginClientId(), p.GetServerId(),
+			strconv.FormatBool(p.GetStopped()), formatLabels(p.GetLabels())}
+	}
+
+	var listClientID, listServerID string
+	listCmd := newCtlListCmd(opts, string(defs.LabelResourceProxy), func(t *ctlTarget, page, pageSize int32, keyword, selector string) error {
+		resp := &pb.ListProxyConfigsResponse{}
+		if err := t.call("/proxy/list_configs", &pb.ListProxyConfigsRequest{
+			Page: &page, PageSize: &pageSize, Keyword: &keyword,
+			ClientId: &listClientID, ServerId: &listServerID, LabelSelector: &selector,
+		}, resp); err != nil {
+			return err
+		}
+		return printMessages(opts.output, resp.GetProxyConfigs(), columns, row)
+	})
+	listCmd.Flags().StringVar(&listClientID, "client-id", "", "filter by client")
+	listCmd.Flags().StringVar(&listServerID, "server-id", "", "filter by server")
+
+	// 以下命令都通过 client id、server id 和 proxy 名称定位一个 proxy
+	var clientID, serverID string
+	withProxyFlags := func(cmd *cobra.Command) *cobra.Command {
+		cmd.Flags().StringVar(&clientID, "client-id", "", "client of the proxy")
+		cmd.Flags().StringVar(&serverID, "server-id", "", "server of the proxy")
+		cmd.MarkFlagRequired("client-id")
+		cmd.MarkFlagRequired("server-id")
+		return cmd
+	}
+
+	getCmd := withProxyFlags(&cobra.Command{
+		Use:   "get <name> --client-id id --server-id id",
+		Short: "get a proxy config and its working status",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			resp := &pb.GetProxyConfigResponse{}
+			if err := t.call("/proxy/get_config", &pb.GetProxyConfigRequest{ClientId: &clientID, ServerId: &serverID, Name: &args[0]}, resp); err != nil {
+				return err
+			}
+			resp.Status = nil
+			return printMessage(opts.output, resp,
+				append(columns, "STATUS", "REMOTE ADDR", "ERROR", "NEXT START", "NEXT STOP"),
+				func(r *pb.GetProxyConfigResponse) []string {
+					return append(row(r.GetProxyConfig()), r.GetWorkingStatus().GetStatus(),
+						r.GetWorkingStatus().GetRemoteAddr(), r.GetWorkingStatus().GetErr(),
+						formatUnixMilli(r.GetSchedule().GetNextStartAt()), formatUnixMilli(r.GetSchedule().GetNextStopAt()))
+				})
+		}),
+	})
+
+	var (
+		file      string
+		overwrite bool
+	)
+	createCmd := withProxyFlags(&cobra.Command{
+		Use:   "create --client-id id --server-id id -f <config>",
+		Short: "create a proxy from a frpc config file containing exactly one proxy",
+		Args:  cobra.NoArgs,
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, content, err := targetWithFile(opts, file)
+			if err != nil {
+				return err
+			}
+			return t.call("/proxy/create_config", &pb.CreateProxyConfigRequest{
+				ClientId: &clientID, ServerId: &serverID, Config: content, Overwrite: &overwrite,
+			}, &pb.CreateProxyConfigResponse{})
+		}),
+	})
+	createCmd.Flags().StringVarP(&file, "file", "f", "", "frpc config file with one proxy, - for stdin")
+	createCmd.Flags().BoolVar(&overwrite, "overwrite", false, "overwrite the proxy if it exists")
+	createCmd.MarkFlagRequired("file")
+
+	updateCmd := withProxyFlags(&cobra.Command{
+		Use:   "update <name> --client-id id --server-id id -f <config>",
+		Short: "update a proxy from a frpc config file containing exactly one proxy",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, content, err := targetWithFile(opts, file)
+			if err != nil {
+				return err
+			}
+			proxyCfgs, err := utils.LoadProxiesFromContent(content)
+			if err != nil {
+				return err
+			}
+			if len(proxyCfgs) != 1 || proxyCfgs[0].GetBaseConfig().Name != args[0] {
+				return fmt.Errorf("config should contain exactly one proxy named [%s]", args[0])
+			}
+			if err := t.call("/proxy/get_config", &pb.GetProxyConfigRequest{
+				ClientId: &clientID, ServerId: &serverID, Name: &args[0],
+			}, &pb.GetProxyConfigResponse{}); err != nil {
+				return fmt.Errorf("get proxy [%s] failed: %w", args[0], err)
+			}
+			// 与页面编辑一致，通过覆盖创建更新，client id 为 proxy list 中的 CLIENT
+			return t.call("/proxy/create_config", &pb.CreateProxyConfigRequest{
+				ClientId: &clientID, ServerId: &serverID, Config: content, Overwrite: lo.ToPtr(true),
+			}, &pb.CreateProxyConfigResponse{})
+		}),
+	})
+	updateCmd.Flags().StringVarP(&file, "file", "f", "", "frpc config file with one proxy, - for stdin")
+	updateCmd.MarkFlagRequired("file")
+
+	proxyAction := func(use, short string, call func(t *ctlTarget, name string) error) *cobra.Command {
+		return withProxyFlags(&cobra.Command{
+			Use:   use + " <name> --client-id id --server-id id",
+			Short: short,
+			Args:  cobra.ExactArgs(1),
+			Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+				t, err := opts.target()
+				if err != nil {
+					return err
+				}
+				return call(t, args[0])
+			}),
+		})
+	}
+
+	deleteCmd := proxyAction("delete", "delete a proxy", func(t *ctlTarget, name string) error {
+		return t.call("/proxy/delete_config", &pb.DeleteProxyConfigRequest{ClientId: &clientID, ServerId: &serverID, Name: &name}, &pb.DeleteProxyConfigResponse{})
+	})
+	startCmd := proxyAction("start", "start a stopped proxy", func(t *ctlTarget, name string) error {
+		return t.call("/proxy/start_proxy", &pb.StartProxyRequest{ClientId: &clientID, ServerId: &serverID, Name: &name}, &pb.StartProxyResponse{})
+	})
+	stopCmd := proxyAction("stop", "stop a proxy, config is kept", func(t *ctlTarget, name string) error {
+		return t.call("/proxy/stop_proxy", &pb.StopProxyRequest{ClientId: &clientID, ServerId: &serverID, Name: &name}, &pb.StopProxyResponse{})
+	})
+
+	var targetClientID, targetServerID, targetName string
+	printRelocated := func(p *pb.ProxyConfig) error {
+		return printMessage(opts.output, p, columns, row)
+	}
+
+	moveCmd := proxyAction("move", "move a proxy to another client or server, stats are kept", func(t *ctlTarget, name string) error {
+		resp := &pb.MoveProxyConfigResponse{}
+		if err := t.call("/proxy/move_config", &pb.MoveProxyConfigRequest{
+			ClientId: &clientID, ServerId: &serverID, Name: &name,
+			TargetClientId: &targetClientID, TargetServerId: &targetServerID,
+		}, resp); err != nil {
+			return err
+		}
+		return printRelocated(resp.GetProxyConfig())
+	})
+	moveCmd.Flags().StringVar(&targetClientID, "target-client-id", "", "client to move to, defaults to the current one")
+	moveCmd.Flags().StringVar(&targetServerID, "target-server-id", "", "server to move to, defaults to the current one")
+
+	cloneCmd := proxyAction("clone", "copy a proxy to another client, server or name", func(t *ctlTarget, name string) error {
+		resp := &pb.CloneProxyConfigResponse{}
+		if err := t.call("/proxy/clone_config", &pb.CloneProxyConfigRequest{
+			ClientId: &clientID, ServerId: &serverID, Name: &name,
+			TargetClientId: &targetClientID, TargetServerId: &targetServerID, TargetName: &targetName,
+		}, resp); err != nil {
+			return err
+		}
+		return printRelocated(resp.GetProxyConfig())
+	})
+	cloneCmd.Flags().StringVar(&targetClientID, "target-client-id", "", "client to copy to, defaults to the current one")
+	cloneCmd.Flags().StringVar(&targetServerID, "target-server-id", "", "server to copy to, defaults to the current one")
+	cloneCmd.Flags().StringVar(&targetName, "target-name", "", "name of the copy, defaults to the current one")
+
+	var (
+		windows  []string
+		ttl      time.Duration
+		clearTTL bool
+	)
+	scheduleCmd := proxyAction("schedule", "set cron start/stop windows or expose a proxy for a while", func(t *ctlTarget, name string) error {
+		req := &pb.SetProxyScheduleRequest{
+			ClientId: &clientID, ServerId: &serverID, Name: &name,
+			TtlSeconds: lo.ToPtr(int64(ttl / time.Second)), ClearTtl: &clearTTL,
+			// 没有指定 --window 时只修改 TTL
+			KeepWindows: lo.ToPtr(len(windows) == 0),
+		}
+		for _, w := range lo.Compact(windows) {
+			start, stop, _ := strings.Cut(w, "|")
+			req.Windows = append(req.Windows, &pb.ProxyScheduleWindow{
+				StartCron: lo.ToPtr(strings.TrimSpace(start)), StopCron: lo.ToPtr(strings.TrimSpace(stop)),
+			})
+		}
+		resp := &pb.SetProxyScheduleResponse{}
+		if err := t.call("/proxy/set_schedule", req, resp); err != nil {
+			return err
+		}
+		return printMessage(opts.output, resp.GetSchedule(), []string{"NAME", "CLIENT", "WINDOWS", "EXPIRE AT", "NEXT START", "NEXT STOP"},
+			func(s *pb.ProxySchedule) []string {
+				return []string{s.GetProxyName(), s.GetClientId(),
+					strings.Join(lo.Map(s.GetWindows(), func(w *pb.ProxyScheduleWindow, _ int) string {
+						return w.GetStartCron() + "|" + w.GetStopCron()
+					}), ", "),
+					formatUnixMilli(s.GetExpireAt()), formatUnixMilli(s.GetNextStartAt()), formatUnixMilli(s.GetNextStopAt())}
+			})
+	})
+	scheduleCmd.Flags().StringArrayVar(&windows, "window", nil, "window as 'start cron|stop cron', either side can be empty, repeatable, replaces existing windows, '' clears them")
+	scheduleCmd.Flags().DurationVar(&ttl, "ttl", 0, "start the proxy now and stop it after this duration, e.g. 2h")
+	scheduleCmd.Flags().BoolVar(&clearTTL, "clear-ttl", false, "cancel the ttl, the proxy keeps its current state")
+
+	unscheduleCmd := proxyAction("unschedule", "remove the schedule of a proxy, the proxy keeps its current state", func(t *ctlTarget, name string) error {
+		return t.call("/proxy/delete_schedule", &pb.DeleteProxyScheduleRequest{ClientId: &clientID, ServerId: &serverID, Name: &name}, &pb.DeleteProxyScheduleResponse{})
+	})
+
+	proxyCmd.AddCommand(listCmd, getCmd, createCmd, updateCmd, deleteCmd, startCmd, stopCmd, moveCmd, cloneCmd, scheduleCmd, unscheduleCmd)
+	return proxyCmd
+}
+
+func newCtlWorkerCmd(opts *ctlOptions) *cobra.Command {
+	workerCmd := &cobra.Command{
+		Use:   "worker",
+		Short: "deploy and inspect workers",
+	}
+
+	columns := []string{"ID", "NAME", "ENTRY", "CRONS", "LABELS"}
+	row := func(w *pb.Worker) []string {
+		return []string{w.GetWorkerId(), w.GetName(), w.GetCodeEntry(), strconv.Itoa(len(w.GetCrons())), formatLabels(w.GetLabels())}
+	}
+
+	var listClientID string
+	listCmd := newCtlListCmd(opts, string(defs.LabelResourceWorker), func(t *ctlTarget, page, pageSize int32, keyword, selector string) error {
+		resp := &pb.ListWorkersResponse{}
+		if err := t.call("/worker/list", &pb.ListWorkersRequest{
+			Page: &page, PageSize: &pageSize, Keyword: &keyword, ClientId: &listClientID, LabelSelector: &selector,
+		}, resp); err != nil {
+			return err
+		}
+		// 列表中不输出代码
+		for _, w := range resp.GetWorkers() {
+			w.Code = nil
+		}
+		return printMessages(opts.output, resp.GetWorkers(), columns, row)
+	})
+	listCmd.Flags().StringVar(&listClientID, "client-id", "", "filter by client")
+
+	getCmd := &cobra.Command{
+		Use:   "get <worker-id>",
+		Short: "get a worker and clients it is deployed to",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			resp := &pb.GetWorkerResponse{}
+			if err := t.call("/worker/get", &pb.GetWorkerRequest{WorkerId: &args[0]}, resp); err != nil {
+				return err
+			}
+			resp.Status = nil
+			return printMessage(opts.output, resp, append(columns, "CLIENTS"), func(r *pb.GetWorkerResponse) []string {
+				return append(row(r.GetWorker()), strings.Join(lo.Map(r.GetClients(), func(c *pb.Client, _ int) string { return c.GetId() }), ","))
+			})
+		}),
+	}
+
+	statusCmd := &cobra.Command{
+		Use:   "status <worker-id>",
+		Short: "get worker status on each client",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			resp := &pb.GetWorkerStatusResponse{}
+			if err := t.call("/worker/status", &pb.GetWorkerStatusRequest{WorkerId: &args[0]}, resp); err != nil {
+				return err
+			}
+			if opts.output != ctlOutputTable {
+				resp.Status = nil
+				return printMessage(opts.output, resp, nil, nil)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CLIENT\tSTATUS")
+			clientIDs := lo.Keys(resp.GetWorkerStatus())
+			sort.Strings(clientIDs)
+			for _, clientID := range clientIDs {
+				fmt.Fprintf(w, "%s\t%s\n", clientID, resp.GetWorkerStatus()[clientID])
+			}
+			return w.Flush()
+		}),
+	}
+
+	var (
+		workerID  string
+		name      string
+		codeEntry string
+		file      string
+		clientIDs []string
+	)
+	deployCmd := &cobra.Command{
+		Use:   "deploy -f <code> (--name name --client-id id... | --worker-id id [--client-id id...])",
+		Short: "create a worker, or update code of an existing worker, and deploy it to clients",
+		Args:  cobra.NoArgs,
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, code, err := targetWithFile(opts, file)
+			if err != nil {
+				return err
+			}
+
+			worker := &pb.Worker{Code: lo.ToPtr(string(code))}
+			if len(name) > 0 {
+				worker.Name = &name
+			}
+			if len(codeEntry) > 0 {
+				worker.CodeEntry = &codeEntry
+			}
+
+			if len(workerID) == 0 {
+				if len(name) == 0 || len(clientIDs) == 0 {
+					return errors.New("new worker requires --name and --client-id")
+				}
+				createResp := &pb.CreateWorkerResponse{}
+				if err := t.call("/worker/create", &pb.CreateWorkerRequest{ClientId: &clientIDs[0], Worker: worker}, createResp); err != nil {
+					return err
+				}
+				workerID = createResp.GetWorkerId()
+				fmt.Printf("worker [%s] created\n", workerID)
+				if len(clientIDs) == 1 {
+					return nil
+				}
+			} else if len(clientIDs) == 0 {
+				// 不指定 client 时保持原有部署，UpdateWorker 只会重新下发到传入的 client
+				getResp := &pb.GetWorkerResponse{}
+				if err := t.call("/worker/get", &pb.GetWorkerRequest{WorkerId: &workerID}, getResp); err != nil {
+					return err
+				}
+				clientIDs = lo.Map(getResp.GetClients(), func(c *pb.Client, _ int) string { return c.GetId() })
+			}
+
+			worker.WorkerId = &workerID
+			if err := t.call("/worker/update", &pb.UpdateWorkerRequest{ClientIds: clientIDs, Worker: worker}, &pb.UpdateWorkerResponse{}); err != nil {
+				return err
+			}
+			fmt.Printf("worker [%s] deployed to %s\n", workerID, strings.Join(clientIDs, ","))
+			return nil
+		}),
+	}
+	deployCmd.Flags().StringVarP(&file, "file", "f", "", "worker code file, - for stdin")
+	deployCmd.Flags().StringVar(&workerID, "worker-id", "", "worker to update, create a new worker if empty")
+	deployCmd.Flags().StringVar(&name, "name", "", "worker name")
+	deployCmd.Flags().StringVar(&codeEntry, "code-entry", "", "worker entry file name, default is entry.js")
+	deployCmd.Flags().StringSliceVar(&clientIDs, "client-id", nil, "clients to deploy to, can be repeated")
+	deployCmd.MarkFlagRequired("file")
+
+	workerCmd.AddCommand(listCmd, getCmd, statusCmd, deployCmd)
+	return workerCmd
+}
+
+// newCtlListCmd 带分页、关键字和标签过滤参数的 list 命令
+// --filter 使用保存的过滤器，和 --selector 同时指定时两者都要满足
+func newCtlListCmd(opts *ctlOptions, resourceType string, list func(t *ctlTarget, page, pageSize int32, keyword, selector string) error) *cobra.Command {
+	var (
+		page, pageSize   int32
+		keyword          string
+		selector, filter string
+	)
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "list with pagination",
+		Args:  cobra.NoArgs,
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			if len(filter) > 0 {
+				saved, err := t.labelFilter(resourceType, filter)
+				if err != nil {
+					return err
+				}
+				selector = strings.Join(lo.Compact([]string{saved, selector}), ",")
+			}
+			return list(t, page, pageSize, keyword, selector)
+		}),
+	}
+	listCmd.Flags().Int32Var(&page, "page", 1, "page number")
+	listCmd.Flags().Int32Var(&pageSize, "page-size", 50, "page size")
+	listCmd.Flags().StringVar(&keyword, "keyword", "", "search keyword")
+	listCmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector, e.g. env=prod,team in (a,b),!deprecated")
+	listCmd.Flags().StringVar(&filter, "filter", "", "saved label filter name")
+	return listCmd
+}
+
+func newCtlDeleteCmd(opts *ctlOptions, kind string, del func(t *ctlTarget, id string) error) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <" + kind + "-id>...",
+		Short: "delete " + kind + "s",
+		Args:  cobra.MinimumNArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			for _, id := range args {
+				if err := del(t, id); err != nil {
+					return fmt.Errorf("delete %s [%s] failed: %w", kind, id, err)
+				}
+				fmt.Printf("%s [%s] deleted\n", kind, id)
+			}
+			return nil
+		}),
+	}
+}
+
+// targetWithFile 读取 -f 指定的文件，- 表示 stdin
+func targetWithFile(opts *ctlOptions, file string) (*ctlTarget, []byte, error) {
+	t, err := opts.target()
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := readFileOrStdin(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, content, nil
+}
+
+func printMessage[T proto.Message](output string, item T, columns []string, row func(T) []string) error {
+	if output == ctlOutputTable {
+		return printTable([]T{item}, columns, row)
+	}
+	raw, err := marshalMessage(item)
+	if err != nil {
+		return err
+	}
+	return printRaw(output, raw)
+}
+
+func printMessages[T proto.Message](output string, items []T, columns []string, row func(T) []string) error {
+	if output == ctlOutputTable {
+		return printTable(items, columns, row)
+	}
+	raws := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		raw, err := marshalMessage(item)
+		if err != nil {
+			return err
+		}
+		raws = append(raws, raw)
+	}
+	raw, err := json.Marshal(raws)
+	if err != nil {
+		return err
+	}
+	return printRaw(output, raw)
+}
+
+func marshalMessage(m proto.Message) (json.RawMessage, error) {
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+}
+
+func printRaw(output string, raw []byte) error {
+	if output == ctlOutputYAML {
+		rawYAML, err := yaml.JSONToYAML(raw)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(rawYAML)
+		return err
+	}
+	buf := &bytes.Buffer{}
+	if err := json.Indent(buf, raw, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(os.Stdout)
+	return err
+}
+
+func printTable[T any](items []T, columns []string, row func(T) []string) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(columns, "\t"))
+	for _, item := range items {
+		fmt.Fprintln(w, strings.Join(row(item), "\t"))
+	}
+	return w.Flush()
+}
+
+// formatLabels 按 key 排序输出 k=v 列表，保证表格输出稳定
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "-"
+	}
+	keys := lo.Keys(labels)
+	sort.Strings(keys)
+	return strings.Join(lo.Map(keys, func(k string, _ int) string { return k + "=" + labels[k] }), ",")
+}
+
+func formatUnixMilli(ts int64) string {
+	if ts <= 0 {
+		return "-"
+	}
+	return time.UnixMilli(ts).Format(time.DateTime)
+}
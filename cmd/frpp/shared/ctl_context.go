@@ -0,0 +1,98 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/samber/lo"
+	"sigs.k8s.io/yaml"
+)
+
+// ctlContext 一个 master 的连接信息，token 由 master 的 SignTokenHandler 签发
+type ctlContext struct {
+	Name   string `json:"name"`
+	APIUrl string `json:"api_url"`
+	Token  string `json:"token"`
+}
+
+// ctlConfig frpp ctl 的本地配置，可以保存多个 master
+type ctlConfig struct {
+	CurrentContext string        `json:"current_context,omitempty"`
+	Contexts       []*ctlContext `json:"contexts,omitempty"`
+
+	path string
+}
+
+func defaultCtlConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "frpp", "ctl.yaml")
+}
+
+// loadCtlConfig 配置文件不存在时返回空配置
+func loadCtlConfig(path string) (*ctlConfig, error) {
+	if len(path) == 0 {
+		path = defaultCtlConfigPath()
+	}
+
+	cfg := &ctlConfig{path: path}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("invalid ctl config [%s]: %w", path, err)
+	}
+	return cfg, nil
+}
+
+func (c *ctlConfig) save() error {
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, content, 0600)
+}
+
+func (c *ctlConfig) get(name string) (*ctlContext, bool) {
+	return lo.Find(c.Contexts, func(ctx *ctlContext) bool { return ctx.Name == name })
+}
+
+// set 新增或更新 context，为空的字段保留原值，第一个 context 自动成为当前 context
+func (c *ctlConfig) set(name, apiURL, token string) {
+	ctx, ok := c.get(name)
+	if !ok {
+		ctx = &ctlContext{Name: name}
+		c.Contexts = append(c.Contexts, ctx)
+	}
+	if len(apiURL) > 0 {
+		ctx.APIUrl = apiURL
+	}
+	if len(token) > 0 {
+		ctx.Token = token
+	}
+	if len(c.CurrentContext) == 0 {
+		c.CurrentContext = name
+	}
+}
+
+func (c *ctlConfig) delete(name string) bool {
+	if _, ok := c.get(name); !ok {
+		return false
+	}
+	c.Contexts = lo.Reject(c.Contexts, func(ctx *ctlContext, _ int) bool { return ctx.Name == name })
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+	return true
+}
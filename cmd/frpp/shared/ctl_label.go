@@ -0,0 +1,184 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+// labelFilter 获取保存的过滤器的 selector，过滤器的资源类型必须和 list 的资源一致
+func (t *ctlTarget) labelFilter(resourceType, name string) (string, error) {
+	resp := &pb.ListLabelFiltersResponse{}
+	if err := t.call("/label/list_filters", &pb.ListLabelFiltersRequest{ResourceType: &resourceType}, resp); err != nil {
+		return "", err
+	}
+	filter, ok := lo.Find(resp.GetFilters(), func(f *pb.LabelFilter) bool { return f.GetName() == name })
+	if !ok {
+		return "", fmt.Errorf("label filter [%s] for %s not found", name, resourceType)
+	}
+	return filter.GetSelector(), nil
+}
+
+func newCtlLabelCmd(opts *ctlOptions) *cobra.Command {
+	labelCmd := &cobra.Command{
+		Use:   "label",
+		Short: "set labels and manage saved label filters",
+	}
+
+	var (
+		proxyName  string
+		removeKeys []string
+		replace    bool
+	)
+	setCmd := &cobra.Command{
+		Use:   "set <proxy|client|server|worker> <id> [key=value]...",
+		Short: "set labels on a resource, proxies need --proxy-name and the child client id",
+		Args:  cobra.MinimumNArgs(2),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			labels := map[string]string{}
+			for _, kv := range args[2:] {
+				key, value, ok := strings.Cut(kv, "=")
+				if !ok {
+					return fmt.Errorf("invalid label [%s], should be key=value", kv)
+				}
+				labels[key] = value
+			}
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			resp := &pb.UpdateLabelsResponse{}
+			if err := t.call("/label/update", &pb.UpdateLabelsRequest{
+				ResourceType: &args[0],
+				ResourceId:   &args[1],
+				ProxyName:    &proxyName,
+				Labels:       labels,
+				RemoveKeys:   removeKeys,
+				Replace:      &replace,
+			}, resp); err != nil {
+				return err
+			}
+			resp.Status = nil
+			return printMessage(opts.output, resp, []string{"LABELS"}, func(r *pb.UpdateLabelsResponse) []string {
+				return []string{formatLabels(r.GetLabels())}
+			})
+		}),
+	}
+	setCmd.Flags().StringVar(&proxyName, "proxy-name", "", "proxy name, required for proxy labels")
+	setCmd.Flags().StringSliceVar(&removeKeys, "remove", nil, "label keys to remove")
+	setCmd.Flags().BoolVar(&replace, "replace", false, "replace all labels instead of merging")
+
+	labelCmd.AddCommand(setCmd, newCtlLabelFilterCmd(opts))
+	return labelCmd
+}
+
+func newCtlLabelFilterCmd(opts *ctlOptions) *cobra.Command {
+	filterCmd := &cobra.Command{
+		Use:   "filter",
+		Short: "save, list and delete named label selectors",
+	}
+
+	columns := []string{"NAME", "RESOURCE", "SELECTOR"}
+	row := func(f *pb.LabelFilter) []string {
+		return []string{f.GetName(), f.GetResourceType(), f.GetSelector()}
+	}
+
+	saveCmd := &cobra.Command{
+		Use:   "save <name> <proxy|client|server|worker> <selector>",
+		Short: "save or overwrite a label filter",
+		Args:  cobra.ExactArgs(3),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			if err := t.call("/label/save_filter", &pb.SaveLabelFilterRequest{Filter: &pb.LabelFilter{
+				Name: &args[0], ResourceType: &args[1], Selector: &args[2],
+			}}, &pb.SaveLabelFilterResponse{}); err != nil {
+				return err
+			}
+			fmt.Printf("label filter [%s] saved\n", args[0])
+			return nil
+		}),
+	}
+
+	var resourceType string
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "list saved label filters",
+		Args:  cobra.NoArgs,
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			resp := &pb.ListLabelFiltersResponse{}
+			if err := t.call("/label/list_filters", &pb.ListLabelFiltersRequest{ResourceType: &resourceType}, resp); err != nil {
+				return err
+			}
+			return printMessages(opts.output, resp.GetFilters(), columns, row)
+		}),
+	}
+	listCmd.Flags().StringVar(&resourceType, "type", "", "filter by resource type")
+
+	deleteCmd := newCtlDeleteCmd(opts, "label filter", func(t *ctlTarget, name string) error {
+		return t.call("/label/delete_filter", &pb.DeleteLabelFilterRequest{Name: &name}, &pb.DeleteLabelFilterResponse{})
+	})
+	deleteCmd.Use = "delete <name>..."
+
+	filterCmd.AddCommand(saveCmd, listCmd, deleteCmd)
+	return filterCmd
+}
+
+func newCtlBatchCmd(opts *ctlOptions) *cobra.Command {
+	var (
+		selector, filter string
+		dryRun           bool
+	)
+	batchCmd := &cobra.Command{
+		Use:   "batch <proxy|client|server|worker> <start|stop|delete> -l selector",
+		Short: "start, stop or delete all resources matching a label selector",
+		Args:  cobra.ExactArgs(2),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			if len(filter) > 0 {
+				saved, err := t.labelFilter(args[0], filter)
+				if err != nil {
+					return err
+				}
+				selector = strings.Join(lo.Compact([]string{saved, selector}), ",")
+			}
+			resp := &pb.BatchOperateResponse{}
+			if err := t.call("/label/batch", &pb.BatchOperateRequest{
+				ResourceType:  &args[0],
+				Operation:     &args[1],
+				LabelSelector: &selector,
+				DryRun:        &dryRun,
+			}, resp); err != nil {
+				return err
+			}
+			if err := printMessages(opts.output, resp.GetResults(),
+				[]string{"ID", "SERVER", "PROXY", "APPLIED", "ERROR"},
+				func(r *pb.BatchOperateResult) []string {
+					return []string{r.GetResourceId(), lo.CoalesceOrEmpty(r.GetServerId(), "-"), lo.CoalesceOrEmpty(r.GetProxyName(), "-"),
+						fmt.Sprint(r.GetApplied()), lo.CoalesceOrEmpty(r.GetError(), "-")}
+				}); err != nil {
+				return err
+			}
+			if failed := lo.CountBy(resp.GetResults(), func(r *pb.BatchOperateResult) bool { return len(r.GetError()) > 0 }); failed > 0 {
+				return fmt.Errorf("%d of %d %s failed", failed, len(resp.GetResults()), args[0])
+			}
+			return nil
+		}),
+	}
+	batchCmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector, e.g. env=prod,team=payments")
+	batchCmd.Flags().StringVar(&filter, "filter", "", "saved label filter name")
+	batchCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only list matched resources")
+	return batchCmd
+}
@@ -0,0 +1,268 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+func newCtlImportCmd(opts *ctlOptions) *cobra.Command {
+	var (
+		dryRun             bool
+		kind               string
+		serverID, serverIP string
+	)
+	importCmd := &cobra.Command{
+		Use:   "import [--dry-run] <file or dir>...",
+		Short: "import existing frpc/frps config files (toml/yaml/json/ini) into master",
+		Args:  cobra.MinimumNArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+			files, err := readImportFiles(args, kind)
+			if err != nil {
+				return fmt.Errorf("read config files failed: %w", err)
+			}
+
+			resp, err := rpc.ImportConfig(t.cfg, t.token, &pb.ImportConfigRequest{
+				Files:    files,
+				DryRun:   &dryRun,
+				ServerId: &serverID,
+				ServerIp: &serverIP,
+			})
+			if err != nil {
+				return fmt.Errorf("import config failed: %w", err)
+			}
+
+			if opts.output != ctlOutputTable {
+				resp.Status = nil
+				return printMessage(opts.output, resp, nil, nil)
+			}
+			for _, result := range resp.GetResults() {
+				printImportResult(result, dryRun)
+			}
+			return nil
+		}),
+	}
+
+	importCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only print what would be imported")
+	importCmd.Flags().StringVar(&kind, "kind", "", "config kind, client or server, auto detect if empty")
+	importCmd.Flags().StringVar(&serverID, "server-id", "", "server for clients whose serverAddr matches no known server")
+	importCmd.Flags().StringVar(&serverIP, "server-ip", "", "public ip for imported servers listening on all addresses")
+	return importCmd
+}
+
+func newCtlExportCmd(opts *ctlOptions) *cobra.Command {
+	var format, clientID, serverID, outputFile string
+	exportCmd := &cobra.Command{
+		Use:   "export [--format toml] [--client-id id] [--server-id id] [--output-file file]",
+		Short: "export client/server config as standalone frpc/frps config, export all as tar.gz if no id given",
+		Args:  cobra.NoArgs,
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+
+			if len(clientID) == 0 && len(serverID) == 0 {
+				resp, err := rpc.ExportArchive(t.cfg, t.token, &pb.ExportArchiveRequest{Format: &format})
+				if err != nil {
+					return fmt.Errorf("export archive failed: %w", err)
+				}
+				file := lo.Ternary(len(outputFile) > 0, outputFile, resp.GetFileName())
+				if err := os.WriteFile(file, resp.GetContent(), 0600); err != nil {
+					return fmt.Errorf("write archive failed: %w", err)
+				}
+				fmt.Printf("exported to %s\n", file)
+				return nil
+			}
+
+			resp, err := rpc.ExportConfig(t.cfg, t.token, &pb.ExportConfigRequest{
+				ClientId: &clientID,
+				ServerId: &serverID,
+				Format:   &format,
+			})
+			if err != nil {
+				return fmt.Errorf("export config failed: %w", err)
+			}
+			if len(outputFile) == 0 {
+				_, err := os.Stdout.Write(resp.GetContent())
+				return err
+			}
+			if err := os.WriteFile(outputFile, resp.GetContent(), 0600); err != nil {
+				return fmt.Errorf("write config failed: %w", err)
+			}
+			return nil
+		}),
+	}
+
+	exportCmd.Flags().StringVar(&format, "format", defs.ConfigFormatTOML, "config format, toml/yaml/json")
+	exportCmd.Flags().StringVar(&clientID, "client-id", "", "client to export, shadow client requires --server-id")
+	exportCmd.Flags().StringVar(&serverID, "server-id", "", "server to export, or server of the shadow client")
+	exportCmd.Flags().StringVar(&outputFile, "output-file", "", "output file, default stdout for single config and returned name for archive")
+	return exportCmd
+}
+
+func newCtlApplyCmd(opts *ctlOptions) *cobra.Command {
+	var (
+		file          string
+		dryRun, prune bool
+	)
+	applyCmd := &cobra.Command{
+		Use:   "apply [--dry-run] [--prune] -f <manifest>",
+		Short: "sync servers, clients, proxies, visitors and workers to the state described in a yaml manifest",
+		Args:  cobra.NoArgs,
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, content, err := targetWithFile(opts, file)
+			if err != nil {
+				return err
+			}
+
+			resp, err := rpc.ApplyManifest(t.cfg, t.token, &pb.ApplyManifestRequest{
+				Manifest: content,
+				DryRun:   &dryRun,
+				Prune:    &prune,
+			})
+			if err != nil {
+				return fmt.Errorf("apply manifest failed: %w", err)
+			}
+
+			if opts.output != ctlOutputTable {
+				resp.Status = nil
+				if err := printMessage(opts.output, resp, nil, nil); err != nil {
+					return err
+				}
+			} else {
+				printManifestPlan(resp.GetItems(), dryRun)
+			}
+
+			if lo.ContainsBy(resp.GetItems(), func(item *pb.ManifestPlanItem) bool { return len(item.GetError()) > 0 }) {
+				return errors.New("some resources failed to apply")
+			}
+			return nil
+		}),
+	}
+
+	applyCmd.Flags().StringVarP(&file, "file", "f", "", "manifest file, - for stdin")
+	applyCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only print the plan")
+	applyCmd.Flags().BoolVar(&prune, "prune", false, "delete resources not declared in manifest")
+	applyCmd.MarkFlagRequired("file")
+	return applyCmd
+}
+
+var importFileExts = []string{".toml", ".yaml", ".yml", ".json", ".ini"}
+
+// readImportFiles 读取文件或目录下的所有配置文件，目录中的文件以相对路径命名
+func readImportFiles(paths []string, kind string) ([]*pb.ImportConfigFile, error) {
+	files := []*pb.ImportConfigFile{}
+	for _, root := range paths {
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			content, err := os.ReadFile(root)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, &pb.ImportConfigFile{Name: lo.ToPtr(filepath.Base(root)), Content: content, Kind: &kind})
+			continue
+		}
+
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !lo.Contains(importFileExts, filepath.Ext(path)) {
+				return err
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			name, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			files = append(files, &pb.ImportConfigFile{Name: lo.ToPtr(filepath.ToSlash(name)), Content: content, Kind: &kind})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func printImportResult(result *pb.ImportConfigResult, dryRun bool) {
+	state := "imported"
+	switch {
+	case len(result.GetError()) > 0:
+		state = "failed"
+	case len(result.GetConflicts()) > 0:
+		state = "conflict"
+	case dryRun:
+		state = "planned"
+	}
+
+	fmt.Printf("[%s] %s (%s)\n", state, result.GetFileName(), result.GetKind())
+	if len(result.GetServerId()) > 0 {
+		fmt.Printf("  server:   %s\n", result.GetServerId())
+	}
+	if len(result.GetClientId()) > 0 {
+		fmt.Printf("  client:   %s\n", result.GetClientId())
+	}
+	if len(result.GetProxyNames()) > 0 {
+		fmt.Printf("  proxies:  %s\n", strings.Join(result.GetProxyNames(), ", "))
+	}
+	if len(result.GetVisitorNames()) > 0 {
+		fmt.Printf("  visitors: %s\n", strings.Join(result.GetVisitorNames(), ", "))
+	}
+	for _, conflict := range result.GetConflicts() {
+		fmt.Printf("  conflict: %s\n", conflict)
+	}
+	if len(result.GetError()) > 0 {
+		fmt.Printf("  error:    %s\n", result.GetError())
+	}
+}
+
+func printManifestPlan(items []*pb.ManifestPlanItem, dryRun bool) {
+	if len(items) == 0 {
+		fmt.Println("no changes")
+		return
+	}
+
+	for _, item := range items {
+		sign := map[string]string{
+			defs.ManifestActionCreate: "+",
+			defs.ManifestActionUpdate: "~",
+			defs.ManifestActionDelete: "-",
+		}[item.GetAction()]
+		target := item.GetId()
+		if item.GetKind() == defs.ManifestKindProxy || item.GetKind() == defs.ManifestKindVisitor {
+			target = fmt.Sprintf("%s/%s@%s", item.GetClientId(), item.GetId(), item.GetServerId())
+		}
+
+		state := ""
+		switch {
+		case len(item.GetError()) > 0:
+			state = " failed: " + item.GetError()
+		case !dryRun && !item.GetApplied():
+			state = " skipped"
+		}
+
+		changes := ""
+		if len(item.GetChanges()) > 0 {
+			changes = " (" + strings.Join(item.GetChanges(), ", ") + ")"
+		}
+		fmt.Printf("%s %s %s%s%s\n", sign, item.GetKind(), target, changes, state)
+	}
+}
@@ -0,0 +1,207 @@
+package shared
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+func newCtlLogCmd(opts *ctlOptions) *cobra.Command {
+	var pkgs []string
+
+	logCmd := &cobra.Command{
+		Use:   "log <client-id|server-id> [--pkgs frp,frpp]",
+		Short: "tail realtime logs of a client or server, ctrl+c to quit",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+
+			query := url.Values{"id": {args[0]}, "pkgs": {strings.Join(pkgs, ",")}}
+			httpReq, err := http.NewRequest(http.MethodGet, conf.GetAPIURL(t.cfg)+"/api/v1/log?"+query.Encode(), nil)
+			if err != nil {
+				return err
+			}
+			httpReq.Header.Set(defs.AuthorizationKey, t.token)
+
+			httpCli := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+			httpResp, err := httpCli.Do(httpReq)
+			if err != nil {
+				return err
+			}
+			defer httpResp.Body.Close()
+
+			if httpResp.StatusCode != http.StatusOK {
+				body, _ := io.ReadAll(httpResp.Body)
+				return fmt.Errorf("request failed, http status: [%s], body: [%s]", httpResp.Status, strings.TrimSpace(string(body)))
+			}
+
+			// 每行是 json 字符串，内容为 base64 编码的日志，与页面的解析方式一致
+			scanner := bufio.NewScanner(httpResp.Body)
+			scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+			for scanner.Scan() {
+				line := scanner.Bytes()
+				if len(line) == 0 {
+					continue
+				}
+				var encoded string
+				if err := json.Unmarshal(line, &encoded); err != nil {
+					encoded = string(line)
+				}
+				logLine, err := base64.StdEncoding.DecodeString(encoded)
+				if err != nil {
+					logLine = []byte(encoded)
+				}
+				fmt.Println(strings.TrimRight(string(logLine), "\r\n"))
+			}
+			return scanner.Err()
+		}),
+	}
+	logCmd.Flags().StringSliceVar(&pkgs, "pkgs", []string{"all"}, "log packages to subscribe, all for every package")
+
+	return logCmd
+}
+
+func newCtlPTYCmd(opts *ctlOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pty <client-id>",
+		Short: "open a remote terminal on a client, session is recorded by master",
+		Args:  cobra.ExactArgs(1),
+		Run: ctlRun(func(cmd *cobra.Command, args []string) error {
+			t, err := opts.target()
+			if err != nil {
+				return err
+			}
+
+			wsURL, err := url.Parse(conf.GetAPIURL(t.cfg) + "/api/v1/pty/" + url.PathEscape(args[0]))
+			if err != nil {
+				return err
+			}
+			wsURL.Scheme = strings.Replace(wsURL.Scheme, "http", "ws", 1)
+
+			stdinFd, stdoutFd := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+			width, height, err := term.GetSize(stdoutFd)
+			if err != nil {
+				width, height = 80, 24
+			}
+			wsURL.RawQuery = url.Values{"width": {strconv.Itoa(width)}, "height": {strconv.Itoa(height)}}.Encode()
+
+			dialer := &websocket.Dialer{
+				Proxy:            http.ProxyFromEnvironment,
+				HandshakeTimeout: 10 * time.Second,
+				TLSClientConfig:  &tls.Config{InsecureSkipVerify: true},
+			}
+			conn, httpResp, err := dialer.Dial(wsURL.String(), http.Header{defs.AuthorizationKey: {t.token}})
+			if err != nil {
+				if httpResp != nil {
+					return fmt.Errorf("connect pty failed, http status: [%s]", httpResp.Status)
+				}
+				return err
+			}
+			defer conn.Close()
+
+			if term.IsTerminal(stdinFd) {
+				oldState, err := term.MakeRaw(stdinFd)
+				if err != nil {
+					return err
+				}
+				defer term.Restore(stdinFd, oldState)
+			}
+
+			session := &ctlPTYSession{conn: conn, done: make(chan struct{})}
+			go session.readOutput()
+			go session.forwardInput()
+			go session.watchSize(stdoutFd, width, height)
+
+			<-session.done
+			fmt.Fprint(os.Stderr, "\r\npty connection closed\r\n")
+			return nil
+		}),
+	}
+}
+
+// ctlPTYSession 浏览器终端协议：输入为 {"data": ...}，窗口大小为 {"height","width"}，输出为二进制消息
+type ctlPTYSession struct {
+	conn      *websocket.Conn
+	writeMu   sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (s *ctlPTYSession) close() {
+	s.closeOnce.Do(func() { close(s.done) })
+}
+
+func (s *ctlPTYSession) send(payload any) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	return s.conn.WriteJSON(payload)
+}
+
+// readOutput 读取时 gorilla websocket 会自动回复 master 的 ping
+func (s *ctlPTYSession) readOutput() {
+	defer s.close()
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		os.Stdout.Write(data)
+	}
+}
+
+// forwardInput stdin 结束后不关闭会话，等待远端 shell 退出，方便通过管道执行命令
+func (s *ctlPTYSession) forwardInput() {
+	buf := make([]byte, 4096)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if n > 0 {
+			if err := s.send(map[string]string{"data": string(buf[:n])}); err != nil {
+				s.close()
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// watchSize 轮询终端大小，各平台行为一致，不依赖 SIGWINCH
+func (s *ctlPTYSession) watchSize(fd, width, height int) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			w, h, err := term.GetSize(fd)
+			if err != nil || (w == width && h == height) {
+				continue
+			}
+			width, height = w, h
+			if err := s.send(map[string]int{"width": width, "height": height}); err != nil {
+				return
+			}
+		}
+	}
+}
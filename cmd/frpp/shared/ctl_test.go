@@ -0,0 +1,50 @@
+package shared
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCtlOptionsTarget(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "ctl.yaml")
+
+	ctlCfg, err := loadCtlConfig(configPath)
+	assert.NoError(t, err)
+	ctlCfg.set("prod", "https://prod.example.com/", "prod-token")
+	ctlCfg.set("dev", "http://dev.example.com", "dev-token")
+	assert.NoError(t, ctlCfg.save())
+
+	tests := []struct {
+		name      string
+		opts      ctlOptions
+		wantURL   string
+		wantToken string
+		wantErr   bool
+	}{
+		{name: "current context", opts: ctlOptions{}, wantURL: "https://prod.example.com", wantToken: "prod-token"},
+		{name: "named context", opts: ctlOptions{context: "dev"}, wantURL: "http://dev.example.com", wantToken: "dev-token"},
+		{name: "flag overrides context", opts: ctlOptions{context: "dev", token: "flag-token"}, wantURL: "http://dev.example.com", wantToken: "flag-token"},
+		{name: "flags only", opts: ctlOptions{context: "missing", apiURL: "http://flag", token: "flag-token"}, wantURL: "http://flag", wantToken: "flag-token"},
+		{name: "unknown context", opts: ctlOptions{context: "missing"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.configPath = configPath
+			target, err := tt.opts.target()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantURL, target.cfg.Client.APIUrl)
+			assert.Equal(t, tt.wantToken, target.token)
+		})
+	}
+
+	// 没有 context 也没有参数时报错
+	_, err = (&ctlOptions{configPath: filepath.Join(t.TempDir(), "empty.yaml")}).target()
+	assert.Error(t, err)
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 
 	"github.com/VaalaCat/frp-panel/biz/master/auth"
+	"github.com/VaalaCat/frp-panel/biz/master/probe"
 	"github.com/VaalaCat/frp-panel/biz/master/proxy"
+	"github.com/VaalaCat/frp-panel/biz/master/shell"
+	"github.com/VaalaCat/frp-panel/biz/master/worker"
 	"github.com/VaalaCat/frp-panel/conf"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/services/cache"
@@ -47,7 +50,12 @@ func runMaster(param runMasterParam) {
 	auth.InitAuth(param.AppInstance)
 
 	param.TaskManager.AddCronTask("0 0 3 * * *", proxy.CollectDailyStats, param.AppInstance)
-	defer param.TaskManager.Stop()
+	param.TaskManager.AddCronTask("0 30 3 * * *", worker.CleanWorkerCronInvocations, param.AppInstance)
+	param.TaskManager.AddCronTask("0 0 4 * * *", shell.CleanPTYRecords, param.AppInstance)
+	param.TaskManager.AddCronTask("0 0 4 * * *", shell.CleanExecRecords, param.AppInstance)
+	param.TaskManager.AddCronTask("0 30 4 * * *", probe.CleanProxyProbeResults, param.AppInstance)
+	param.AppInstance.SetTaskManager(param.TaskManager)
+	proxy.InitProxySchedules(param.AppInstance)
 
 	logger.Logger(param.Ctx).Infof("start to run master")
 	var wg conc.WaitGroup
@@ -65,6 +73,9 @@ func runMaster(param runMasterParam) {
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
+			if notifyMgr := param.AppInstance.GetNotifyMgr(); notifyMgr != nil {
+				notifyMgr.Stop()
+			}
 			param.MasterService.GetServer().Stop()
 			param.TLSMuxServer.Stop()
 			param.HTTPMuxServer.Stop()
@@ -40,10 +40,12 @@ var (
 	commonMod = fx.Module("common", fx.Provide(
 		NewLogHookManager,
 		NewPTYManager,
+		NewFileTransferManager,
+		NewNotifyManager,
 		NewBaseApp,
 		NewContext,
 		NewClientsManager,
 		NewAutoJoin,
 		fx.Annotate(NewPatchedConfig, fx.ResultTags(`name:"argsPatchedConfig"`)),
-	))
+	), fx.Invoke(initTelemetry))
 )
@@ -12,6 +12,8 @@ import (
 
 	bizcommon "github.com/VaalaCat/frp-panel/biz/common"
 	bizmaster "github.com/VaalaCat/frp-panel/biz/master"
+	"github.com/VaalaCat/frp-panel/biz/master/file"
+	"github.com/VaalaCat/frp-panel/biz/master/notify"
 	"github.com/VaalaCat/frp-panel/biz/master/shell"
 	"github.com/VaalaCat/frp-panel/biz/master/streamlog"
 	bizserver "github.com/VaalaCat/frp-panel/biz/server"
@@ -26,6 +28,7 @@ import (
 	"github.com/VaalaCat/frp-panel/services/mux"
 	"github.com/VaalaCat/frp-panel/services/rbac"
 	"github.com/VaalaCat/frp-panel/services/rpc"
+	"github.com/VaalaCat/frp-panel/services/telemetry"
 	"github.com/VaalaCat/frp-panel/services/watcher"
 	"github.com/VaalaCat/frp-panel/services/workerd"
 	"github.com/VaalaCat/frp-panel/utils"
@@ -51,19 +54,31 @@ func NewPTYManager() app.ShellPTYMgr {
 	return shell.NewPTYMgr()
 }
 
+func NewFileTransferManager() app.FileTransferMgr {
+	return file.NewFileTransferMgr()
+}
+
+func NewNotifyManager() app.NotifyMgr {
+	return notify.NewNotifyMgr()
+}
+
 func NewBaseApp(param struct {
 	fx.In
 
-	Cfg     conf.Config `name:"originConfig"`
-	CliMgr  app.ClientsManager
-	HookMgr app.StreamLogHookMgr
-	PtyMgr  app.ShellPTYMgr
+	Cfg       conf.Config `name:"originConfig"`
+	CliMgr    app.ClientsManager
+	HookMgr   app.StreamLogHookMgr
+	PtyMgr    app.ShellPTYMgr
+	FileMgr   app.FileTransferMgr
+	NotifyMgr app.NotifyMgr
 }) app.Application {
 	appInstance := app.NewApp()
 	appInstance.SetConfig(param.Cfg)
 	appInstance.SetClientsManager(param.CliMgr)
 	appInstance.SetStreamLogHookMgr(param.HookMgr)
 	appInstance.SetShellPTYMgr(param.PtyMgr)
+	appInstance.SetFileTransferMgr(param.FileMgr)
+	appInstance.SetNotifyMgr(param.NotifyMgr)
 	appInstance.SetClientRecvMap(&sync.Map{})
 	return appInstance
 }
@@ -136,6 +151,10 @@ func NewDBManager(ctx *app.Context, appInstance app.Application) app.DBManager {
 		logger.Logger(ctx).Panicf("currently unsupported database type: %s", appInstance.GetConfig().DB.Type)
 	}
 
+	if err := appInstance.GetDBManager().GetDB(appInstance.GetConfig().DB.Type, defs.DBRoleDefault).Use(telemetry.NewGormPlugin()); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("register gorm tracing plugin failed")
+	}
+
 	memoryDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	if err != nil {
 		logger.Logger(ctx).Panic(err)
@@ -376,15 +395,28 @@ func NewEnforcer(param struct {
 	return e
 }
 
-func NewWorkersManager(lx fx.Lifecycle, mgr app.WorkerExecManager, appInstance app.Application) app.WorkersManager {
+func NewWorkersManager(param struct {
+	fx.In
+
+	Lx          fx.Lifecycle
+	Mgr         app.WorkerExecManager
+	AppInstance app.Application
+	TaskManager watcher.Client `name:"clientTaskManager"`
+}) app.WorkersManager {
+	appInstance := param.AppInstance
 	if !appInstance.GetConfig().Client.Features.EnableFunctions {
 		return nil
 	}
 
-	workerMgr := workerd.NewWorkersManager()
+	opts := []workerd.WorkersManagerOpt{}
+	if appInstance.GetConfig().Client.Worker.SharedProcess {
+		opts = append(opts, workerd.WithSharedWorkerd(appInstance.GetConfig().Client.Worker.WorkerdWorkDir))
+	}
+
+	workerMgr := workerd.NewWorkersManager(param.TaskManager, opts...)
 	appInstance.SetWorkersManager(workerMgr)
 
-	lx.Append(fx.Hook{
+	param.Lx.Append(fx.Hook{
 		OnStop: func(ctx context.Context) error {
 			workerMgr.StopAllWorkers(app.NewContext(ctx, appInstance))
 			logger.Logger(ctx).Info("stop all workers")
@@ -406,8 +438,37 @@ func NewWorkerExecManager(cfg conf.Config, appInstance app.Application) app.Work
 		logger.Logger(context.Background()).WithError(err).Fatalf("create work dir failed, path: [%s]", cfg.Client.Worker.WorkerdWorkDir)
 	}
 
+	opts := []workerd.ExecManagerOpt{workerd.WithStatusReporter(workerd.NewMasterStatusReporter(appInstance))}
+	if cfg.Client.Worker.EnableCgroup {
+		opts = append(opts, workerd.WithCgroupRoot(cfg.Client.Worker.CgroupRoot))
+	}
+
 	mgr := workerd.NewExecManager(workerdBinPath,
-		[]string{"serve", "--watch", "--verbose"})
+		[]string{"serve", "--watch", "--verbose"}, opts...)
 	appInstance.SetWorkerExecManager(mgr)
 	return mgr
 }
+
+func initTelemetry(param struct {
+	fx.In
+
+	Lc   fx.Lifecycle
+	Ctx  *app.Context
+	Cfg  conf.Config
+	Role defs.AppRole
+}) {
+	shutdown, err := telemetry.Setup(param.Ctx, param.Cfg, param.Role)
+	if err != nil {
+		logger.Logger(param.Ctx).WithError(err).Errorf("init opentelemetry failed, endpoint: [%s]", param.Cfg.Telemetry.OTLPEndpoint)
+		return
+	}
+	if param.Cfg.Telemetry.Enable {
+		logger.Logger(param.Ctx).Infof("opentelemetry tracing enabled, endpoint: [%s]", param.Cfg.Telemetry.OTLPEndpoint)
+	}
+
+	param.Lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return shutdown(ctx)
+		},
+	})
+}
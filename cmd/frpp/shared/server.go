@@ -38,6 +38,7 @@ func runServer(param runServerParam) {
 	)
 
 	logger.Logger(c).Infof("start to init server")
+	enablePersistLog(c, param.Cfg, clientID)
 
 	if len(clientID) == 0 {
 		logger.Logger(ctx).Fatal("client id cannot be empty")
@@ -45,6 +46,7 @@ func runServer(param runServerParam) {
 
 	param.TaskManager.AddDurationTask(defs.PullConfigDuration, bizserver.PullConfig, appInstance, clientID, clientSecret)
 	param.TaskManager.AddDurationTask(defs.PushProxyInfoDuration, bizserver.PushProxyInfo, appInstance, clientID, clientSecret)
+	param.TaskManager.AddDurationTask(defs.ProxyProbeDuration, bizserver.RunProxyProbes, appInstance, clientID, clientSecret)
 
 	var wg conc.WaitGroup
 
@@ -29,7 +29,29 @@ type ReqType interface {
 		pb.CreateWorkerRequest | pb.RemoveWorkerRequest | pb.RunWorkerRequest | pb.StopWorkerRequest | pb.UpdateWorkerRequest | pb.GetWorkerRequest |
 		pb.ListWorkersRequest | pb.CreateWorkerIngressRequest | pb.GetWorkerIngressRequest |
 		pb.GetWorkerStatusRequest | pb.InstallWorkerdRequest | pb.RedeployWorkerRequest |
-		pb.StartSteamLogRequest
+		pb.StartSteamLogRequest |
+		pb.ListWorkerCronInvocationsRequest | pb.ListWorkerdArtifactsRequest | pb.DeleteWorkerdArtifactRequest |
+		pb.ListPTYSessionsRequest | pb.TerminatePTYSessionRequest | pb.UpdatePTYSessionShareRequest |
+		pb.SetPTYPolicyRequest | pb.ExecCommandRequest | pb.ListExecRecordsRequest |
+		pb.StartFileTransferRequest | pb.ListDirRequest | pb.QueryLogsRequest |
+		pb.CreateNotifyChannelRequest | pb.UpdateNotifyChannelRequest | pb.DeleteNotifyChannelRequest |
+		pb.ListNotifyChannelsRequest | pb.TestNotifyChannelRequest |
+		pb.ListVisitorConfigsRequest | pb.CreateVisitorConfigRequest | pb.UpdateVisitorConfigRequest | pb.DeleteVisitorConfigRequest |
+		pb.GetVisitorConfigRequest | pb.StartVisitorRequest | pb.StopVisitorRequest | pb.GrantVisitorAccessRequest |
+		pb.ListProxyStatusRequest |
+		pb.SetProxyProbeRequest |
+		pb.DeleteProxyProbeRequest |
+		pb.GetProxyProbeRequest |
+		pb.ListProxyProbesRequest |
+		pb.SetProxyScheduleRequest |
+		pb.DeleteProxyScheduleRequest |
+		pb.ImportConfigRequest |
+		pb.ExportConfigRequest |
+		pb.ExportArchiveRequest |
+		pb.ApplyManifestRequest |
+		pb.MoveProxyConfigRequest | pb.CloneProxyConfigRequest |
+		pb.UpdateLabelsRequest | pb.SaveLabelFilterRequest | pb.ListLabelFiltersRequest | pb.DeleteLabelFilterRequest |
+		pb.BatchOperateRequest
 }
 
 func GetProtoRequest[T ReqType](c *gin.Context) (r *T, err error) {
@@ -30,11 +30,34 @@ type RespType interface {
 		pb.CreateWorkerResponse | pb.RemoveWorkerResponse | pb.RunWorkerResponse | pb.StopWorkerResponse | pb.UpdateWorkerResponse | pb.GetWorkerResponse |
 		pb.ListWorkersResponse | pb.CreateWorkerIngressResponse | pb.GetWorkerIngressResponse |
 		pb.GetWorkerStatusResponse | pb.InstallWorkerdResponse | pb.RedeployWorkerResponse |
-		pb.StartSteamLogResponse
+		pb.StartSteamLogResponse |
+		pb.ListWorkerCronInvocationsResponse | pb.UploadWorkerdArtifactResponse | pb.ListWorkerdArtifactsResponse |
+		pb.DeleteWorkerdArtifactResponse |
+		pb.ListPTYSessionsResponse | pb.TerminatePTYSessionResponse | pb.UpdatePTYSessionShareResponse |
+		pb.SetPTYPolicyResponse | pb.ExecCommandResponse | pb.ListExecRecordsResponse |
+		pb.StartFileTransferResponse | pb.ListDirResponse | pb.UploadClientFileResponse |
+		pb.QueryLogsResponse | pb.CreateNotifyChannelResponse | pb.UpdateNotifyChannelResponse |
+		pb.DeleteNotifyChannelResponse | pb.ListNotifyChannelsResponse | pb.TestNotifyChannelResponse |
+		pb.ListVisitorConfigsResponse | pb.CreateVisitorConfigResponse | pb.UpdateVisitorConfigResponse | pb.DeleteVisitorConfigResponse |
+		pb.GetVisitorConfigResponse | pb.StartVisitorResponse | pb.StopVisitorResponse | pb.GrantVisitorAccessResponse |
+		pb.ListProxyStatusResponse |
+		pb.SetProxyProbeResponse |
+		pb.DeleteProxyProbeResponse |
+		pb.GetProxyProbeResponse |
+		pb.ListProxyProbesResponse |
+		pb.SetProxyScheduleResponse |
+		pb.DeleteProxyScheduleResponse |
+		pb.ImportConfigResponse |
+		pb.ExportConfigResponse |
+		pb.ExportArchiveResponse |
+		pb.ApplyManifestResponse |
+		pb.MoveProxyConfigResponse | pb.CloneProxyConfigResponse |
+		pb.UpdateLabelsResponse | pb.SaveLabelFilterResponse | pb.ListLabelFiltersResponse | pb.DeleteLabelFilterResponse |
+		pb.BatchOperateResponse
 }
 
 func OKResp[T RespType](c *gin.Context, origin *T) {
-	c.Header(defs.TraceIDKey, c.GetString(defs.TraceIDKey))
+	c.Header(defs.TraceIDHeader, c.GetString(defs.TraceIDKey))
 	if c.ContentType() == "application/x-protobuf" {
 		c.ProtoBuf(http.StatusOK, origin)
 	} else {
@@ -43,7 +66,7 @@ func OKResp[T RespType](c *gin.Context, origin *T) {
 }
 
 func ErrResp[T RespType](c *gin.Context, origin *T, err string) {
-	c.Header(defs.TraceIDKey, c.GetString(defs.TraceIDKey))
+	c.Header(defs.TraceIDHeader, c.GetString(defs.TraceIDKey))
 	if c.ContentType() == "application/x-protobuf" {
 		c.ProtoBuf(http.StatusInternalServerError, origin)
 	} else {
@@ -52,7 +75,7 @@ func ErrResp[T RespType](c *gin.Context, origin *T, err string) {
 }
 
 func ErrUnAuthorized(c *gin.Context, err string) {
-	c.Header(defs.TraceIDKey, c.GetString(defs.TraceIDKey))
+	c.Header(defs.TraceIDHeader, c.GetString(defs.TraceIDKey))
 	if c.ContentType() == "application/x-protobuf" {
 		c.ProtoBuf(http.StatusUnauthorized,
 			&pb.CommonResponse{Status: &pb.Status{Code: pb.RespCode_RESP_CODE_UNAUTHORIZED, Message: err}})
@@ -108,6 +131,14 @@ func getEvent(origin interface{}) (pb.Event, protoreflect.ProtoMessage, error) {
 		return pb.Event_EVENT_GET_WORKER_STATUS, ptr, nil
 	case *pb.InstallWorkerdResponse:
 		return pb.Event_EVENT_INSTALL_WORKERD, ptr, nil
+	case *pb.ExecCommandResponse:
+		return pb.Event_EVENT_EXEC_COMMAND, ptr, nil
+	case *pb.StartFileTransferResponse:
+		return pb.Event_EVENT_START_FILE_TRANSFER, ptr, nil
+	case *pb.ListDirResponse:
+		return pb.Event_EVENT_LIST_DIR, ptr, nil
+	case *pb.QueryLogsResponse:
+		return pb.Event_EVENT_QUERY_LOGS, ptr, nil
 	default:
 		return 0, nil, fmt.Errorf("cannot unmarshal unknown type: %T", origin)
 	}
@@ -39,6 +39,12 @@ type Config struct {
 		InternalFRPAuthServerHost string `env:"INTERNAL_FRP_AUTH_SERVER_HOST" env-default:"127.0.0.1" env-description:"internal frp auth server host"`
 		InternalFRPAuthServerPort int    `env:"INTERNAL_FRP_AUTH_SERVER_PORT" env-default:"8999" env-description:"internal frp auth server port"`
 		InternalFRPAuthServerPath string `env:"INTERNAL_FRP_AUTH_SERVER_PATH" env-default:"/auth" env-description:"internal frp auth server path"`
+		WorkerdArtifactDir        string `env:"WORKERD_ARTIFACT_DIR" env-default:"/data/workerd" env-description:"dir to store uploaded workerd binaries"`
+		PTYRecordEnable           bool   `env:"PTY_RECORD_ENABLE" env-default:"true" env-description:"record remote shell sessions in asciicast v2 format"`
+		PTYRecordDir              string `env:"PTY_RECORD_DIR" env-default:"/data/pty-records" env-description:"dir to store remote shell recordings"`
+		PTYRecordRetentionDays    int    `env:"PTY_RECORD_RETENTION_DAYS" env-default:"30" env-description:"days to keep remote shell recordings and exec records, 0 means keep forever"`
+		TrafficQuotaDailyMB       int64  `env:"TRAFFIC_QUOTA_DAILY_MB" env-default:"0" env-description:"daily traffic quota of each proxy in MB, owners are notified when exceeded, 0 means no quota"`
+		NotifyAllowPrivateTarget  bool   `env:"NOTIFY_ALLOW_PRIVATE_TARGET" env-default:"false" env-description:"allow notify channels to send to loopback, link-local and private addresses, only enable it when all users are trusted"`
 	} `env-prefix:"MASTER_"`
 	Server struct {
 		APIPort int `env:"API_PORT" env-default:"8999" env-description:"server api port"`
@@ -62,16 +68,39 @@ type Config struct {
 				LinuxArm64 string `env:"LINUX_ARM64" env-default:"https://github.com/cloudflare/workerd/releases/download/v1.20250505.0/workerd-linux-arm64.gz"`
 				LinuxX8664 string `env:"LINUX_X86_64" env-default:"https://github.com/cloudflare/workerd/releases/download/v1.20250505.0/workerd-linux-64.gz"`
 			} `env-prefix:"WORKERD_DOWNLOAD_URL_" env-description:"workerd download url"`
+			EnableCgroup  bool   `env:"ENABLE_CGROUP" env-default:"true" env-description:"use cgroups v2 to limit worker resources when available, fallback to rlimit"`
+			CgroupRoot    string `env:"CGROUP_ROOT" env-default:"/sys/fs/cgroup/frpp-workers" env-description:"cgroup v2 directory to create worker cgroups in"`
+			SharedProcess bool   `env:"SHARED_PROCESS" env-default:"false" env-description:"serve all workers of this client from a single workerd process, workers with resource limits or custom config template still run alone"`
 		} `env-prefix:"WORKER_" env-description:"worker's config"`
+		PTY struct {
+			Shell              string   `env:"SHELL" env-description:"shell binary for remote shell, auto detect when empty"`
+			User               string   `env:"USER" env-description:"run remote shell as this user, only works on unix and frpp needs to be root"`
+			WorkDir            string   `env:"WORK_DIR" env-description:"working directory of remote shell, default is the user's home"`
+			EnvAllowlist       []string `env:"ENV_ALLOWLIST" env-default:"PATH,LANG,LC_*,TZ" env-description:"env vars passed from frpp to remote shell, support prefix match like LC_*"`
+			IdleTimeoutSeconds int      `env:"IDLE_TIMEOUT_SECONDS" env-default:"0" env-description:"close remote shell after no input for this many seconds, 0 means never"`
+		} `env-prefix:"PTY_" env-description:"remote shell config"`
+		FileTransfer struct {
+			AllowPaths []string `env:"ALLOW_PATHS" env-description:"directories master can list, upload to and download from, file transfer is disabled when empty"`
+		} `env-prefix:"FILE_TRANSFER_" env-description:"file transfer config"`
 		Features struct {
-			EnableFunctions bool `env:"ENABLE_FUNCTIONS" env-default:"true" env-description:"enable functions"`
+			EnableFunctions   bool `env:"ENABLE_FUNCTIONS" env-default:"true" env-description:"enable functions"`
+			EnableRemoteShell bool `env:"ENABLE_REMOTE_SHELL" env-default:"true" env-description:"allow master to open remote shell on this node, master can not override it"`
 		} `env-prefix:"FEATURES_" env-description:"features config"`
 	} `env-prefix:"CLIENT_"`
 	IsDebug bool `env:"IS_DEBUG" env-default:"false" env-description:"is debug mode"`
 	Logger  struct {
 		DefaultLoggerLevel string `env:"DEFAULT_LOGGER_LEVEL" env-default:"info" env-description:"frp-panel internal default logger level"`
 		FRPLoggerLevel     string `env:"FRP_LOGGER_LEVEL" env-default:"info" env-description:"frp logger level"`
+		Format             string `env:"FORMAT" env-default:"text" env-description:"log format, text or json"`
+		PersistDir         string `env:"PERSIST_DIR" env-default:"/data/logs" env-description:"dir to keep logs of client and server for querying from master, file name contains the node id"`
+		PersistMaxSizeMB   int    `env:"PERSIST_MAX_SIZE_MB" env-default:"32" env-description:"max size of each persisted log file, one rotated file is kept, 0 means disabled"`
 	} `env-prefix:"LOGGER_"`
+	Telemetry struct {
+		Enable       bool    `env:"ENABLE" env-default:"false" env-description:"enable opentelemetry tracing"`
+		OTLPEndpoint string  `env:"OTLP_ENDPOINT" env-default:"localhost:4317" env-description:"otlp grpc endpoint to export spans to"`
+		Insecure     bool    `env:"INSECURE" env-default:"true" env-description:"connect to otlp endpoint without tls"`
+		SampleRatio  float64 `env:"SAMPLE_RATIO" env-default:"1" env-description:"ratio of traces to sample, from 0 to 1"`
+	} `env-prefix:"TELEMETRY_"`
 	HTTP_PROXY string `env:"HTTP_PROXY" env-description:"http proxy"`
 }
 
@@ -16,6 +16,7 @@ const (
 	UAKey               = "User-Agent"
 	ContentTypeKey      = "Content-Type"
 	TraceIDKey          = "TraceID"
+	TraceIDHeader       = "X-Trace-Id"
 	TokenKey            = "token"
 	FRPAuthTokenKey     = "token"
 	ErrKey              = "err"
@@ -71,6 +72,53 @@ const (
 	PullConfigDuration        = 30 * time.Second
 	PushProxyInfoDuration     = 30 * time.Second
 	PullClientWorkersDuration = 30 * time.Second
+	PushProxyStatusDuration   = 30 * time.Second
+	ProxyProbeDuration        = 60 * time.Second
+
+	WorkerCronInvocationRetention = 7 * 24 * time.Hour
+)
+
+const (
+	// ProxyStatusUnknown 超过 ProxyStatusStaleDuration 没有上报的 proxy 状态
+	ProxyStatusUnknown       = "unknown"
+	ProxyStatusStaleDuration = 3 * PushProxyStatusDuration
+)
+
+const (
+	ProxyProbeDefaultTimeout    = 5 * time.Second
+	ProxyProbeDefaultUDPPayload = "ping"
+	ProxyProbeHistoryLimit      = 100
+	ProxyProbeConcurrency       = 16
+	ProxyProbeResultRetention   = 7 * 24 * time.Hour
+)
+
+const (
+	// 每个 proxy 的 start/stop/expire 任务分别使用 tag 前缀 + 动作 + client id + proxy 名称
+	ProxyScheduleTaskTagPrefix = "proxy-schedule-"
+	ProxyScheduleMaxWindows    = 16
+	ProxyScheduleMaxTTL        = 30 * 24 * time.Hour
+	// TTL 到期停止 proxy 失败后的重试间隔
+	ProxyScheduleExpireRetryInterval = time.Minute
+)
+
+// 导出 frp 配置支持的格式
+const (
+	ConfigFormatTOML = "toml"
+	ConfigFormatYAML = "yaml"
+	ConfigFormatJSON = "json"
+)
+
+// manifest apply 计划中的资源类型和操作
+const (
+	ManifestKindServer  = CliTypeServer
+	ManifestKindClient  = CliTypeClient
+	ManifestKindProxy   = "proxy"
+	ManifestKindVisitor = "visitor"
+	ManifestKindWorker  = "worker"
+
+	ManifestActionCreate = "create"
+	ManifestActionUpdate = "update"
+	ManifestActionDelete = "delete"
 )
 
 const (
@@ -124,6 +172,39 @@ const (
 
 	DefaultConfigTemplate = `using Workerd = import "/workerd/workerd.capnp";
 
+const config :Workerd.Config = (
+  services = [
+    (name = "{{.WorkerId}}", worker = .v{{.WorkerId}}Worker),{{range .ExternalServices}}
+    (name = "{{.Name}}", external = (address = "{{.Address}}", http = ())),{{end}}{{range .DiskServices}}
+    (name = "{{.Name}}", disk = (path = "{{.Path}}", writable = true)),{{end}}
+  ],
+
+  sockets = [
+    (
+      name = "{{.WorkerId}}",
+      address = "{{.Socket.Address}}",
+      http=(),
+      service="{{.WorkerId}}"
+    ),
+  ]
+);
+
+const v{{.WorkerId}}Worker :Workerd.Worker = (
+  modules = [{{if .ScheduledShimEntry}}
+    (name = "{{.ScheduledShimEntry}}", esModule = embed "src/{{.ScheduledShimEntry}}"),{{end}}
+    (name = "{{.CodeEntry}}", esModule = embed "src/{{.CodeEntry}}"),
+  ],
+  compatibilityDate = "2023-04-03",{{if .Bindings}}
+  bindings = [{{range .Bindings}}
+    (name = "{{.Name}}", {{.Kind}} = "{{.Service}}"),{{end}}
+  ],{{end}}
+);`
+)
+
+// LegacyDefaultConfigTemplates 旧版本创建 worker 时保存的默认模板，生成配置时视为默认模板
+var LegacyDefaultConfigTemplates = []string{
+	`using Workerd = import "/workerd/workerd.capnp";
+
 const config :Workerd.Config = (
   services = [
     (name = "{{.WorkerId}}", worker = .v{{.WorkerId}}Worker),
@@ -144,7 +225,153 @@ const v{{.WorkerId}}Worker :Workerd.Worker = (
     (name = "{{.CodeEntry}}", esModule = embed "src/{{.CodeEntry}}"),
   ],
   compatibilityDate = "2023-04-03",
-);`
+);`,
+	`using Workerd = import "/workerd/workerd.capnp";
+
+const config :Workerd.Config = (
+  services = [
+    (name = "{{.WorkerId}}", worker = .v{{.WorkerId}}Worker),
+  ],
+
+  sockets = [
+    (
+      name = "{{.WorkerId}}",
+      address = "{{.Socket.Address}}",
+      http=(),
+      service="{{.WorkerId}}"
+    ),
+  ]
+);
+
+const v{{.WorkerId}}Worker :Workerd.Worker = (
+  modules = [{{if .ScheduledShimEntry}}
+    (name = "{{.ScheduledShimEntry}}", esModule = embed "src/{{.ScheduledShimEntry}}"),{{end}}
+    (name = "{{.CodeEntry}}", esModule = embed "src/{{.CodeEntry}}"),
+  ],
+  compatibilityDate = "2023-04-03",
+);`,
+}
+
+const (
+	HeaderClientID     = "X-Frpp-Client-Id"
+	HeaderClientSecret = "X-Frpp-Client-Secret"
+
+	WorkerdArtifactPath     = "/api/v1/workerd/artifact"
+	WorkerdArtifactMaxBytes = 512 << 20
+)
+
+const (
+	SharedWorkerdCmdID   = "frpp-shared-workerd"
+	SharedConfigTemplate = `using Workerd = import "/workerd/workerd.capnp";
+
+const config :Workerd.Config = (
+  services = [{{range .Workers}}
+    (name = "{{.WorkerId}}", worker = .v{{.WorkerId}}Worker),{{end}}{{range .ExternalServices}}
+    (name = "{{.Name}}", external = (address = "{{.Address}}", http = ())),{{end}}{{range .DiskServices}}
+    (name = "{{.Name}}", disk = (path = "{{.Path}}", writable = true)),{{end}}
+  ],
+
+  sockets = [{{range .Workers}}
+    (
+      name = "{{.WorkerId}}",
+      address = "{{.Socket.Address}}",
+      http=(),
+      service="{{.WorkerId}}"
+    ),{{end}}
+  ]
+);
+{{range .Workers}}
+const v{{.WorkerId}}Worker :Workerd.Worker = (
+  modules = [{{if .ScheduledShimEntry}}
+    (name = "{{.ScheduledShimEntry}}", esModule = embed "{{.CodeDir}}/{{.ScheduledShimEntry}}"),{{end}}
+    (name = "{{.CodeEntry}}", esModule = embed "{{.CodeDir}}/{{.CodeEntry}}"),
+  ],
+  compatibilityDate = "2023-04-03",{{if .Bindings}}
+  bindings = [{{range .Bindings}}
+    (name = "{{.Name}}", {{.Kind}} = "{{.Service}}"),{{end}}
+  ],{{end}}
+);
+{{end}}`
+)
+
+const (
+	WorkerKVPath             = "kv"
+	WorkerKVDefaultNamespace = "default"
+	WorkerKVTaskTagPrefix    = "worker-kv-"
+	WorkerKVSyncInterval     = time.Minute
+	WorkerKVSyncMaxBytes     = 8 << 20
+)
+
+const (
+	// 所有浏览器断开后会话继续保留的时间，期间可以重新加入
+	PTYSessionDetachTimeout = 10 * time.Minute
+	// 新加入的用户先收到最近的这部分输出
+	PTYSessionScrollbackBytes = 64 << 10
+	// 每个浏览器连接最多积压的输出消息数，超过时断开该连接，避免拖慢其他人
+	PTYViewerSendQueueSize = 256
+)
+
+const (
+	ExecDefaultTimeout = 30 * time.Second
+	ExecMaxTimeout     = 10 * time.Minute
+	// stdout 和 stderr 各自最多返回的字节数
+	ExecOutputMaxBytes = 64 << 10
+	// 同时下发命令的节点数
+	ExecConcurrency = 16
+	// 审计记录中 stdout 和 stderr 各自保存的字节数
+	ExecRecordOutputMaxBytes = 4 << 10
+)
+
+const (
+	FileTransferChunkSize = 256 << 10
+	// 上传未完成时的临时文件后缀，续传时从该文件末尾继续写入
+	FileTransferPartSuffix   = ".frpp-part"
+	FileTransferSHA256Header = "X-Frpp-File-Sha256"
+)
+
+const (
+	LogQueryDefaultLimit = 500
+	LogQueryMaxLimit     = 2000
+)
+
+const (
+	// 同一资源的同类事件在冷却时间内只通知一次
+	NotifyCooldown             = 10 * time.Minute
+	NotifyTrafficQuotaCooldown = 24 * time.Hour
+	NotifySendTimeout          = 10 * time.Second
+	NotifySignatureHeader      = "X-Frpp-Signature"
+	NotifyEventHeader          = "X-Frpp-Event"
+)
+
+const (
+	// worker 进程运行不足 WorkerCrashLoopMinUptime 即退出视为一次快速退出，连续达到阈值后上报 crash loop
+	WorkerCrashLoopThreshold = 5
+	WorkerCrashLoopMinUptime = time.Minute
+)
+
+const (
+	WorkerCronTaskTagPrefix     = "worker-cron-"
+	WorkerScheduledShimEntry    = "__frpp_scheduled.js"
+	WorkerScheduledPath         = "/__frpp/scheduled"
+	WorkerScheduledTokenHeader  = "X-Frpp-Scheduled-Token"
+	WorkerCronDefaultTimeout    = 30 * time.Second
+	WorkerCronResultMaxBytes    = 1024
+	WorkerScheduledShimTemplate = `import worker from "./%s";
+
+export default {
+  ...worker,
+  async fetch(req, env, ctx) {
+    const url = new URL(req.url);
+    if (url.pathname === "%s" && req.headers.get("%s") === "%s") {
+      if (typeof worker.scheduled !== "function") {
+        return new Response("worker has no scheduled handler", { status: 501 });
+      }
+      await worker.scheduled({ cron: url.searchParams.get("cron") || "", scheduledTime: Date.now(), noRetry() {} }, env, ctx);
+      return new Response("ok");
+    }
+    return worker.fetch(req, env, ctx);
+  },
+};`
 )
 
 type TokenStatus string
@@ -165,9 +392,11 @@ const (
 type WorkerStatus string
 
 const (
-	WorkerStatus_Unknown  WorkerStatus = "unknown"
-	WorkerStatus_Running  WorkerStatus = "running"
-	WorkerStatus_Inactive WorkerStatus = "inactive"
+	WorkerStatus_Unknown   WorkerStatus = "unknown"
+	WorkerStatus_Running   WorkerStatus = "running"
+	WorkerStatus_Inactive  WorkerStatus = "inactive"
+	WorkerStatus_OOMKilled WorkerStatus = "oom_killed"
+	WorkerStatus_CrashLoop WorkerStatus = "crash_loop"
 )
 
 const (
@@ -175,3 +404,20 @@ const (
 	FrpProxyAnnotationsKey_WorkerId          = "worker_id"
 	FrpProxyAnnotationsKey_LoadBalancerGroup = "load_balancer_group"
 )
+
+type LabelResourceType string
+
+const (
+	LabelResourceProxy  LabelResourceType = "proxy"
+	LabelResourceClient LabelResourceType = "client"
+	LabelResourceServer LabelResourceType = "server"
+	LabelResourceWorker LabelResourceType = "worker"
+)
+
+type BatchOperation string
+
+const (
+	BatchOperationStart  BatchOperation = "start"
+	BatchOperationStop   BatchOperation = "stop"
+	BatchOperationDelete BatchOperation = "delete"
+)
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var traceIDRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// TraceID 为每个请求生成 trace id，下发给节点的消息会带上它，便于串联三端日志
+// 请求头中带有合法的 X-Trace-Id 时沿用
+func TraceID() func(*gin.Context) {
+	return func(c *gin.Context) {
+		traceID := c.GetHeader(defs.TraceIDHeader)
+		if !traceIDRegexp.MatchString(traceID) {
+			traceID = uuid.New().String()
+		}
+
+		c.Set(defs.TraceIDKey, traceID)
+		c.Header(defs.TraceIDHeader, traceID)
+		c.Next()
+	}
+}
@@ -29,6 +29,7 @@ type ClientEntity struct {
 	OriginClientID string `json:"origin_client_id" gorm:"index"`
 	FrpsUrl        string `json:"frps_url" gorm:"index"`
 	Ephemeral      bool   `json:"ephemeral" gorm:"index"`
+	PTYDisabled    bool   `json:"pty_disabled"`
 
 	LastSeenAt *time.Time `json:"last_seen_at" gorm:"index"`
 	CreatedAt  time.Time
@@ -44,14 +45,14 @@ func (c *ClientEntity) SetConfigContent(cfg v1.ClientConfig) error {
 	newCfg := struct {
 		v1.ClientCommonConfig
 		Proxies  []v1.ProxyConfigurer   `json:"proxies,omitempty"`
-		Visitors []v1.VisitorBaseConfig `json:"visitors,omitempty"`
+		Visitors []v1.VisitorConfigurer `json:"visitors,omitempty"`
 	}{
 		ClientCommonConfig: cfg.ClientCommonConfig,
 		Proxies: lo.Map(cfg.Proxies, func(item v1.TypedProxyConfig, _ int) v1.ProxyConfigurer {
 			return item.ProxyConfigurer
 		}),
-		Visitors: lo.Map(cfg.Visitors, func(item v1.TypedVisitorConfig, _ int) v1.VisitorBaseConfig {
-			return *item.GetBaseConfig()
+		Visitors: lo.Map(cfg.Visitors, func(item v1.TypedVisitorConfig, _ int) v1.VisitorConfigurer {
+			return item.VisitorConfigurer
 		}),
 	}
 	raw, err := json.Marshal(newCfg)
@@ -89,6 +90,7 @@ func (c *ClientEntity) ToPB() *pb.Client {
 		OriginClientId: &c.OriginClientID,
 		FrpsUrl:        &c.FrpsUrl,
 		Ephemeral:      &c.Ephemeral,
+		PtyDisabled:    &c.PTYDisabled,
 	}
 	if c.LastSeenAt != nil {
 		resp.LastSeenAt = lo.ToPtr(c.LastSeenAt.UnixMilli())
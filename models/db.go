@@ -38,12 +38,51 @@ func (dbm *dbManagerImpl) Init() {
 			if err := db.AutoMigrate(&Worker{}); err != nil {
 				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&Worker{}).TableName())
 			}
+			if err := db.AutoMigrate(&WorkerCronInvocation{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&WorkerCronInvocation{}).TableName())
+			}
+			if err := db.AutoMigrate(&WorkerdArtifact{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&WorkerdArtifact{}).TableName())
+			}
+			if err := db.AutoMigrate(&WorkerKVEntry{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&WorkerKVEntry{}).TableName())
+			}
+			if err := db.AutoMigrate(&PTYSession{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&PTYSession{}).TableName())
+			}
+			if err := db.AutoMigrate(&ExecRecord{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&ExecRecord{}).TableName())
+			}
 			if err := db.AutoMigrate(&ProxyConfig{}); err != nil {
 				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&ProxyConfig{}).TableName())
 			}
+			if err := db.AutoMigrate(&ProxyProbe{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&ProxyProbe{}).TableName())
+			}
+			if err := db.AutoMigrate(&ProxyProbeResult{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&ProxyProbeResult{}).TableName())
+			}
+			if err := db.AutoMigrate(&ProxySchedule{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&ProxySchedule{}).TableName())
+			}
+			if err := db.AutoMigrate(&ProxyWorkingStatus{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&ProxyWorkingStatus{}).TableName())
+			}
+			if err := db.AutoMigrate(&VisitorConfig{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&VisitorConfig{}).TableName())
+			}
 			if err := db.AutoMigrate(&UserGroup{}); err != nil {
 				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&UserGroup{}).TableName())
 			}
+			if err := db.AutoMigrate(&NotifyChannel{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&NotifyChannel{}).TableName())
+			}
+			if err := db.AutoMigrate(&Label{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&Label{}).TableName())
+			}
+			if err := db.AutoMigrate(&LabelFilter{}); err != nil {
+				logger.Logger(context.Background()).WithError(err).Fatalf("cannot init db table [%s]", (&LabelFilter{}).TableName())
+			}
 		}
 	}
 }
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// ExecRecord 记录通过 master 在节点上执行的非交互命令，用于审计
+type ExecRecord struct {
+	gorm.Model
+	ClientID   string         `json:"client_id" gorm:"index"`
+	UserID     uint32         `json:"user_id" gorm:"index"`
+	TenantID   uint32         `json:"tenant_id" gorm:"index"`
+	UserName   string         `json:"user_name"`
+	Command    string         `json:"command"`
+	Args       JSON[[]string] `json:"args"`
+	WorkDir    string         `json:"work_dir"`
+	ExitCode   int32          `json:"exit_code"`
+	TimedOut   bool           `json:"timed_out"`
+	Stdout     []byte         `json:"stdout"`
+	Stderr     []byte         `json:"stderr"`
+	Error      string         `json:"error"`
+	StartedAt  time.Time      `json:"started_at" gorm:"index"`
+	DurationMs int64          `json:"duration_ms"`
+}
+
+func (*ExecRecord) TableName() string {
+	return "exec_records"
+}
+
+func (r *ExecRecord) ToPB() *pb.ExecRecord {
+	return &pb.ExecRecord{
+		Id:         lo.ToPtr(uint32(r.ID)),
+		ClientId:   lo.ToPtr(r.ClientID),
+		UserId:     lo.ToPtr(r.UserID),
+		UserName:   lo.ToPtr(r.UserName),
+		Command:    lo.ToPtr(r.Command),
+		Args:       r.Args.Data,
+		WorkDir:    lo.ToPtr(r.WorkDir),
+		ExitCode:   lo.ToPtr(r.ExitCode),
+		TimedOut:   lo.ToPtr(r.TimedOut),
+		Stdout:     r.Stdout,
+		Stderr:     r.Stderr,
+		Error:      lo.ToPtr(r.Error),
+		StartedAt:  lo.ToPtr(r.StartedAt.UnixMilli()),
+		DurationMs: lo.ToPtr(r.DurationMs),
+	}
+}
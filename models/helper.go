@@ -36,13 +36,14 @@ func ParseProxyConfigFromClient(client *Client) ([]*ProxyConfigEntity, error) {
 	return resp, nil
 }
 
+// BuildClientConfigFromProxyConfig 用 ProxyConfig 记录组装 client 的完整 frpc 配置，不修改传入的 client
 func BuildClientConfigFromProxyConfig(client *Client, proxyCfgs []*ProxyConfig) (*Client, error) {
-	if client == nil || len(proxyCfgs) == 0 {
-		return nil, errors.New("client or proxy config is nil")
+	if client == nil || client.ClientEntity == nil {
+		return nil, errors.New("client is nil")
 	}
 
-	resp := &Client{}
-	if err := deepcopy.Copy(resp, client); err != nil {
+	resp := &Client{ClientEntity: &ClientEntity{}, Workers: client.Workers}
+	if err := deepcopy.Copy(resp.ClientEntity, client.ClientEntity); err != nil {
 		return nil, err
 	}
 
@@ -53,13 +54,13 @@ func BuildClientConfigFromProxyConfig(client *Client, proxyCfgs []*ProxyConfig)
 
 	pxyCfgs := []v1.TypedProxyConfig{}
 	for _, proxyCfg := range proxyCfgs {
-		pxy, err := utils.LoadProxiesFromContent(proxyCfg.Content)
+		pxy, err := proxyCfg.GetTypedProxyConfig()
 		if err != nil {
 			logger.Logger(context.Background()).WithError(err).Errorf("cannot load proxy config, name: [%s]", proxyCfg.Name)
 			continue
 		}
 
-		pxyCfgs = append(pxyCfgs, pxy...)
+		pxyCfgs = append(pxyCfgs, pxy)
 	}
 
 	cliCfg.Proxies = pxyCfgs
@@ -68,7 +69,7 @@ func BuildClientConfigFromProxyConfig(client *Client, proxyCfgs []*ProxyConfig)
 		return nil, err
 	}
 
-	client.ConfigContent = cliCfgBytes
+	resp.ConfigContent = cliCfgBytes
 
-	return client, nil
+	return resp, nil
 }
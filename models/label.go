@@ -0,0 +1,227 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// Label 资源上的 key/value 标签，value 为空时即为 tag
+// proxy 的配置记录会随 client 配置重建，所以用 client id 和 proxy 名称定位 proxy，而不是记录 id
+type Label struct {
+	*gorm.Model
+	*LabelEntity
+}
+
+type LabelEntity struct {
+	UserID       int                    `json:"user_id" gorm:"uniqueIndex:idx_label_resource_key"`
+	TenantID     int                    `json:"tenant_id" gorm:"uniqueIndex:idx_label_resource_key"`
+	ResourceType defs.LabelResourceType `json:"resource_type" gorm:"type:varchar(32);uniqueIndex:idx_label_resource_key"`
+	ResourceID   string                 `json:"resource_id" gorm:"type:varchar(255);uniqueIndex:idx_label_resource_key"`   // proxy 为所在的 client id
+	ResourceName string                 `json:"resource_name" gorm:"type:varchar(255);uniqueIndex:idx_label_resource_key"` // 仅 proxy 使用，为 proxy 名称
+	Key          string                 `json:"key" gorm:"column:label_key;type:varchar(63);uniqueIndex:idx_label_resource_key"`
+	Value        string                 `json:"value" gorm:"column:label_value;type:varchar(63)"`
+}
+
+func (*Label) TableName() string {
+	return "labels"
+}
+
+// LabelResourceRef 定位一个带标签的资源
+type LabelResourceRef struct {
+	ID   string
+	Name string
+}
+
+// LabelFilter 用户保存的 label selector，方便在列表和批量操作中复用
+type LabelFilter struct {
+	*gorm.Model
+	*LabelFilterEntity
+}
+
+type LabelFilterEntity struct {
+	UserID       int                    `json:"user_id" gorm:"uniqueIndex:idx_label_filter_name"`
+	TenantID     int                    `json:"tenant_id" gorm:"uniqueIndex:idx_label_filter_name"`
+	Name         string                 `json:"name" gorm:"type:varchar(255);uniqueIndex:idx_label_filter_name"`
+	ResourceType defs.LabelResourceType `json:"resource_type" gorm:"type:varchar(32);index"`
+	Selector     string                 `json:"selector"`
+}
+
+func (*LabelFilter) TableName() string {
+	return "label_filters"
+}
+
+func (f *LabelFilter) ToPB() *pb.LabelFilter {
+	return &pb.LabelFilter{
+		Name:         lo.ToPtr(f.Name),
+		ResourceType: lo.ToPtr(string(f.ResourceType)),
+		Selector:     lo.ToPtr(f.Selector),
+	}
+}
+
+func ParseLabelResourceType(t string) (defs.LabelResourceType, error) {
+	switch resourceType := defs.LabelResourceType(t); resourceType {
+	case defs.LabelResourceProxy, defs.LabelResourceClient, defs.LabelResourceServer, defs.LabelResourceWorker:
+		return resourceType, nil
+	}
+	return "", fmt.Errorf("invalid resource type [%s], should be one of proxy, client, server, worker", t)
+}
+
+var (
+	labelKeyRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+	labelValueRegexp = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?)?$`)
+)
+
+const labelMaxLength = 63
+
+func ValidateLabelKey(key string) error {
+	if len(key) == 0 || len(key) > labelMaxLength || !labelKeyRegexp.MatchString(key) {
+		return fmt.Errorf("invalid label key [%s]", key)
+	}
+	return nil
+}
+
+func ValidateLabelValue(value string) error {
+	if len(value) > labelMaxLength || !labelValueRegexp.MatchString(value) {
+		return fmt.Errorf("invalid label value [%s]", value)
+	}
+	return nil
+}
+
+type LabelOperator string
+
+const (
+	LabelOperatorEquals       LabelOperator = "="
+	LabelOperatorNotEquals    LabelOperator = "!="
+	LabelOperatorIn           LabelOperator = "in"
+	LabelOperatorNotIn        LabelOperator = "notin"
+	LabelOperatorExists       LabelOperator = "exists"
+	LabelOperatorDoesNotExist LabelOperator = "!"
+)
+
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+// LabelSelector 所有条件同时满足才匹配，空 selector 匹配全部
+type LabelSelector []LabelRequirement
+
+// ParseLabelSelector 解析逗号分隔的条件，支持
+// key=value, key==value, key!=value, key in (a,b), key notin (a,b), key, !key
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	var (
+		result LabelSelector
+		depth  int
+		start  int
+	)
+	selector = strings.TrimSpace(selector)
+	if len(selector) == 0 {
+		return result, nil
+	}
+
+	parts := []string{}
+	for i, ch := range selector {
+		switch ch {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+		if depth < 0 || depth > 1 {
+			return nil, fmt.Errorf("invalid label selector [%s], unbalanced parentheses", selector)
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("invalid label selector [%s], unbalanced parentheses", selector)
+	}
+	parts = append(parts, selector[start:])
+
+	for _, part := range parts {
+		req, err := parseLabelRequirement(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector [%s]: %w", selector, err)
+		}
+		result = append(result, req)
+	}
+	return result, nil
+}
+
+func parseLabelRequirement(part string) (LabelRequirement, error) {
+	var req LabelRequirement
+
+	switch {
+	case strings.HasPrefix(part, "!") && !strings.Contains(part, "="):
+		req = LabelRequirement{Key: strings.TrimSpace(part[1:]), Operator: LabelOperatorDoesNotExist}
+	case strings.Contains(part, "!="):
+		key, value, _ := strings.Cut(part, "!=")
+		req = LabelRequirement{Key: strings.TrimSpace(key), Operator: LabelOperatorNotEquals, Values: []string{strings.TrimSpace(value)}}
+	case strings.Contains(part, "="):
+		key, value, _ := strings.Cut(part, "=")
+		value = strings.TrimPrefix(value, "=")
+		req = LabelRequirement{Key: strings.TrimSpace(key), Operator: LabelOperatorEquals, Values: []string{strings.TrimSpace(value)}}
+	case strings.Contains(part, "("):
+		fields := strings.Fields(part[:strings.Index(part, "(")])
+		if len(fields) != 2 || !strings.HasSuffix(part, ")") {
+			return req, fmt.Errorf("invalid requirement [%s]", part)
+		}
+		op := LabelOperator(fields[1])
+		if op != LabelOperatorIn && op != LabelOperatorNotIn {
+			return req, fmt.Errorf("unknown operator [%s]", fields[1])
+		}
+		values := strings.Split(part[strings.Index(part, "(")+1:len(part)-1], ",")
+		req = LabelRequirement{Key: fields[0], Operator: op, Values: lo.Map(values, func(v string, _ int) string { return strings.TrimSpace(v) })}
+	default:
+		req = LabelRequirement{Key: part, Operator: LabelOperatorExists}
+	}
+
+	if err := ValidateLabelKey(req.Key); err != nil {
+		return req, err
+	}
+	for _, value := range req.Values {
+		if err := ValidateLabelValue(value); err != nil {
+			return req, err
+		}
+	}
+	return req, nil
+}
+
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, req := range s {
+		value, ok := labels[req.Key]
+		switch req.Operator {
+		case LabelOperatorEquals, LabelOperatorIn:
+			if !ok || !lo.Contains(req.Values, value) {
+				return false
+			}
+		case LabelOperatorNotEquals, LabelOperatorNotIn:
+			if ok && lo.Contains(req.Values, value) {
+				return false
+			}
+		case LabelOperatorExists:
+			if !ok {
+				return false
+			}
+		case LabelOperatorDoesNotExist:
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s LabelSelector) Empty() bool {
+	return len(s) == 0
+}
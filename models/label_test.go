@@ -0,0 +1,69 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		want     LabelSelector
+		wantErr  bool
+	}{
+		{name: "empty", selector: "  ", want: nil},
+		{name: "equals", selector: "env=prod", want: LabelSelector{
+			{Key: "env", Operator: LabelOperatorEquals, Values: []string{"prod"}},
+		}},
+		{name: "double equals", selector: "env==prod", want: LabelSelector{
+			{Key: "env", Operator: LabelOperatorEquals, Values: []string{"prod"}},
+		}},
+		{name: "empty value", selector: "env=", want: LabelSelector{
+			{Key: "env", Operator: LabelOperatorEquals, Values: []string{""}},
+		}},
+		{name: "not equals", selector: "env!=prod", want: LabelSelector{
+			{Key: "env", Operator: LabelOperatorNotEquals, Values: []string{"prod"}},
+		}},
+		{name: "in", selector: "env in (prod,staging)", want: LabelSelector{
+			{Key: "env", Operator: LabelOperatorIn, Values: []string{"prod", "staging"}},
+		}},
+		{name: "notin", selector: "env notin (dev)", want: LabelSelector{
+			{Key: "env", Operator: LabelOperatorNotIn, Values: []string{"dev"}},
+		}},
+		{name: "exists", selector: "region/zone", want: LabelSelector{
+			{Key: "region/zone", Operator: LabelOperatorExists},
+		}},
+		{name: "does not exist", selector: "!deprecated", want: LabelSelector{
+			{Key: "deprecated", Operator: LabelOperatorDoesNotExist},
+		}},
+		{name: "whitespace", selector: "  env = prod ,tier in ( web , db ) , ! old ", want: LabelSelector{
+			{Key: "env", Operator: LabelOperatorEquals, Values: []string{"prod"}},
+			{Key: "tier", Operator: LabelOperatorIn, Values: []string{"web", "db"}},
+			{Key: "old", Operator: LabelOperatorDoesNotExist},
+		}},
+		{name: "unclosed parenthesis", selector: "env in (prod", wantErr: true},
+		{name: "unopened parenthesis", selector: "env in prod)", wantErr: true},
+		{name: "nested parenthesis", selector: "env in ((prod))", wantErr: true},
+		{name: "unknown operator", selector: "env has (prod)", wantErr: true},
+		{name: "missing operator", selector: "env (prod)", wantErr: true},
+		{name: "empty key", selector: "=prod", wantErr: true},
+		{name: "empty requirement", selector: "env=prod,,tier", wantErr: true},
+		{name: "invalid key", selector: "-env=prod", wantErr: true},
+		{name: "invalid value", selector: "env=prod stage", wantErr: true},
+		{name: "invalid value in set", selector: "env in (prod,a b)", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLabelSelector(tt.selector)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
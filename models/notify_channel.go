@@ -0,0 +1,79 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// NotifyChannel 用户配置的通知渠道，订阅的事件发生时向渠道发送通知
+type NotifyChannel struct {
+	gorm.Model
+	Name       string                        `json:"name"`
+	UserID     uint32                        `json:"user_id" gorm:"index"`
+	TenantID   uint32                        `json:"tenant_id" gorm:"index"`
+	Type       int32                         `json:"type"`
+	Enabled    bool                          `json:"enabled"`
+	Events     GormArray[int32]              `json:"events"`
+	Webhook    JSON[*pb.NotifyWebhookConfig] `json:"webhook"`
+	Email      JSON[*pb.NotifyEmailConfig]   `json:"email"`
+	Chat       JSON[*pb.NotifyChatConfig]    `json:"chat"`
+	LastError  string                        `json:"last_error"`
+	LastSentAt time.Time                     `json:"last_sent_at"`
+}
+
+func (*NotifyChannel) TableName() string {
+	return "notify_channels"
+}
+
+func (n *NotifyChannel) FromPB(channel *pb.NotifyChannel) *NotifyChannel {
+	n.Name = channel.GetName()
+	n.Type = int32(channel.GetType())
+	n.Enabled = channel.GetEnabled()
+	n.Events = lo.Map(lo.Uniq(channel.GetEvents()), func(e pb.NotifyEventType, _ int) int32 { return int32(e) })
+	n.Webhook = JSON[*pb.NotifyWebhookConfig]{Data: channel.GetWebhook()}
+	n.Email = JSON[*pb.NotifyEmailConfig]{Data: channel.GetEmail()}
+	n.Chat = JSON[*pb.NotifyChatConfig]{Data: channel.GetChat()}
+	return n
+}
+
+func (n *NotifyChannel) ToPB() *pb.NotifyChannel {
+	lastSentAt := int64(0)
+	if !n.LastSentAt.IsZero() {
+		lastSentAt = n.LastSentAt.UnixMilli()
+	}
+	return &pb.NotifyChannel{
+		Id:         lo.ToPtr(uint32(n.ID)),
+		Name:       lo.ToPtr(n.Name),
+		Type:       lo.ToPtr(pb.NotifyChannel_Type(n.Type)),
+		Enabled:    lo.ToPtr(n.Enabled),
+		Events:     lo.Map(n.Events, func(e int32, _ int) pb.NotifyEventType { return pb.NotifyEventType(e) }),
+		Webhook:    n.Webhook.Data,
+		Email:      n.Email.Data,
+		Chat:       n.Chat.Data,
+		LastError:  lo.ToPtr(n.LastError),
+		LastSentAt: lo.ToPtr(lastSentAt),
+	}
+}
+
+// ToSafePB 去掉密码和签名密钥，用于返回给前端
+func (n *NotifyChannel) ToSafePB() *pb.NotifyChannel {
+	channel := n.ToPB()
+	if channel.Webhook != nil {
+		channel.Webhook = proto.Clone(channel.Webhook).(*pb.NotifyWebhookConfig)
+		channel.Webhook.Secret = nil
+	}
+	if channel.Email != nil {
+		channel.Email = proto.Clone(channel.Email).(*pb.NotifyEmailConfig)
+		channel.Email.Password = nil
+	}
+	return channel
+}
+
+func (n *NotifyChannel) Subscribed(eventType pb.NotifyEventType) bool {
+	return slices.Contains(n.Events, int32(eventType))
+}
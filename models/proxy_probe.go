@@ -0,0 +1,99 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// ProxyProbe 隧道的可达性探测配置和最近一次结果，由服务该隧道的 frps 节点执行
+type ProxyProbe struct {
+	*gorm.Model
+	*ProxyProbeEntity
+}
+
+type ProxyProbeEntity struct {
+	ServerID     string `json:"server_id" gorm:"index"`
+	ClientID     string `json:"client_id" gorm:"index"`
+	ProxyName    string `json:"proxy_name" gorm:"index"`
+	UserID       int    `json:"user_id" gorm:"index"`
+	TenantID     int    `json:"tenant_id" gorm:"index"`
+	Type         int32  `json:"type"`
+	Enabled      bool   `json:"enabled" gorm:"index"`
+	HTTPPath     string `json:"http_path"`
+	ExpectStatus int32  `json:"expect_status"`
+	UDPPayload   string `json:"udp_payload"`
+	TimeoutMs    int32  `json:"timeout_ms"`
+	// https 探测时不校验证书，用于自签证书的隧道
+	InsecureSkipVerify bool `json:"insecure_skip_verify"`
+
+	LastSuccess   bool       `json:"last_success"`
+	LastLatencyMs int64      `json:"last_latency_ms"`
+	LastError     string     `json:"last_error"`
+	LastCheckAt   *time.Time `json:"last_check_at"`
+}
+
+func (*ProxyProbe) TableName() string {
+	return "proxy_probes"
+}
+
+// FromPB 只更新探测配置，不覆盖所属隧道和探测结果，探测地址总是由隧道配置推导
+func (p *ProxyProbeEntity) FromPB(probe *pb.ProxyProbe) *ProxyProbeEntity {
+	p.Type = int32(probe.GetType())
+	p.Enabled = probe.GetEnabled()
+	p.HTTPPath = probe.GetHttpPath()
+	p.ExpectStatus = probe.GetExpectStatus()
+	p.UDPPayload = probe.GetUdpPayload()
+	p.TimeoutMs = probe.GetTimeoutMs()
+	p.InsecureSkipVerify = probe.GetInsecureSkipVerify()
+	return p
+}
+
+func (p *ProxyProbe) ToPB() *pb.ProxyProbe {
+	resp := &pb.ProxyProbe{
+		Id:                 lo.ToPtr(uint32(p.ID)),
+		ProxyName:          lo.ToPtr(p.ProxyName),
+		ClientId:           lo.ToPtr(p.ClientID),
+		ServerId:           lo.ToPtr(p.ServerID),
+		Type:               lo.ToPtr(pb.ProxyProbe_Type(p.Type)),
+		Enabled:            lo.ToPtr(p.Enabled),
+		HttpPath:           lo.ToPtr(p.HTTPPath),
+		ExpectStatus:       lo.ToPtr(p.ExpectStatus),
+		UdpPayload:         lo.ToPtr(p.UDPPayload),
+		TimeoutMs:          lo.ToPtr(p.TimeoutMs),
+		LastSuccess:        lo.ToPtr(p.LastSuccess),
+		LastLatencyMs:      lo.ToPtr(p.LastLatencyMs),
+		LastError:          lo.ToPtr(p.LastError),
+		InsecureSkipVerify: lo.ToPtr(p.InsecureSkipVerify),
+	}
+	if p.LastCheckAt != nil {
+		resp.LastCheckTime = lo.ToPtr(p.LastCheckAt.UnixMilli())
+	}
+	return resp
+}
+
+// ProxyProbeResult 每次探测的结果，用于展示延迟历史
+type ProxyProbeResult struct {
+	ID        uint      `gorm:"primarykey"`
+	ProbeID   uint      `gorm:"index"`
+	Success   bool      `json:"success"`
+	LatencyMs int64     `json:"latency_ms"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `gorm:"index"`
+}
+
+func (*ProxyProbeResult) TableName() string {
+	return "proxy_probe_results"
+}
+
+func (r *ProxyProbeResult) ToPB() *pb.ProxyProbeResult {
+	return &pb.ProxyProbeResult{
+		ProbeId:   lo.ToPtr(uint32(r.ProbeID)),
+		Success:   lo.ToPtr(r.Success),
+		LatencyMs: lo.ToPtr(r.LatencyMs),
+		Error:     lo.ToPtr(r.Error),
+		Time:      lo.ToPtr(r.CreatedAt.UnixMilli()),
+	}
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// ProxySchedule 隧道的定时开放配置，由 master 的调度器执行
+// 和标签一样通过子 client id 和 proxy 名称定位，proxy 配置重建后仍然有效
+type ProxySchedule struct {
+	*gorm.Model
+	*ProxyScheduleEntity
+}
+
+type ProxyScheduleEntity struct {
+	ServerID  string                      `json:"server_id" gorm:"index"`
+	ClientID  string                      `json:"client_id" gorm:"uniqueIndex:idx_proxy_schedule"`
+	ProxyName string                      `json:"proxy_name" gorm:"uniqueIndex:idx_proxy_schedule"`
+	UserID    int                         `json:"user_id" gorm:"index"`
+	TenantID  int                         `json:"tenant_id" gorm:"index"`
+	Windows   JSON[[]ProxyScheduleWindow] `json:"windows"`
+	ExpireAt  *time.Time                  `json:"expire_at"`
+}
+
+type ProxyScheduleWindow struct {
+	StartCron string `json:"start_cron"`
+	StopCron  string `json:"stop_cron"`
+}
+
+func (*ProxySchedule) TableName() string {
+	return "proxy_schedules"
+}
+
+// Empty 没有 window 也没有 TTL 时，定时配置不再有意义
+func (s *ProxyScheduleEntity) Empty() bool {
+	return len(s.Windows.Data) == 0 && s.ExpireAt == nil
+}
+
+func (s *ProxySchedule) ToPB() *pb.ProxySchedule {
+	resp := &pb.ProxySchedule{
+		ClientId:  lo.ToPtr(s.ClientID),
+		ServerId:  lo.ToPtr(s.ServerID),
+		ProxyName: lo.ToPtr(s.ProxyName),
+		Windows: lo.Map(s.Windows.Data, func(w ProxyScheduleWindow, _ int) *pb.ProxyScheduleWindow {
+			return &pb.ProxyScheduleWindow{StartCron: lo.ToPtr(w.StartCron), StopCron: lo.ToPtr(w.StopCron)}
+		}),
+	}
+	if s.ExpireAt != nil {
+		resp.ExpireAt = lo.ToPtr(s.ExpireAt.UnixMilli())
+	}
+	return resp
+}
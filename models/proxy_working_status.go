@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// ProxyWorkingStatus client 定时上报的 proxy 运行状态，每个 client 上的 proxy 一条
+type ProxyWorkingStatus struct {
+	*gorm.Model
+	*ProxyWorkingStatusEntity
+}
+
+type ProxyWorkingStatusEntity struct {
+	ClientID       string    `json:"client_id" gorm:"uniqueIndex:idx_proxy_working_status_client_name"`
+	Name           string    `json:"name" gorm:"uniqueIndex:idx_proxy_working_status_client_name"`
+	ServerID       string    `json:"server_id" gorm:"index"`
+	OriginClientID string    `json:"origin_client_id" gorm:"index"`
+	Type           string    `json:"type"`
+	UserID         int       `json:"user_id" gorm:"index"`
+	TenantID       int       `json:"tenant_id" gorm:"index"`
+	Status         string    `json:"status" gorm:"index"`
+	Err            string    `json:"err"`
+	RemoteAddr     string    `json:"remote_addr"`
+	LastChangeAt   time.Time `json:"last_change_at"`
+	LastReportAt   time.Time `json:"last_report_at" gorm:"index"`
+}
+
+func (*ProxyWorkingStatus) TableName() string {
+	return "proxy_working_status"
+}
+
+// SameReport 判断上报内容是否与已保存的一致，不比较时间
+func (p *ProxyWorkingStatusEntity) SameReport(o *ProxyWorkingStatusEntity) bool {
+	return p.ServerID == o.ServerID && p.OriginClientID == o.OriginClientID && p.Type == o.Type &&
+		p.UserID == o.UserID && p.TenantID == o.TenantID && p.Status == o.Status && p.Err == o.Err &&
+		p.RemoteAddr == o.RemoteAddr
+}
+
+// Stale 超过 defs.ProxyStatusStaleDuration 没有上报，client 可能已离线
+func (p *ProxyWorkingStatusEntity) Stale() bool {
+	return time.Since(p.LastReportAt) > defs.ProxyStatusStaleDuration
+}
+
+func (p *ProxyWorkingStatusEntity) ToPB() *pb.ProxyWorkingStatus {
+	return &pb.ProxyWorkingStatus{
+		Name:           lo.ToPtr(p.Name),
+		Type:           lo.ToPtr(p.Type),
+		Status:         lo.ToPtr(lo.Ternary(p.Stale(), defs.ProxyStatusUnknown, p.Status)),
+		Err:            lo.ToPtr(p.Err),
+		RemoteAddr:     lo.ToPtr(p.RemoteAddr),
+		ClientId:       lo.ToPtr(p.ClientID),
+		ServerId:       lo.ToPtr(p.ServerID),
+		OriginClientId: lo.ToPtr(p.OriginClientID),
+		LastChangeTime: lo.ToPtr(p.LastChangeAt.UnixMilli()),
+		LastReportTime: lo.ToPtr(p.LastReportAt.UnixMilli()),
+	}
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// PTYSession 记录通过 master 打开的远程终端会话，录像保存在 master 本地磁盘
+type PTYSession struct {
+	gorm.Model
+	SessionID string    `json:"session_id" gorm:"uniqueIndex"`
+	ClientID  string    `json:"client_id" gorm:"index"`
+	UserID    uint32    `json:"user_id" gorm:"index"`
+	TenantID  uint32    `json:"tenant_id" gorm:"index"`
+	UserName  string    `json:"user_name"`
+	StartedAt time.Time `json:"started_at" gorm:"index"`
+	EndedAt   time.Time `json:"ended_at"`
+	Width     int32     `json:"width"`
+	Height    int32     `json:"height"`
+	Size      int64     `json:"size"`
+	FilePath  string    `json:"file_path"`
+}
+
+func (*PTYSession) TableName() string {
+	return "pty_sessions"
+}
+
+func (s *PTYSession) ToPB() *pb.PTYSession {
+	endedAt := int64(0)
+	if !s.EndedAt.IsZero() {
+		endedAt = s.EndedAt.UnixMilli()
+	}
+	return &pb.PTYSession{
+		Id:        lo.ToPtr(uint32(s.ID)),
+		SessionId: lo.ToPtr(s.SessionID),
+		ClientId:  lo.ToPtr(s.ClientID),
+		UserId:    lo.ToPtr(s.UserID),
+		UserName:  lo.ToPtr(s.UserName),
+		StartedAt: lo.ToPtr(s.StartedAt.UnixMilli()),
+		EndedAt:   lo.ToPtr(endedAt),
+		Width:     lo.ToPtr(s.Width),
+		Height:    lo.ToPtr(s.Height),
+		Size:      lo.ToPtr(s.Size),
+	}
+}
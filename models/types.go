@@ -24,5 +24,8 @@ func (j JSON[T]) Value() (driver.Value, error) {
 }
 
 func (j *JSON[T]) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
 	return json.Unmarshal(value.([]byte), &j)
 }
@@ -22,6 +22,7 @@ type UserInfo interface {
 	GetRole() string
 	GetToken() string
 	GetTenantID() int
+	GetPTYDisabled() bool
 	GetSafeUserInfo() UserEntity
 	IsAdmin() bool
 	Valid() bool
@@ -30,17 +31,19 @@ type UserInfo interface {
 var _ UserInfo = (*UserEntity)(nil)
 
 type UserEntity struct {
-	UserID    int    `json:"user_id" gorm:"primaryKey"`
-	UserName  string `json:"user_name" gorm:"type:varchar(255);uniqueIndex;not null"`
-	Password  string `json:"password"`
-	Email     string `json:"email" gorm:"type:varchar(255);uniqueIndex;not null"`
-	Status    int    `json:"status"`
-	Role      string `json:"role"`
-	TenantID  int    `json:"tenant_id"`
-	Token     string `json:"token"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	UserID   int    `json:"user_id" gorm:"primaryKey"`
+	UserName string `json:"user_name" gorm:"type:varchar(255);uniqueIndex;not null"`
+	Password string `json:"password"`
+	Email    string `json:"email" gorm:"type:varchar(255);uniqueIndex;not null"`
+	Status   int    `json:"status"`
+	Role     string `json:"role"`
+	TenantID int    `json:"tenant_id"`
+	Token    string `json:"token"`
+	// PTYDisabled 由管理员设置，禁止使用远程终端
+	PTYDisabled bool `json:"pty_disabled"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
 
 	Groups []*UserGroup `json:"groups,omitempty" gorm:"many2many:user_group_memberships;"`
 }
@@ -81,6 +84,10 @@ func (u *UserEntity) GetToken() string {
 	return u.Token
 }
 
+func (u *UserEntity) GetPTYDisabled() bool {
+	return u.PTYDisabled
+}
+
 func (u *UserEntity) GetSafeUserInfo() UserEntity {
 	return UserEntity{
 		UserID:   u.UserID,
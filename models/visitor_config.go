@@ -0,0 +1,85 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+type VisitorConfig struct {
+	*gorm.Model
+	*VisitorConfigEntity
+}
+
+type VisitorConfigEntity struct {
+	ServerID       string `json:"server_id" gorm:"index"`
+	ClientID       string `json:"client_id" gorm:"index"`
+	Name           string `json:"name" gorm:"index"`
+	Type           string `json:"type" gorm:"index"`
+	ServerName     string `json:"server_name" gorm:"index"` // 访问的目标 proxy 名称
+	UserID         int    `json:"user_id" gorm:"index"`
+	TenantID       int    `json:"tenant_id" gorm:"index"`
+	OriginClientID string `json:"origin_client_id" gorm:"index"`
+	Content        []byte `json:"content"`
+	Stopped        bool   `json:"stopped" gorm:"index"`
+}
+
+func (*VisitorConfig) TableName() string {
+	return "visitor_config"
+}
+
+func (v *VisitorConfigEntity) FillTypedVisitorConfig(cfg v1.TypedVisitorConfig) error {
+	var err error
+	v.Name = cfg.GetBaseConfig().Name
+	v.Type = cfg.GetBaseConfig().Type
+	v.ServerName = cfg.GetBaseConfig().ServerName
+	v.Content, err = cfg.MarshalJSON()
+	return err
+}
+
+func (v *VisitorConfigEntity) FillClientConfig(cli *ClientEntity) error {
+	if cli == nil {
+		return fmt.Errorf("invalid client, client is nil")
+	}
+	v.ServerID = cli.ServerID
+	v.ClientID = cli.ClientID
+	v.UserID = cli.UserID
+	v.TenantID = cli.TenantID
+	v.OriginClientID = cli.OriginClientID
+	return nil
+}
+
+func (v *VisitorConfigEntity) GetTypedVisitorConfig() (v1.TypedVisitorConfig, error) {
+	var cfg v1.TypedVisitorConfig
+	err := cfg.UnmarshalJSON(v.Content)
+	return cfg, err
+}
+
+func (v *VisitorConfig) GetTypedVisitorConfig() (v1.TypedVisitorConfig, error) {
+	return v.VisitorConfigEntity.GetTypedVisitorConfig()
+}
+
+func (v *VisitorConfig) FillClientConfig(cli *ClientEntity) error {
+	return v.VisitorConfigEntity.FillClientConfig(cli)
+}
+
+func (v *VisitorConfig) FillTypedVisitorConfig(cfg v1.TypedVisitorConfig) error {
+	return v.VisitorConfigEntity.FillTypedVisitorConfig(cfg)
+}
+
+func (v *VisitorConfig) ToPB() *pb.VisitorConfig {
+	return &pb.VisitorConfig{
+		Id:             lo.ToPtr(uint32(v.ID)),
+		Name:           lo.ToPtr(v.Name),
+		Type:           lo.ToPtr(v.Type),
+		ServerName:     lo.ToPtr(v.ServerName),
+		Config:         lo.ToPtr(string(v.Content)),
+		Stopped:        lo.ToPtr(v.Stopped),
+		ServerId:       lo.ToPtr(v.ServerID),
+		ClientId:       lo.ToPtr(v.ClientID),
+		OriginClientId: lo.ToPtr(v.OriginClientID),
+	}
+}
@@ -24,14 +24,18 @@ type Worker struct {
 }
 
 type WorkerEntity struct {
-	ID             string `gorm:"type:varchar(255);uniqueIndex;not null;primaryKey"`
-	Name           string `gorm:"type:varchar(255);index"`
-	UserId         uint32 `gorm:"index"`
-	TenantId       uint32 `gorm:"index"`
-	Socket         JSON[*pb.Socket]
-	CodeEntry      string
-	Code           string
-	ConfigTemplate string
+	ID              string `gorm:"type:varchar(255);uniqueIndex;not null;primaryKey"`
+	Name            string `gorm:"type:varchar(255);index"`
+	UserId          uint32 `gorm:"index"`
+	TenantId        uint32 `gorm:"index"`
+	Socket          JSON[*pb.Socket]
+	CodeEntry       string
+	Code            string
+	ConfigTemplate  string
+	Crons           JSON[[]*pb.WorkerCron]
+	ResourceLimits  JSON[*pb.WorkerResourceLimits]
+	ServiceBindings JSON[[]*pb.WorkerServiceBinding]
+	KVNamespaces    JSON[[]*pb.WorkerKVNamespace]
 }
 
 func (w *Worker) TableName() string {
@@ -47,20 +51,28 @@ func (w *WorkerEntity) FromPB(worker *pb.Worker) *WorkerEntity {
 	w.CodeEntry = worker.GetCodeEntry()
 	w.Code = worker.GetCode()
 	w.ConfigTemplate = worker.GetConfigTemplate()
+	w.Crons = JSON[[]*pb.WorkerCron]{Data: worker.GetCrons()}
+	w.ResourceLimits = JSON[*pb.WorkerResourceLimits]{Data: worker.GetResourceLimits()}
+	w.ServiceBindings = JSON[[]*pb.WorkerServiceBinding]{Data: worker.GetServiceBindings()}
+	w.KVNamespaces = JSON[[]*pb.WorkerKVNamespace]{Data: worker.GetKvNamespaces()}
 
 	return w
 }
 
 func (w *WorkerEntity) ToPB() *pb.Worker {
 	return &pb.Worker{
-		WorkerId:       lo.ToPtr(w.ID),
-		Name:           lo.ToPtr(w.Name),
-		UserId:         lo.ToPtr(uint32(w.UserId)),
-		TenantId:       lo.ToPtr(uint32(w.TenantId)),
-		Socket:         w.Socket.Data,
-		CodeEntry:      lo.ToPtr(w.CodeEntry),
-		Code:           lo.ToPtr(w.Code),
-		ConfigTemplate: lo.ToPtr(w.ConfigTemplate),
+		WorkerId:        lo.ToPtr(w.ID),
+		Name:            lo.ToPtr(w.Name),
+		UserId:          lo.ToPtr(uint32(w.UserId)),
+		TenantId:        lo.ToPtr(uint32(w.TenantId)),
+		Socket:          w.Socket.Data,
+		CodeEntry:       lo.ToPtr(w.CodeEntry),
+		Code:            lo.ToPtr(w.Code),
+		ConfigTemplate:  lo.ToPtr(w.ConfigTemplate),
+		Crons:           w.Crons.Data,
+		ResourceLimits:  w.ResourceLimits.Data,
+		ServiceBindings: w.ServiceBindings.Data,
+		KvNamespaces:    w.KVNamespaces.Data,
 	}
 }
 
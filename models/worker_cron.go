@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// WorkerCronInvocation 记录 client 执行 worker 定时触发的结果
+type WorkerCronInvocation struct {
+	gorm.Model
+	WorkerID    string    `json:"worker_id" gorm:"index"`
+	ClientID    string    `json:"client_id" gorm:"index"`
+	CronID      string    `json:"cron_id" gorm:"index"`
+	Cron        string    `json:"cron"`
+	UserID      uint32    `json:"user_id" gorm:"index"`
+	TenantID    uint32    `json:"tenant_id" gorm:"index"`
+	TriggeredAt time.Time `json:"triggered_at" gorm:"index"`
+	DurationMs  int64     `json:"duration_ms"`
+	Success     bool      `json:"success"`
+	StatusCode  int32     `json:"status_code"`
+	Result      string    `json:"result"`
+	Error       string    `json:"error"`
+}
+
+func (*WorkerCronInvocation) TableName() string {
+	return "worker_cron_invocations"
+}
+
+func (w *WorkerCronInvocation) FromPB(invocation *pb.WorkerCronInvocation) *WorkerCronInvocation {
+	w.WorkerID = invocation.GetWorkerId()
+	w.ClientID = invocation.GetClientId()
+	w.CronID = invocation.GetCronId()
+	w.Cron = invocation.GetCron()
+	w.TriggeredAt = time.UnixMilli(invocation.GetTriggeredAt())
+	w.DurationMs = invocation.GetDurationMs()
+	w.Success = invocation.GetSuccess()
+	w.StatusCode = invocation.GetStatusCode()
+	w.Result = invocation.GetResult()
+	w.Error = invocation.GetError()
+	return w
+}
+
+func (w *WorkerCronInvocation) ToPB() *pb.WorkerCronInvocation {
+	return &pb.WorkerCronInvocation{
+		Id:          lo.ToPtr(uint32(w.ID)),
+		WorkerId:    lo.ToPtr(w.WorkerID),
+		ClientId:    lo.ToPtr(w.ClientID),
+		CronId:      lo.ToPtr(w.CronID),
+		Cron:        lo.ToPtr(w.Cron),
+		TriggeredAt: lo.ToPtr(w.TriggeredAt.UnixMilli()),
+		DurationMs:  lo.ToPtr(w.DurationMs),
+		Success:     lo.ToPtr(w.Success),
+		StatusCode:  lo.ToPtr(w.StatusCode),
+		Result:      lo.ToPtr(w.Result),
+		Error:       lo.ToPtr(w.Error),
+	}
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// WorkerKVEntry client 同步到 master 的 worker kv 数据
+// 同一个 namespace 可能由多个 client 同时写入，按 key 以 Revision 做 last-write-wins，删除的 key 保留为 tombstone
+type WorkerKVEntry struct {
+	gorm.Model
+	Namespace string `json:"namespace" gorm:"type:varchar(255);uniqueIndex:idx_worker_kv_ns_key"`
+	Key       string `json:"key" gorm:"type:varchar(512);uniqueIndex:idx_worker_kv_ns_key"`
+	Value     []byte `json:"value"`
+	Revision  int64  `json:"revision"`
+	Deleted   bool   `json:"deleted"`
+	UserID    uint32 `json:"user_id" gorm:"index"`
+	TenantID  uint32 `json:"tenant_id" gorm:"index"`
+}
+
+func (*WorkerKVEntry) TableName() string {
+	return "worker_kv_entries"
+}
+
+func (e *WorkerKVEntry) ToPB() *pb.WorkerKVEntry {
+	return &pb.WorkerKVEntry{
+		Key:      lo.ToPtr(e.Key),
+		Value:    e.Value,
+		Revision: lo.ToPtr(e.Revision),
+		Deleted:  lo.ToPtr(e.Deleted),
+	}
+}
+
+// MergeWorkerKVEntries 把 client 上传的快照按 key 合并到已保存的数据中
+// toSave 是 client 更新的 key，需要写入数据库，沿用已有记录的 ID
+// toClient 是 master 上更新的 key 和 client 没有的 key，client 需要写入或删除
+func MergeWorkerKVEntries(stored, incoming []*WorkerKVEntry) (toSave, toClient []*WorkerKVEntry) {
+	storedByKey := lo.KeyBy(stored, func(e *WorkerKVEntry) string { return e.Key })
+	seen := map[string]bool{}
+
+	for _, in := range incoming {
+		if seen[in.Key] {
+			continue
+		}
+		seen[in.Key] = true
+
+		old, ok := storedByKey[in.Key]
+		switch {
+		case !ok:
+			if !in.Deleted {
+				toSave = append(toSave, in)
+			}
+		case in.Revision > old.Revision:
+			in.Model = old.Model
+			toSave = append(toSave, in)
+		case old.Revision > in.Revision:
+			toClient = append(toClient, old)
+		}
+	}
+
+	for _, old := range stored {
+		if !seen[old.Key] && !old.Deleted {
+			toClient = append(toClient, old)
+		}
+	}
+	return toSave, toClient
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestMergeWorkerKVEntries(t *testing.T) {
+	stored := []*WorkerKVEntry{
+		{Model: gorm.Model{ID: 1}, Key: "same", Value: []byte("v"), Revision: 100},
+		{Model: gorm.Model{ID: 2}, Key: "client-newer", Value: []byte("old"), Revision: 100},
+		{Model: gorm.Model{ID: 3}, Key: "master-newer", Value: []byte("new"), Revision: 200},
+		{Model: gorm.Model{ID: 4}, Key: "only-master", Value: []byte("x"), Revision: 100},
+		{Model: gorm.Model{ID: 5}, Key: "deleted-on-master", Revision: 300, Deleted: true},
+		{Model: gorm.Model{ID: 6}, Key: "tombstone", Revision: 100, Deleted: true},
+		{Model: gorm.Model{ID: 7}, Key: "deleted-by-client", Value: []byte("y"), Revision: 100},
+	}
+	incoming := []*WorkerKVEntry{
+		{Key: "same", Value: []byte("v"), Revision: 100},
+		{Key: "client-newer", Value: []byte("new"), Revision: 150},
+		{Key: "master-newer", Value: []byte("old"), Revision: 150},
+		{Key: "deleted-on-master", Value: []byte("z"), Revision: 250},
+		{Key: "deleted-by-client", Revision: 200, Deleted: true},
+		{Key: "only-client", Value: []byte("c"), Revision: 100},
+		{Key: "unknown-deleted", Revision: 100, Deleted: true},
+	}
+
+	toSave, toClient := MergeWorkerKVEntries(stored, incoming)
+
+	saved := lo.KeyBy(toSave, func(e *WorkerKVEntry) string { return e.Key })
+	assert.ElementsMatch(t, []string{"client-newer", "deleted-by-client", "only-client"}, lo.Keys(saved))
+	assert.Equal(t, uint(2), saved["client-newer"].ID, "update keeps the stored row id")
+	assert.Equal(t, []byte("new"), saved["client-newer"].Value)
+	assert.True(t, saved["deleted-by-client"].Deleted)
+	assert.Equal(t, uint(0), saved["only-client"].ID)
+
+	sent := lo.KeyBy(toClient, func(e *WorkerKVEntry) string { return e.Key })
+	assert.ElementsMatch(t, []string{"master-newer", "only-master", "deleted-on-master"}, lo.Keys(sent))
+	assert.True(t, sent["deleted-on-master"].Deleted)
+	assert.Equal(t, []byte("new"), sent["master-newer"].Value)
+}
+
+func TestMergeWorkerKVEntriesFromTwoClients(t *testing.T) {
+	// 两个 client 写同一个 tenant namespace，各自只写自己的 key，不会互相覆盖
+	stored, _ := MergeWorkerKVEntries(nil, []*WorkerKVEntry{{Key: "a", Value: []byte("1"), Revision: 100}})
+
+	toSave, toClient := MergeWorkerKVEntries(stored, []*WorkerKVEntry{{Key: "b", Value: []byte("2"), Revision: 110}})
+	assert.Len(t, toSave, 1)
+	assert.Equal(t, "b", toSave[0].Key)
+	assert.Len(t, toClient, 1)
+	assert.Equal(t, "a", toClient[0].Key)
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// WorkerdArtifact 管理员上传到 master 的 workerd 二进制
+type WorkerdArtifact struct {
+	gorm.Model
+	Version  string `json:"version"`
+	OS       string `json:"os" gorm:"index"`
+	Arch     string `json:"arch" gorm:"index"`
+	SHA256   string `json:"sha256"`
+	Size     int64  `json:"size"`
+	FilePath string `json:"file_path"`
+}
+
+func (*WorkerdArtifact) TableName() string {
+	return "workerd_artifacts"
+}
+
+func (a *WorkerdArtifact) ToPB() *pb.WorkerdArtifact {
+	return &pb.WorkerdArtifact{
+		Id:        lo.ToPtr(uint32(a.ID)),
+		Version:   lo.ToPtr(a.Version),
+		Os:        lo.ToPtr(a.OS),
+		Arch:      lo.ToPtr(a.Arch),
+		Sha256:    lo.ToPtr(a.SHA256),
+		Size:      lo.ToPtr(a.Size),
+		CreatedAt: lo.ToPtr(a.CreatedAt.UnixMilli()),
+	}
+}
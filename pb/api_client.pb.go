@@ -21,6 +21,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StartFileTransferRequest_Op int32
+
+const (
+	StartFileTransferRequest_OP_UNSPECIFIED StartFileTransferRequest_Op = 0
+	StartFileTransferRequest_OP_DOWNLOAD    StartFileTransferRequest_Op = 1
+	StartFileTransferRequest_OP_UPLOAD      StartFileTransferRequest_Op = 2
+)
+
+// Enum value maps for StartFileTransferRequest_Op.
+var (
+	StartFileTransferRequest_Op_name = map[int32]string{
+		0: "OP_UNSPECIFIED",
+		1: "OP_DOWNLOAD",
+		2: "OP_UPLOAD",
+	}
+	StartFileTransferRequest_Op_value = map[string]int32{
+		"OP_UNSPECIFIED": 0,
+		"OP_DOWNLOAD":    1,
+		"OP_UPLOAD":      2,
+	}
+)
+
+func (x StartFileTransferRequest_Op) Enum() *StartFileTransferRequest_Op {
+	p := new(StartFileTransferRequest_Op)
+	*p = x
+	return p
+}
+
+func (x StartFileTransferRequest_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StartFileTransferRequest_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_client_proto_enumTypes[0].Descriptor()
+}
+
+func (StartFileTransferRequest_Op) Type() protoreflect.EnumType {
+	return &file_api_client_proto_enumTypes[0]
+}
+
+func (x StartFileTransferRequest_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StartFileTransferRequest_Op.Descriptor instead.
+func (StartFileTransferRequest_Op) EnumDescriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{109, 0}
+}
+
 type InitClientRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
@@ -130,6 +179,7 @@ type ListClientsRequest struct {
 	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
 	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
 	Keyword       *string                `protobuf:"bytes,3,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	LabelSelector *string                `protobuf:"bytes,4,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"` // 例如 env=prod,team=payments
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -185,6 +235,13 @@ func (x *ListClientsRequest) GetKeyword() string {
 	return ""
 }
 
+func (x *ListClientsRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
 type ListClientsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
@@ -924,6 +981,7 @@ type ListProxyConfigsRequest struct {
 	Keyword       *string                `protobuf:"bytes,3,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
 	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
 	ServerId      *string                `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	LabelSelector *string                `protobuf:"bytes,6,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"` // 例如 env=prod,team=payments
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -993,6 +1051,13 @@ func (x *ListProxyConfigsRequest) GetServerId() string {
 	return ""
 }
 
+func (x *ListProxyConfigsRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
 type ListProxyConfigsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
@@ -1446,6 +1511,7 @@ type GetProxyConfigResponse struct {
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	ProxyConfig   *ProxyConfig           `protobuf:"bytes,2,opt,name=proxy_config,json=proxyConfig,proto3,oneof" json:"proxy_config,omitempty"`
 	WorkingStatus *ProxyWorkingStatus    `protobuf:"bytes,3,opt,name=working_status,json=workingStatus,proto3,oneof" json:"working_status,omitempty"`
+	Schedule      *ProxySchedule         `protobuf:"bytes,4,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"` // 没有定时配置时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1501,6 +1567,13 @@ func (x *GetProxyConfigResponse) GetWorkingStatus() *ProxyWorkingStatus {
 	return nil
 }
 
+func (x *GetProxyConfigResponse) GetSchedule() *ProxySchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
 type StopProxyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
@@ -1709,28 +1782,32 @@ func (x *StartProxyResponse) GetStatus() *Status {
 	return nil
 }
 
-type CreateWorkerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
-	Worker        *Worker                `protobuf:"bytes,2,opt,name=worker,proto3,oneof" json:"worker,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// MoveProxyConfigRequest 把 proxy 迁移到其他 client 或 server，target 为空时表示不变
+type MoveProxyConfigRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ClientId       *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId       *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name           *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	TargetClientId *string                `protobuf:"bytes,4,opt,name=target_client_id,json=targetClientId,proto3,oneof" json:"target_client_id,omitempty"` // 目标 client 的原始 id
+	TargetServerId *string                `protobuf:"bytes,5,opt,name=target_server_id,json=targetServerId,proto3,oneof" json:"target_server_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateWorkerRequest) Reset() {
-	*x = CreateWorkerRequest{}
+func (x *MoveProxyConfigRequest) Reset() {
+	*x = MoveProxyConfigRequest{}
 	mi := &file_api_client_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWorkerRequest) String() string {
+func (x *MoveProxyConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWorkerRequest) ProtoMessage() {}
+func (*MoveProxyConfigRequest) ProtoMessage() {}
 
-func (x *CreateWorkerRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveProxyConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1742,47 +1819,68 @@ func (x *CreateWorkerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWorkerRequest.ProtoReflect.Descriptor instead.
-func (*CreateWorkerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveProxyConfigRequest.ProtoReflect.Descriptor instead.
+func (*MoveProxyConfigRequest) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CreateWorkerRequest) GetClientId() string {
+func (x *MoveProxyConfigRequest) GetClientId() string {
 	if x != nil && x.ClientId != nil {
 		return *x.ClientId
 	}
 	return ""
 }
 
-func (x *CreateWorkerRequest) GetWorker() *Worker {
-	if x != nil {
-		return x.Worker
+func (x *MoveProxyConfigRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
 	}
-	return nil
+	return ""
 }
 
-type CreateWorkerResponse struct {
+func (x *MoveProxyConfigRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *MoveProxyConfigRequest) GetTargetClientId() string {
+	if x != nil && x.TargetClientId != nil {
+		return *x.TargetClientId
+	}
+	return ""
+}
+
+func (x *MoveProxyConfigRequest) GetTargetServerId() string {
+	if x != nil && x.TargetServerId != nil {
+		return *x.TargetServerId
+	}
+	return ""
+}
+
+type MoveProxyConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	ProxyConfig   *ProxyConfig           `protobuf:"bytes,2,opt,name=proxy_config,json=proxyConfig,proto3,oneof" json:"proxy_config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWorkerResponse) Reset() {
-	*x = CreateWorkerResponse{}
+func (x *MoveProxyConfigResponse) Reset() {
+	*x = MoveProxyConfigResponse{}
 	mi := &file_api_client_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWorkerResponse) String() string {
+func (x *MoveProxyConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWorkerResponse) ProtoMessage() {}
+func (*MoveProxyConfigResponse) ProtoMessage() {}
 
-func (x *CreateWorkerResponse) ProtoReflect() protoreflect.Message {
+func (x *MoveProxyConfigResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1794,47 +1892,52 @@ func (x *CreateWorkerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWorkerResponse.ProtoReflect.Descriptor instead.
-func (*CreateWorkerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveProxyConfigResponse.ProtoReflect.Descriptor instead.
+func (*MoveProxyConfigResponse) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CreateWorkerResponse) GetStatus() *Status {
+func (x *MoveProxyConfigResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *CreateWorkerResponse) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
+func (x *MoveProxyConfigResponse) GetProxyConfig() *ProxyConfig {
+	if x != nil {
+		return x.ProxyConfig
 	}
-	return ""
+	return nil
 }
 
-type RemoveWorkerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
-	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CloneProxyConfigRequest 复制 proxy 到其他 client 或 server，同一 server 上需要指定新名称
+type CloneProxyConfigRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ClientId       *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId       *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name           *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	TargetClientId *string                `protobuf:"bytes,4,opt,name=target_client_id,json=targetClientId,proto3,oneof" json:"target_client_id,omitempty"` // 目标 client 的原始 id
+	TargetServerId *string                `protobuf:"bytes,5,opt,name=target_server_id,json=targetServerId,proto3,oneof" json:"target_server_id,omitempty"`
+	TargetName     *string                `protobuf:"bytes,6,opt,name=target_name,json=targetName,proto3,oneof" json:"target_name,omitempty"` // 为空时沿用原名称
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RemoveWorkerRequest) Reset() {
-	*x = RemoveWorkerRequest{}
+func (x *CloneProxyConfigRequest) Reset() {
+	*x = CloneProxyConfigRequest{}
 	mi := &file_api_client_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveWorkerRequest) String() string {
+func (x *CloneProxyConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveWorkerRequest) ProtoMessage() {}
+func (*CloneProxyConfigRequest) ProtoMessage() {}
 
-func (x *RemoveWorkerRequest) ProtoReflect() protoreflect.Message {
+func (x *CloneProxyConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1846,46 +1949,75 @@ func (x *RemoveWorkerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveWorkerRequest.ProtoReflect.Descriptor instead.
-func (*RemoveWorkerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloneProxyConfigRequest.ProtoReflect.Descriptor instead.
+func (*CloneProxyConfigRequest) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RemoveWorkerRequest) GetClientId() string {
+func (x *CloneProxyConfigRequest) GetClientId() string {
 	if x != nil && x.ClientId != nil {
 		return *x.ClientId
 	}
 	return ""
 }
 
-func (x *RemoveWorkerRequest) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
+func (x *CloneProxyConfigRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
 	}
 	return ""
 }
 
-type RemoveWorkerResponse struct {
+func (x *CloneProxyConfigRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CloneProxyConfigRequest) GetTargetClientId() string {
+	if x != nil && x.TargetClientId != nil {
+		return *x.TargetClientId
+	}
+	return ""
+}
+
+func (x *CloneProxyConfigRequest) GetTargetServerId() string {
+	if x != nil && x.TargetServerId != nil {
+		return *x.TargetServerId
+	}
+	return ""
+}
+
+func (x *CloneProxyConfigRequest) GetTargetName() string {
+	if x != nil && x.TargetName != nil {
+		return *x.TargetName
+	}
+	return ""
+}
+
+type CloneProxyConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ProxyConfig   *ProxyConfig           `protobuf:"bytes,2,opt,name=proxy_config,json=proxyConfig,proto3,oneof" json:"proxy_config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveWorkerResponse) Reset() {
-	*x = RemoveWorkerResponse{}
+func (x *CloneProxyConfigResponse) Reset() {
+	*x = CloneProxyConfigResponse{}
 	mi := &file_api_client_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveWorkerResponse) String() string {
+func (x *CloneProxyConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveWorkerResponse) ProtoMessage() {}
+func (*CloneProxyConfigResponse) ProtoMessage() {}
 
-func (x *RemoveWorkerResponse) ProtoReflect() protoreflect.Message {
+func (x *CloneProxyConfigResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1897,40 +2029,51 @@ func (x *RemoveWorkerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveWorkerResponse.ProtoReflect.Descriptor instead.
-func (*RemoveWorkerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloneProxyConfigResponse.ProtoReflect.Descriptor instead.
+func (*CloneProxyConfigResponse) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *RemoveWorkerResponse) GetStatus() *Status {
+func (x *CloneProxyConfigResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type UpdateWorkerRequest struct {
+func (x *CloneProxyConfigResponse) GetProxyConfig() *ProxyConfig {
+	if x != nil {
+		return x.ProxyConfig
+	}
+	return nil
+}
+
+type ListProxyStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientIds     []string               `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
-	Worker        *Worker                `protobuf:"bytes,2,opt,name=worker,proto3,oneof" json:"worker,omitempty"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Keyword       *string                `protobuf:"bytes,3,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Status        *string                `protobuf:"bytes,6,opt,name=status,proto3,oneof" json:"status,omitempty"` // running, start error, check failed, unknown 等
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateWorkerRequest) Reset() {
-	*x = UpdateWorkerRequest{}
+func (x *ListProxyStatusRequest) Reset() {
+	*x = ListProxyStatusRequest{}
 	mi := &file_api_client_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWorkerRequest) String() string {
+func (x *ListProxyStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkerRequest) ProtoMessage() {}
+func (*ListProxyStatusRequest) ProtoMessage() {}
 
-func (x *UpdateWorkerRequest) ProtoReflect() protoreflect.Message {
+func (x *ListProxyStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1942,46 +2085,77 @@ func (x *UpdateWorkerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListProxyStatusRequest.ProtoReflect.Descriptor instead.
+func (*ListProxyStatusRequest) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *UpdateWorkerRequest) GetClientIds() []string {
-	if x != nil {
-		return x.ClientIds
+func (x *ListProxyStatusRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateWorkerRequest) GetWorker() *Worker {
-	if x != nil {
-		return x.Worker
+func (x *ListProxyStatusRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type UpdateWorkerResponse struct {
+func (x *ListProxyStatusRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *ListProxyStatusRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ListProxyStatusRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *ListProxyStatusRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+type ListProxyStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Statuses      []*ProxyWorkingStatus  `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	StatusCounts  map[string]int32       `protobuf:"bytes,4,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 各状态的 proxy 数量，不受 status 过滤影响
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateWorkerResponse) Reset() {
-	*x = UpdateWorkerResponse{}
+func (x *ListProxyStatusResponse) Reset() {
+	*x = ListProxyStatusResponse{}
 	mi := &file_api_client_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWorkerResponse) String() string {
+func (x *ListProxyStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkerResponse) ProtoMessage() {}
+func (*ListProxyStatusResponse) ProtoMessage() {}
 
-func (x *UpdateWorkerResponse) ProtoReflect() protoreflect.Message {
+func (x *ListProxyStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1993,40 +2167,63 @@ func (x *UpdateWorkerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkerResponse.ProtoReflect.Descriptor instead.
-func (*UpdateWorkerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListProxyStatusResponse.ProtoReflect.Descriptor instead.
+func (*ListProxyStatusResponse) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UpdateWorkerResponse) GetStatus() *Status {
+func (x *ListProxyStatusResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type RunWorkerRequest struct {
+func (x *ListProxyStatusResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListProxyStatusResponse) GetStatuses() []*ProxyWorkingStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListProxyStatusResponse) GetStatusCounts() map[string]int32 {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+type SetProxyProbeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
-	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Probe         *ProxyProbe            `protobuf:"bytes,4,opt,name=probe,proto3,oneof" json:"probe,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RunWorkerRequest) Reset() {
-	*x = RunWorkerRequest{}
+func (x *SetProxyProbeRequest) Reset() {
+	*x = SetProxyProbeRequest{}
 	mi := &file_api_client_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunWorkerRequest) String() string {
+func (x *SetProxyProbeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunWorkerRequest) ProtoMessage() {}
+func (*SetProxyProbeRequest) ProtoMessage() {}
 
-func (x *RunWorkerRequest) ProtoReflect() protoreflect.Message {
+func (x *SetProxyProbeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2038,46 +2235,61 @@ func (x *RunWorkerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunWorkerRequest.ProtoReflect.Descriptor instead.
-func (*RunWorkerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProxyProbeRequest.ProtoReflect.Descriptor instead.
+func (*SetProxyProbeRequest) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RunWorkerRequest) GetClientId() string {
+func (x *SetProxyProbeRequest) GetClientId() string {
 	if x != nil && x.ClientId != nil {
 		return *x.ClientId
 	}
 	return ""
 }
 
-func (x *RunWorkerRequest) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
+func (x *SetProxyProbeRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
 	}
 	return ""
 }
 
-type RunWorkerResponse struct {
+func (x *SetProxyProbeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SetProxyProbeRequest) GetProbe() *ProxyProbe {
+	if x != nil {
+		return x.Probe
+	}
+	return nil
+}
+
+type SetProxyProbeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Probe         *ProxyProbe            `protobuf:"bytes,2,opt,name=probe,proto3,oneof" json:"probe,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RunWorkerResponse) Reset() {
-	*x = RunWorkerResponse{}
+func (x *SetProxyProbeResponse) Reset() {
+	*x = SetProxyProbeResponse{}
 	mi := &file_api_client_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunWorkerResponse) String() string {
+func (x *SetProxyProbeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunWorkerResponse) ProtoMessage() {}
+func (*SetProxyProbeResponse) ProtoMessage() {}
 
-func (x *RunWorkerResponse) ProtoReflect() protoreflect.Message {
+func (x *SetProxyProbeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2089,40 +2301,48 @@ func (x *RunWorkerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunWorkerResponse.ProtoReflect.Descriptor instead.
-func (*RunWorkerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProxyProbeResponse.ProtoReflect.Descriptor instead.
+func (*SetProxyProbeResponse) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *RunWorkerResponse) GetStatus() *Status {
+func (x *SetProxyProbeResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type StopWorkerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
-	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+func (x *SetProxyProbeResponse) GetProbe() *ProxyProbe {
+	if x != nil {
+		return x.Probe
+	}
+	return nil
+}
+
+type DeleteProxyProbeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopWorkerRequest) Reset() {
-	*x = StopWorkerRequest{}
+func (x *DeleteProxyProbeRequest) Reset() {
+	*x = DeleteProxyProbeRequest{}
 	mi := &file_api_client_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopWorkerRequest) String() string {
+func (x *DeleteProxyProbeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopWorkerRequest) ProtoMessage() {}
+func (*DeleteProxyProbeRequest) ProtoMessage() {}
 
-func (x *StopWorkerRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteProxyProbeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2134,46 +2354,53 @@ func (x *StopWorkerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopWorkerRequest.ProtoReflect.Descriptor instead.
-func (*StopWorkerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteProxyProbeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProxyProbeRequest) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *StopWorkerRequest) GetClientId() string {
+func (x *DeleteProxyProbeRequest) GetClientId() string {
 	if x != nil && x.ClientId != nil {
 		return *x.ClientId
 	}
 	return ""
 }
 
-func (x *StopWorkerRequest) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
+func (x *DeleteProxyProbeRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
 	}
 	return ""
 }
 
-type StopWorkerResponse struct {
+func (x *DeleteProxyProbeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type DeleteProxyProbeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopWorkerResponse) Reset() {
-	*x = StopWorkerResponse{}
+func (x *DeleteProxyProbeResponse) Reset() {
+	*x = DeleteProxyProbeResponse{}
 	mi := &file_api_client_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopWorkerResponse) String() string {
+func (x *DeleteProxyProbeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopWorkerResponse) ProtoMessage() {}
+func (*DeleteProxyProbeResponse) ProtoMessage() {}
 
-func (x *StopWorkerResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteProxyProbeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2185,43 +2412,42 @@ func (x *StopWorkerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopWorkerResponse.ProtoReflect.Descriptor instead.
-func (*StopWorkerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteProxyProbeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProxyProbeResponse) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *StopWorkerResponse) GetStatus() *Status {
+func (x *DeleteProxyProbeResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type ListWorkersRequest struct {
+type GetProxyProbeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	Keyword       *string                `protobuf:"bytes,3,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
-	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
-	ServerId      *string                `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	HistoryLimit  *int32                 `protobuf:"varint,4,opt,name=history_limit,json=historyLimit,proto3,oneof" json:"history_limit,omitempty"` // 默认返回最近 100 条
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWorkersRequest) Reset() {
-	*x = ListWorkersRequest{}
+func (x *GetProxyProbeRequest) Reset() {
+	*x = GetProxyProbeRequest{}
 	mi := &file_api_client_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkersRequest) String() string {
+func (x *GetProxyProbeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkersRequest) ProtoMessage() {}
+func (*GetProxyProbeRequest) ProtoMessage() {}
 
-func (x *ListWorkersRequest) ProtoReflect() protoreflect.Message {
+func (x *GetProxyProbeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2233,69 +2459,62 @@ func (x *ListWorkersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkersRequest.ProtoReflect.Descriptor instead.
-func (*ListWorkersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProxyProbeRequest.ProtoReflect.Descriptor instead.
+func (*GetProxyProbeRequest) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListWorkersRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
-}
-
-func (x *ListWorkersRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *GetProxyProbeRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListWorkersRequest) GetKeyword() string {
-	if x != nil && x.Keyword != nil {
-		return *x.Keyword
+func (x *GetProxyProbeRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
 	}
 	return ""
 }
 
-func (x *ListWorkersRequest) GetClientId() string {
-	if x != nil && x.ClientId != nil {
-		return *x.ClientId
+func (x *GetProxyProbeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *ListWorkersRequest) GetServerId() string {
-	if x != nil && x.ServerId != nil {
-		return *x.ServerId
+func (x *GetProxyProbeRequest) GetHistoryLimit() int32 {
+	if x != nil && x.HistoryLimit != nil {
+		return *x.HistoryLimit
 	}
-	return ""
+	return 0
 }
 
-type ListWorkersResponse struct {
+type GetProxyProbeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
-	Workers       []*Worker              `protobuf:"bytes,3,rep,name=workers,proto3" json:"workers,omitempty"`
+	Probe         *ProxyProbe            `protobuf:"bytes,2,opt,name=probe,proto3,oneof" json:"probe,omitempty"`
+	History       []*ProxyProbeResult    `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"` // 按时间倒序
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWorkersResponse) Reset() {
-	*x = ListWorkersResponse{}
+func (x *GetProxyProbeResponse) Reset() {
+	*x = GetProxyProbeResponse{}
 	mi := &file_api_client_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkersResponse) String() string {
+func (x *GetProxyProbeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkersResponse) ProtoMessage() {}
+func (*GetProxyProbeResponse) ProtoMessage() {}
 
-func (x *ListWorkersResponse) ProtoReflect() protoreflect.Message {
+func (x *GetProxyProbeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2307,56 +2526,56 @@ func (x *ListWorkersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkersResponse.ProtoReflect.Descriptor instead.
-func (*ListWorkersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProxyProbeResponse.ProtoReflect.Descriptor instead.
+func (*GetProxyProbeResponse) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListWorkersResponse) GetStatus() *Status {
+func (x *GetProxyProbeResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *ListWorkersResponse) GetTotal() int32 {
-	if x != nil && x.Total != nil {
-		return *x.Total
+func (x *GetProxyProbeResponse) GetProbe() *ProxyProbe {
+	if x != nil {
+		return x.Probe
 	}
-	return 0
+	return nil
 }
 
-func (x *ListWorkersResponse) GetWorkers() []*Worker {
+func (x *GetProxyProbeResponse) GetHistory() []*ProxyProbeResult {
 	if x != nil {
-		return x.Workers
+		return x.History
 	}
 	return nil
 }
 
-// 为 client 在一个 server 创建ingress
-type CreateWorkerIngressRequest struct {
+type ListProxyProbesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
-	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
-	WorkerId      *string                `protobuf:"bytes,3,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	ClientId      *string                `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWorkerIngressRequest) Reset() {
-	*x = CreateWorkerIngressRequest{}
+func (x *ListProxyProbesRequest) Reset() {
+	*x = ListProxyProbesRequest{}
 	mi := &file_api_client_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWorkerIngressRequest) String() string {
+func (x *ListProxyProbesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWorkerIngressRequest) ProtoMessage() {}
+func (*ListProxyProbesRequest) ProtoMessage() {}
 
-func (x *CreateWorkerIngressRequest) ProtoReflect() protoreflect.Message {
+func (x *ListProxyProbesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2368,53 +2587,62 @@ func (x *CreateWorkerIngressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWorkerIngressRequest.ProtoReflect.Descriptor instead.
-func (*CreateWorkerIngressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListProxyProbesRequest.ProtoReflect.Descriptor instead.
+func (*ListProxyProbesRequest) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CreateWorkerIngressRequest) GetClientId() string {
+func (x *ListProxyProbesRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListProxyProbesRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProxyProbesRequest) GetClientId() string {
 	if x != nil && x.ClientId != nil {
 		return *x.ClientId
 	}
 	return ""
 }
 
-func (x *CreateWorkerIngressRequest) GetServerId() string {
+func (x *ListProxyProbesRequest) GetServerId() string {
 	if x != nil && x.ServerId != nil {
 		return *x.ServerId
 	}
 	return ""
 }
 
-func (x *CreateWorkerIngressRequest) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
-	}
-	return ""
-}
-
-type CreateWorkerIngressResponse struct {
+type ListProxyProbesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Probes        []*ProxyProbe          `protobuf:"bytes,3,rep,name=probes,proto3" json:"probes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateWorkerIngressResponse) Reset() {
-	*x = CreateWorkerIngressResponse{}
+func (x *ListProxyProbesResponse) Reset() {
+	*x = ListProxyProbesResponse{}
 	mi := &file_api_client_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateWorkerIngressResponse) String() string {
+func (x *ListProxyProbesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWorkerIngressResponse) ProtoMessage() {}
+func (*ListProxyProbesResponse) ProtoMessage() {}
 
-func (x *CreateWorkerIngressResponse) ProtoReflect() protoreflect.Message {
+func (x *ListProxyProbesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2426,39 +2654,59 @@ func (x *CreateWorkerIngressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWorkerIngressResponse.ProtoReflect.Descriptor instead.
-func (*CreateWorkerIngressResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListProxyProbesResponse.ProtoReflect.Descriptor instead.
+func (*ListProxyProbesResponse) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CreateWorkerIngressResponse) GetStatus() *Status {
+func (x *ListProxyProbesResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type GetWorkerIngressRequest struct {
+func (x *ListProxyProbesResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListProxyProbesResponse) GetProbes() []*ProxyProbe {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+type SetProxyScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkerId      *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Windows       []*ProxyScheduleWindow `protobuf:"bytes,4,rep,name=windows,proto3" json:"windows,omitempty"`                                   // 覆盖原有的 windows
+	TtlSeconds    *int64                 `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3,oneof" json:"ttl_seconds,omitempty"`    // 大于 0 时立即启动 proxy，到期后自动停止，为 0 时保留原有的 TTL
+	ClearTtl      *bool                  `protobuf:"varint,6,opt,name=clear_ttl,json=clearTtl,proto3,oneof" json:"clear_ttl,omitempty"`          // 取消 TTL，proxy 保持当前状态
+	KeepWindows   *bool                  `protobuf:"varint,7,opt,name=keep_windows,json=keepWindows,proto3,oneof" json:"keep_windows,omitempty"` // 为 true 时忽略 windows，保留原有的 windows，用于只设置 TTL
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkerIngressRequest) Reset() {
-	*x = GetWorkerIngressRequest{}
+func (x *SetProxyScheduleRequest) Reset() {
+	*x = SetProxyScheduleRequest{}
 	mi := &file_api_client_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkerIngressRequest) String() string {
+func (x *SetProxyScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkerIngressRequest) ProtoMessage() {}
+func (*SetProxyScheduleRequest) ProtoMessage() {}
 
-func (x *GetWorkerIngressRequest) ProtoReflect() protoreflect.Message {
+func (x *SetProxyScheduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2470,40 +2718,82 @@ func (x *GetWorkerIngressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkerIngressRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkerIngressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProxyScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetProxyScheduleRequest) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetWorkerIngressRequest) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
+func (x *SetProxyScheduleRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
 	}
 	return ""
 }
 
-type GetWorkerIngressResponse struct {
+func (x *SetProxyScheduleRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *SetProxyScheduleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SetProxyScheduleRequest) GetWindows() []*ProxyScheduleWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *SetProxyScheduleRequest) GetTtlSeconds() int64 {
+	if x != nil && x.TtlSeconds != nil {
+		return *x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *SetProxyScheduleRequest) GetClearTtl() bool {
+	if x != nil && x.ClearTtl != nil {
+		return *x.ClearTtl
+	}
+	return false
+}
+
+func (x *SetProxyScheduleRequest) GetKeepWindows() bool {
+	if x != nil && x.KeepWindows != nil {
+		return *x.KeepWindows
+	}
+	return false
+}
+
+type SetProxyScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	ProxyConfigs  []*ProxyConfig         `protobuf:"bytes,2,rep,name=proxy_configs,json=proxyConfigs,proto3" json:"proxy_configs,omitempty"`
+	Schedule      *ProxySchedule         `protobuf:"bytes,2,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkerIngressResponse) Reset() {
-	*x = GetWorkerIngressResponse{}
+func (x *SetProxyScheduleResponse) Reset() {
+	*x = SetProxyScheduleResponse{}
 	mi := &file_api_client_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkerIngressResponse) String() string {
+func (x *SetProxyScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkerIngressResponse) ProtoMessage() {}
+func (*SetProxyScheduleResponse) ProtoMessage() {}
 
-func (x *GetWorkerIngressResponse) ProtoReflect() protoreflect.Message {
+func (x *SetProxyScheduleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2515,46 +2805,48 @@ func (x *GetWorkerIngressResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkerIngressResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkerIngressResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetProxyScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetProxyScheduleResponse) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetWorkerIngressResponse) GetStatus() *Status {
+func (x *SetProxyScheduleResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *GetWorkerIngressResponse) GetProxyConfigs() []*ProxyConfig {
+func (x *SetProxyScheduleResponse) GetSchedule() *ProxySchedule {
 	if x != nil {
-		return x.ProxyConfigs
+		return x.Schedule
 	}
 	return nil
 }
 
-type GetWorkerRequest struct {
+type DeleteProxyScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkerId      *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkerRequest) Reset() {
-	*x = GetWorkerRequest{}
+func (x *DeleteProxyScheduleRequest) Reset() {
+	*x = DeleteProxyScheduleRequest{}
 	mi := &file_api_client_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkerRequest) String() string {
+func (x *DeleteProxyScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkerRequest) ProtoMessage() {}
+func (*DeleteProxyScheduleRequest) ProtoMessage() {}
 
-func (x *GetWorkerRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteProxyScheduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2566,41 +2858,53 @@ func (x *GetWorkerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkerRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteProxyScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProxyScheduleRequest) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetWorkerRequest) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
+func (x *DeleteProxyScheduleRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
 	}
 	return ""
 }
 
-type GetWorkerResponse struct {
+func (x *DeleteProxyScheduleRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *DeleteProxyScheduleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type DeleteProxyScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	Worker        *Worker                `protobuf:"bytes,2,opt,name=worker,proto3,oneof" json:"worker,omitempty"`
-	Clients       []*Client              `protobuf:"bytes,3,rep,name=clients,proto3" json:"clients,omitempty"` // worker 已经部署到的 client
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkerResponse) Reset() {
-	*x = GetWorkerResponse{}
+func (x *DeleteProxyScheduleResponse) Reset() {
+	*x = DeleteProxyScheduleResponse{}
 	mi := &file_api_client_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkerResponse) String() string {
+func (x *DeleteProxyScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkerResponse) ProtoMessage() {}
+func (*DeleteProxyScheduleResponse) ProtoMessage() {}
 
-func (x *GetWorkerResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteProxyScheduleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_client_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2612,12 +2916,1887 @@ func (x *GetWorkerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkerResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteProxyScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProxyScheduleResponse) Descriptor() ([]byte, []int) {
 	return file_api_client_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetWorkerResponse) GetStatus() *Status {
+func (x *DeleteProxyScheduleResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListVisitorConfigsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Keyword       *string                `protobuf:"bytes,3,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVisitorConfigsRequest) Reset() {
+	*x = ListVisitorConfigsRequest{}
+	mi := &file_api_client_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVisitorConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVisitorConfigsRequest) ProtoMessage() {}
+
+func (x *ListVisitorConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVisitorConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListVisitorConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListVisitorConfigsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListVisitorConfigsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListVisitorConfigsRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *ListVisitorConfigsRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ListVisitorConfigsRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+type ListVisitorConfigsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total          *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	VisitorConfigs []*VisitorConfig       `protobuf:"bytes,3,rep,name=visitor_configs,json=visitorConfigs,proto3" json:"visitor_configs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListVisitorConfigsResponse) Reset() {
+	*x = ListVisitorConfigsResponse{}
+	mi := &file_api_client_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVisitorConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVisitorConfigsResponse) ProtoMessage() {}
+
+func (x *ListVisitorConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVisitorConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListVisitorConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListVisitorConfigsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListVisitorConfigsResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListVisitorConfigsResponse) GetVisitorConfigs() []*VisitorConfig {
+	if x != nil {
+		return x.VisitorConfigs
+	}
+	return nil
+}
+
+type CreateVisitorConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Config        []byte                 `protobuf:"bytes,3,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	Overwrite     *bool                  `protobuf:"varint,4,opt,name=overwrite,proto3,oneof" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVisitorConfigRequest) Reset() {
+	*x = CreateVisitorConfigRequest{}
+	mi := &file_api_client_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVisitorConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVisitorConfigRequest) ProtoMessage() {}
+
+func (x *CreateVisitorConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVisitorConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateVisitorConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateVisitorConfigRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *CreateVisitorConfigRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateVisitorConfigRequest) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateVisitorConfigRequest) GetOverwrite() bool {
+	if x != nil && x.Overwrite != nil {
+		return *x.Overwrite
+	}
+	return false
+}
+
+type CreateVisitorConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVisitorConfigResponse) Reset() {
+	*x = CreateVisitorConfigResponse{}
+	mi := &file_api_client_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVisitorConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVisitorConfigResponse) ProtoMessage() {}
+
+func (x *CreateVisitorConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVisitorConfigResponse.ProtoReflect.Descriptor instead.
+func (*CreateVisitorConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateVisitorConfigResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DeleteVisitorConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVisitorConfigRequest) Reset() {
+	*x = DeleteVisitorConfigRequest{}
+	mi := &file_api_client_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVisitorConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVisitorConfigRequest) ProtoMessage() {}
+
+func (x *DeleteVisitorConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVisitorConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVisitorConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteVisitorConfigRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *DeleteVisitorConfigRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *DeleteVisitorConfigRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type DeleteVisitorConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVisitorConfigResponse) Reset() {
+	*x = DeleteVisitorConfigResponse{}
+	mi := &file_api_client_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVisitorConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVisitorConfigResponse) ProtoMessage() {}
+
+func (x *DeleteVisitorConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVisitorConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVisitorConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteVisitorConfigResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type UpdateVisitorConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Config        []byte                 `protobuf:"bytes,4,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVisitorConfigRequest) Reset() {
+	*x = UpdateVisitorConfigRequest{}
+	mi := &file_api_client_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVisitorConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVisitorConfigRequest) ProtoMessage() {}
+
+func (x *UpdateVisitorConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVisitorConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVisitorConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateVisitorConfigRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *UpdateVisitorConfigRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *UpdateVisitorConfigRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateVisitorConfigRequest) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type UpdateVisitorConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVisitorConfigResponse) Reset() {
+	*x = UpdateVisitorConfigResponse{}
+	mi := &file_api_client_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVisitorConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVisitorConfigResponse) ProtoMessage() {}
+
+func (x *UpdateVisitorConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVisitorConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVisitorConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UpdateVisitorConfigResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetVisitorConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVisitorConfigRequest) Reset() {
+	*x = GetVisitorConfigRequest{}
+	mi := &file_api_client_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitorConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitorConfigRequest) ProtoMessage() {}
+
+func (x *GetVisitorConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisitorConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetVisitorConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetVisitorConfigRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *GetVisitorConfigRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *GetVisitorConfigRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type GetVisitorConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	VisitorConfig *VisitorConfig         `protobuf:"bytes,2,opt,name=visitor_config,json=visitorConfig,proto3,oneof" json:"visitor_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVisitorConfigResponse) Reset() {
+	*x = GetVisitorConfigResponse{}
+	mi := &file_api_client_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitorConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitorConfigResponse) ProtoMessage() {}
+
+func (x *GetVisitorConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisitorConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetVisitorConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetVisitorConfigResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetVisitorConfigResponse) GetVisitorConfig() *VisitorConfig {
+	if x != nil {
+		return x.VisitorConfig
+	}
+	return nil
+}
+
+type StopVisitorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopVisitorRequest) Reset() {
+	*x = StopVisitorRequest{}
+	mi := &file_api_client_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopVisitorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopVisitorRequest) ProtoMessage() {}
+
+func (x *StopVisitorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopVisitorRequest.ProtoReflect.Descriptor instead.
+func (*StopVisitorRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *StopVisitorRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *StopVisitorRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *StopVisitorRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type StopVisitorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopVisitorResponse) Reset() {
+	*x = StopVisitorResponse{}
+	mi := &file_api_client_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopVisitorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopVisitorResponse) ProtoMessage() {}
+
+func (x *StopVisitorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopVisitorResponse.ProtoReflect.Descriptor instead.
+func (*StopVisitorResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *StopVisitorResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type StartVisitorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVisitorRequest) Reset() {
+	*x = StartVisitorRequest{}
+	mi := &file_api_client_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisitorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisitorRequest) ProtoMessage() {}
+
+func (x *StartVisitorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisitorRequest.ProtoReflect.Descriptor instead.
+func (*StartVisitorRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StartVisitorRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *StartVisitorRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *StartVisitorRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type StartVisitorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVisitorResponse) Reset() {
+	*x = StartVisitorResponse{}
+	mi := &file_api_client_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisitorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisitorResponse) ProtoMessage() {}
+
+func (x *StartVisitorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisitorResponse.ProtoReflect.Descriptor instead.
+func (*StartVisitorResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StartVisitorResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GrantVisitorAccessRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientId        *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"` // 被访问的 proxy 所在的 client
+	ServerId        *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	ProxyName       *string                `protobuf:"bytes,3,opt,name=proxy_name,json=proxyName,proto3,oneof" json:"proxy_name,omitempty"`
+	VisitorClientId *string                `protobuf:"bytes,4,opt,name=visitor_client_id,json=visitorClientId,proto3,oneof" json:"visitor_client_id,omitempty"` // 创建 visitor 的 client
+	VisitorName     *string                `protobuf:"bytes,5,opt,name=visitor_name,json=visitorName,proto3,oneof" json:"visitor_name,omitempty"`
+	BindAddr        *string                `protobuf:"bytes,6,opt,name=bind_addr,json=bindAddr,proto3,oneof" json:"bind_addr,omitempty"`
+	BindPort        *int32                 `protobuf:"varint,7,opt,name=bind_port,json=bindPort,proto3,oneof" json:"bind_port,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GrantVisitorAccessRequest) Reset() {
+	*x = GrantVisitorAccessRequest{}
+	mi := &file_api_client_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantVisitorAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantVisitorAccessRequest) ProtoMessage() {}
+
+func (x *GrantVisitorAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantVisitorAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantVisitorAccessRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GrantVisitorAccessRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *GrantVisitorAccessRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *GrantVisitorAccessRequest) GetProxyName() string {
+	if x != nil && x.ProxyName != nil {
+		return *x.ProxyName
+	}
+	return ""
+}
+
+func (x *GrantVisitorAccessRequest) GetVisitorClientId() string {
+	if x != nil && x.VisitorClientId != nil {
+		return *x.VisitorClientId
+	}
+	return ""
+}
+
+func (x *GrantVisitorAccessRequest) GetVisitorName() string {
+	if x != nil && x.VisitorName != nil {
+		return *x.VisitorName
+	}
+	return ""
+}
+
+func (x *GrantVisitorAccessRequest) GetBindAddr() string {
+	if x != nil && x.BindAddr != nil {
+		return *x.BindAddr
+	}
+	return ""
+}
+
+func (x *GrantVisitorAccessRequest) GetBindPort() int32 {
+	if x != nil && x.BindPort != nil {
+		return *x.BindPort
+	}
+	return 0
+}
+
+type GrantVisitorAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	VisitorConfig *VisitorConfig         `protobuf:"bytes,2,opt,name=visitor_config,json=visitorConfig,proto3,oneof" json:"visitor_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantVisitorAccessResponse) Reset() {
+	*x = GrantVisitorAccessResponse{}
+	mi := &file_api_client_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantVisitorAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantVisitorAccessResponse) ProtoMessage() {}
+
+func (x *GrantVisitorAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantVisitorAccessResponse.ProtoReflect.Descriptor instead.
+func (*GrantVisitorAccessResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GrantVisitorAccessResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GrantVisitorAccessResponse) GetVisitorConfig() *VisitorConfig {
+	if x != nil {
+		return x.VisitorConfig
+	}
+	return nil
+}
+
+type CreateWorkerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	Worker        *Worker                `protobuf:"bytes,2,opt,name=worker,proto3,oneof" json:"worker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkerRequest) Reset() {
+	*x = CreateWorkerRequest{}
+	mi := &file_api_client_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkerRequest) ProtoMessage() {}
+
+func (x *CreateWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkerRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateWorkerRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *CreateWorkerRequest) GetWorker() *Worker {
+	if x != nil {
+		return x.Worker
+	}
+	return nil
+}
+
+type CreateWorkerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkerResponse) Reset() {
+	*x = CreateWorkerResponse{}
+	mi := &file_api_client_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkerResponse) ProtoMessage() {}
+
+func (x *CreateWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkerResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateWorkerResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateWorkerResponse) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+type RemoveWorkerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWorkerRequest) Reset() {
+	*x = RemoveWorkerRequest{}
+	mi := &file_api_client_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWorkerRequest) ProtoMessage() {}
+
+func (x *RemoveWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWorkerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveWorkerRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *RemoveWorkerRequest) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+type RemoveWorkerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWorkerResponse) Reset() {
+	*x = RemoveWorkerResponse{}
+	mi := &file_api_client_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWorkerResponse) ProtoMessage() {}
+
+func (x *RemoveWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWorkerResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RemoveWorkerResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type UpdateWorkerRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ClientIds            []string               `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	Worker               *Worker                `protobuf:"bytes,2,opt,name=worker,proto3,oneof" json:"worker,omitempty"`
+	ClearCrons           *bool                  `protobuf:"varint,3,opt,name=clear_crons,json=clearCrons,proto3,oneof" json:"clear_crons,omitempty"`                                 // worker.crons 为空时，是否清空已有的定时触发
+	ClearServiceBindings *bool                  `protobuf:"varint,4,opt,name=clear_service_bindings,json=clearServiceBindings,proto3,oneof" json:"clear_service_bindings,omitempty"` // worker.service_bindings 为空时，是否清空已有的服务绑定
+	ClearKvNamespaces    *bool                  `protobuf:"varint,5,opt,name=clear_kv_namespaces,json=clearKvNamespaces,proto3,oneof" json:"clear_kv_namespaces,omitempty"`          // worker.kv_namespaces 为空时，是否清空已有的 kv 绑定
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateWorkerRequest) Reset() {
+	*x = UpdateWorkerRequest{}
+	mi := &file_api_client_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkerRequest) ProtoMessage() {}
+
+func (x *UpdateWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateWorkerRequest) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+func (x *UpdateWorkerRequest) GetWorker() *Worker {
+	if x != nil {
+		return x.Worker
+	}
+	return nil
+}
+
+func (x *UpdateWorkerRequest) GetClearCrons() bool {
+	if x != nil && x.ClearCrons != nil {
+		return *x.ClearCrons
+	}
+	return false
+}
+
+func (x *UpdateWorkerRequest) GetClearServiceBindings() bool {
+	if x != nil && x.ClearServiceBindings != nil {
+		return *x.ClearServiceBindings
+	}
+	return false
+}
+
+func (x *UpdateWorkerRequest) GetClearKvNamespaces() bool {
+	if x != nil && x.ClearKvNamespaces != nil {
+		return *x.ClearKvNamespaces
+	}
+	return false
+}
+
+type UpdateWorkerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkerResponse) Reset() {
+	*x = UpdateWorkerResponse{}
+	mi := &file_api_client_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkerResponse) ProtoMessage() {}
+
+func (x *UpdateWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateWorkerResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type RunWorkerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunWorkerRequest) Reset() {
+	*x = RunWorkerRequest{}
+	mi := &file_api_client_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunWorkerRequest) ProtoMessage() {}
+
+func (x *RunWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunWorkerRequest.ProtoReflect.Descriptor instead.
+func (*RunWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RunWorkerRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *RunWorkerRequest) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+type RunWorkerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunWorkerResponse) Reset() {
+	*x = RunWorkerResponse{}
+	mi := &file_api_client_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunWorkerResponse) ProtoMessage() {}
+
+func (x *RunWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunWorkerResponse.ProtoReflect.Descriptor instead.
+func (*RunWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RunWorkerResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type StopWorkerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopWorkerRequest) Reset() {
+	*x = StopWorkerRequest{}
+	mi := &file_api_client_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopWorkerRequest) ProtoMessage() {}
+
+func (x *StopWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopWorkerRequest.ProtoReflect.Descriptor instead.
+func (*StopWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *StopWorkerRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *StopWorkerRequest) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+type StopWorkerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopWorkerResponse) Reset() {
+	*x = StopWorkerResponse{}
+	mi := &file_api_client_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopWorkerResponse) ProtoMessage() {}
+
+func (x *StopWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopWorkerResponse.ProtoReflect.Descriptor instead.
+func (*StopWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *StopWorkerResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListWorkersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	Keyword       *string                `protobuf:"bytes,3,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	LabelSelector *string                `protobuf:"bytes,6,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"` // 例如 env=prod,team=payments
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkersRequest) Reset() {
+	*x = ListWorkersRequest{}
+	mi := &file_api_client_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkersRequest) ProtoMessage() {}
+
+func (x *ListWorkersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkersRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkersRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListWorkersRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListWorkersRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWorkersRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *ListWorkersRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ListWorkersRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *ListWorkersRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+type ListWorkersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Workers       []*Worker              `protobuf:"bytes,3,rep,name=workers,proto3" json:"workers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkersResponse) Reset() {
+	*x = ListWorkersResponse{}
+	mi := &file_api_client_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkersResponse) ProtoMessage() {}
+
+func (x *ListWorkersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkersResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkersResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListWorkersResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListWorkersResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListWorkersResponse) GetWorkers() []*Worker {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+// 为 client 在一个 server 创建ingress
+type CreateWorkerIngressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	WorkerId      *string                `protobuf:"bytes,3,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkerIngressRequest) Reset() {
+	*x = CreateWorkerIngressRequest{}
+	mi := &file_api_client_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkerIngressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkerIngressRequest) ProtoMessage() {}
+
+func (x *CreateWorkerIngressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkerIngressRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkerIngressRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateWorkerIngressRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *CreateWorkerIngressRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *CreateWorkerIngressRequest) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+type CreateWorkerIngressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkerIngressResponse) Reset() {
+	*x = CreateWorkerIngressResponse{}
+	mi := &file_api_client_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkerIngressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkerIngressResponse) ProtoMessage() {}
+
+func (x *CreateWorkerIngressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkerIngressResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkerIngressResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateWorkerIngressResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetWorkerIngressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkerId      *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerIngressRequest) Reset() {
+	*x = GetWorkerIngressRequest{}
+	mi := &file_api_client_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerIngressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerIngressRequest) ProtoMessage() {}
+
+func (x *GetWorkerIngressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerIngressRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkerIngressRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetWorkerIngressRequest) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+type GetWorkerIngressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	ProxyConfigs  []*ProxyConfig         `protobuf:"bytes,2,rep,name=proxy_configs,json=proxyConfigs,proto3" json:"proxy_configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerIngressResponse) Reset() {
+	*x = GetWorkerIngressResponse{}
+	mi := &file_api_client_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerIngressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerIngressResponse) ProtoMessage() {}
+
+func (x *GetWorkerIngressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerIngressResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkerIngressResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetWorkerIngressResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetWorkerIngressResponse) GetProxyConfigs() []*ProxyConfig {
+	if x != nil {
+		return x.ProxyConfigs
+	}
+	return nil
+}
+
+type GetWorkerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkerId      *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerRequest) Reset() {
+	*x = GetWorkerRequest{}
+	mi := &file_api_client_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerRequest) ProtoMessage() {}
+
+func (x *GetWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetWorkerRequest) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+type GetWorkerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Worker        *Worker                `protobuf:"bytes,2,opt,name=worker,proto3,oneof" json:"worker,omitempty"`
+	Clients       []*Client              `protobuf:"bytes,3,rep,name=clients,proto3" json:"clients,omitempty"` // worker 已经部署到的 client
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerResponse) Reset() {
+	*x = GetWorkerResponse{}
+	mi := &file_api_client_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerResponse) ProtoMessage() {}
+
+func (x *GetWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetWorkerResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
@@ -2626,40 +4805,1227 @@ func (x *GetWorkerResponse) GetStatus() *Status {
 
 func (x *GetWorkerResponse) GetWorker() *Worker {
 	if x != nil {
-		return x.Worker
+		return x.Worker
+	}
+	return nil
+}
+
+func (x *GetWorkerResponse) GetClients() []*Client {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type GetWorkerStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkerId      *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerStatusRequest) Reset() {
+	*x = GetWorkerStatusRequest{}
+	mi := &file_api_client_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerStatusRequest) ProtoMessage() {}
+
+func (x *GetWorkerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetWorkerStatusRequest) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+type GetWorkerStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	WorkerStatus  map[string]string      `protobuf:"bytes,2,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // client_id -> status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerStatusResponse) Reset() {
+	*x = GetWorkerStatusResponse{}
+	mi := &file_api_client_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerStatusResponse) ProtoMessage() {}
+
+func (x *GetWorkerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetWorkerStatusResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetWorkerStatusResponse) GetWorkerStatus() map[string]string {
+	if x != nil {
+		return x.WorkerStatus
+	}
+	return nil
+}
+
+type InstallWorkerdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	DownloadUrl   *string                `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3,oneof" json:"download_url,omitempty"`
+	ArtifactId    *uint32                `protobuf:"varint,3,opt,name=artifact_id,json=artifactId,proto3,oneof" json:"artifact_id,omitempty"` // 从 master 托管的 workerd 安装
+	Sha256        *string                `protobuf:"bytes,4,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`                            // 安装前校验下载文件
+	Version       *string                `protobuf:"bytes,5,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallWorkerdRequest) Reset() {
+	*x = InstallWorkerdRequest{}
+	mi := &file_api_client_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallWorkerdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallWorkerdRequest) ProtoMessage() {}
+
+func (x *InstallWorkerdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallWorkerdRequest.ProtoReflect.Descriptor instead.
+func (*InstallWorkerdRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *InstallWorkerdRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *InstallWorkerdRequest) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *InstallWorkerdRequest) GetArtifactId() uint32 {
+	if x != nil && x.ArtifactId != nil {
+		return *x.ArtifactId
+	}
+	return 0
+}
+
+func (x *InstallWorkerdRequest) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+func (x *InstallWorkerdRequest) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return ""
+}
+
+type InstallWorkerdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallWorkerdResponse) Reset() {
+	*x = InstallWorkerdResponse{}
+	mi := &file_api_client_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallWorkerdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallWorkerdResponse) ProtoMessage() {}
+
+func (x *InstallWorkerdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallWorkerdResponse.ProtoReflect.Descriptor instead.
+func (*InstallWorkerdResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *InstallWorkerdResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type RedeployWorkerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkerId      *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	ClientIds     []string               `protobuf:"bytes,2,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeployWorkerRequest) Reset() {
+	*x = RedeployWorkerRequest{}
+	mi := &file_api_client_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeployWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeployWorkerRequest) ProtoMessage() {}
+
+func (x *RedeployWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeployWorkerRequest.ProtoReflect.Descriptor instead.
+func (*RedeployWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RedeployWorkerRequest) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+func (x *RedeployWorkerRequest) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+type RedeployWorkerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeployWorkerResponse) Reset() {
+	*x = RedeployWorkerResponse{}
+	mi := &file_api_client_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeployWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeployWorkerResponse) ProtoMessage() {}
+
+func (x *RedeployWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeployWorkerResponse.ProtoReflect.Descriptor instead.
+func (*RedeployWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RedeployWorkerResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListWorkerCronInvocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkerId      *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	CronId        *string                `protobuf:"bytes,2,opt,name=cron_id,json=cronId,proto3,oneof" json:"cron_id,omitempty"`
+	Page          *int32                 `protobuf:"varint,3,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkerCronInvocationsRequest) Reset() {
+	*x = ListWorkerCronInvocationsRequest{}
+	mi := &file_api_client_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkerCronInvocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkerCronInvocationsRequest) ProtoMessage() {}
+
+func (x *ListWorkerCronInvocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkerCronInvocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkerCronInvocationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListWorkerCronInvocationsRequest) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+func (x *ListWorkerCronInvocationsRequest) GetCronId() string {
+	if x != nil && x.CronId != nil {
+		return *x.CronId
+	}
+	return ""
+}
+
+func (x *ListWorkerCronInvocationsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListWorkerCronInvocationsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListWorkerCronInvocationsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Status        *Status                 `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                  `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Invocations   []*WorkerCronInvocation `protobuf:"bytes,3,rep,name=invocations,proto3" json:"invocations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkerCronInvocationsResponse) Reset() {
+	*x = ListWorkerCronInvocationsResponse{}
+	mi := &file_api_client_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkerCronInvocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkerCronInvocationsResponse) ProtoMessage() {}
+
+func (x *ListWorkerCronInvocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkerCronInvocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkerCronInvocationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListWorkerCronInvocationsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListWorkerCronInvocationsResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListWorkerCronInvocationsResponse) GetInvocations() []*WorkerCronInvocation {
+	if x != nil {
+		return x.Invocations
+	}
+	return nil
+}
+
+type UploadWorkerdArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Artifact      *WorkerdArtifact       `protobuf:"bytes,2,opt,name=artifact,proto3,oneof" json:"artifact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadWorkerdArtifactResponse) Reset() {
+	*x = UploadWorkerdArtifactResponse{}
+	mi := &file_api_client_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadWorkerdArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadWorkerdArtifactResponse) ProtoMessage() {}
+
+func (x *UploadWorkerdArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadWorkerdArtifactResponse.ProtoReflect.Descriptor instead.
+func (*UploadWorkerdArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UploadWorkerdArtifactResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UploadWorkerdArtifactResponse) GetArtifact() *WorkerdArtifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+type ListWorkerdArtifactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Os            *string                `protobuf:"bytes,1,opt,name=os,proto3,oneof" json:"os,omitempty"`
+	Arch          *string                `protobuf:"bytes,2,opt,name=arch,proto3,oneof" json:"arch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkerdArtifactsRequest) Reset() {
+	*x = ListWorkerdArtifactsRequest{}
+	mi := &file_api_client_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkerdArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkerdArtifactsRequest) ProtoMessage() {}
+
+func (x *ListWorkerdArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkerdArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkerdArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListWorkerdArtifactsRequest) GetOs() string {
+	if x != nil && x.Os != nil {
+		return *x.Os
+	}
+	return ""
+}
+
+func (x *ListWorkerdArtifactsRequest) GetArch() string {
+	if x != nil && x.Arch != nil {
+		return *x.Arch
+	}
+	return ""
+}
+
+type ListWorkerdArtifactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Artifacts     []*WorkerdArtifact     `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkerdArtifactsResponse) Reset() {
+	*x = ListWorkerdArtifactsResponse{}
+	mi := &file_api_client_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkerdArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkerdArtifactsResponse) ProtoMessage() {}
+
+func (x *ListWorkerdArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkerdArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkerdArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListWorkerdArtifactsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListWorkerdArtifactsResponse) GetArtifacts() []*WorkerdArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+type DeleteWorkerdArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkerdArtifactRequest) Reset() {
+	*x = DeleteWorkerdArtifactRequest{}
+	mi := &file_api_client_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkerdArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkerdArtifactRequest) ProtoMessage() {}
+
+func (x *DeleteWorkerdArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkerdArtifactRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkerdArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteWorkerdArtifactRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type DeleteWorkerdArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkerdArtifactResponse) Reset() {
+	*x = DeleteWorkerdArtifactResponse{}
+	mi := &file_api_client_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkerdArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkerdArtifactResponse) ProtoMessage() {}
+
+func (x *DeleteWorkerdArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkerdArtifactResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkerdArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DeleteWorkerdArtifactResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListPTYSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	Page          *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	LiveOnly      *bool                  `protobuf:"varint,4,opt,name=live_only,json=liveOnly,proto3,oneof" json:"live_only,omitempty"` // 只返回正在运行的会话，不分页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPTYSessionsRequest) Reset() {
+	*x = ListPTYSessionsRequest{}
+	mi := &file_api_client_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPTYSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPTYSessionsRequest) ProtoMessage() {}
+
+func (x *ListPTYSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPTYSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPTYSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListPTYSessionsRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ListPTYSessionsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListPTYSessionsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPTYSessionsRequest) GetLiveOnly() bool {
+	if x != nil && x.LiveOnly != nil {
+		return *x.LiveOnly
+	}
+	return false
+}
+
+type ListPTYSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Sessions      []*PTYSession          `protobuf:"bytes,3,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPTYSessionsResponse) Reset() {
+	*x = ListPTYSessionsResponse{}
+	mi := &file_api_client_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPTYSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPTYSessionsResponse) ProtoMessage() {}
+
+func (x *ListPTYSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPTYSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPTYSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListPTYSessionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListPTYSessionsResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListPTYSessionsResponse) GetSessions() []*PTYSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type TerminatePTYSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminatePTYSessionRequest) Reset() {
+	*x = TerminatePTYSessionRequest{}
+	mi := &file_api_client_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminatePTYSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminatePTYSessionRequest) ProtoMessage() {}
+
+func (x *TerminatePTYSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminatePTYSessionRequest.ProtoReflect.Descriptor instead.
+func (*TerminatePTYSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TerminatePTYSessionRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+type TerminatePTYSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminatePTYSessionResponse) Reset() {
+	*x = TerminatePTYSessionResponse{}
+	mi := &file_api_client_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminatePTYSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminatePTYSessionResponse) ProtoMessage() {}
+
+func (x *TerminatePTYSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminatePTYSessionResponse.ProtoReflect.Descriptor instead.
+func (*TerminatePTYSessionResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *TerminatePTYSessionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type UpdatePTYSessionShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	ShareMode     *PTYSession_ShareMode  `protobuf:"varint,2,opt,name=share_mode,json=shareMode,proto3,enum=common.PTYSession_ShareMode,oneof" json:"share_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePTYSessionShareRequest) Reset() {
+	*x = UpdatePTYSessionShareRequest{}
+	mi := &file_api_client_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePTYSessionShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePTYSessionShareRequest) ProtoMessage() {}
+
+func (x *UpdatePTYSessionShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePTYSessionShareRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePTYSessionShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdatePTYSessionShareRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *UpdatePTYSessionShareRequest) GetShareMode() PTYSession_ShareMode {
+	if x != nil && x.ShareMode != nil {
+		return *x.ShareMode
+	}
+	return PTYSession_SHARE_MODE_UNSPECIFIED
+}
+
+type UpdatePTYSessionShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePTYSessionShareResponse) Reset() {
+	*x = UpdatePTYSessionShareResponse{}
+	mi := &file_api_client_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePTYSessionShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePTYSessionShareResponse) ProtoMessage() {}
+
+func (x *UpdatePTYSessionShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePTYSessionShareResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePTYSessionShareResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdatePTYSessionShareResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// 管理员设置节点或用户是否允许使用远程终端，client_id 和 user_id 二选一
+type SetPTYPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	UserId        *int64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,3,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPTYPolicyRequest) Reset() {
+	*x = SetPTYPolicyRequest{}
+	mi := &file_api_client_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPTYPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPTYPolicyRequest) ProtoMessage() {}
+
+func (x *SetPTYPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPTYPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetPTYPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SetPTYPolicyRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *SetPTYPolicyRequest) GetUserId() int64 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *SetPTYPolicyRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+type SetPTYPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPTYPolicyResponse) Reset() {
+	*x = SetPTYPolicyResponse{}
+	mi := &file_api_client_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPTYPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPTYPolicyResponse) ProtoMessage() {}
+
+func (x *SetPTYPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPTYPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetPTYPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SetPTYPolicyResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// 在一个或多个节点上执行非交互命令，节点需要允许使用远程终端
+type ExecCommandRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ClientIds      []string               `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	Command        *string                `protobuf:"bytes,2,opt,name=command,proto3,oneof" json:"command,omitempty"`
+	Args           []string               `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Env            map[string]string      `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TimeoutSeconds *int32                 `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"` // 默认 30s
+	WorkDir        *string                `protobuf:"bytes,6,opt,name=work_dir,json=workDir,proto3,oneof" json:"work_dir,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExecCommandRequest) Reset() {
+	*x = ExecCommandRequest{}
+	mi := &file_api_client_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecCommandRequest) ProtoMessage() {}
+
+func (x *ExecCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecCommandRequest.ProtoReflect.Descriptor instead.
+func (*ExecCommandRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ExecCommandRequest) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+func (x *ExecCommandRequest) GetCommand() string {
+	if x != nil && x.Command != nil {
+		return *x.Command
+	}
+	return ""
+}
+
+func (x *ExecCommandRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
 	}
 	return nil
 }
 
-func (x *GetWorkerResponse) GetClients() []*Client {
+func (x *ExecCommandRequest) GetEnv() map[string]string {
 	if x != nil {
-		return x.Clients
+		return x.Env
 	}
 	return nil
 }
 
-type GetWorkerStatusRequest struct {
+func (x *ExecCommandRequest) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ExecCommandRequest) GetWorkDir() string {
+	if x != nil && x.WorkDir != nil {
+		return *x.WorkDir
+	}
+	return ""
+}
+
+type ExecCommandResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkerId      *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Results       []*ExecResult          `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkerStatusRequest) Reset() {
-	*x = GetWorkerStatusRequest{}
-	mi := &file_api_client_proto_msgTypes[50]
+func (x *ExecCommandResponse) Reset() {
+	*x = ExecCommandResponse{}
+	mi := &file_api_client_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkerStatusRequest) String() string {
+func (x *ExecCommandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkerStatusRequest) ProtoMessage() {}
+func (*ExecCommandResponse) ProtoMessage() {}
 
-func (x *GetWorkerStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_client_proto_msgTypes[50]
+func (x *ExecCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2670,41 +6036,109 @@ func (x *GetWorkerStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkerStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkerStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_client_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ExecCommandResponse.ProtoReflect.Descriptor instead.
+func (*ExecCommandResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetWorkerStatusRequest) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
+func (x *ExecCommandResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ExecCommandResponse) GetResults() []*ExecResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ListExecRecordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	Page          *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExecRecordsRequest) Reset() {
+	*x = ListExecRecordsRequest{}
+	mi := &file_api_client_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExecRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExecRecordsRequest) ProtoMessage() {}
+
+func (x *ListExecRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExecRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListExecRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListExecRecordsRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
 	}
 	return ""
 }
 
-type GetWorkerStatusResponse struct {
+func (x *ListExecRecordsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListExecRecordsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type ListExecRecordsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	WorkerStatus  map[string]string      `protobuf:"bytes,2,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // client_id -> status
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Records       []*ExecRecord          `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkerStatusResponse) Reset() {
-	*x = GetWorkerStatusResponse{}
-	mi := &file_api_client_proto_msgTypes[51]
+func (x *ListExecRecordsResponse) Reset() {
+	*x = ListExecRecordsResponse{}
+	mi := &file_api_client_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkerStatusResponse) String() string {
+func (x *ListExecRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkerStatusResponse) ProtoMessage() {}
+func (*ListExecRecordsResponse) ProtoMessage() {}
 
-func (x *GetWorkerStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_client_proto_msgTypes[51]
+func (x *ListExecRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2715,48 +6149,191 @@ func (x *GetWorkerStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkerStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkerStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_client_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ListExecRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListExecRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetWorkerStatusResponse) GetStatus() *Status {
+func (x *ListExecRecordsResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *GetWorkerStatusResponse) GetWorkerStatus() map[string]string {
+func (x *ListExecRecordsResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *ListExecRecordsResponse) GetRecords() []*ExecRecord {
 	if x != nil {
-		return x.WorkerStatus
+		return x.Records
 	}
 	return nil
 }
 
-type InstallWorkerdRequest struct {
+type StartFileTransferRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	TransferId    *string                      `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3,oneof" json:"transfer_id,omitempty"`
+	Op            *StartFileTransferRequest_Op `protobuf:"varint,2,opt,name=op,proto3,enum=api_client.StartFileTransferRequest_Op,oneof" json:"op,omitempty"`
+	Path          *string                      `protobuf:"bytes,3,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	Offset        *int64                       `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"` // 断点续传的起始位置
+	Sha256        *string                      `protobuf:"bytes,5,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`  // 上传时期望的完整文件 sha256，为空时不校验
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartFileTransferRequest) Reset() {
+	*x = StartFileTransferRequest{}
+	mi := &file_api_client_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartFileTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartFileTransferRequest) ProtoMessage() {}
+
+func (x *StartFileTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartFileTransferRequest.ProtoReflect.Descriptor instead.
+func (*StartFileTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *StartFileTransferRequest) GetTransferId() string {
+	if x != nil && x.TransferId != nil {
+		return *x.TransferId
+	}
+	return ""
+}
+
+func (x *StartFileTransferRequest) GetOp() StartFileTransferRequest_Op {
+	if x != nil && x.Op != nil {
+		return *x.Op
+	}
+	return StartFileTransferRequest_OP_UNSPECIFIED
+}
+
+func (x *StartFileTransferRequest) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *StartFileTransferRequest) GetOffset() int64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *StartFileTransferRequest) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+type StartFileTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Size          *int64                 `protobuf:"varint,2,opt,name=size,proto3,oneof" json:"size,omitempty"`    // 下载时为文件大小，上传时为已接收的大小
+	Sha256        *string                `protobuf:"bytes,3,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"` // 已废弃，下载时 sha256 随最后一条 FileTransferClientMessage 返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartFileTransferResponse) Reset() {
+	*x = StartFileTransferResponse{}
+	mi := &file_api_client_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartFileTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartFileTransferResponse) ProtoMessage() {}
+
+func (x *StartFileTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartFileTransferResponse.ProtoReflect.Descriptor instead.
+func (*StartFileTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *StartFileTransferResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *StartFileTransferResponse) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *StartFileTransferResponse) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+type ListDirRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
-	DownloadUrl   *string                `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3,oneof" json:"download_url,omitempty"`
+	Path          *string                `protobuf:"bytes,2,opt,name=path,proto3,oneof" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallWorkerdRequest) Reset() {
-	*x = InstallWorkerdRequest{}
-	mi := &file_api_client_proto_msgTypes[52]
+func (x *ListDirRequest) Reset() {
+	*x = ListDirRequest{}
+	mi := &file_api_client_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallWorkerdRequest) String() string {
+func (x *ListDirRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallWorkerdRequest) ProtoMessage() {}
+func (*ListDirRequest) ProtoMessage() {}
 
-func (x *InstallWorkerdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_client_proto_msgTypes[52]
+func (x *ListDirRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2767,47 +6344,48 @@ func (x *InstallWorkerdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallWorkerdRequest.ProtoReflect.Descriptor instead.
-func (*InstallWorkerdRequest) Descriptor() ([]byte, []int) {
-	return file_api_client_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ListDirRequest.ProtoReflect.Descriptor instead.
+func (*ListDirRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *InstallWorkerdRequest) GetClientId() string {
+func (x *ListDirRequest) GetClientId() string {
 	if x != nil && x.ClientId != nil {
 		return *x.ClientId
 	}
 	return ""
 }
 
-func (x *InstallWorkerdRequest) GetDownloadUrl() string {
-	if x != nil && x.DownloadUrl != nil {
-		return *x.DownloadUrl
+func (x *ListDirRequest) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
 	}
 	return ""
 }
 
-type InstallWorkerdResponse struct {
+type ListDirResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Files         []*FileInfo            `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallWorkerdResponse) Reset() {
-	*x = InstallWorkerdResponse{}
-	mi := &file_api_client_proto_msgTypes[53]
+func (x *ListDirResponse) Reset() {
+	*x = ListDirResponse{}
+	mi := &file_api_client_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallWorkerdResponse) String() string {
+func (x *ListDirResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallWorkerdResponse) ProtoMessage() {}
+func (*ListDirResponse) ProtoMessage() {}
 
-func (x *InstallWorkerdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_client_proto_msgTypes[53]
+func (x *ListDirResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2818,41 +6396,113 @@ func (x *InstallWorkerdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallWorkerdResponse.ProtoReflect.Descriptor instead.
-func (*InstallWorkerdResponse) Descriptor() ([]byte, []int) {
-	return file_api_client_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ListDirResponse.ProtoReflect.Descriptor instead.
+func (*ListDirResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *InstallWorkerdResponse) GetStatus() *Status {
+func (x *ListDirResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListDirResponse) GetFiles() []*FileInfo {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type UploadClientFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Size          *int64                 `protobuf:"varint,2,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	Sha256        *string                `protobuf:"bytes,3,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadClientFileResponse) Reset() {
+	*x = UploadClientFileResponse{}
+	mi := &file_api_client_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadClientFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadClientFileResponse) ProtoMessage() {}
+
+func (x *UploadClientFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadClientFileResponse.ProtoReflect.Descriptor instead.
+func (*UploadClientFileResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UploadClientFileResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type RedeployWorkerRequest struct {
+func (x *UploadClientFileResponse) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *UploadClientFileResponse) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+type QueryLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkerId      *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
-	ClientIds     []string               `protobuf:"bytes,2,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	StartTime     *int64                 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"` // 毫秒时间戳，为空时不限制
+	EndTime       *int64                 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`       // 毫秒时间戳，为空时不限制
+	Level         *string                `protobuf:"bytes,4,opt,name=level,proto3,oneof" json:"level,omitempty"`                           // 返回该级别及更严重的日志，为空时返回全部
+	Pkgs          []string               `protobuf:"bytes,5,rep,name=pkgs,proto3" json:"pkgs,omitempty"`
+	Keyword       *string                `protobuf:"bytes,6,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"` // 不区分大小写的子串匹配，匹配日志内容和字段值
+	Limit         *int32                 `protobuf:"varint,7,opt,name=limit,proto3,oneof" json:"limit,omitempty"`    // 返回最新的 limit 条
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RedeployWorkerRequest) Reset() {
-	*x = RedeployWorkerRequest{}
-	mi := &file_api_client_proto_msgTypes[54]
+func (x *QueryLogsRequest) Reset() {
+	*x = QueryLogsRequest{}
+	mi := &file_api_client_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RedeployWorkerRequest) String() string {
+func (x *QueryLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedeployWorkerRequest) ProtoMessage() {}
+func (*QueryLogsRequest) ProtoMessage() {}
 
-func (x *RedeployWorkerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_client_proto_msgTypes[54]
+func (x *QueryLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2863,47 +6513,84 @@ func (x *RedeployWorkerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RedeployWorkerRequest.ProtoReflect.Descriptor instead.
-func (*RedeployWorkerRequest) Descriptor() ([]byte, []int) {
-	return file_api_client_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use QueryLogsRequest.ProtoReflect.Descriptor instead.
+func (*QueryLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *RedeployWorkerRequest) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
+func (x *QueryLogsRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
 	}
 	return ""
 }
 
-func (x *RedeployWorkerRequest) GetClientIds() []string {
+func (x *QueryLogsRequest) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *QueryLogsRequest) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+func (x *QueryLogsRequest) GetLevel() string {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return ""
+}
+
+func (x *QueryLogsRequest) GetPkgs() []string {
 	if x != nil {
-		return x.ClientIds
+		return x.Pkgs
 	}
 	return nil
 }
 
-type RedeployWorkerResponse struct {
+func (x *QueryLogsRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *QueryLogsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type QueryLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Entries       []*LogEntry            `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	Truncated     *bool                  `protobuf:"varint,3,opt,name=truncated,proto3,oneof" json:"truncated,omitempty"` // 还有更早的匹配日志未返回
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RedeployWorkerResponse) Reset() {
-	*x = RedeployWorkerResponse{}
-	mi := &file_api_client_proto_msgTypes[55]
+func (x *QueryLogsResponse) Reset() {
+	*x = QueryLogsResponse{}
+	mi := &file_api_client_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RedeployWorkerResponse) String() string {
+func (x *QueryLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedeployWorkerResponse) ProtoMessage() {}
+func (*QueryLogsResponse) ProtoMessage() {}
 
-func (x *RedeployWorkerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_client_proto_msgTypes[55]
+func (x *QueryLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_client_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2914,18 +6601,32 @@ func (x *RedeployWorkerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RedeployWorkerResponse.ProtoReflect.Descriptor instead.
-func (*RedeployWorkerResponse) Descriptor() ([]byte, []int) {
-	return file_api_client_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use QueryLogsResponse.ProtoReflect.Descriptor instead.
+func (*QueryLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_client_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *RedeployWorkerResponse) GetStatus() *Status {
+func (x *QueryLogsResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
+func (x *QueryLogsResponse) GetEntries() []*LogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *QueryLogsResponse) GetTruncated() bool {
+	if x != nil && x.Truncated != nil {
+		return *x.Truncated
+	}
+	return false
+}
+
 var File_api_client_proto protoreflect.FileDescriptor
 
 const file_api_client_proto_rawDesc = "" +
@@ -2944,16 +6645,18 @@ const file_api_client_proto_rawDesc = "" +
 	"\tclient_id\x18\x02 \x01(\tH\x01R\bclientId\x88\x01\x01B\t\n" +
 	"\a_statusB\f\n" +
 	"\n" +
-	"_client_id\"\x91\x01\n" +
+	"_client_id\"\xd0\x01\n" +
 	"\x12ListClientsRequest\x12\x17\n" +
 	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
-	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01B\a\n" +
+	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01\x12*\n" +
+	"\x0elabel_selector\x18\x04 \x01(\tH\x03R\rlabelSelector\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\n" +
 	"\n" +
-	"\b_keyword\"\x9c\x01\n" +
+	"\b_keywordB\x11\n" +
+	"\x0f_label_selector\"\x9c\x01\n" +
 	"\x13ListClientsResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
 	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12(\n" +
@@ -3025,13 +6728,14 @@ const file_api_client_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x122\n" +
 	"\vproxy_infos\x18\x02 \x03(\v2\x11.common.ProxyInfoR\n" +
 	"proxyInfosB\t\n" +
-	"\a_status\"\xf6\x01\n" +
+	"\a_status\"\xb5\x02\n" +
 	"\x17ListProxyConfigsRequest\x12\x17\n" +
 	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
 	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01\x12 \n" +
 	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12 \n" +
-	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01B\a\n" +
+	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01\x12*\n" +
+	"\x0elabel_selector\x18\x06 \x01(\tH\x05R\rlabelSelector\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\n" +
@@ -3040,7 +6744,8 @@ const file_api_client_proto_rawDesc = "" +
 	"\n" +
 	"_client_idB\f\n" +
 	"\n" +
-	"_server_id\"\xb1\x01\n" +
+	"_server_idB\x11\n" +
+	"\x0f_label_selector\"\xb1\x01\n" +
 	"\x18ListProxyConfigsResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
 	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x128\n" +
@@ -3096,14 +6801,16 @@ const file_api_client_proto_rawDesc = "" +
 	"_client_idB\f\n" +
 	"\n" +
 	"_server_idB\a\n" +
-	"\x05_name\"\xf9\x01\n" +
+	"\x05_name\"\xbe\x02\n" +
 	"\x16GetProxyConfigResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12;\n" +
 	"\fproxy_config\x18\x02 \x01(\v2\x13.common.ProxyConfigH\x01R\vproxyConfig\x88\x01\x01\x12F\n" +
-	"\x0eworking_status\x18\x03 \x01(\v2\x1a.common.ProxyWorkingStatusH\x02R\rworkingStatus\x88\x01\x01B\t\n" +
+	"\x0eworking_status\x18\x03 \x01(\v2\x1a.common.ProxyWorkingStatusH\x02R\rworkingStatus\x88\x01\x01\x126\n" +
+	"\bschedule\x18\x04 \x01(\v2\x15.common.ProxyScheduleH\x03R\bschedule\x88\x01\x01B\t\n" +
 	"\a_statusB\x0f\n" +
 	"\r_proxy_configB\x11\n" +
-	"\x0f_working_status\"\x94\x01\n" +
+	"\x0f_working_statusB\v\n" +
+	"\t_schedule\"\x94\x01\n" +
 	"\x10StopProxyRequest\x12 \n" +
 	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
 	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
@@ -3127,7 +6834,296 @@ const file_api_client_proto_rawDesc = "" +
 	"\x05_name\"L\n" +
 	"\x12StartProxyResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
-	"\a_status\"}\n" +
+	"\a_status\"\xa2\x02\n" +
+	"\x16MoveProxyConfigRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01\x12-\n" +
+	"\x10target_client_id\x18\x04 \x01(\tH\x03R\x0etargetClientId\x88\x01\x01\x12-\n" +
+	"\x10target_server_id\x18\x05 \x01(\tH\x04R\x0etargetServerId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_nameB\x13\n" +
+	"\x11_target_client_idB\x13\n" +
+	"\x11_target_server_id\"\x9f\x01\n" +
+	"\x17MoveProxyConfigResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12;\n" +
+	"\fproxy_config\x18\x02 \x01(\v2\x13.common.ProxyConfigH\x01R\vproxyConfig\x88\x01\x01B\t\n" +
+	"\a_statusB\x0f\n" +
+	"\r_proxy_config\"\xd9\x02\n" +
+	"\x17CloneProxyConfigRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01\x12-\n" +
+	"\x10target_client_id\x18\x04 \x01(\tH\x03R\x0etargetClientId\x88\x01\x01\x12-\n" +
+	"\x10target_server_id\x18\x05 \x01(\tH\x04R\x0etargetServerId\x88\x01\x01\x12$\n" +
+	"\vtarget_name\x18\x06 \x01(\tH\x05R\n" +
+	"targetName\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_nameB\x13\n" +
+	"\x11_target_client_idB\x13\n" +
+	"\x11_target_server_idB\x0e\n" +
+	"\f_target_name\"\xa0\x01\n" +
+	"\x18CloneProxyConfigResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12;\n" +
+	"\fproxy_config\x18\x02 \x01(\v2\x13.common.ProxyConfigH\x01R\vproxyConfig\x88\x01\x01B\t\n" +
+	"\a_statusB\x0f\n" +
+	"\r_proxy_config\"\x9d\x02\n" +
+	"\x16ListProxyStatusRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
+	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x06 \x01(\tH\x05R\x06status\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\n" +
+	"\n" +
+	"\b_keywordB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\t\n" +
+	"\a_status\"\xcb\x02\n" +
+	"\x17ListProxyStatusResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x126\n" +
+	"\bstatuses\x18\x03 \x03(\v2\x1a.common.ProxyWorkingStatusR\bstatuses\x12Z\n" +
+	"\rstatus_counts\x18\x04 \x03(\v25.api_client.ListProxyStatusResponse.StatusCountsEntryR\fstatusCounts\x1a?\n" +
+	"\x11StatusCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"\xd1\x01\n" +
+	"\x14SetProxyProbeRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01\x12-\n" +
+	"\x05probe\x18\x04 \x01(\v2\x12.common.ProxyProbeH\x03R\x05probe\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_probe\"\x88\x01\n" +
+	"\x15SetProxyProbeResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12-\n" +
+	"\x05probe\x18\x02 \x01(\v2\x12.common.ProxyProbeH\x01R\x05probe\x88\x01\x01B\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_probe\"\x9b\x01\n" +
+	"\x17DeleteProxyProbeRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_name\"R\n" +
+	"\x18DeleteProxyProbeResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xd4\x01\n" +
+	"\x14GetProxyProbeRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01\x12(\n" +
+	"\rhistory_limit\x18\x04 \x01(\x05H\x03R\fhistoryLimit\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_nameB\x10\n" +
+	"\x0e_history_limit\"\xbc\x01\n" +
+	"\x15GetProxyProbeResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12-\n" +
+	"\x05probe\x18\x02 \x01(\v2\x12.common.ProxyProbeH\x01R\x05probe\x88\x01\x01\x122\n" +
+	"\ahistory\x18\x03 \x03(\v2\x18.common.ProxyProbeResultR\ahistoryB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_probe\"\xca\x01\n" +
+	"\x16ListProxyProbesRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x03 \x01(\tH\x02R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x04 \x01(\tH\x03R\bserverId\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_id\"\xa2\x01\n" +
+	"\x17ListProxyProbesResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12*\n" +
+	"\x06probes\x18\x03 \x03(\v2\x12.common.ProxyProbeR\x06probesB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"\xf1\x02\n" +
+	"\x17SetProxyScheduleRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01\x125\n" +
+	"\awindows\x18\x04 \x03(\v2\x1b.common.ProxyScheduleWindowR\awindows\x12$\n" +
+	"\vttl_seconds\x18\x05 \x01(\x03H\x03R\n" +
+	"ttlSeconds\x88\x01\x01\x12 \n" +
+	"\tclear_ttl\x18\x06 \x01(\bH\x04R\bclearTtl\x88\x01\x01\x12&\n" +
+	"\fkeep_windows\x18\a \x01(\bH\x05R\vkeepWindows\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_ttl_secondsB\f\n" +
+	"\n" +
+	"_clear_ttlB\x0f\n" +
+	"\r_keep_windows\"\x97\x01\n" +
+	"\x18SetProxyScheduleResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x126\n" +
+	"\bschedule\x18\x02 \x01(\v2\x15.common.ProxyScheduleH\x01R\bschedule\x88\x01\x01B\t\n" +
+	"\a_statusB\v\n" +
+	"\t_schedule\"\x9e\x01\n" +
+	"\x1aDeleteProxyScheduleRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_name\"U\n" +
+	"\x1bDeleteProxyScheduleResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xf8\x01\n" +
+	"\x19ListVisitorConfigsRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
+	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\n" +
+	"\n" +
+	"\b_keywordB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_id\"\xb9\x01\n" +
+	"\x1aListVisitorConfigsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12>\n" +
+	"\x0fvisitor_configs\x18\x03 \x03(\v2\x15.common.VisitorConfigR\x0evisitorConfigsB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"\xd5\x01\n" +
+	"\x1aCreateVisitorConfigRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x1b\n" +
+	"\x06config\x18\x03 \x01(\fH\x02R\x06config\x88\x01\x01\x12!\n" +
+	"\toverwrite\x18\x04 \x01(\bH\x03R\toverwrite\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\t\n" +
+	"\a_configB\f\n" +
+	"\n" +
+	"_overwrite\"U\n" +
+	"\x1bCreateVisitorConfigResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x9e\x01\n" +
+	"\x1aDeleteVisitorConfigRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_name\"U\n" +
+	"\x1bDeleteVisitorConfigResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xc6\x01\n" +
+	"\x1aUpdateVisitorConfigRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01\x12\x1b\n" +
+	"\x06config\x18\x04 \x01(\fH\x03R\x06config\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_nameB\t\n" +
+	"\a_config\"U\n" +
+	"\x1bUpdateVisitorConfigResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x9b\x01\n" +
+	"\x17GetVisitorConfigRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_name\"\xa8\x01\n" +
+	"\x18GetVisitorConfigResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12A\n" +
+	"\x0evisitor_config\x18\x02 \x01(\v2\x15.common.VisitorConfigH\x01R\rvisitorConfig\x88\x01\x01B\t\n" +
+	"\a_statusB\x11\n" +
+	"\x0f_visitor_config\"\x96\x01\n" +
+	"\x12StopVisitorRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_name\"M\n" +
+	"\x13StopVisitorResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x97\x01\n" +
+	"\x13StartVisitorRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_name\"N\n" +
+	"\x14StartVisitorResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x8e\x03\n" +
+	"\x19GrantVisitorAccessRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"proxy_name\x18\x03 \x01(\tH\x02R\tproxyName\x88\x01\x01\x12/\n" +
+	"\x11visitor_client_id\x18\x04 \x01(\tH\x03R\x0fvisitorClientId\x88\x01\x01\x12&\n" +
+	"\fvisitor_name\x18\x05 \x01(\tH\x04R\vvisitorName\x88\x01\x01\x12 \n" +
+	"\tbind_addr\x18\x06 \x01(\tH\x05R\bbindAddr\x88\x01\x01\x12 \n" +
+	"\tbind_port\x18\a \x01(\x05H\x06R\bbindPort\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\r\n" +
+	"\v_proxy_nameB\x14\n" +
+	"\x12_visitor_client_idB\x0f\n" +
+	"\r_visitor_nameB\f\n" +
+	"\n" +
+	"_bind_addrB\f\n" +
+	"\n" +
+	"_bind_port\"\xaa\x01\n" +
+	"\x1aGrantVisitorAccessResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12A\n" +
+	"\x0evisitor_config\x18\x02 \x01(\v2\x15.common.VisitorConfigH\x01R\rvisitorConfig\x88\x01\x01B\t\n" +
+	"\a_statusB\x11\n" +
+	"\x0f_visitor_config\"}\n" +
 	"\x13CreateWorkerRequest\x12 \n" +
 	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12+\n" +
 	"\x06worker\x18\x02 \x01(\v2\x0e.common.WorkerH\x01R\x06worker\x88\x01\x01B\f\n" +
@@ -3149,12 +7145,19 @@ const file_api_client_proto_rawDesc = "" +
 	"_worker_id\"N\n" +
 	"\x14RemoveWorkerResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
-	"\a_status\"l\n" +
+	"\a_status\"\xc5\x02\n" +
 	"\x13UpdateWorkerRequest\x12\x1d\n" +
 	"\n" +
 	"client_ids\x18\x01 \x03(\tR\tclientIds\x12+\n" +
-	"\x06worker\x18\x02 \x01(\v2\x0e.common.WorkerH\x00R\x06worker\x88\x01\x01B\t\n" +
-	"\a_worker\"N\n" +
+	"\x06worker\x18\x02 \x01(\v2\x0e.common.WorkerH\x00R\x06worker\x88\x01\x01\x12$\n" +
+	"\vclear_crons\x18\x03 \x01(\bH\x01R\n" +
+	"clearCrons\x88\x01\x01\x129\n" +
+	"\x16clear_service_bindings\x18\x04 \x01(\bH\x02R\x14clearServiceBindings\x88\x01\x01\x123\n" +
+	"\x13clear_kv_namespaces\x18\x05 \x01(\bH\x03R\x11clearKvNamespaces\x88\x01\x01B\t\n" +
+	"\a_workerB\x0e\n" +
+	"\f_clear_cronsB\x19\n" +
+	"\x17_clear_service_bindingsB\x16\n" +
+	"\x14_clear_kv_namespaces\"N\n" +
 	"\x14UpdateWorkerResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
 	"\a_status\"r\n" +
@@ -3177,13 +7180,14 @@ const file_api_client_proto_rawDesc = "" +
 	"_worker_id\"L\n" +
 	"\x12StopWorkerResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
-	"\a_status\"\xf1\x01\n" +
+	"\a_status\"\xb0\x02\n" +
 	"\x12ListWorkersRequest\x12\x17\n" +
 	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
 	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01\x12 \n" +
 	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12 \n" +
-	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01B\a\n" +
+	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01\x12*\n" +
+	"\x0elabel_selector\x18\x06 \x01(\tH\x05R\rlabelSelector\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\n" +
@@ -3192,7 +7196,8 @@ const file_api_client_proto_rawDesc = "" +
 	"\n" +
 	"_client_idB\f\n" +
 	"\n" +
-	"_server_id\"\x9c\x01\n" +
+	"_server_idB\x11\n" +
+	"\x0f_label_selector\"\x9c\x01\n" +
 	"\x13ListWorkersResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
 	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12(\n" +
@@ -3240,13 +7245,21 @@ const file_api_client_proto_rawDesc = "" +
 	"\x11WorkerStatusEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
-	"\a_status\"\x80\x01\n" +
+	"\a_status\"\x89\x02\n" +
 	"\x15InstallWorkerdRequest\x12 \n" +
 	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12&\n" +
-	"\fdownload_url\x18\x02 \x01(\tH\x01R\vdownloadUrl\x88\x01\x01B\f\n" +
+	"\fdownload_url\x18\x02 \x01(\tH\x01R\vdownloadUrl\x88\x01\x01\x12$\n" +
+	"\vartifact_id\x18\x03 \x01(\rH\x02R\n" +
+	"artifactId\x88\x01\x01\x12\x1b\n" +
+	"\x06sha256\x18\x04 \x01(\tH\x03R\x06sha256\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x05 \x01(\tH\x04R\aversion\x88\x01\x01B\f\n" +
 	"\n" +
 	"_client_idB\x0f\n" +
-	"\r_download_url\"P\n" +
+	"\r_download_urlB\x0e\n" +
+	"\f_artifact_idB\t\n" +
+	"\a_sha256B\n" +
+	"\n" +
+	"\b_version\"P\n" +
 	"\x16InstallWorkerdResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
 	"\a_status\"f\n" +
@@ -3258,7 +7271,191 @@ const file_api_client_proto_rawDesc = "" +
 	"_worker_id\"P\n" +
 	"\x16RedeployWorkerResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
-	"\a_statusB\aZ\x05../pbb\x06proto3"
+	"\a_status\"\xce\x01\n" +
+	" ListWorkerCronInvocationsRequest\x12 \n" +
+	"\tworker_id\x18\x01 \x01(\tH\x00R\bworkerId\x88\x01\x01\x12\x1c\n" +
+	"\acron_id\x18\x02 \x01(\tH\x01R\x06cronId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x03 \x01(\x05H\x02R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x04 \x01(\x05H\x03R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_worker_idB\n" +
+	"\n" +
+	"\b_cron_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\xc0\x01\n" +
+	"!ListWorkerCronInvocationsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12>\n" +
+	"\vinvocations\x18\x03 \x03(\v2\x1c.common.WorkerCronInvocationR\vinvocationsB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"\x9e\x01\n" +
+	"\x1dUploadWorkerdArtifactResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x128\n" +
+	"\bartifact\x18\x02 \x01(\v2\x17.common.WorkerdArtifactH\x01R\bartifact\x88\x01\x01B\t\n" +
+	"\a_statusB\v\n" +
+	"\t_artifact\"[\n" +
+	"\x1bListWorkerdArtifactsRequest\x12\x13\n" +
+	"\x02os\x18\x01 \x01(\tH\x00R\x02os\x88\x01\x01\x12\x17\n" +
+	"\x04arch\x18\x02 \x01(\tH\x01R\x04arch\x88\x01\x01B\x05\n" +
+	"\x03_osB\a\n" +
+	"\x05_arch\"\x8d\x01\n" +
+	"\x1cListWorkerdArtifactsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x125\n" +
+	"\tartifacts\x18\x02 \x03(\v2\x17.common.WorkerdArtifactR\tartifactsB\t\n" +
+	"\a_status\":\n" +
+	"\x1cDeleteWorkerdArtifactRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"W\n" +
+	"\x1dDeleteWorkerdArtifactResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xca\x01\n" +
+	"\x16ListPTYSessionsRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\x05H\x01R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05H\x02R\bpageSize\x88\x01\x01\x12 \n" +
+	"\tlive_only\x18\x04 \x01(\bH\x03R\bliveOnly\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\f\n" +
+	"\n" +
+	"_live_only\"\xa6\x01\n" +
+	"\x17ListPTYSessionsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12.\n" +
+	"\bsessions\x18\x03 \x03(\v2\x12.common.PTYSessionR\bsessionsB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"O\n" +
+	"\x1aTerminatePTYSessionRequest\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01B\r\n" +
+	"\v_session_id\"U\n" +
+	"\x1bTerminatePTYSessionResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xa2\x01\n" +
+	"\x1cUpdatePTYSessionShareRequest\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12@\n" +
+	"\n" +
+	"share_mode\x18\x02 \x01(\x0e2\x1c.common.PTYSession.ShareModeH\x01R\tshareMode\x88\x01\x01B\r\n" +
+	"\v_session_idB\r\n" +
+	"\v_share_mode\"W\n" +
+	"\x1dUpdatePTYSessionShareResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x9a\x01\n" +
+	"\x13SetPTYPolicyRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\x03H\x01R\x06userId\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x03 \x01(\bH\x02R\aenabled\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\n" +
+	"\n" +
+	"\b_user_idB\n" +
+	"\n" +
+	"\b_enabled\"N\n" +
+	"\x14SetPTYPolicyResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xd4\x02\n" +
+	"\x12ExecCommandRequest\x12\x1d\n" +
+	"\n" +
+	"client_ids\x18\x01 \x03(\tR\tclientIds\x12\x1d\n" +
+	"\acommand\x18\x02 \x01(\tH\x00R\acommand\x88\x01\x01\x12\x12\n" +
+	"\x04args\x18\x03 \x03(\tR\x04args\x129\n" +
+	"\x03env\x18\x04 \x03(\v2'.api_client.ExecCommandRequest.EnvEntryR\x03env\x12,\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x05H\x01R\x0etimeoutSeconds\x88\x01\x01\x12\x1e\n" +
+	"\bwork_dir\x18\x06 \x01(\tH\x02R\aworkDir\x88\x01\x01\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_commandB\x12\n" +
+	"\x10_timeout_secondsB\v\n" +
+	"\t_work_dir\"{\n" +
+	"\x13ExecCommandResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12,\n" +
+	"\aresults\x18\x02 \x03(\v2\x12.common.ExecResultR\aresultsB\t\n" +
+	"\a_status\"\x9a\x01\n" +
+	"\x16ListExecRecordsRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\x05H\x01R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05H\x02R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\xa4\x01\n" +
+	"\x17ListExecRecordsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12,\n" +
+	"\arecords\x18\x03 \x03(\v2\x12.common.ExecRecordR\arecordsB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_total\"\xc1\x02\n" +
+	"\x18StartFileTransferRequest\x12$\n" +
+	"\vtransfer_id\x18\x01 \x01(\tH\x00R\n" +
+	"transferId\x88\x01\x01\x12<\n" +
+	"\x02op\x18\x02 \x01(\x0e2'.api_client.StartFileTransferRequest.OpH\x01R\x02op\x88\x01\x01\x12\x17\n" +
+	"\x04path\x18\x03 \x01(\tH\x02R\x04path\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x04 \x01(\x03H\x03R\x06offset\x88\x01\x01\x12\x1b\n" +
+	"\x06sha256\x18\x05 \x01(\tH\x04R\x06sha256\x88\x01\x01\"8\n" +
+	"\x02Op\x12\x12\n" +
+	"\x0eOP_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vOP_DOWNLOAD\x10\x01\x12\r\n" +
+	"\tOP_UPLOAD\x10\x02B\x0e\n" +
+	"\f_transfer_idB\x05\n" +
+	"\x03_opB\a\n" +
+	"\x05_pathB\t\n" +
+	"\a_offsetB\t\n" +
+	"\a_sha256\"\x9d\x01\n" +
+	"\x19StartFileTransferResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x02 \x01(\x03H\x01R\x04size\x88\x01\x01\x12\x1b\n" +
+	"\x06sha256\x18\x03 \x01(\tH\x02R\x06sha256\x88\x01\x01B\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_sizeB\t\n" +
+	"\a_sha256\"b\n" +
+	"\x0eListDirRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12\x17\n" +
+	"\x04path\x18\x02 \x01(\tH\x01R\x04path\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\a\n" +
+	"\x05_path\"q\n" +
+	"\x0fListDirResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12&\n" +
+	"\x05files\x18\x02 \x03(\v2\x10.common.FileInfoR\x05filesB\t\n" +
+	"\a_status\"\x9c\x01\n" +
+	"\x18UploadClientFileResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x02 \x01(\x03H\x01R\x04size\x88\x01\x01\x12\x1b\n" +
+	"\x06sha256\x18\x03 \x01(\tH\x02R\x06sha256\x88\x01\x01B\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_sizeB\t\n" +
+	"\a_sha256\"\xab\x02\n" +
+	"\x10QueryLogsRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x03H\x01R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x03 \x01(\x03H\x02R\aendTime\x88\x01\x01\x12\x19\n" +
+	"\x05level\x18\x04 \x01(\tH\x03R\x05level\x88\x01\x01\x12\x12\n" +
+	"\x04pkgs\x18\x05 \x03(\tR\x04pkgs\x12\x1d\n" +
+	"\akeyword\x18\x06 \x01(\tH\x04R\akeyword\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\a \x01(\x05H\x05R\x05limit\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\b\n" +
+	"\x06_levelB\n" +
+	"\n" +
+	"\b_keywordB\b\n" +
+	"\x06_limit\"\xa8\x01\n" +
+	"\x11QueryLogsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12*\n" +
+	"\aentries\x18\x02 \x03(\v2\x10.common.LogEntryR\aentries\x12!\n" +
+	"\ttruncated\x18\x03 \x01(\bH\x01R\ttruncated\x88\x01\x01B\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_truncatedB\aZ\x05../pbb\x06proto3"
 
 var (
 	file_api_client_proto_rawDescOnce sync.Once
@@ -3272,119 +7469,253 @@ func file_api_client_proto_rawDescGZIP() []byte {
 	return file_api_client_proto_rawDescData
 }
 
-var file_api_client_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_api_client_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_client_proto_msgTypes = make([]protoimpl.MessageInfo, 119)
 var file_api_client_proto_goTypes = []any{
-	(*InitClientRequest)(nil),               // 0: api_client.InitClientRequest
-	(*InitClientResponse)(nil),              // 1: api_client.InitClientResponse
-	(*ListClientsRequest)(nil),              // 2: api_client.ListClientsRequest
-	(*ListClientsResponse)(nil),             // 3: api_client.ListClientsResponse
-	(*GetClientRequest)(nil),                // 4: api_client.GetClientRequest
-	(*GetClientResponse)(nil),               // 5: api_client.GetClientResponse
-	(*DeleteClientRequest)(nil),             // 6: api_client.DeleteClientRequest
-	(*DeleteClientResponse)(nil),            // 7: api_client.DeleteClientResponse
-	(*UpdateFRPCRequest)(nil),               // 8: api_client.UpdateFRPCRequest
-	(*UpdateFRPCResponse)(nil),              // 9: api_client.UpdateFRPCResponse
-	(*RemoveFRPCRequest)(nil),               // 10: api_client.RemoveFRPCRequest
-	(*RemoveFRPCResponse)(nil),              // 11: api_client.RemoveFRPCResponse
-	(*StopFRPCRequest)(nil),                 // 12: api_client.StopFRPCRequest
-	(*StopFRPCResponse)(nil),                // 13: api_client.StopFRPCResponse
-	(*StartFRPCRequest)(nil),                // 14: api_client.StartFRPCRequest
-	(*StartFRPCResponse)(nil),               // 15: api_client.StartFRPCResponse
-	(*GetProxyStatsByClientIDRequest)(nil),  // 16: api_client.GetProxyStatsByClientIDRequest
-	(*GetProxyStatsByClientIDResponse)(nil), // 17: api_client.GetProxyStatsByClientIDResponse
-	(*ListProxyConfigsRequest)(nil),         // 18: api_client.ListProxyConfigsRequest
-	(*ListProxyConfigsResponse)(nil),        // 19: api_client.ListProxyConfigsResponse
-	(*CreateProxyConfigRequest)(nil),        // 20: api_client.CreateProxyConfigRequest
-	(*CreateProxyConfigResponse)(nil),       // 21: api_client.CreateProxyConfigResponse
-	(*DeleteProxyConfigRequest)(nil),        // 22: api_client.DeleteProxyConfigRequest
-	(*DeleteProxyConfigResponse)(nil),       // 23: api_client.DeleteProxyConfigResponse
-	(*UpdateProxyConfigRequest)(nil),        // 24: api_client.UpdateProxyConfigRequest
-	(*UpdateProxyConfigResponse)(nil),       // 25: api_client.UpdateProxyConfigResponse
-	(*GetProxyConfigRequest)(nil),           // 26: api_client.GetProxyConfigRequest
-	(*GetProxyConfigResponse)(nil),          // 27: api_client.GetProxyConfigResponse
-	(*StopProxyRequest)(nil),                // 28: api_client.StopProxyRequest
-	(*StopProxyResponse)(nil),               // 29: api_client.StopProxyResponse
-	(*StartProxyRequest)(nil),               // 30: api_client.StartProxyRequest
-	(*StartProxyResponse)(nil),              // 31: api_client.StartProxyResponse
-	(*CreateWorkerRequest)(nil),             // 32: api_client.CreateWorkerRequest
-	(*CreateWorkerResponse)(nil),            // 33: api_client.CreateWorkerResponse
-	(*RemoveWorkerRequest)(nil),             // 34: api_client.RemoveWorkerRequest
-	(*RemoveWorkerResponse)(nil),            // 35: api_client.RemoveWorkerResponse
-	(*UpdateWorkerRequest)(nil),             // 36: api_client.UpdateWorkerRequest
-	(*UpdateWorkerResponse)(nil),            // 37: api_client.UpdateWorkerResponse
-	(*RunWorkerRequest)(nil),                // 38: api_client.RunWorkerRequest
-	(*RunWorkerResponse)(nil),               // 39: api_client.RunWorkerResponse
-	(*StopWorkerRequest)(nil),               // 40: api_client.StopWorkerRequest
-	(*StopWorkerResponse)(nil),              // 41: api_client.StopWorkerResponse
-	(*ListWorkersRequest)(nil),              // 42: api_client.ListWorkersRequest
-	(*ListWorkersResponse)(nil),             // 43: api_client.ListWorkersResponse
-	(*CreateWorkerIngressRequest)(nil),      // 44: api_client.CreateWorkerIngressRequest
-	(*CreateWorkerIngressResponse)(nil),     // 45: api_client.CreateWorkerIngressResponse
-	(*GetWorkerIngressRequest)(nil),         // 46: api_client.GetWorkerIngressRequest
-	(*GetWorkerIngressResponse)(nil),        // 47: api_client.GetWorkerIngressResponse
-	(*GetWorkerRequest)(nil),                // 48: api_client.GetWorkerRequest
-	(*GetWorkerResponse)(nil),               // 49: api_client.GetWorkerResponse
-	(*GetWorkerStatusRequest)(nil),          // 50: api_client.GetWorkerStatusRequest
-	(*GetWorkerStatusResponse)(nil),         // 51: api_client.GetWorkerStatusResponse
-	(*InstallWorkerdRequest)(nil),           // 52: api_client.InstallWorkerdRequest
-	(*InstallWorkerdResponse)(nil),          // 53: api_client.InstallWorkerdResponse
-	(*RedeployWorkerRequest)(nil),           // 54: api_client.RedeployWorkerRequest
-	(*RedeployWorkerResponse)(nil),          // 55: api_client.RedeployWorkerResponse
-	nil,                                     // 56: api_client.GetWorkerStatusResponse.WorkerStatusEntry
-	(*Status)(nil),                          // 57: common.Status
-	(*Client)(nil),                          // 58: common.Client
-	(*ProxyInfo)(nil),                       // 59: common.ProxyInfo
-	(*ProxyConfig)(nil),                     // 60: common.ProxyConfig
-	(*ProxyWorkingStatus)(nil),              // 61: common.ProxyWorkingStatus
-	(*Worker)(nil),                          // 62: common.Worker
+	(StartFileTransferRequest_Op)(0),          // 0: api_client.StartFileTransferRequest.Op
+	(*InitClientRequest)(nil),                 // 1: api_client.InitClientRequest
+	(*InitClientResponse)(nil),                // 2: api_client.InitClientResponse
+	(*ListClientsRequest)(nil),                // 3: api_client.ListClientsRequest
+	(*ListClientsResponse)(nil),               // 4: api_client.ListClientsResponse
+	(*GetClientRequest)(nil),                  // 5: api_client.GetClientRequest
+	(*GetClientResponse)(nil),                 // 6: api_client.GetClientResponse
+	(*DeleteClientRequest)(nil),               // 7: api_client.DeleteClientRequest
+	(*DeleteClientResponse)(nil),              // 8: api_client.DeleteClientResponse
+	(*UpdateFRPCRequest)(nil),                 // 9: api_client.UpdateFRPCRequest
+	(*UpdateFRPCResponse)(nil),                // 10: api_client.UpdateFRPCResponse
+	(*RemoveFRPCRequest)(nil),                 // 11: api_client.RemoveFRPCRequest
+	(*RemoveFRPCResponse)(nil),                // 12: api_client.RemoveFRPCResponse
+	(*StopFRPCRequest)(nil),                   // 13: api_client.StopFRPCRequest
+	(*StopFRPCResponse)(nil),                  // 14: api_client.StopFRPCResponse
+	(*StartFRPCRequest)(nil),                  // 15: api_client.StartFRPCRequest
+	(*StartFRPCResponse)(nil),                 // 16: api_client.StartFRPCResponse
+	(*GetProxyStatsByClientIDRequest)(nil),    // 17: api_client.GetProxyStatsByClientIDRequest
+	(*GetProxyStatsByClientIDResponse)(nil),   // 18: api_client.GetProxyStatsByClientIDResponse
+	(*ListProxyConfigsRequest)(nil),           // 19: api_client.ListProxyConfigsRequest
+	(*ListProxyConfigsResponse)(nil),          // 20: api_client.ListProxyConfigsResponse
+	(*CreateProxyConfigRequest)(nil),          // 21: api_client.CreateProxyConfigRequest
+	(*CreateProxyConfigResponse)(nil),         // 22: api_client.CreateProxyConfigResponse
+	(*DeleteProxyConfigRequest)(nil),          // 23: api_client.DeleteProxyConfigRequest
+	(*DeleteProxyConfigResponse)(nil),         // 24: api_client.DeleteProxyConfigResponse
+	(*UpdateProxyConfigRequest)(nil),          // 25: api_client.UpdateProxyConfigRequest
+	(*UpdateProxyConfigResponse)(nil),         // 26: api_client.UpdateProxyConfigResponse
+	(*GetProxyConfigRequest)(nil),             // 27: api_client.GetProxyConfigRequest
+	(*GetProxyConfigResponse)(nil),            // 28: api_client.GetProxyConfigResponse
+	(*StopProxyRequest)(nil),                  // 29: api_client.StopProxyRequest
+	(*StopProxyResponse)(nil),                 // 30: api_client.StopProxyResponse
+	(*StartProxyRequest)(nil),                 // 31: api_client.StartProxyRequest
+	(*StartProxyResponse)(nil),                // 32: api_client.StartProxyResponse
+	(*MoveProxyConfigRequest)(nil),            // 33: api_client.MoveProxyConfigRequest
+	(*MoveProxyConfigResponse)(nil),           // 34: api_client.MoveProxyConfigResponse
+	(*CloneProxyConfigRequest)(nil),           // 35: api_client.CloneProxyConfigRequest
+	(*CloneProxyConfigResponse)(nil),          // 36: api_client.CloneProxyConfigResponse
+	(*ListProxyStatusRequest)(nil),            // 37: api_client.ListProxyStatusRequest
+	(*ListProxyStatusResponse)(nil),           // 38: api_client.ListProxyStatusResponse
+	(*SetProxyProbeRequest)(nil),              // 39: api_client.SetProxyProbeRequest
+	(*SetProxyProbeResponse)(nil),             // 40: api_client.SetProxyProbeResponse
+	(*DeleteProxyProbeRequest)(nil),           // 41: api_client.DeleteProxyProbeRequest
+	(*DeleteProxyProbeResponse)(nil),          // 42: api_client.DeleteProxyProbeResponse
+	(*GetProxyProbeRequest)(nil),              // 43: api_client.GetProxyProbeRequest
+	(*GetProxyProbeResponse)(nil),             // 44: api_client.GetProxyProbeResponse
+	(*ListProxyProbesRequest)(nil),            // 45: api_client.ListProxyProbesRequest
+	(*ListProxyProbesResponse)(nil),           // 46: api_client.ListProxyProbesResponse
+	(*SetProxyScheduleRequest)(nil),           // 47: api_client.SetProxyScheduleRequest
+	(*SetProxyScheduleResponse)(nil),          // 48: api_client.SetProxyScheduleResponse
+	(*DeleteProxyScheduleRequest)(nil),        // 49: api_client.DeleteProxyScheduleRequest
+	(*DeleteProxyScheduleResponse)(nil),       // 50: api_client.DeleteProxyScheduleResponse
+	(*ListVisitorConfigsRequest)(nil),         // 51: api_client.ListVisitorConfigsRequest
+	(*ListVisitorConfigsResponse)(nil),        // 52: api_client.ListVisitorConfigsResponse
+	(*CreateVisitorConfigRequest)(nil),        // 53: api_client.CreateVisitorConfigRequest
+	(*CreateVisitorConfigResponse)(nil),       // 54: api_client.CreateVisitorConfigResponse
+	(*DeleteVisitorConfigRequest)(nil),        // 55: api_client.DeleteVisitorConfigRequest
+	(*DeleteVisitorConfigResponse)(nil),       // 56: api_client.DeleteVisitorConfigResponse
+	(*UpdateVisitorConfigRequest)(nil),        // 57: api_client.UpdateVisitorConfigRequest
+	(*UpdateVisitorConfigResponse)(nil),       // 58: api_client.UpdateVisitorConfigResponse
+	(*GetVisitorConfigRequest)(nil),           // 59: api_client.GetVisitorConfigRequest
+	(*GetVisitorConfigResponse)(nil),          // 60: api_client.GetVisitorConfigResponse
+	(*StopVisitorRequest)(nil),                // 61: api_client.StopVisitorRequest
+	(*StopVisitorResponse)(nil),               // 62: api_client.StopVisitorResponse
+	(*StartVisitorRequest)(nil),               // 63: api_client.StartVisitorRequest
+	(*StartVisitorResponse)(nil),              // 64: api_client.StartVisitorResponse
+	(*GrantVisitorAccessRequest)(nil),         // 65: api_client.GrantVisitorAccessRequest
+	(*GrantVisitorAccessResponse)(nil),        // 66: api_client.GrantVisitorAccessResponse
+	(*CreateWorkerRequest)(nil),               // 67: api_client.CreateWorkerRequest
+	(*CreateWorkerResponse)(nil),              // 68: api_client.CreateWorkerResponse
+	(*RemoveWorkerRequest)(nil),               // 69: api_client.RemoveWorkerRequest
+	(*RemoveWorkerResponse)(nil),              // 70: api_client.RemoveWorkerResponse
+	(*UpdateWorkerRequest)(nil),               // 71: api_client.UpdateWorkerRequest
+	(*UpdateWorkerResponse)(nil),              // 72: api_client.UpdateWorkerResponse
+	(*RunWorkerRequest)(nil),                  // 73: api_client.RunWorkerRequest
+	(*RunWorkerResponse)(nil),                 // 74: api_client.RunWorkerResponse
+	(*StopWorkerRequest)(nil),                 // 75: api_client.StopWorkerRequest
+	(*StopWorkerResponse)(nil),                // 76: api_client.StopWorkerResponse
+	(*ListWorkersRequest)(nil),                // 77: api_client.ListWorkersRequest
+	(*ListWorkersResponse)(nil),               // 78: api_client.ListWorkersResponse
+	(*CreateWorkerIngressRequest)(nil),        // 79: api_client.CreateWorkerIngressRequest
+	(*CreateWorkerIngressResponse)(nil),       // 80: api_client.CreateWorkerIngressResponse
+	(*GetWorkerIngressRequest)(nil),           // 81: api_client.GetWorkerIngressRequest
+	(*GetWorkerIngressResponse)(nil),          // 82: api_client.GetWorkerIngressResponse
+	(*GetWorkerRequest)(nil),                  // 83: api_client.GetWorkerRequest
+	(*GetWorkerResponse)(nil),                 // 84: api_client.GetWorkerResponse
+	(*GetWorkerStatusRequest)(nil),            // 85: api_client.GetWorkerStatusRequest
+	(*GetWorkerStatusResponse)(nil),           // 86: api_client.GetWorkerStatusResponse
+	(*InstallWorkerdRequest)(nil),             // 87: api_client.InstallWorkerdRequest
+	(*InstallWorkerdResponse)(nil),            // 88: api_client.InstallWorkerdResponse
+	(*RedeployWorkerRequest)(nil),             // 89: api_client.RedeployWorkerRequest
+	(*RedeployWorkerResponse)(nil),            // 90: api_client.RedeployWorkerResponse
+	(*ListWorkerCronInvocationsRequest)(nil),  // 91: api_client.ListWorkerCronInvocationsRequest
+	(*ListWorkerCronInvocationsResponse)(nil), // 92: api_client.ListWorkerCronInvocationsResponse
+	(*UploadWorkerdArtifactResponse)(nil),     // 93: api_client.UploadWorkerdArtifactResponse
+	(*ListWorkerdArtifactsRequest)(nil),       // 94: api_client.ListWorkerdArtifactsRequest
+	(*ListWorkerdArtifactsResponse)(nil),      // 95: api_client.ListWorkerdArtifactsResponse
+	(*DeleteWorkerdArtifactRequest)(nil),      // 96: api_client.DeleteWorkerdArtifactRequest
+	(*DeleteWorkerdArtifactResponse)(nil),     // 97: api_client.DeleteWorkerdArtifactResponse
+	(*ListPTYSessionsRequest)(nil),            // 98: api_client.ListPTYSessionsRequest
+	(*ListPTYSessionsResponse)(nil),           // 99: api_client.ListPTYSessionsResponse
+	(*TerminatePTYSessionRequest)(nil),        // 100: api_client.TerminatePTYSessionRequest
+	(*TerminatePTYSessionResponse)(nil),       // 101: api_client.TerminatePTYSessionResponse
+	(*UpdatePTYSessionShareRequest)(nil),      // 102: api_client.UpdatePTYSessionShareRequest
+	(*UpdatePTYSessionShareResponse)(nil),     // 103: api_client.UpdatePTYSessionShareResponse
+	(*SetPTYPolicyRequest)(nil),               // 104: api_client.SetPTYPolicyRequest
+	(*SetPTYPolicyResponse)(nil),              // 105: api_client.SetPTYPolicyResponse
+	(*ExecCommandRequest)(nil),                // 106: api_client.ExecCommandRequest
+	(*ExecCommandResponse)(nil),               // 107: api_client.ExecCommandResponse
+	(*ListExecRecordsRequest)(nil),            // 108: api_client.ListExecRecordsRequest
+	(*ListExecRecordsResponse)(nil),           // 109: api_client.ListExecRecordsResponse
+	(*StartFileTransferRequest)(nil),          // 110: api_client.StartFileTransferRequest
+	(*StartFileTransferResponse)(nil),         // 111: api_client.StartFileTransferResponse
+	(*ListDirRequest)(nil),                    // 112: api_client.ListDirRequest
+	(*ListDirResponse)(nil),                   // 113: api_client.ListDirResponse
+	(*UploadClientFileResponse)(nil),          // 114: api_client.UploadClientFileResponse
+	(*QueryLogsRequest)(nil),                  // 115: api_client.QueryLogsRequest
+	(*QueryLogsResponse)(nil),                 // 116: api_client.QueryLogsResponse
+	nil,                                       // 117: api_client.ListProxyStatusResponse.StatusCountsEntry
+	nil,                                       // 118: api_client.GetWorkerStatusResponse.WorkerStatusEntry
+	nil,                                       // 119: api_client.ExecCommandRequest.EnvEntry
+	(*Status)(nil),                            // 120: common.Status
+	(*Client)(nil),                            // 121: common.Client
+	(*ProxyInfo)(nil),                         // 122: common.ProxyInfo
+	(*ProxyConfig)(nil),                       // 123: common.ProxyConfig
+	(*ProxyWorkingStatus)(nil),                // 124: common.ProxyWorkingStatus
+	(*ProxySchedule)(nil),                     // 125: common.ProxySchedule
+	(*ProxyProbe)(nil),                        // 126: common.ProxyProbe
+	(*ProxyProbeResult)(nil),                  // 127: common.ProxyProbeResult
+	(*ProxyScheduleWindow)(nil),               // 128: common.ProxyScheduleWindow
+	(*VisitorConfig)(nil),                     // 129: common.VisitorConfig
+	(*Worker)(nil),                            // 130: common.Worker
+	(*WorkerCronInvocation)(nil),              // 131: common.WorkerCronInvocation
+	(*WorkerdArtifact)(nil),                   // 132: common.WorkerdArtifact
+	(*PTYSession)(nil),                        // 133: common.PTYSession
+	(PTYSession_ShareMode)(0),                 // 134: common.PTYSession.ShareMode
+	(*ExecResult)(nil),                        // 135: common.ExecResult
+	(*ExecRecord)(nil),                        // 136: common.ExecRecord
+	(*FileInfo)(nil),                          // 137: common.FileInfo
+	(*LogEntry)(nil),                          // 138: common.LogEntry
 }
 var file_api_client_proto_depIdxs = []int32{
-	57, // 0: api_client.InitClientResponse.status:type_name -> common.Status
-	57, // 1: api_client.ListClientsResponse.status:type_name -> common.Status
-	58, // 2: api_client.ListClientsResponse.clients:type_name -> common.Client
-	57, // 3: api_client.GetClientResponse.status:type_name -> common.Status
-	58, // 4: api_client.GetClientResponse.client:type_name -> common.Client
-	57, // 5: api_client.DeleteClientResponse.status:type_name -> common.Status
-	57, // 6: api_client.UpdateFRPCResponse.status:type_name -> common.Status
-	57, // 7: api_client.RemoveFRPCResponse.status:type_name -> common.Status
-	57, // 8: api_client.StopFRPCResponse.status:type_name -> common.Status
-	57, // 9: api_client.StartFRPCResponse.status:type_name -> common.Status
-	57, // 10: api_client.GetProxyStatsByClientIDResponse.status:type_name -> common.Status
-	59, // 11: api_client.GetProxyStatsByClientIDResponse.proxy_infos:type_name -> common.ProxyInfo
-	57, // 12: api_client.ListProxyConfigsResponse.status:type_name -> common.Status
-	60, // 13: api_client.ListProxyConfigsResponse.proxy_configs:type_name -> common.ProxyConfig
-	57, // 14: api_client.CreateProxyConfigResponse.status:type_name -> common.Status
-	57, // 15: api_client.DeleteProxyConfigResponse.status:type_name -> common.Status
-	57, // 16: api_client.UpdateProxyConfigResponse.status:type_name -> common.Status
-	57, // 17: api_client.GetProxyConfigResponse.status:type_name -> common.Status
-	60, // 18: api_client.GetProxyConfigResponse.proxy_config:type_name -> common.ProxyConfig
-	61, // 19: api_client.GetProxyConfigResponse.working_status:type_name -> common.ProxyWorkingStatus
-	57, // 20: api_client.StopProxyResponse.status:type_name -> common.Status
-	57, // 21: api_client.StartProxyResponse.status:type_name -> common.Status
-	62, // 22: api_client.CreateWorkerRequest.worker:type_name -> common.Worker
-	57, // 23: api_client.CreateWorkerResponse.status:type_name -> common.Status
-	57, // 24: api_client.RemoveWorkerResponse.status:type_name -> common.Status
-	62, // 25: api_client.UpdateWorkerRequest.worker:type_name -> common.Worker
-	57, // 26: api_client.UpdateWorkerResponse.status:type_name -> common.Status
-	57, // 27: api_client.RunWorkerResponse.status:type_name -> common.Status
-	57, // 28: api_client.StopWorkerResponse.status:type_name -> common.Status
-	57, // 29: api_client.ListWorkersResponse.status:type_name -> common.Status
-	62, // 30: api_client.ListWorkersResponse.workers:type_name -> common.Worker
-	57, // 31: api_client.CreateWorkerIngressResponse.status:type_name -> common.Status
-	57, // 32: api_client.GetWorkerIngressResponse.status:type_name -> common.Status
-	60, // 33: api_client.GetWorkerIngressResponse.proxy_configs:type_name -> common.ProxyConfig
-	57, // 34: api_client.GetWorkerResponse.status:type_name -> common.Status
-	62, // 35: api_client.GetWorkerResponse.worker:type_name -> common.Worker
-	58, // 36: api_client.GetWorkerResponse.clients:type_name -> common.Client
-	57, // 37: api_client.GetWorkerStatusResponse.status:type_name -> common.Status
-	56, // 38: api_client.GetWorkerStatusResponse.worker_status:type_name -> api_client.GetWorkerStatusResponse.WorkerStatusEntry
-	57, // 39: api_client.InstallWorkerdResponse.status:type_name -> common.Status
-	57, // 40: api_client.RedeployWorkerResponse.status:type_name -> common.Status
-	41, // [41:41] is the sub-list for method output_type
-	41, // [41:41] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+	120, // 0: api_client.InitClientResponse.status:type_name -> common.Status
+	120, // 1: api_client.ListClientsResponse.status:type_name -> common.Status
+	121, // 2: api_client.ListClientsResponse.clients:type_name -> common.Client
+	120, // 3: api_client.GetClientResponse.status:type_name -> common.Status
+	121, // 4: api_client.GetClientResponse.client:type_name -> common.Client
+	120, // 5: api_client.DeleteClientResponse.status:type_name -> common.Status
+	120, // 6: api_client.UpdateFRPCResponse.status:type_name -> common.Status
+	120, // 7: api_client.RemoveFRPCResponse.status:type_name -> common.Status
+	120, // 8: api_client.StopFRPCResponse.status:type_name -> common.Status
+	120, // 9: api_client.StartFRPCResponse.status:type_name -> common.Status
+	120, // 10: api_client.GetProxyStatsByClientIDResponse.status:type_name -> common.Status
+	122, // 11: api_client.GetProxyStatsByClientIDResponse.proxy_infos:type_name -> common.ProxyInfo
+	120, // 12: api_client.ListProxyConfigsResponse.status:type_name -> common.Status
+	123, // 13: api_client.ListProxyConfigsResponse.proxy_configs:type_name -> common.ProxyConfig
+	120, // 14: api_client.CreateProxyConfigResponse.status:type_name -> common.Status
+	120, // 15: api_client.DeleteProxyConfigResponse.status:type_name -> common.Status
+	120, // 16: api_client.UpdateProxyConfigResponse.status:type_name -> common.Status
+	120, // 17: api_client.GetProxyConfigResponse.status:type_name -> common.Status
+	123, // 18: api_client.GetProxyConfigResponse.proxy_config:type_name -> common.ProxyConfig
+	124, // 19: api_client.GetProxyConfigResponse.working_status:type_name -> common.ProxyWorkingStatus
+	125, // 20: api_client.GetProxyConfigResponse.schedule:type_name -> common.ProxySchedule
+	120, // 21: api_client.StopProxyResponse.status:type_name -> common.Status
+	120, // 22: api_client.StartProxyResponse.status:type_name -> common.Status
+	120, // 23: api_client.MoveProxyConfigResponse.status:type_name -> common.Status
+	123, // 24: api_client.MoveProxyConfigResponse.proxy_config:type_name -> common.ProxyConfig
+	120, // 25: api_client.CloneProxyConfigResponse.status:type_name -> common.Status
+	123, // 26: api_client.CloneProxyConfigResponse.proxy_config:type_name -> common.ProxyConfig
+	120, // 27: api_client.ListProxyStatusResponse.status:type_name -> common.Status
+	124, // 28: api_client.ListProxyStatusResponse.statuses:type_name -> common.ProxyWorkingStatus
+	117, // 29: api_client.ListProxyStatusResponse.status_counts:type_name -> api_client.ListProxyStatusResponse.StatusCountsEntry
+	126, // 30: api_client.SetProxyProbeRequest.probe:type_name -> common.ProxyProbe
+	120, // 31: api_client.SetProxyProbeResponse.status:type_name -> common.Status
+	126, // 32: api_client.SetProxyProbeResponse.probe:type_name -> common.ProxyProbe
+	120, // 33: api_client.DeleteProxyProbeResponse.status:type_name -> common.Status
+	120, // 34: api_client.GetProxyProbeResponse.status:type_name -> common.Status
+	126, // 35: api_client.GetProxyProbeResponse.probe:type_name -> common.ProxyProbe
+	127, // 36: api_client.GetProxyProbeResponse.history:type_name -> common.ProxyProbeResult
+	120, // 37: api_client.ListProxyProbesResponse.status:type_name -> common.Status
+	126, // 38: api_client.ListProxyProbesResponse.probes:type_name -> common.ProxyProbe
+	128, // 39: api_client.SetProxyScheduleRequest.windows:type_name -> common.ProxyScheduleWindow
+	120, // 40: api_client.SetProxyScheduleResponse.status:type_name -> common.Status
+	125, // 41: api_client.SetProxyScheduleResponse.schedule:type_name -> common.ProxySchedule
+	120, // 42: api_client.DeleteProxyScheduleResponse.status:type_name -> common.Status
+	120, // 43: api_client.ListVisitorConfigsResponse.status:type_name -> common.Status
+	129, // 44: api_client.ListVisitorConfigsResponse.visitor_configs:type_name -> common.VisitorConfig
+	120, // 45: api_client.CreateVisitorConfigResponse.status:type_name -> common.Status
+	120, // 46: api_client.DeleteVisitorConfigResponse.status:type_name -> common.Status
+	120, // 47: api_client.UpdateVisitorConfigResponse.status:type_name -> common.Status
+	120, // 48: api_client.GetVisitorConfigResponse.status:type_name -> common.Status
+	129, // 49: api_client.GetVisitorConfigResponse.visitor_config:type_name -> common.VisitorConfig
+	120, // 50: api_client.StopVisitorResponse.status:type_name -> common.Status
+	120, // 51: api_client.StartVisitorResponse.status:type_name -> common.Status
+	120, // 52: api_client.GrantVisitorAccessResponse.status:type_name -> common.Status
+	129, // 53: api_client.GrantVisitorAccessResponse.visitor_config:type_name -> common.VisitorConfig
+	130, // 54: api_client.CreateWorkerRequest.worker:type_name -> common.Worker
+	120, // 55: api_client.CreateWorkerResponse.status:type_name -> common.Status
+	120, // 56: api_client.RemoveWorkerResponse.status:type_name -> common.Status
+	130, // 57: api_client.UpdateWorkerRequest.worker:type_name -> common.Worker
+	120, // 58: api_client.UpdateWorkerResponse.status:type_name -> common.Status
+	120, // 59: api_client.RunWorkerResponse.status:type_name -> common.Status
+	120, // 60: api_client.StopWorkerResponse.status:type_name -> common.Status
+	120, // 61: api_client.ListWorkersResponse.status:type_name -> common.Status
+	130, // 62: api_client.ListWorkersResponse.workers:type_name -> common.Worker
+	120, // 63: api_client.CreateWorkerIngressResponse.status:type_name -> common.Status
+	120, // 64: api_client.GetWorkerIngressResponse.status:type_name -> common.Status
+	123, // 65: api_client.GetWorkerIngressResponse.proxy_configs:type_name -> common.ProxyConfig
+	120, // 66: api_client.GetWorkerResponse.status:type_name -> common.Status
+	130, // 67: api_client.GetWorkerResponse.worker:type_name -> common.Worker
+	121, // 68: api_client.GetWorkerResponse.clients:type_name -> common.Client
+	120, // 69: api_client.GetWorkerStatusResponse.status:type_name -> common.Status
+	118, // 70: api_client.GetWorkerStatusResponse.worker_status:type_name -> api_client.GetWorkerStatusResponse.WorkerStatusEntry
+	120, // 71: api_client.InstallWorkerdResponse.status:type_name -> common.Status
+	120, // 72: api_client.RedeployWorkerResponse.status:type_name -> common.Status
+	120, // 73: api_client.ListWorkerCronInvocationsResponse.status:type_name -> common.Status
+	131, // 74: api_client.ListWorkerCronInvocationsResponse.invocations:type_name -> common.WorkerCronInvocation
+	120, // 75: api_client.UploadWorkerdArtifactResponse.status:type_name -> common.Status
+	132, // 76: api_client.UploadWorkerdArtifactResponse.artifact:type_name -> common.WorkerdArtifact
+	120, // 77: api_client.ListWorkerdArtifactsResponse.status:type_name -> common.Status
+	132, // 78: api_client.ListWorkerdArtifactsResponse.artifacts:type_name -> common.WorkerdArtifact
+	120, // 79: api_client.DeleteWorkerdArtifactResponse.status:type_name -> common.Status
+	120, // 80: api_client.ListPTYSessionsResponse.status:type_name -> common.Status
+	133, // 81: api_client.ListPTYSessionsResponse.sessions:type_name -> common.PTYSession
+	120, // 82: api_client.TerminatePTYSessionResponse.status:type_name -> common.Status
+	134, // 83: api_client.UpdatePTYSessionShareRequest.share_mode:type_name -> common.PTYSession.ShareMode
+	120, // 84: api_client.UpdatePTYSessionShareResponse.status:type_name -> common.Status
+	120, // 85: api_client.SetPTYPolicyResponse.status:type_name -> common.Status
+	119, // 86: api_client.ExecCommandRequest.env:type_name -> api_client.ExecCommandRequest.EnvEntry
+	120, // 87: api_client.ExecCommandResponse.status:type_name -> common.Status
+	135, // 88: api_client.ExecCommandResponse.results:type_name -> common.ExecResult
+	120, // 89: api_client.ListExecRecordsResponse.status:type_name -> common.Status
+	136, // 90: api_client.ListExecRecordsResponse.records:type_name -> common.ExecRecord
+	0,   // 91: api_client.StartFileTransferRequest.op:type_name -> api_client.StartFileTransferRequest.Op
+	120, // 92: api_client.StartFileTransferResponse.status:type_name -> common.Status
+	120, // 93: api_client.ListDirResponse.status:type_name -> common.Status
+	137, // 94: api_client.ListDirResponse.files:type_name -> common.FileInfo
+	120, // 95: api_client.UploadClientFileResponse.status:type_name -> common.Status
+	120, // 96: api_client.QueryLogsResponse.status:type_name -> common.Status
+	138, // 97: api_client.QueryLogsResponse.entries:type_name -> common.LogEntry
+	98,  // [98:98] is the sub-list for method output_type
+	98,  // [98:98] is the sub-list for method input_type
+	98,  // [98:98] is the sub-list for extension type_name
+	98,  // [98:98] is the sub-list for extension extendee
+	0,   // [0:98] is the sub-list for field type_name
 }
 
 func init() { file_api_client_proto_init() }
@@ -3449,18 +7780,79 @@ func file_api_client_proto_init() {
 	file_api_client_proto_msgTypes[53].OneofWrappers = []any{}
 	file_api_client_proto_msgTypes[54].OneofWrappers = []any{}
 	file_api_client_proto_msgTypes[55].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[56].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[57].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[58].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[59].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[60].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[61].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[62].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[63].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[64].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[65].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[66].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[67].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[68].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[69].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[70].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[71].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[72].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[73].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[74].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[75].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[76].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[77].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[78].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[79].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[80].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[81].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[82].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[83].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[84].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[85].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[86].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[87].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[88].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[89].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[90].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[91].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[92].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[93].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[94].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[95].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[96].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[97].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[98].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[99].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[100].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[101].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[102].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[103].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[104].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[105].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[106].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[107].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[108].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[109].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[110].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[111].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[112].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[113].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[114].OneofWrappers = []any{}
+	file_api_client_proto_msgTypes[115].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_client_proto_rawDesc), len(file_api_client_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   57,
+			NumEnums:      1,
+			NumMessages:   119,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_api_client_proto_goTypes,
 		DependencyIndexes: file_api_client_proto_depIdxs,
+		EnumInfos:         file_api_client_proto_enumTypes,
 		MessageInfos:      file_api_client_proto_msgTypes,
 	}.Build()
 	File_api_client_proto = out.File
@@ -166,16 +166,17 @@ func (x *ClientStatus) GetConnectTime() int64 {
 }
 
 type ClientVersion struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GitVersion    string                 `protobuf:"bytes,1,opt,name=GitVersion,proto3" json:"GitVersion,omitempty"`
-	GitCommit     string                 `protobuf:"bytes,2,opt,name=GitCommit,proto3" json:"GitCommit,omitempty"`
-	BuildDate     string                 `protobuf:"bytes,3,opt,name=BuildDate,proto3" json:"BuildDate,omitempty"`
-	GoVersion     string                 `protobuf:"bytes,4,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
-	Compiler      string                 `protobuf:"bytes,5,opt,name=Compiler,proto3" json:"Compiler,omitempty"`
-	Platform      string                 `protobuf:"bytes,6,opt,name=Platform,proto3" json:"Platform,omitempty"`
-	GitBranch     string                 `protobuf:"bytes,7,opt,name=GitBranch,proto3" json:"GitBranch,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GitVersion     string                 `protobuf:"bytes,1,opt,name=GitVersion,proto3" json:"GitVersion,omitempty"`
+	GitCommit      string                 `protobuf:"bytes,2,opt,name=GitCommit,proto3" json:"GitCommit,omitempty"`
+	BuildDate      string                 `protobuf:"bytes,3,opt,name=BuildDate,proto3" json:"BuildDate,omitempty"`
+	GoVersion      string                 `protobuf:"bytes,4,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
+	Compiler       string                 `protobuf:"bytes,5,opt,name=Compiler,proto3" json:"Compiler,omitempty"`
+	Platform       string                 `protobuf:"bytes,6,opt,name=Platform,proto3" json:"Platform,omitempty"`
+	GitBranch      string                 `protobuf:"bytes,7,opt,name=GitBranch,proto3" json:"GitBranch,omitempty"`
+	WorkerdVersion string                 `protobuf:"bytes,8,opt,name=WorkerdVersion,proto3" json:"WorkerdVersion,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ClientVersion) Reset() {
@@ -257,6 +258,13 @@ func (x *ClientVersion) GetGitBranch() string {
 	return ""
 }
 
+func (x *ClientVersion) GetWorkerdVersion() string {
+	if x != nil {
+		return x.WorkerdVersion
+	}
+	return ""
+}
+
 type GetClientsStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClientType    ClientType             `protobuf:"varint,1,opt,name=client_type,json=clientType,proto3,enum=common.ClientType" json:"client_type,omitempty"`
@@ -561,65 +569,2054 @@ func (x *StartSteamLogResponse) GetStatus() *Status {
 	return nil
 }
 
-var File_api_master_proto protoreflect.FileDescriptor
+type CreateNotifyChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *NotifyChannel         `protobuf:"bytes,1,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_master_proto_rawDesc = "" +
-	"\n" +
-	"\x10api_master.proto\x12\n" +
-	"api_master\x1a\fcommon.proto\"\xa9\x03\n" +
-	"\fClientStatus\x123\n" +
-	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
-	"clientType\x12\x1b\n" +
-	"\tclient_id\x18\x02 \x01(\tR\bclientId\x127\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x1f.api_master.ClientStatus.StatusR\x06status\x12\x12\n" +
-	"\x04ping\x18\x04 \x01(\x05R\x04ping\x128\n" +
-	"\aversion\x18\x05 \x01(\v2\x19.api_master.ClientVersionH\x00R\aversion\x88\x01\x01\x12\x17\n" +
-	"\x04addr\x18\x06 \x01(\tH\x01R\x04addr\x88\x01\x01\x12&\n" +
-	"\fconnect_time\x18\a \x01(\x03H\x02R\vconnectTime\x88\x01\x01\"Y\n" +
-	"\x06Status\x12\x16\n" +
-	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
-	"\rSTATUS_ONLINE\x10\x01\x12\x12\n" +
-	"\x0eSTATUS_OFFLINE\x10\x02\x12\x10\n" +
-	"\fSTATUS_ERROR\x10\x03B\n" +
-	"\n" +
-	"\b_versionB\a\n" +
-	"\x05_addrB\x0f\n" +
-	"\r_connect_time\"\xdf\x01\n" +
-	"\rClientVersion\x12\x1e\n" +
-	"\n" +
-	"GitVersion\x18\x01 \x01(\tR\n" +
-	"GitVersion\x12\x1c\n" +
-	"\tGitCommit\x18\x02 \x01(\tR\tGitCommit\x12\x1c\n" +
-	"\tBuildDate\x18\x03 \x01(\tR\tBuildDate\x12\x1c\n" +
-	"\tGoVersion\x18\x04 \x01(\tR\tGoVersion\x12\x1a\n" +
-	"\bCompiler\x18\x05 \x01(\tR\bCompiler\x12\x1a\n" +
-	"\bPlatform\x18\x06 \x01(\tR\bPlatform\x12\x1c\n" +
-	"\tGitBranch\x18\a \x01(\tR\tGitBranch\"m\n" +
-	"\x17GetClientsStatusRequest\x123\n" +
-	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
-	"clientType\x12\x1d\n" +
-	"\n" +
-	"client_ids\x18\x02 \x03(\tR\tclientIds\"\xf5\x01\n" +
-	"\x18GetClientsStatusResponse\x12+\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12K\n" +
-	"\aclients\x18\x02 \x03(\v21.api_master.GetClientsStatusResponse.ClientsEntryR\aclients\x1aT\n" +
-	"\fClientsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
-	"\x05value\x18\x02 \x01(\v2\x18.api_master.ClientStatusR\x05value:\x028\x01B\t\n" +
-	"\a_status\"\x8d\x01\n" +
-	"\x14GetClientCertRequest\x123\n" +
-	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
-	"clientType\x12\x1b\n" +
-	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
-	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\"c\n" +
-	"\x15GetClientCertResponse\x12+\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x12\n" +
-	"\x04cert\x18\x02 \x01(\fR\x04certB\t\n" +
-	"\a_status\"*\n" +
-	"\x14StartSteamLogRequest\x12\x12\n" +
-	"\x04pkgs\x18\x01 \x03(\tR\x04pkgs\"O\n" +
-	"\x15StartSteamLogResponse\x12+\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+func (x *CreateNotifyChannelRequest) Reset() {
+	*x = CreateNotifyChannelRequest{}
+	mi := &file_api_master_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotifyChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotifyChannelRequest) ProtoMessage() {}
+
+func (x *CreateNotifyChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotifyChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotifyChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateNotifyChannelRequest) GetChannel() *NotifyChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type CreateNotifyChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Channel       *NotifyChannel         `protobuf:"bytes,2,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotifyChannelResponse) Reset() {
+	*x = CreateNotifyChannelResponse{}
+	mi := &file_api_master_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotifyChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotifyChannelResponse) ProtoMessage() {}
+
+func (x *CreateNotifyChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotifyChannelResponse.ProtoReflect.Descriptor instead.
+func (*CreateNotifyChannelResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateNotifyChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateNotifyChannelResponse) GetChannel() *NotifyChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type UpdateNotifyChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *NotifyChannel         `protobuf:"bytes,1,opt,name=channel,proto3,oneof" json:"channel,omitempty"` // 密码和签名密钥为空时保持不变
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotifyChannelRequest) Reset() {
+	*x = UpdateNotifyChannelRequest{}
+	mi := &file_api_master_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotifyChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotifyChannelRequest) ProtoMessage() {}
+
+func (x *UpdateNotifyChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotifyChannelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotifyChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateNotifyChannelRequest) GetChannel() *NotifyChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type UpdateNotifyChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotifyChannelResponse) Reset() {
+	*x = UpdateNotifyChannelResponse{}
+	mi := &file_api_master_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotifyChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotifyChannelResponse) ProtoMessage() {}
+
+func (x *UpdateNotifyChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotifyChannelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotifyChannelResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateNotifyChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DeleteNotifyChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotifyChannelRequest) Reset() {
+	*x = DeleteNotifyChannelRequest{}
+	mi := &file_api_master_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotifyChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotifyChannelRequest) ProtoMessage() {}
+
+func (x *DeleteNotifyChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotifyChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotifyChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteNotifyChannelRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type DeleteNotifyChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotifyChannelResponse) Reset() {
+	*x = DeleteNotifyChannelResponse{}
+	mi := &file_api_master_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotifyChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotifyChannelResponse) ProtoMessage() {}
+
+func (x *DeleteNotifyChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotifyChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotifyChannelResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteNotifyChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListNotifyChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotifyChannelsRequest) Reset() {
+	*x = ListNotifyChannelsRequest{}
+	mi := &file_api_master_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotifyChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotifyChannelsRequest) ProtoMessage() {}
+
+func (x *ListNotifyChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotifyChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotifyChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{14}
+}
+
+type ListNotifyChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Channels      []*NotifyChannel       `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"` // 密码和签名密钥不会返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotifyChannelsResponse) Reset() {
+	*x = ListNotifyChannelsResponse{}
+	mi := &file_api_master_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotifyChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotifyChannelsResponse) ProtoMessage() {}
+
+func (x *ListNotifyChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotifyChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotifyChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListNotifyChannelsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListNotifyChannelsResponse) GetChannels() []*NotifyChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type TestNotifyChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestNotifyChannelRequest) Reset() {
+	*x = TestNotifyChannelRequest{}
+	mi := &file_api_master_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestNotifyChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestNotifyChannelRequest) ProtoMessage() {}
+
+func (x *TestNotifyChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestNotifyChannelRequest.ProtoReflect.Descriptor instead.
+func (*TestNotifyChannelRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TestNotifyChannelRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type TestNotifyChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestNotifyChannelResponse) Reset() {
+	*x = TestNotifyChannelResponse{}
+	mi := &file_api_master_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestNotifyChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestNotifyChannelResponse) ProtoMessage() {}
+
+func (x *TestNotifyChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestNotifyChannelResponse.ProtoReflect.Descriptor instead.
+func (*TestNotifyChannelResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TestNotifyChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ImportConfigFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`       // 文件名，去掉扩展名后作为 client/server id
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3,oneof" json:"content,omitempty"` // toml/yaml/json/ini 格式的 frpc 或 frps 配置
+	Kind          *string                `protobuf:"bytes,3,opt,name=kind,proto3,oneof" json:"kind,omitempty"`       // client 或 server，为空时自动识别
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConfigFile) Reset() {
+	*x = ImportConfigFile{}
+	mi := &file_api_master_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfigFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigFile) ProtoMessage() {}
+
+func (x *ImportConfigFile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigFile.ProtoReflect.Descriptor instead.
+func (*ImportConfigFile) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportConfigFile) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ImportConfigFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportConfigFile) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+type ImportConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*ImportConfigFile    `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	DryRun        *bool                  `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"`
+	ServerId      *string                `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"` // serverAddr 匹配不到已知 server 的 client 会挂到这个 server 上
+	ServerIp      *string                `protobuf:"bytes,4,opt,name=server_ip,json=serverIp,proto3,oneof" json:"server_ip,omitempty"` // 导入的 server 没有监听具体地址时使用的公网 ip
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConfigRequest) Reset() {
+	*x = ImportConfigRequest{}
+	mi := &file_api_master_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigRequest) ProtoMessage() {}
+
+func (x *ImportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ImportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ImportConfigRequest) GetFiles() []*ImportConfigFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ImportConfigRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *ImportConfigRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *ImportConfigRequest) GetServerIp() string {
+	if x != nil && x.ServerIp != nil {
+		return *x.ServerIp
+	}
+	return ""
+}
+
+type ImportConfigResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      *string                `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`
+	Kind          *string                `protobuf:"bytes,2,opt,name=kind,proto3,oneof" json:"kind,omitempty"`
+	ServerId      *string                `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ProxyNames    []string               `protobuf:"bytes,5,rep,name=proxy_names,json=proxyNames,proto3" json:"proxy_names,omitempty"`
+	VisitorNames  []string               `protobuf:"bytes,6,rep,name=visitor_names,json=visitorNames,proto3" json:"visitor_names,omitempty"`
+	Conflicts     []string               `protobuf:"bytes,7,rep,name=conflicts,proto3" json:"conflicts,omitempty"` // 存在冲突时不会导入该文件
+	Imported      *bool                  `protobuf:"varint,8,opt,name=imported,proto3,oneof" json:"imported,omitempty"`
+	Error         *string                `protobuf:"bytes,9,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConfigResult) Reset() {
+	*x = ImportConfigResult{}
+	mi := &file_api_master_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfigResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigResult) ProtoMessage() {}
+
+func (x *ImportConfigResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigResult.ProtoReflect.Descriptor instead.
+func (*ImportConfigResult) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportConfigResult) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *ImportConfigResult) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *ImportConfigResult) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *ImportConfigResult) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ImportConfigResult) GetProxyNames() []string {
+	if x != nil {
+		return x.ProxyNames
+	}
+	return nil
+}
+
+func (x *ImportConfigResult) GetVisitorNames() []string {
+	if x != nil {
+		return x.VisitorNames
+	}
+	return nil
+}
+
+func (x *ImportConfigResult) GetConflicts() []string {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *ImportConfigResult) GetImported() bool {
+	if x != nil && x.Imported != nil {
+		return *x.Imported
+	}
+	return false
+}
+
+func (x *ImportConfigResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type ImportConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Results       []*ImportConfigResult  `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConfigResponse) Reset() {
+	*x = ImportConfigResponse{}
+	mi := &file_api_master_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigResponse) ProtoMessage() {}
+
+func (x *ImportConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigResponse.ProtoReflect.Descriptor instead.
+func (*ImportConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportConfigResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ImportConfigResponse) GetResults() []*ImportConfigResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExportConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"` // 为空时导出 server 的 frps 配置
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Format        *string                `protobuf:"bytes,3,opt,name=format,proto3,oneof" json:"format,omitempty"` // toml/yaml/json，默认 toml
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportConfigRequest) Reset() {
+	*x = ExportConfigRequest{}
+	mi := &file_api_master_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigRequest) ProtoMessage() {}
+
+func (x *ExportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ExportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportConfigRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ExportConfigRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *ExportConfigRequest) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return ""
+}
+
+type ExportConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	FileName      *string                `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportConfigResponse) Reset() {
+	*x = ExportConfigResponse{}
+	mi := &file_api_master_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigResponse) ProtoMessage() {}
+
+func (x *ExportConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigResponse.ProtoReflect.Descriptor instead.
+func (*ExportConfigResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportConfigResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ExportConfigResponse) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *ExportConfigResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ExportArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        *string                `protobuf:"bytes,1,opt,name=format,proto3,oneof" json:"format,omitempty"` // toml/yaml/json，默认 toml
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArchiveRequest) Reset() {
+	*x = ExportArchiveRequest{}
+	mi := &file_api_master_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArchiveRequest) ProtoMessage() {}
+
+func (x *ExportArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArchiveRequest.ProtoReflect.Descriptor instead.
+func (*ExportArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportArchiveRequest) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return ""
+}
+
+type ExportArchiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	FileName      *string                `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"` // tar.gz
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportArchiveResponse) Reset() {
+	*x = ExportArchiveResponse{}
+	mi := &file_api_master_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportArchiveResponse) ProtoMessage() {}
+
+func (x *ExportArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportArchiveResponse.ProtoReflect.Descriptor instead.
+func (*ExportArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportArchiveResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ExportArchiveResponse) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *ExportArchiveResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ApplyManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      []byte                 `protobuf:"bytes,1,opt,name=manifest,proto3,oneof" json:"manifest,omitempty"` // yaml 或 json 格式的声明式配置
+	DryRun        *bool                  `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"`
+	Prune         *bool                  `protobuf:"varint,3,opt,name=prune,proto3,oneof" json:"prune,omitempty"` // 删除 manifest 中没有声明的资源
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyManifestRequest) Reset() {
+	*x = ApplyManifestRequest{}
+	mi := &file_api_master_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyManifestRequest) ProtoMessage() {}
+
+func (x *ApplyManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyManifestRequest.ProtoReflect.Descriptor instead.
+func (*ApplyManifestRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ApplyManifestRequest) GetManifest() []byte {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *ApplyManifestRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *ApplyManifestRequest) GetPrune() bool {
+	if x != nil && x.Prune != nil {
+		return *x.Prune
+	}
+	return false
+}
+
+type ManifestPlanItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          *string                `protobuf:"bytes,1,opt,name=kind,proto3,oneof" json:"kind,omitempty"`     // server/client/proxy/visitor/worker
+	Action        *string                `protobuf:"bytes,2,opt,name=action,proto3,oneof" json:"action,omitempty"` // create/update/delete
+	Id            *string                `protobuf:"bytes,3,opt,name=id,proto3,oneof" json:"id,omitempty"`         // server 和 client 为 id，proxy、visitor 和 worker 为名称
+	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Changes       []string               `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"` // update 时变化的字段
+	Applied       *bool                  `protobuf:"varint,7,opt,name=applied,proto3,oneof" json:"applied,omitempty"`
+	Error         *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManifestPlanItem) Reset() {
+	*x = ManifestPlanItem{}
+	mi := &file_api_master_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManifestPlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestPlanItem) ProtoMessage() {}
+
+func (x *ManifestPlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestPlanItem.ProtoReflect.Descriptor instead.
+func (*ManifestPlanItem) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ManifestPlanItem) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *ManifestPlanItem) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *ManifestPlanItem) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *ManifestPlanItem) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ManifestPlanItem) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *ManifestPlanItem) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ManifestPlanItem) GetApplied() bool {
+	if x != nil && x.Applied != nil {
+		return *x.Applied
+	}
+	return false
+}
+
+func (x *ManifestPlanItem) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type ApplyManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Items         []*ManifestPlanItem    `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // 按执行顺序排列，没有变化的资源不会出现
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyManifestResponse) Reset() {
+	*x = ApplyManifestResponse{}
+	mi := &file_api_master_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyManifestResponse) ProtoMessage() {}
+
+func (x *ApplyManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyManifestResponse.ProtoReflect.Descriptor instead.
+func (*ApplyManifestResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApplyManifestResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ApplyManifestResponse) GetItems() []*ManifestPlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateLabelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  *string                `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`                                     // proxy/client/server/worker
+	ResourceId    *string                `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`                                           // client/server/worker id，proxy 为所在的 client id
+	ProxyName     *string                `protobuf:"bytes,3,opt,name=proxy_name,json=proxyName,proto3,oneof" json:"proxy_name,omitempty"`                                              // 仅 proxy 需要
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 新增或修改的标签，value 为空即为 tag
+	RemoveKeys    []string               `protobuf:"bytes,5,rep,name=remove_keys,json=removeKeys,proto3" json:"remove_keys,omitempty"`
+	Replace       *bool                  `protobuf:"varint,6,opt,name=replace,proto3,oneof" json:"replace,omitempty"` // 用 labels 替换资源的全部标签
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelsRequest) Reset() {
+	*x = UpdateLabelsRequest{}
+	mi := &file_api_master_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelsRequest) ProtoMessage() {}
+
+func (x *UpdateLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateLabelsRequest) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *UpdateLabelsRequest) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *UpdateLabelsRequest) GetProxyName() string {
+	if x != nil && x.ProxyName != nil {
+		return *x.ProxyName
+	}
+	return ""
+}
+
+func (x *UpdateLabelsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateLabelsRequest) GetRemoveKeys() []string {
+	if x != nil {
+		return x.RemoveKeys
+	}
+	return nil
+}
+
+func (x *UpdateLabelsRequest) GetReplace() bool {
+	if x != nil && x.Replace != nil {
+		return *x.Replace
+	}
+	return false
+}
+
+type UpdateLabelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 更新后的全部标签
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelsResponse) Reset() {
+	*x = UpdateLabelsResponse{}
+	mi := &file_api_master_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelsResponse) ProtoMessage() {}
+
+func (x *UpdateLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateLabelsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateLabelsResponse) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type SaveLabelFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *LabelFilter           `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"` // 同名时覆盖
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveLabelFilterRequest) Reset() {
+	*x = SaveLabelFilterRequest{}
+	mi := &file_api_master_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveLabelFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLabelFilterRequest) ProtoMessage() {}
+
+func (x *SaveLabelFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLabelFilterRequest.ProtoReflect.Descriptor instead.
+func (*SaveLabelFilterRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SaveLabelFilterRequest) GetFilter() *LabelFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type SaveLabelFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveLabelFilterResponse) Reset() {
+	*x = SaveLabelFilterResponse{}
+	mi := &file_api_master_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveLabelFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLabelFilterResponse) ProtoMessage() {}
+
+func (x *SaveLabelFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLabelFilterResponse.ProtoReflect.Descriptor instead.
+func (*SaveLabelFilterResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SaveLabelFilterResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListLabelFiltersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  *string                `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"` // 为空时返回全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLabelFiltersRequest) Reset() {
+	*x = ListLabelFiltersRequest{}
+	mi := &file_api_master_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLabelFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelFiltersRequest) ProtoMessage() {}
+
+func (x *ListLabelFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelFiltersRequest.ProtoReflect.Descriptor instead.
+func (*ListLabelFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListLabelFiltersRequest) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+type ListLabelFiltersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Filters       []*LabelFilter         `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLabelFiltersResponse) Reset() {
+	*x = ListLabelFiltersResponse{}
+	mi := &file_api_master_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLabelFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelFiltersResponse) ProtoMessage() {}
+
+func (x *ListLabelFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ListLabelFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListLabelFiltersResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListLabelFiltersResponse) GetFilters() []*LabelFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type DeleteLabelFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLabelFilterRequest) Reset() {
+	*x = DeleteLabelFilterRequest{}
+	mi := &file_api_master_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLabelFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelFilterRequest) ProtoMessage() {}
+
+func (x *DeleteLabelFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelFilterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLabelFilterRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteLabelFilterRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type DeleteLabelFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLabelFilterResponse) Reset() {
+	*x = DeleteLabelFilterResponse{}
+	mi := &file_api_master_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLabelFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelFilterResponse) ProtoMessage() {}
+
+func (x *DeleteLabelFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelFilterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLabelFilterResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteLabelFilterResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type BatchOperateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  *string                `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"`    // proxy/client/server/worker
+	LabelSelector *string                `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"` // 不能为空，避免误操作全部资源
+	Operation     *string                `protobuf:"bytes,3,opt,name=operation,proto3,oneof" json:"operation,omitempty"`                              // start/stop/delete，server 和 worker 只支持 delete
+	DryRun        *bool                  `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"`                     // 只返回匹配的资源
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperateRequest) Reset() {
+	*x = BatchOperateRequest{}
+	mi := &file_api_master_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperateRequest) ProtoMessage() {}
+
+func (x *BatchOperateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperateRequest.ProtoReflect.Descriptor instead.
+func (*BatchOperateRequest) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BatchOperateRequest) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *BatchOperateRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
+func (x *BatchOperateRequest) GetOperation() string {
+	if x != nil && x.Operation != nil {
+		return *x.Operation
+	}
+	return ""
+}
+
+func (x *BatchOperateRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+type BatchOperateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    *string                `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"` // proxy 为所在的 client id
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`       // 仅 proxy 有
+	ProxyName     *string                `protobuf:"bytes,3,opt,name=proxy_name,json=proxyName,proto3,oneof" json:"proxy_name,omitempty"`
+	Applied       *bool                  `protobuf:"varint,4,opt,name=applied,proto3,oneof" json:"applied,omitempty"`
+	Error         *string                `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperateResult) Reset() {
+	*x = BatchOperateResult{}
+	mi := &file_api_master_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperateResult) ProtoMessage() {}
+
+func (x *BatchOperateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperateResult.ProtoReflect.Descriptor instead.
+func (*BatchOperateResult) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchOperateResult) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *BatchOperateResult) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *BatchOperateResult) GetProxyName() string {
+	if x != nil && x.ProxyName != nil {
+		return *x.ProxyName
+	}
+	return ""
+}
+
+func (x *BatchOperateResult) GetApplied() bool {
+	if x != nil && x.Applied != nil {
+		return *x.Applied
+	}
+	return false
+}
+
+func (x *BatchOperateResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type BatchOperateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Results       []*BatchOperateResult  `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperateResponse) Reset() {
+	*x = BatchOperateResponse{}
+	mi := &file_api_master_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperateResponse) ProtoMessage() {}
+
+func (x *BatchOperateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_master_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperateResponse.ProtoReflect.Descriptor instead.
+func (*BatchOperateResponse) Descriptor() ([]byte, []int) {
+	return file_api_master_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchOperateResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *BatchOperateResponse) GetResults() []*BatchOperateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_api_master_proto protoreflect.FileDescriptor
+
+const file_api_master_proto_rawDesc = "" +
+	"\n" +
+	"\x10api_master.proto\x12\n" +
+	"api_master\x1a\fcommon.proto\"\xa9\x03\n" +
+	"\fClientStatus\x123\n" +
+	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
+	"clientType\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.api_master.ClientStatus.StatusR\x06status\x12\x12\n" +
+	"\x04ping\x18\x04 \x01(\x05R\x04ping\x128\n" +
+	"\aversion\x18\x05 \x01(\v2\x19.api_master.ClientVersionH\x00R\aversion\x88\x01\x01\x12\x17\n" +
+	"\x04addr\x18\x06 \x01(\tH\x01R\x04addr\x88\x01\x01\x12&\n" +
+	"\fconnect_time\x18\a \x01(\x03H\x02R\vconnectTime\x88\x01\x01\"Y\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATUS_ONLINE\x10\x01\x12\x12\n" +
+	"\x0eSTATUS_OFFLINE\x10\x02\x12\x10\n" +
+	"\fSTATUS_ERROR\x10\x03B\n" +
+	"\n" +
+	"\b_versionB\a\n" +
+	"\x05_addrB\x0f\n" +
+	"\r_connect_time\"\x87\x02\n" +
+	"\rClientVersion\x12\x1e\n" +
+	"\n" +
+	"GitVersion\x18\x01 \x01(\tR\n" +
+	"GitVersion\x12\x1c\n" +
+	"\tGitCommit\x18\x02 \x01(\tR\tGitCommit\x12\x1c\n" +
+	"\tBuildDate\x18\x03 \x01(\tR\tBuildDate\x12\x1c\n" +
+	"\tGoVersion\x18\x04 \x01(\tR\tGoVersion\x12\x1a\n" +
+	"\bCompiler\x18\x05 \x01(\tR\bCompiler\x12\x1a\n" +
+	"\bPlatform\x18\x06 \x01(\tR\bPlatform\x12\x1c\n" +
+	"\tGitBranch\x18\a \x01(\tR\tGitBranch\x12&\n" +
+	"\x0eWorkerdVersion\x18\b \x01(\tR\x0eWorkerdVersion\"m\n" +
+	"\x17GetClientsStatusRequest\x123\n" +
+	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
+	"clientType\x12\x1d\n" +
+	"\n" +
+	"client_ids\x18\x02 \x03(\tR\tclientIds\"\xf5\x01\n" +
+	"\x18GetClientsStatusResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12K\n" +
+	"\aclients\x18\x02 \x03(\v21.api_master.GetClientsStatusResponse.ClientsEntryR\aclients\x1aT\n" +
+	"\fClientsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.api_master.ClientStatusR\x05value:\x028\x01B\t\n" +
+	"\a_status\"\x8d\x01\n" +
+	"\x14GetClientCertRequest\x123\n" +
+	"\vclient_type\x18\x01 \x01(\x0e2\x12.common.ClientTypeR\n" +
+	"clientType\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\"c\n" +
+	"\x15GetClientCertResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x12\n" +
+	"\x04cert\x18\x02 \x01(\fR\x04certB\t\n" +
+	"\a_status\"*\n" +
+	"\x14StartSteamLogRequest\x12\x12\n" +
+	"\x04pkgs\x18\x01 \x03(\tR\x04pkgs\"O\n" +
+	"\x15StartSteamLogResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"^\n" +
+	"\x1aCreateNotifyChannelRequest\x124\n" +
+	"\achannel\x18\x01 \x01(\v2\x15.common.NotifyChannelH\x00R\achannel\x88\x01\x01B\n" +
+	"\n" +
+	"\b_channel\"\x97\x01\n" +
+	"\x1bCreateNotifyChannelResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x124\n" +
+	"\achannel\x18\x02 \x01(\v2\x15.common.NotifyChannelH\x01R\achannel\x88\x01\x01B\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_channel\"^\n" +
+	"\x1aUpdateNotifyChannelRequest\x124\n" +
+	"\achannel\x18\x01 \x01(\v2\x15.common.NotifyChannelH\x00R\achannel\x88\x01\x01B\n" +
+	"\n" +
+	"\b_channel\"U\n" +
+	"\x1bUpdateNotifyChannelResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"8\n" +
+	"\x1aDeleteNotifyChannelRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"U\n" +
+	"\x1bDeleteNotifyChannelResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x1b\n" +
+	"\x19ListNotifyChannelsRequest\"\x87\x01\n" +
+	"\x1aListNotifyChannelsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x121\n" +
+	"\bchannels\x18\x02 \x03(\v2\x15.common.NotifyChannelR\bchannelsB\t\n" +
+	"\a_status\"6\n" +
+	"\x18TestNotifyChannelRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"S\n" +
+	"\x19TestNotifyChannelResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x81\x01\n" +
+	"\x10ImportConfigFile\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\acontent\x18\x02 \x01(\fH\x01R\acontent\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x03 \x01(\tH\x02R\x04kind\x88\x01\x01B\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_contentB\a\n" +
+	"\x05_kind\"\xd3\x01\n" +
+	"\x13ImportConfigRequest\x122\n" +
+	"\x05files\x18\x01 \x03(\v2\x1c.api_master.ImportConfigFileR\x05files\x12\x1c\n" +
+	"\adry_run\x18\x02 \x01(\bH\x00R\x06dryRun\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x03 \x01(\tH\x01R\bserverId\x88\x01\x01\x12 \n" +
+	"\tserver_ip\x18\x04 \x01(\tH\x02R\bserverIp\x88\x01\x01B\n" +
+	"\n" +
+	"\b_dry_runB\f\n" +
+	"\n" +
+	"_server_idB\f\n" +
+	"\n" +
+	"_server_ip\"\xfd\x02\n" +
+	"\x12ImportConfigResult\x12 \n" +
+	"\tfile_name\x18\x01 \x01(\tH\x00R\bfileName\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tH\x01R\x04kind\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x03 \x01(\tH\x02R\bserverId\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12\x1f\n" +
+	"\vproxy_names\x18\x05 \x03(\tR\n" +
+	"proxyNames\x12#\n" +
+	"\rvisitor_names\x18\x06 \x03(\tR\fvisitorNames\x12\x1c\n" +
+	"\tconflicts\x18\a \x03(\tR\tconflicts\x12\x1f\n" +
+	"\bimported\x18\b \x01(\bH\x04R\bimported\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\t \x01(\tH\x05R\x05error\x88\x01\x01B\f\n" +
+	"\n" +
+	"_file_nameB\a\n" +
+	"\x05_kindB\f\n" +
+	"\n" +
+	"_server_idB\f\n" +
+	"\n" +
+	"_client_idB\v\n" +
+	"\t_importedB\b\n" +
+	"\x06_error\"\x88\x01\n" +
+	"\x14ImportConfigResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x128\n" +
+	"\aresults\x18\x02 \x03(\v2\x1e.api_master.ImportConfigResultR\aresultsB\t\n" +
+	"\a_status\"\x9d\x01\n" +
+	"\x13ExportConfigRequest\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x03 \x01(\tH\x02R\x06format\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\t\n" +
+	"\a_format\"\xa9\x01\n" +
+	"\x14ExportConfigResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12 \n" +
+	"\tfile_name\x18\x02 \x01(\tH\x01R\bfileName\x88\x01\x01\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\fH\x02R\acontent\x88\x01\x01B\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_file_nameB\n" +
+	"\n" +
+	"\b_content\">\n" +
+	"\x14ExportArchiveRequest\x12\x1b\n" +
+	"\x06format\x18\x01 \x01(\tH\x00R\x06format\x88\x01\x01B\t\n" +
+	"\a_format\"\xaa\x01\n" +
+	"\x15ExportArchiveResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12 \n" +
+	"\tfile_name\x18\x02 \x01(\tH\x01R\bfileName\x88\x01\x01\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\fH\x02R\acontent\x88\x01\x01B\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_file_nameB\n" +
+	"\n" +
+	"\b_content\"\x93\x01\n" +
+	"\x14ApplyManifestRequest\x12\x1f\n" +
+	"\bmanifest\x18\x01 \x01(\fH\x00R\bmanifest\x88\x01\x01\x12\x1c\n" +
+	"\adry_run\x18\x02 \x01(\bH\x01R\x06dryRun\x88\x01\x01\x12\x19\n" +
+	"\x05prune\x18\x03 \x01(\bH\x02R\x05prune\x88\x01\x01B\v\n" +
+	"\t_manifestB\n" +
+	"\n" +
+	"\b_dry_runB\b\n" +
+	"\x06_prune\"\xc2\x02\n" +
+	"\x10ManifestPlanItem\x12\x17\n" +
+	"\x04kind\x18\x01 \x01(\tH\x00R\x04kind\x88\x01\x01\x12\x1b\n" +
+	"\x06action\x18\x02 \x01(\tH\x01R\x06action\x88\x01\x01\x12\x13\n" +
+	"\x02id\x18\x03 \x01(\tH\x02R\x02id\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01\x12\x18\n" +
+	"\achanges\x18\x06 \x03(\tR\achanges\x12\x1d\n" +
+	"\aapplied\x18\a \x01(\bH\x05R\aapplied\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\b \x01(\tH\x06R\x05error\x88\x01\x01B\a\n" +
+	"\x05_kindB\t\n" +
+	"\a_actionB\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\n" +
+	"\n" +
+	"\b_appliedB\b\n" +
+	"\x06_error\"\x83\x01\n" +
+	"\x15ApplyManifestResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x122\n" +
+	"\x05items\x18\x02 \x03(\v2\x1c.api_master.ManifestPlanItemR\x05itemsB\t\n" +
+	"\a_status\"\x86\x03\n" +
+	"\x13UpdateLabelsRequest\x12(\n" +
+	"\rresource_type\x18\x01 \x01(\tH\x00R\fresourceType\x88\x01\x01\x12$\n" +
+	"\vresource_id\x18\x02 \x01(\tH\x01R\n" +
+	"resourceId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"proxy_name\x18\x03 \x01(\tH\x02R\tproxyName\x88\x01\x01\x12C\n" +
+	"\x06labels\x18\x04 \x03(\v2+.api_master.UpdateLabelsRequest.LabelsEntryR\x06labels\x12\x1f\n" +
+	"\vremove_keys\x18\x05 \x03(\tR\n" +
+	"removeKeys\x12\x1d\n" +
+	"\areplace\x18\x06 \x01(\bH\x03R\areplace\x88\x01\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x10\n" +
+	"\x0e_resource_typeB\x0e\n" +
+	"\f_resource_idB\r\n" +
+	"\v_proxy_nameB\n" +
+	"\n" +
+	"\b_replace\"\xcf\x01\n" +
+	"\x14UpdateLabelsResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12D\n" +
+	"\x06labels\x18\x02 \x03(\v2,.api_master.UpdateLabelsResponse.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
+	"\a_status\"U\n" +
+	"\x16SaveLabelFilterRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x13.common.LabelFilterH\x00R\x06filter\x88\x01\x01B\t\n" +
+	"\a_filter\"Q\n" +
+	"\x17SaveLabelFilterResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"U\n" +
+	"\x17ListLabelFiltersRequest\x12(\n" +
+	"\rresource_type\x18\x01 \x01(\tH\x00R\fresourceType\x88\x01\x01B\x10\n" +
+	"\x0e_resource_type\"\x81\x01\n" +
+	"\x18ListLabelFiltersResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12-\n" +
+	"\afilters\x18\x02 \x03(\v2\x13.common.LabelFilterR\afiltersB\t\n" +
+	"\a_status\"<\n" +
+	"\x18DeleteLabelFilterRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"S\n" +
+	"\x19DeleteLabelFilterResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\xeb\x01\n" +
+	"\x13BatchOperateRequest\x12(\n" +
+	"\rresource_type\x18\x01 \x01(\tH\x00R\fresourceType\x88\x01\x01\x12*\n" +
+	"\x0elabel_selector\x18\x02 \x01(\tH\x01R\rlabelSelector\x88\x01\x01\x12!\n" +
+	"\toperation\x18\x03 \x01(\tH\x02R\toperation\x88\x01\x01\x12\x1c\n" +
+	"\adry_run\x18\x04 \x01(\bH\x03R\x06dryRun\x88\x01\x01B\x10\n" +
+	"\x0e_resource_typeB\x11\n" +
+	"\x0f_label_selectorB\f\n" +
+	"\n" +
+	"_operationB\n" +
+	"\n" +
+	"\b_dry_run\"\xfd\x01\n" +
+	"\x12BatchOperateResult\x12$\n" +
+	"\vresource_id\x18\x01 \x01(\tH\x00R\n" +
+	"resourceId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"proxy_name\x18\x03 \x01(\tH\x02R\tproxyName\x88\x01\x01\x12\x1d\n" +
+	"\aapplied\x18\x04 \x01(\bH\x03R\aapplied\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\x05 \x01(\tH\x04R\x05error\x88\x01\x01B\x0e\n" +
+	"\f_resource_idB\f\n" +
+	"\n" +
+	"_server_idB\r\n" +
+	"\v_proxy_nameB\n" +
+	"\n" +
+	"\b_appliedB\b\n" +
+	"\x06_error\"\x88\x01\n" +
+	"\x14BatchOperateResponse\x12+\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x128\n" +
+	"\aresults\x18\x02 \x03(\v2\x1e.api_master.BatchOperateResultR\aresultsB\t\n" +
 	"\a_statusB\aZ\x05../pbb\x06proto3"
 
 var (
@@ -635,37 +2632,99 @@ func file_api_master_proto_rawDescGZIP() []byte {
 }
 
 var file_api_master_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_master_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_master_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_api_master_proto_goTypes = []any{
-	(ClientStatus_Status)(0),         // 0: api_master.ClientStatus.Status
-	(*ClientStatus)(nil),             // 1: api_master.ClientStatus
-	(*ClientVersion)(nil),            // 2: api_master.ClientVersion
-	(*GetClientsStatusRequest)(nil),  // 3: api_master.GetClientsStatusRequest
-	(*GetClientsStatusResponse)(nil), // 4: api_master.GetClientsStatusResponse
-	(*GetClientCertRequest)(nil),     // 5: api_master.GetClientCertRequest
-	(*GetClientCertResponse)(nil),    // 6: api_master.GetClientCertResponse
-	(*StartSteamLogRequest)(nil),     // 7: api_master.StartSteamLogRequest
-	(*StartSteamLogResponse)(nil),    // 8: api_master.StartSteamLogResponse
-	nil,                              // 9: api_master.GetClientsStatusResponse.ClientsEntry
-	(ClientType)(0),                  // 10: common.ClientType
-	(*Status)(nil),                   // 11: common.Status
+	(ClientStatus_Status)(0),            // 0: api_master.ClientStatus.Status
+	(*ClientStatus)(nil),                // 1: api_master.ClientStatus
+	(*ClientVersion)(nil),               // 2: api_master.ClientVersion
+	(*GetClientsStatusRequest)(nil),     // 3: api_master.GetClientsStatusRequest
+	(*GetClientsStatusResponse)(nil),    // 4: api_master.GetClientsStatusResponse
+	(*GetClientCertRequest)(nil),        // 5: api_master.GetClientCertRequest
+	(*GetClientCertResponse)(nil),       // 6: api_master.GetClientCertResponse
+	(*StartSteamLogRequest)(nil),        // 7: api_master.StartSteamLogRequest
+	(*StartSteamLogResponse)(nil),       // 8: api_master.StartSteamLogResponse
+	(*CreateNotifyChannelRequest)(nil),  // 9: api_master.CreateNotifyChannelRequest
+	(*CreateNotifyChannelResponse)(nil), // 10: api_master.CreateNotifyChannelResponse
+	(*UpdateNotifyChannelRequest)(nil),  // 11: api_master.UpdateNotifyChannelRequest
+	(*UpdateNotifyChannelResponse)(nil), // 12: api_master.UpdateNotifyChannelResponse
+	(*DeleteNotifyChannelRequest)(nil),  // 13: api_master.DeleteNotifyChannelRequest
+	(*DeleteNotifyChannelResponse)(nil), // 14: api_master.DeleteNotifyChannelResponse
+	(*ListNotifyChannelsRequest)(nil),   // 15: api_master.ListNotifyChannelsRequest
+	(*ListNotifyChannelsResponse)(nil),  // 16: api_master.ListNotifyChannelsResponse
+	(*TestNotifyChannelRequest)(nil),    // 17: api_master.TestNotifyChannelRequest
+	(*TestNotifyChannelResponse)(nil),   // 18: api_master.TestNotifyChannelResponse
+	(*ImportConfigFile)(nil),            // 19: api_master.ImportConfigFile
+	(*ImportConfigRequest)(nil),         // 20: api_master.ImportConfigRequest
+	(*ImportConfigResult)(nil),          // 21: api_master.ImportConfigResult
+	(*ImportConfigResponse)(nil),        // 22: api_master.ImportConfigResponse
+	(*ExportConfigRequest)(nil),         // 23: api_master.ExportConfigRequest
+	(*ExportConfigResponse)(nil),        // 24: api_master.ExportConfigResponse
+	(*ExportArchiveRequest)(nil),        // 25: api_master.ExportArchiveRequest
+	(*ExportArchiveResponse)(nil),       // 26: api_master.ExportArchiveResponse
+	(*ApplyManifestRequest)(nil),        // 27: api_master.ApplyManifestRequest
+	(*ManifestPlanItem)(nil),            // 28: api_master.ManifestPlanItem
+	(*ApplyManifestResponse)(nil),       // 29: api_master.ApplyManifestResponse
+	(*UpdateLabelsRequest)(nil),         // 30: api_master.UpdateLabelsRequest
+	(*UpdateLabelsResponse)(nil),        // 31: api_master.UpdateLabelsResponse
+	(*SaveLabelFilterRequest)(nil),      // 32: api_master.SaveLabelFilterRequest
+	(*SaveLabelFilterResponse)(nil),     // 33: api_master.SaveLabelFilterResponse
+	(*ListLabelFiltersRequest)(nil),     // 34: api_master.ListLabelFiltersRequest
+	(*ListLabelFiltersResponse)(nil),    // 35: api_master.ListLabelFiltersResponse
+	(*DeleteLabelFilterRequest)(nil),    // 36: api_master.DeleteLabelFilterRequest
+	(*DeleteLabelFilterResponse)(nil),   // 37: api_master.DeleteLabelFilterResponse
+	(*BatchOperateRequest)(nil),         // 38: api_master.BatchOperateRequest
+	(*BatchOperateResult)(nil),          // 39: api_master.BatchOperateResult
+	(*BatchOperateResponse)(nil),        // 40: api_master.BatchOperateResponse
+	nil,                                 // 41: api_master.GetClientsStatusResponse.ClientsEntry
+	nil,                                 // 42: api_master.UpdateLabelsRequest.LabelsEntry
+	nil,                                 // 43: api_master.UpdateLabelsResponse.LabelsEntry
+	(ClientType)(0),                     // 44: common.ClientType
+	(*Status)(nil),                      // 45: common.Status
+	(*NotifyChannel)(nil),               // 46: common.NotifyChannel
+	(*LabelFilter)(nil),                 // 47: common.LabelFilter
 }
 var file_api_master_proto_depIdxs = []int32{
-	10, // 0: api_master.ClientStatus.client_type:type_name -> common.ClientType
+	44, // 0: api_master.ClientStatus.client_type:type_name -> common.ClientType
 	0,  // 1: api_master.ClientStatus.status:type_name -> api_master.ClientStatus.Status
 	2,  // 2: api_master.ClientStatus.version:type_name -> api_master.ClientVersion
-	10, // 3: api_master.GetClientsStatusRequest.client_type:type_name -> common.ClientType
-	11, // 4: api_master.GetClientsStatusResponse.status:type_name -> common.Status
-	9,  // 5: api_master.GetClientsStatusResponse.clients:type_name -> api_master.GetClientsStatusResponse.ClientsEntry
-	10, // 6: api_master.GetClientCertRequest.client_type:type_name -> common.ClientType
-	11, // 7: api_master.GetClientCertResponse.status:type_name -> common.Status
-	11, // 8: api_master.StartSteamLogResponse.status:type_name -> common.Status
-	1,  // 9: api_master.GetClientsStatusResponse.ClientsEntry.value:type_name -> api_master.ClientStatus
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	44, // 3: api_master.GetClientsStatusRequest.client_type:type_name -> common.ClientType
+	45, // 4: api_master.GetClientsStatusResponse.status:type_name -> common.Status
+	41, // 5: api_master.GetClientsStatusResponse.clients:type_name -> api_master.GetClientsStatusResponse.ClientsEntry
+	44, // 6: api_master.GetClientCertRequest.client_type:type_name -> common.ClientType
+	45, // 7: api_master.GetClientCertResponse.status:type_name -> common.Status
+	45, // 8: api_master.StartSteamLogResponse.status:type_name -> common.Status
+	46, // 9: api_master.CreateNotifyChannelRequest.channel:type_name -> common.NotifyChannel
+	45, // 10: api_master.CreateNotifyChannelResponse.status:type_name -> common.Status
+	46, // 11: api_master.CreateNotifyChannelResponse.channel:type_name -> common.NotifyChannel
+	46, // 12: api_master.UpdateNotifyChannelRequest.channel:type_name -> common.NotifyChannel
+	45, // 13: api_master.UpdateNotifyChannelResponse.status:type_name -> common.Status
+	45, // 14: api_master.DeleteNotifyChannelResponse.status:type_name -> common.Status
+	45, // 15: api_master.ListNotifyChannelsResponse.status:type_name -> common.Status
+	46, // 16: api_master.ListNotifyChannelsResponse.channels:type_name -> common.NotifyChannel
+	45, // 17: api_master.TestNotifyChannelResponse.status:type_name -> common.Status
+	19, // 18: api_master.ImportConfigRequest.files:type_name -> api_master.ImportConfigFile
+	45, // 19: api_master.ImportConfigResponse.status:type_name -> common.Status
+	21, // 20: api_master.ImportConfigResponse.results:type_name -> api_master.ImportConfigResult
+	45, // 21: api_master.ExportConfigResponse.status:type_name -> common.Status
+	45, // 22: api_master.ExportArchiveResponse.status:type_name -> common.Status
+	45, // 23: api_master.ApplyManifestResponse.status:type_name -> common.Status
+	28, // 24: api_master.ApplyManifestResponse.items:type_name -> api_master.ManifestPlanItem
+	42, // 25: api_master.UpdateLabelsRequest.labels:type_name -> api_master.UpdateLabelsRequest.LabelsEntry
+	45, // 26: api_master.UpdateLabelsResponse.status:type_name -> common.Status
+	43, // 27: api_master.UpdateLabelsResponse.labels:type_name -> api_master.UpdateLabelsResponse.LabelsEntry
+	47, // 28: api_master.SaveLabelFilterRequest.filter:type_name -> common.LabelFilter
+	45, // 29: api_master.SaveLabelFilterResponse.status:type_name -> common.Status
+	45, // 30: api_master.ListLabelFiltersResponse.status:type_name -> common.Status
+	47, // 31: api_master.ListLabelFiltersResponse.filters:type_name -> common.LabelFilter
+	45, // 32: api_master.DeleteLabelFilterResponse.status:type_name -> common.Status
+	45, // 33: api_master.BatchOperateResponse.status:type_name -> common.Status
+	39, // 34: api_master.BatchOperateResponse.results:type_name -> api_master.BatchOperateResult
+	1,  // 35: api_master.GetClientsStatusResponse.ClientsEntry.value:type_name -> api_master.ClientStatus
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_api_master_proto_init() }
@@ -678,13 +2737,44 @@ func file_api_master_proto_init() {
 	file_api_master_proto_msgTypes[3].OneofWrappers = []any{}
 	file_api_master_proto_msgTypes[5].OneofWrappers = []any{}
 	file_api_master_proto_msgTypes[7].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[8].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[9].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[10].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[11].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[13].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[15].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[16].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[17].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[18].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[19].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[20].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[21].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[23].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[24].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[25].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[26].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[27].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[28].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[29].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[30].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[31].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[32].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[33].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[34].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[35].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[36].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[37].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[38].OneofWrappers = []any{}
+	file_api_master_proto_msgTypes[39].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_master_proto_rawDesc), len(file_api_master_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -138,6 +138,7 @@ type ListServersRequest struct {
 	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
 	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
 	Keyword       *string                `protobuf:"bytes,3,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	LabelSelector *string                `protobuf:"bytes,4,opt,name=label_selector,json=labelSelector,proto3,oneof" json:"label_selector,omitempty"` // 例如 env=prod,team=payments
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -193,6 +194,13 @@ func (x *ListServersRequest) GetKeyword() string {
 	return ""
 }
 
+func (x *ListServersRequest) GetLabelSelector() string {
+	if x != nil && x.LabelSelector != nil {
+		return *x.LabelSelector
+	}
+	return ""
+}
+
 type ListServersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
@@ -938,16 +946,18 @@ const file_api_server_proto_rawDesc = "" +
 	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01B\t\n" +
 	"\a_statusB\f\n" +
 	"\n" +
-	"_server_id\"\x91\x01\n" +
+	"_server_id\"\xd0\x01\n" +
 	"\x12ListServersRequest\x12\x17\n" +
 	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12\x1d\n" +
-	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01B\a\n" +
+	"\akeyword\x18\x03 \x01(\tH\x02R\akeyword\x88\x01\x01\x12*\n" +
+	"\x0elabel_selector\x18\x04 \x01(\tH\x03R\rlabelSelector\x88\x01\x01B\a\n" +
 	"\x05_pageB\f\n" +
 	"\n" +
 	"_page_sizeB\n" +
 	"\n" +
-	"\b_keyword\"\x9c\x01\n" +
+	"\b_keywordB\x11\n" +
+	"\x0f_label_selector\"\x9c\x01\n" +
 	"\x13ListServersResponse\x12+\n" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x19\n" +
 	"\x05total\x18\x02 \x01(\x05H\x01R\x05total\x88\x01\x01\x12(\n" +
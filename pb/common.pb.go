@@ -131,6 +131,327 @@ func (ClientType) EnumDescriptor() ([]byte, []int) {
 	return file_common_proto_rawDescGZIP(), []int{1}
 }
 
+type NotifyEventType int32
+
+const (
+	NotifyEventType_NOTIFY_EVENT_TYPE_UNSPECIFIED            NotifyEventType = 0
+	NotifyEventType_NOTIFY_EVENT_TYPE_CLIENT_OFFLINE         NotifyEventType = 1 // client 与 master 的连接断开
+	NotifyEventType_NOTIFY_EVENT_TYPE_SERVER_OFFLINE         NotifyEventType = 2 // server 与 master 的连接断开
+	NotifyEventType_NOTIFY_EVENT_TYPE_PROXY_ERROR            NotifyEventType = 3 // 隧道工作状态为 error
+	NotifyEventType_NOTIFY_EVENT_TYPE_WORKER_CRASH_LOOP      NotifyEventType = 4 // worker 进程反复退出
+	NotifyEventType_NOTIFY_EVENT_TYPE_TRAFFIC_QUOTA_EXCEEDED NotifyEventType = 5 // 隧道当日流量超过配额
+	NotifyEventType_NOTIFY_EVENT_TYPE_CLIENT_JOINED          NotifyEventType = 6 // 新 client 通过 join token 加入
+	NotifyEventType_NOTIFY_EVENT_TYPE_PROXY_UNREACHABLE      NotifyEventType = 7 // 隧道可达性探测失败
+	NotifyEventType_NOTIFY_EVENT_TYPE_WORKER_OOM_KILLED      NotifyEventType = 8 // worker 进程被 OOM 杀死，不再重启
+)
+
+// Enum value maps for NotifyEventType.
+var (
+	NotifyEventType_name = map[int32]string{
+		0: "NOTIFY_EVENT_TYPE_UNSPECIFIED",
+		1: "NOTIFY_EVENT_TYPE_CLIENT_OFFLINE",
+		2: "NOTIFY_EVENT_TYPE_SERVER_OFFLINE",
+		3: "NOTIFY_EVENT_TYPE_PROXY_ERROR",
+		4: "NOTIFY_EVENT_TYPE_WORKER_CRASH_LOOP",
+		5: "NOTIFY_EVENT_TYPE_TRAFFIC_QUOTA_EXCEEDED",
+		6: "NOTIFY_EVENT_TYPE_CLIENT_JOINED",
+		7: "NOTIFY_EVENT_TYPE_PROXY_UNREACHABLE",
+		8: "NOTIFY_EVENT_TYPE_WORKER_OOM_KILLED",
+	}
+	NotifyEventType_value = map[string]int32{
+		"NOTIFY_EVENT_TYPE_UNSPECIFIED":            0,
+		"NOTIFY_EVENT_TYPE_CLIENT_OFFLINE":         1,
+		"NOTIFY_EVENT_TYPE_SERVER_OFFLINE":         2,
+		"NOTIFY_EVENT_TYPE_PROXY_ERROR":            3,
+		"NOTIFY_EVENT_TYPE_WORKER_CRASH_LOOP":      4,
+		"NOTIFY_EVENT_TYPE_TRAFFIC_QUOTA_EXCEEDED": 5,
+		"NOTIFY_EVENT_TYPE_CLIENT_JOINED":          6,
+		"NOTIFY_EVENT_TYPE_PROXY_UNREACHABLE":      7,
+		"NOTIFY_EVENT_TYPE_WORKER_OOM_KILLED":      8,
+	}
+)
+
+func (x NotifyEventType) Enum() *NotifyEventType {
+	p := new(NotifyEventType)
+	*p = x
+	return p
+}
+
+func (x NotifyEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotifyEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[2].Descriptor()
+}
+
+func (NotifyEventType) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[2]
+}
+
+func (x NotifyEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotifyEventType.Descriptor instead.
+func (NotifyEventType) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{2}
+}
+
+type ProxyProbe_Type int32
+
+const (
+	ProxyProbe_TYPE_UNSPECIFIED ProxyProbe_Type = 0
+	ProxyProbe_TYPE_TCP         ProxyProbe_Type = 1 // 连接公网端口
+	ProxyProbe_TYPE_HTTP        ProxyProbe_Type = 2 // GET 请求，检查状态码
+	ProxyProbe_TYPE_UDP         ProxyProbe_Type = 3 // 发送 payload，期望原样返回
+)
+
+// Enum value maps for ProxyProbe_Type.
+var (
+	ProxyProbe_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_TCP",
+		2: "TYPE_HTTP",
+		3: "TYPE_UDP",
+	}
+	ProxyProbe_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_TCP":         1,
+		"TYPE_HTTP":        2,
+		"TYPE_UDP":         3,
+	}
+)
+
+func (x ProxyProbe_Type) Enum() *ProxyProbe_Type {
+	p := new(ProxyProbe_Type)
+	*p = x
+	return p
+}
+
+func (x ProxyProbe_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProxyProbe_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[3].Descriptor()
+}
+
+func (ProxyProbe_Type) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[3]
+}
+
+func (x ProxyProbe_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProxyProbe_Type.Descriptor instead.
+func (ProxyProbe_Type) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type WorkerKVNamespace_Scope int32
+
+const (
+	WorkerKVNamespace_SCOPE_UNSPECIFIED WorkerKVNamespace_Scope = 0
+	WorkerKVNamespace_SCOPE_WORKER      WorkerKVNamespace_Scope = 1 // 仅当前 worker 可见
+	WorkerKVNamespace_SCOPE_TENANT      WorkerKVNamespace_Scope = 2 // 同租户下的 worker 共享
+)
+
+// Enum value maps for WorkerKVNamespace_Scope.
+var (
+	WorkerKVNamespace_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "SCOPE_WORKER",
+		2: "SCOPE_TENANT",
+	}
+	WorkerKVNamespace_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED": 0,
+		"SCOPE_WORKER":      1,
+		"SCOPE_TENANT":      2,
+	}
+)
+
+func (x WorkerKVNamespace_Scope) Enum() *WorkerKVNamespace_Scope {
+	p := new(WorkerKVNamespace_Scope)
+	*p = x
+	return p
+}
+
+func (x WorkerKVNamespace_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkerKVNamespace_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[4].Descriptor()
+}
+
+func (WorkerKVNamespace_Scope) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[4]
+}
+
+func (x WorkerKVNamespace_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkerKVNamespace_Scope.Descriptor instead.
+func (WorkerKVNamespace_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type WorkerCron_TriggerType int32
+
+const (
+	WorkerCron_TRIGGER_TYPE_UNSPECIFIED WorkerCron_TriggerType = 0
+	WorkerCron_TRIGGER_TYPE_SCHEDULED   WorkerCron_TriggerType = 1 // 调用 worker 的 scheduled handler
+	WorkerCron_TRIGGER_TYPE_HTTP        WorkerCron_TriggerType = 2 // POST 到 worker 的 path
+)
+
+// Enum value maps for WorkerCron_TriggerType.
+var (
+	WorkerCron_TriggerType_name = map[int32]string{
+		0: "TRIGGER_TYPE_UNSPECIFIED",
+		1: "TRIGGER_TYPE_SCHEDULED",
+		2: "TRIGGER_TYPE_HTTP",
+	}
+	WorkerCron_TriggerType_value = map[string]int32{
+		"TRIGGER_TYPE_UNSPECIFIED": 0,
+		"TRIGGER_TYPE_SCHEDULED":   1,
+		"TRIGGER_TYPE_HTTP":        2,
+	}
+)
+
+func (x WorkerCron_TriggerType) Enum() *WorkerCron_TriggerType {
+	p := new(WorkerCron_TriggerType)
+	*p = x
+	return p
+}
+
+func (x WorkerCron_TriggerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkerCron_TriggerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[5].Descriptor()
+}
+
+func (WorkerCron_TriggerType) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[5]
+}
+
+func (x WorkerCron_TriggerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkerCron_TriggerType.Descriptor instead.
+func (WorkerCron_TriggerType) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type PTYSession_ShareMode int32
+
+const (
+	PTYSession_SHARE_MODE_UNSPECIFIED PTYSession_ShareMode = 0 // 同 SHARE_MODE_PRIVATE
+	PTYSession_SHARE_MODE_PRIVATE     PTYSession_ShareMode = 1 // 仅发起者和管理员可以加入
+	PTYSession_SHARE_MODE_READ        PTYSession_ShareMode = 2 // 同租户用户可以只读加入
+	PTYSession_SHARE_MODE_WRITE       PTYSession_ShareMode = 3 // 同租户用户可以加入并输入
+)
+
+// Enum value maps for PTYSession_ShareMode.
+var (
+	PTYSession_ShareMode_name = map[int32]string{
+		0: "SHARE_MODE_UNSPECIFIED",
+		1: "SHARE_MODE_PRIVATE",
+		2: "SHARE_MODE_READ",
+		3: "SHARE_MODE_WRITE",
+	}
+	PTYSession_ShareMode_value = map[string]int32{
+		"SHARE_MODE_UNSPECIFIED": 0,
+		"SHARE_MODE_PRIVATE":     1,
+		"SHARE_MODE_READ":        2,
+		"SHARE_MODE_WRITE":       3,
+	}
+)
+
+func (x PTYSession_ShareMode) Enum() *PTYSession_ShareMode {
+	p := new(PTYSession_ShareMode)
+	*p = x
+	return p
+}
+
+func (x PTYSession_ShareMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PTYSession_ShareMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[6].Descriptor()
+}
+
+func (PTYSession_ShareMode) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[6]
+}
+
+func (x PTYSession_ShareMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PTYSession_ShareMode.Descriptor instead.
+func (PTYSession_ShareMode) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type NotifyChannel_Type int32
+
+const (
+	NotifyChannel_TYPE_UNSPECIFIED NotifyChannel_Type = 0
+	NotifyChannel_TYPE_WEBHOOK     NotifyChannel_Type = 1
+	NotifyChannel_TYPE_EMAIL       NotifyChannel_Type = 2
+	NotifyChannel_TYPE_CHAT        NotifyChannel_Type = 3
+)
+
+// Enum value maps for NotifyChannel_Type.
+var (
+	NotifyChannel_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_WEBHOOK",
+		2: "TYPE_EMAIL",
+		3: "TYPE_CHAT",
+	}
+	NotifyChannel_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_WEBHOOK":     1,
+		"TYPE_EMAIL":       2,
+		"TYPE_CHAT":        3,
+	}
+)
+
+func (x NotifyChannel_Type) Enum() *NotifyChannel_Type {
+	p := new(NotifyChannel_Type)
+	*p = x
+	return p
+}
+
+func (x NotifyChannel_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotifyChannel_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[7].Descriptor()
+}
+
+func (NotifyChannel_Type) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[7]
+}
+
+func (x NotifyChannel_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotifyChannel_Type.Descriptor instead.
+func (NotifyChannel_Type) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{33, 0}
+}
+
 type Status struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          RespCode               `protobuf:"varint,1,opt,name=code,proto3,enum=common.RespCode" json:"code,omitempty"`
@@ -289,9 +610,11 @@ type Client struct {
 	Stopped        *bool                  `protobuf:"varint,7,opt,name=stopped,proto3,oneof" json:"stopped,omitempty"`
 	ClientIds      []string               `protobuf:"bytes,8,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"` // some client can connected to more than one server, make a shadow client to handle this
 	OriginClientId *string                `protobuf:"bytes,9,opt,name=origin_client_id,json=originClientId,proto3,oneof" json:"origin_client_id,omitempty"`
-	FrpsUrl        *string                `protobuf:"bytes,10,opt,name=frps_url,json=frpsUrl,proto3,oneof" json:"frps_url,omitempty"`             // 客户端用于连接frps的url，解决 frp 在 CDN 后的问题，格式类似 [tcp/ws/wss/quic/kcp]://example.com:7000
-	Ephemeral      *bool                  `protobuf:"varint,11,opt,name=ephemeral,proto3,oneof" json:"ephemeral,omitempty"`                       // 是否临时节点
-	LastSeenAt     *int64                 `protobuf:"varint,12,opt,name=last_seen_at,json=lastSeenAt,proto3,oneof" json:"last_seen_at,omitempty"` // 最后一次心跳时间戳
+	FrpsUrl        *string                `protobuf:"bytes,10,opt,name=frps_url,json=frpsUrl,proto3,oneof" json:"frps_url,omitempty"`                                                    // 客户端用于连接frps的url，解决 frp 在 CDN 后的问题，格式类似 [tcp/ws/wss/quic/kcp]://example.com:7000
+	Ephemeral      *bool                  `protobuf:"varint,11,opt,name=ephemeral,proto3,oneof" json:"ephemeral,omitempty"`                                                              // 是否临时节点
+	LastSeenAt     *int64                 `protobuf:"varint,12,opt,name=last_seen_at,json=lastSeenAt,proto3,oneof" json:"last_seen_at,omitempty"`                                        // 最后一次心跳时间戳
+	PtyDisabled    *bool                  `protobuf:"varint,13,opt,name=pty_disabled,json=ptyDisabled,proto3,oneof" json:"pty_disabled,omitempty"`                                       // master 禁止打开该节点的远程终端
+	Labels         map[string]string      `protobuf:"bytes,14,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 用户自定义标签，value 为空即为 tag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -403,14 +726,29 @@ func (x *Client) GetLastSeenAt() int64 {
 	return 0
 }
 
+func (x *Client) GetPtyDisabled() bool {
+	if x != nil && x.PtyDisabled != nil {
+		return *x.PtyDisabled
+	}
+	return false
+}
+
+func (x *Client) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
 	Secret        *string                `protobuf:"bytes,2,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
 	Ip            *string                `protobuf:"bytes,3,opt,name=ip,proto3,oneof" json:"ip,omitempty"`
-	Config        *string                `protobuf:"bytes,4,opt,name=config,proto3,oneof" json:"config,omitempty"`               // 在定义上，ip和port只是为了方便使用
-	Comment       *string                `protobuf:"bytes,5,opt,name=comment,proto3,oneof" json:"comment,omitempty"`             // 用户自定义的备注
-	FrpsUrls      []string               `protobuf:"bytes,6,rep,name=frps_urls,json=frpsUrls,proto3" json:"frps_urls,omitempty"` // 客户端用于连接frps的url，解决 frp 在 CDN 后的问题，格式类似 [tcp/ws/wss/quic/kcp]://example.com:7000，可以有多个
+	Config        *string                `protobuf:"bytes,4,opt,name=config,proto3,oneof" json:"config,omitempty"`                                                                     // 在定义上，ip和port只是为了方便使用
+	Comment       *string                `protobuf:"bytes,5,opt,name=comment,proto3,oneof" json:"comment,omitempty"`                                                                   // 用户自定义的备注
+	FrpsUrls      []string               `protobuf:"bytes,6,rep,name=frps_urls,json=frpsUrls,proto3" json:"frps_urls,omitempty"`                                                       // 客户端用于连接frps的url，解决 frp 在 CDN 后的问题，格式类似 [tcp/ws/wss/quic/kcp]://example.com:7000，可以有多个
+	Labels        map[string]string      `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 用户自定义标签，value 为空即为 tag
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -487,6 +825,13 @@ func (x *Server) GetFrpsUrls() []string {
 	return nil
 }
 
+func (x *Server) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        *int64                 `protobuf:"varint,1,opt,name=UserID,proto3,oneof" json:"UserID,omitempty"`
@@ -497,6 +842,7 @@ type User struct {
 	Role          *string                `protobuf:"bytes,6,opt,name=Role,proto3,oneof" json:"Role,omitempty"`
 	Token         *string                `protobuf:"bytes,7,opt,name=Token,proto3,oneof" json:"Token,omitempty"`
 	RawPassword   *string                `protobuf:"bytes,8,opt,name=RawPassword,proto3,oneof" json:"RawPassword,omitempty"`
+	PTYDisabled   *bool                  `protobuf:"varint,9,opt,name=PTYDisabled,proto3,oneof" json:"PTYDisabled,omitempty"` // 禁止该用户使用远程终端
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -587,6 +933,13 @@ func (x *User) GetRawPassword() string {
 	return ""
 }
 
+func (x *User) GetPTYDisabled() bool {
+	if x != nil && x.PTYDisabled != nil {
+		return *x.PTYDisabled
+	}
+	return false
+}
+
 type ProxyInfo struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Name              *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
@@ -705,6 +1058,7 @@ type ProxyConfig struct {
 	Config         *string                `protobuf:"bytes,6,opt,name=config,proto3,oneof" json:"config,omitempty"`
 	OriginClientId *string                `protobuf:"bytes,7,opt,name=origin_client_id,json=originClientId,proto3,oneof" json:"origin_client_id,omitempty"`
 	Stopped        *bool                  `protobuf:"varint,8,opt,name=stopped,proto3,oneof" json:"stopped,omitempty"`
+	Labels         map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 用户自定义标签，value 为空即为 tag
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -795,31 +1149,42 @@ func (x *ProxyConfig) GetStopped() bool {
 	return false
 }
 
-type ProxyWorkingStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	Status        *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	Err           *string                `protobuf:"bytes,4,opt,name=err,proto3,oneof" json:"err,omitempty"`
-	RemoteAddr    *string                `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3,oneof" json:"remote_addr,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ProxyConfig) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
 }
 
-func (x *ProxyWorkingStatus) Reset() {
-	*x = ProxyWorkingStatus{}
+type VisitorConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name           *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type           *string                `protobuf:"bytes,3,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	ClientId       *string                `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId       *string                `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Config         *string                `protobuf:"bytes,6,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	OriginClientId *string                `protobuf:"bytes,7,opt,name=origin_client_id,json=originClientId,proto3,oneof" json:"origin_client_id,omitempty"`
+	Stopped        *bool                  `protobuf:"varint,8,opt,name=stopped,proto3,oneof" json:"stopped,omitempty"`
+	ServerName     *string                `protobuf:"bytes,9,opt,name=server_name,json=serverName,proto3,oneof" json:"server_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VisitorConfig) Reset() {
+	*x = VisitorConfig{}
 	mi := &file_common_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProxyWorkingStatus) String() string {
+func (x *VisitorConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProxyWorkingStatus) ProtoMessage() {}
+func (*VisitorConfig) ProtoMessage() {}
 
-func (x *ProxyWorkingStatus) ProtoReflect() protoreflect.Message {
+func (x *VisitorConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_common_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -831,74 +1196,112 @@ func (x *ProxyWorkingStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProxyWorkingStatus.ProtoReflect.Descriptor instead.
-func (*ProxyWorkingStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use VisitorConfig.ProtoReflect.Descriptor instead.
+func (*VisitorConfig) Descriptor() ([]byte, []int) {
 	return file_common_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ProxyWorkingStatus) GetName() string {
+func (x *VisitorConfig) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *VisitorConfig) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *ProxyWorkingStatus) GetType() string {
+func (x *VisitorConfig) GetType() string {
 	if x != nil && x.Type != nil {
 		return *x.Type
 	}
 	return ""
 }
 
-func (x *ProxyWorkingStatus) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *VisitorConfig) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
 	}
 	return ""
 }
 
-func (x *ProxyWorkingStatus) GetErr() string {
-	if x != nil && x.Err != nil {
-		return *x.Err
+func (x *VisitorConfig) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
 	}
 	return ""
 }
 
-func (x *ProxyWorkingStatus) GetRemoteAddr() string {
-	if x != nil && x.RemoteAddr != nil {
-		return *x.RemoteAddr
+func (x *VisitorConfig) GetConfig() string {
+	if x != nil && x.Config != nil {
+		return *x.Config
 	}
 	return ""
 }
 
-type Worker struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	WorkerId       *string                `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
-	Name           *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                    // worker's name, also use at worker routing, must be unique, default is UID
-	UserId         *uint32                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // worker's user id
-	TenantId       *uint32                `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
-	Socket         *Socket                `protobuf:"bytes,5,opt,name=socket,proto3,oneof" json:"socket,omitempty"`                                       // worker's socket, platfrom will obtain free port while init worker
-	CodeEntry      *string                `protobuf:"bytes,6,opt,name=code_entry,json=codeEntry,proto3,oneof" json:"code_entry,omitempty"`                // worker's entry file, default is 'entry.js'
-	Code           *string                `protobuf:"bytes,7,opt,name=code,proto3,oneof" json:"code,omitempty"`                                           // worker's code
-	ConfigTemplate *string                `protobuf:"bytes,8,opt,name=config_template,json=configTemplate,proto3,oneof" json:"config_template,omitempty"` // worker's capnp file template
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *VisitorConfig) GetOriginClientId() string {
+	if x != nil && x.OriginClientId != nil {
+		return *x.OriginClientId
+	}
+	return ""
 }
 
-func (x *Worker) Reset() {
-	*x = Worker{}
+func (x *VisitorConfig) GetStopped() bool {
+	if x != nil && x.Stopped != nil {
+		return *x.Stopped
+	}
+	return false
+}
+
+func (x *VisitorConfig) GetServerName() string {
+	if x != nil && x.ServerName != nil {
+		return *x.ServerName
+	}
+	return ""
+}
+
+// 由服务该隧道的 frps 节点定时执行的可达性探测
+type ProxyProbe struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	ProxyName          *string                `protobuf:"bytes,2,opt,name=proxy_name,json=proxyName,proto3,oneof" json:"proxy_name,omitempty"`
+	ClientId           *string                `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId           *string                `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	Type               *ProxyProbe_Type       `protobuf:"varint,5,opt,name=type,proto3,enum=common.ProxyProbe_Type,oneof" json:"type,omitempty"`
+	Enabled            *bool                  `protobuf:"varint,6,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Target             *string                `protobuf:"bytes,7,opt,name=target,proto3,oneof" json:"target,omitempty"` // 由 master 根据隧道配置推导，tcp/udp 为 host:port，http 为 url，设置探测时忽略
+	HttpPath           *string                `protobuf:"bytes,8,opt,name=http_path,json=httpPath,proto3,oneof" json:"http_path,omitempty"`
+	ExpectStatus       *int32                 `protobuf:"varint,9,opt,name=expect_status,json=expectStatus,proto3,oneof" json:"expect_status,omitempty"` // 为空时接受 2xx 和 3xx
+	UdpPayload         *string                `protobuf:"bytes,10,opt,name=udp_payload,json=udpPayload,proto3,oneof" json:"udp_payload,omitempty"`
+	TimeoutMs          *int32                 `protobuf:"varint,11,opt,name=timeout_ms,json=timeoutMs,proto3,oneof" json:"timeout_ms,omitempty"`
+	LastSuccess        *bool                  `protobuf:"varint,12,opt,name=last_success,json=lastSuccess,proto3,oneof" json:"last_success,omitempty"`
+	LastLatencyMs      *int64                 `protobuf:"varint,13,opt,name=last_latency_ms,json=lastLatencyMs,proto3,oneof" json:"last_latency_ms,omitempty"`
+	LastError          *string                `protobuf:"bytes,14,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	LastCheckTime      *int64                 `protobuf:"varint,15,opt,name=last_check_time,json=lastCheckTime,proto3,oneof" json:"last_check_time,omitempty"`
+	DialAddr           *string                `protobuf:"bytes,16,opt,name=dial_addr,json=dialAddr,proto3,oneof" json:"dial_addr,omitempty"`                                  // http 探测实际连接的 frps 地址，由 master 推导
+	InsecureSkipVerify *bool                  `protobuf:"varint,17,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3,oneof" json:"insecure_skip_verify,omitempty"` // https 探测时不校验证书
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ProxyProbe) Reset() {
+	*x = ProxyProbe{}
 	mi := &file_common_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Worker) String() string {
+func (x *ProxyProbe) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Worker) ProtoMessage() {}
+func (*ProxyProbe) ProtoMessage() {}
 
-func (x *Worker) ProtoReflect() protoreflect.Message {
+func (x *ProxyProbe) ProtoReflect() protoreflect.Message {
 	mi := &file_common_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -910,91 +1313,2157 @@ func (x *Worker) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Worker.ProtoReflect.Descriptor instead.
-func (*Worker) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProxyProbe.ProtoReflect.Descriptor instead.
+func (*ProxyProbe) Descriptor() ([]byte, []int) {
 	return file_common_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Worker) GetWorkerId() string {
-	if x != nil && x.WorkerId != nil {
-		return *x.WorkerId
+func (x *ProxyProbe) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Worker) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *ProxyProbe) GetProxyName() string {
+	if x != nil && x.ProxyName != nil {
+		return *x.ProxyName
 	}
 	return ""
 }
 
-func (x *Worker) GetUserId() uint32 {
+func (x *ProxyProbe) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ProxyProbe) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *ProxyProbe) GetType() ProxyProbe_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ProxyProbe_TYPE_UNSPECIFIED
+}
+
+func (x *ProxyProbe) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *ProxyProbe) GetTarget() string {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return ""
+}
+
+func (x *ProxyProbe) GetHttpPath() string {
+	if x != nil && x.HttpPath != nil {
+		return *x.HttpPath
+	}
+	return ""
+}
+
+func (x *ProxyProbe) GetExpectStatus() int32 {
+	if x != nil && x.ExpectStatus != nil {
+		return *x.ExpectStatus
+	}
+	return 0
+}
+
+func (x *ProxyProbe) GetUdpPayload() string {
+	if x != nil && x.UdpPayload != nil {
+		return *x.UdpPayload
+	}
+	return ""
+}
+
+func (x *ProxyProbe) GetTimeoutMs() int32 {
+	if x != nil && x.TimeoutMs != nil {
+		return *x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *ProxyProbe) GetLastSuccess() bool {
+	if x != nil && x.LastSuccess != nil {
+		return *x.LastSuccess
+	}
+	return false
+}
+
+func (x *ProxyProbe) GetLastLatencyMs() int64 {
+	if x != nil && x.LastLatencyMs != nil {
+		return *x.LastLatencyMs
+	}
+	return 0
+}
+
+func (x *ProxyProbe) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *ProxyProbe) GetLastCheckTime() int64 {
+	if x != nil && x.LastCheckTime != nil {
+		return *x.LastCheckTime
+	}
+	return 0
+}
+
+func (x *ProxyProbe) GetDialAddr() string {
+	if x != nil && x.DialAddr != nil {
+		return *x.DialAddr
+	}
+	return ""
+}
+
+func (x *ProxyProbe) GetInsecureSkipVerify() bool {
+	if x != nil && x.InsecureSkipVerify != nil {
+		return *x.InsecureSkipVerify
+	}
+	return false
+}
+
+// proxy 的定时开放配置，由 master 的调度器按时调用 start/stop
+type ProxyScheduleWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartCron     *string                `protobuf:"bytes,1,opt,name=start_cron,json=startCron,proto3,oneof" json:"start_cron,omitempty"` // 到点启动，支持 5 位或带秒的 6 位 cron，可用 CRON_TZ= 前缀指定时区
+	StopCron      *string                `protobuf:"bytes,2,opt,name=stop_cron,json=stopCron,proto3,oneof" json:"stop_cron,omitempty"`    // 到点停止
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyScheduleWindow) Reset() {
+	*x = ProxyScheduleWindow{}
+	mi := &file_common_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyScheduleWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyScheduleWindow) ProtoMessage() {}
+
+func (x *ProxyScheduleWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyScheduleWindow.ProtoReflect.Descriptor instead.
+func (*ProxyScheduleWindow) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProxyScheduleWindow) GetStartCron() string {
+	if x != nil && x.StartCron != nil {
+		return *x.StartCron
+	}
+	return ""
+}
+
+func (x *ProxyScheduleWindow) GetStopCron() string {
+	if x != nil && x.StopCron != nil {
+		return *x.StopCron
+	}
+	return ""
+}
+
+type ProxySchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId      *string                `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	ProxyName     *string                `protobuf:"bytes,3,opt,name=proxy_name,json=proxyName,proto3,oneof" json:"proxy_name,omitempty"`
+	Windows       []*ProxyScheduleWindow `protobuf:"bytes,4,rep,name=windows,proto3" json:"windows,omitempty"`
+	ExpireAt      *int64                 `protobuf:"varint,5,opt,name=expire_at,json=expireAt,proto3,oneof" json:"expire_at,omitempty"`            // TTL 到期时间，unix 毫秒，到期后自动停止，0 表示不过期
+	NextStartAt   *int64                 `protobuf:"varint,6,opt,name=next_start_at,json=nextStartAt,proto3,oneof" json:"next_start_at,omitempty"` // 只读，由调度器计算
+	NextStopAt    *int64                 `protobuf:"varint,7,opt,name=next_stop_at,json=nextStopAt,proto3,oneof" json:"next_stop_at,omitempty"`    // 只读，由调度器计算
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxySchedule) Reset() {
+	*x = ProxySchedule{}
+	mi := &file_common_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxySchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxySchedule) ProtoMessage() {}
+
+func (x *ProxySchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxySchedule.ProtoReflect.Descriptor instead.
+func (*ProxySchedule) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProxySchedule) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ProxySchedule) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *ProxySchedule) GetProxyName() string {
+	if x != nil && x.ProxyName != nil {
+		return *x.ProxyName
+	}
+	return ""
+}
+
+func (x *ProxySchedule) GetWindows() []*ProxyScheduleWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *ProxySchedule) GetExpireAt() int64 {
+	if x != nil && x.ExpireAt != nil {
+		return *x.ExpireAt
+	}
+	return 0
+}
+
+func (x *ProxySchedule) GetNextStartAt() int64 {
+	if x != nil && x.NextStartAt != nil {
+		return *x.NextStartAt
+	}
+	return 0
+}
+
+func (x *ProxySchedule) GetNextStopAt() int64 {
+	if x != nil && x.NextStopAt != nil {
+		return *x.NextStopAt
+	}
+	return 0
+}
+
+type ProxyProbeResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProbeId       *uint32                `protobuf:"varint,1,opt,name=probe_id,json=probeId,proto3,oneof" json:"probe_id,omitempty"`
+	Success       *bool                  `protobuf:"varint,2,opt,name=success,proto3,oneof" json:"success,omitempty"`
+	LatencyMs     *int64                 `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3,oneof" json:"latency_ms,omitempty"`
+	Error         *string                `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Time          *int64                 `protobuf:"varint,5,opt,name=time,proto3,oneof" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProxyProbeResult) Reset() {
+	*x = ProxyProbeResult{}
+	mi := &file_common_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyProbeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyProbeResult) ProtoMessage() {}
+
+func (x *ProxyProbeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyProbeResult.ProtoReflect.Descriptor instead.
+func (*ProxyProbeResult) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProxyProbeResult) GetProbeId() uint32 {
+	if x != nil && x.ProbeId != nil {
+		return *x.ProbeId
+	}
+	return 0
+}
+
+func (x *ProxyProbeResult) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *ProxyProbeResult) GetLatencyMs() int64 {
+	if x != nil && x.LatencyMs != nil {
+		return *x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ProxyProbeResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ProxyProbeResult) GetTime() int64 {
+	if x != nil && x.Time != nil {
+		return *x.Time
+	}
+	return 0
+}
+
+type ProxyWorkingStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type           *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Status         *string                `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Err            *string                `protobuf:"bytes,4,opt,name=err,proto3,oneof" json:"err,omitempty"`
+	RemoteAddr     *string                `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3,oneof" json:"remote_addr,omitempty"`
+	ClientId       *string                `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ServerId       *string                `protobuf:"bytes,7,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+	OriginClientId *string                `protobuf:"bytes,8,opt,name=origin_client_id,json=originClientId,proto3,oneof" json:"origin_client_id,omitempty"`
+	LastChangeTime *int64                 `protobuf:"varint,9,opt,name=last_change_time,json=lastChangeTime,proto3,oneof" json:"last_change_time,omitempty"`  // status 或 err 最近一次变化的时间，毫秒
+	LastReportTime *int64                 `protobuf:"varint,10,opt,name=last_report_time,json=lastReportTime,proto3,oneof" json:"last_report_time,omitempty"` // client 最近一次上报的时间，毫秒
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProxyWorkingStatus) Reset() {
+	*x = ProxyWorkingStatus{}
+	mi := &file_common_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProxyWorkingStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyWorkingStatus) ProtoMessage() {}
+
+func (x *ProxyWorkingStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyWorkingStatus.ProtoReflect.Descriptor instead.
+func (*ProxyWorkingStatus) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProxyWorkingStatus) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ProxyWorkingStatus) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *ProxyWorkingStatus) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ProxyWorkingStatus) GetErr() string {
+	if x != nil && x.Err != nil {
+		return *x.Err
+	}
+	return ""
+}
+
+func (x *ProxyWorkingStatus) GetRemoteAddr() string {
+	if x != nil && x.RemoteAddr != nil {
+		return *x.RemoteAddr
+	}
+	return ""
+}
+
+func (x *ProxyWorkingStatus) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ProxyWorkingStatus) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+func (x *ProxyWorkingStatus) GetOriginClientId() string {
+	if x != nil && x.OriginClientId != nil {
+		return *x.OriginClientId
+	}
+	return ""
+}
+
+func (x *ProxyWorkingStatus) GetLastChangeTime() int64 {
+	if x != nil && x.LastChangeTime != nil {
+		return *x.LastChangeTime
+	}
+	return 0
+}
+
+func (x *ProxyWorkingStatus) GetLastReportTime() int64 {
+	if x != nil && x.LastReportTime != nil {
+		return *x.LastReportTime
+	}
+	return 0
+}
+
+type Worker struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	WorkerId        *string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	Name            *string                 `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`                    // worker's name, also use at worker routing, must be unique, default is UID
+	UserId          *uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // worker's user id
+	TenantId        *uint32                 `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Socket          *Socket                 `protobuf:"bytes,5,opt,name=socket,proto3,oneof" json:"socket,omitempty"`                                                                      // worker's socket, platfrom will obtain free port while init worker
+	CodeEntry       *string                 `protobuf:"bytes,6,opt,name=code_entry,json=codeEntry,proto3,oneof" json:"code_entry,omitempty"`                                               // worker's entry file, default is 'entry.js'
+	Code            *string                 `protobuf:"bytes,7,opt,name=code,proto3,oneof" json:"code,omitempty"`                                                                          // worker's code
+	ConfigTemplate  *string                 `protobuf:"bytes,8,opt,name=config_template,json=configTemplate,proto3,oneof" json:"config_template,omitempty"`                                // worker's capnp file template
+	Crons           []*WorkerCron           `protobuf:"bytes,9,rep,name=crons,proto3" json:"crons,omitempty"`                                                                              // worker's cron triggers, executed by the client hosting it
+	ResourceLimits  *WorkerResourceLimits   `protobuf:"bytes,10,opt,name=resource_limits,json=resourceLimits,proto3,oneof" json:"resource_limits,omitempty"`                               // worker's resource limits, only works on linux
+	ServiceBindings []*WorkerServiceBinding `protobuf:"bytes,11,rep,name=service_bindings,json=serviceBindings,proto3" json:"service_bindings,omitempty"`                                  // bind other workers on the same client into env
+	KvNamespaces    []*WorkerKVNamespace    `protobuf:"bytes,12,rep,name=kv_namespaces,json=kvNamespaces,proto3" json:"kv_namespaces,omitempty"`                                           // kv namespaces backed by workerd disk storage
+	Labels          map[string]string       `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // user defined labels, empty value works as a tag
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Worker) Reset() {
+	*x = Worker{}
+	mi := &file_common_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Worker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Worker) ProtoMessage() {}
+
+func (x *Worker) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Worker.ProtoReflect.Descriptor instead.
+func (*Worker) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Worker) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+func (x *Worker) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Worker) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *Worker) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *Worker) GetSocket() *Socket {
+	if x != nil {
+		return x.Socket
+	}
+	return nil
+}
+
+func (x *Worker) GetCodeEntry() string {
+	if x != nil && x.CodeEntry != nil {
+		return *x.CodeEntry
+	}
+	return ""
+}
+
+func (x *Worker) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *Worker) GetConfigTemplate() string {
+	if x != nil && x.ConfigTemplate != nil {
+		return *x.ConfigTemplate
+	}
+	return ""
+}
+
+func (x *Worker) GetCrons() []*WorkerCron {
+	if x != nil {
+		return x.Crons
+	}
+	return nil
+}
+
+func (x *Worker) GetResourceLimits() *WorkerResourceLimits {
+	if x != nil {
+		return x.ResourceLimits
+	}
+	return nil
+}
+
+func (x *Worker) GetServiceBindings() []*WorkerServiceBinding {
+	if x != nil {
+		return x.ServiceBindings
+	}
+	return nil
+}
+
+func (x *Worker) GetKvNamespaces() []*WorkerKVNamespace {
+	if x != nil {
+		return x.KvNamespaces
+	}
+	return nil
+}
+
+func (x *Worker) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type WorkerServiceBinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`                         // env 中的绑定名
+	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"` // 被绑定的 worker，需要部署在相同的 client 上
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerServiceBinding) Reset() {
+	*x = WorkerServiceBinding{}
+	mi := &file_common_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerServiceBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerServiceBinding) ProtoMessage() {}
+
+func (x *WorkerServiceBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerServiceBinding.ProtoReflect.Descriptor instead.
+func (*WorkerServiceBinding) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WorkerServiceBinding) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *WorkerServiceBinding) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+type WorkerKVNamespace struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Binding       *string                  `protobuf:"bytes,1,opt,name=binding,proto3,oneof" json:"binding,omitempty"`     // env 中的绑定名
+	Namespace     *string                  `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"` // 为空时为 default
+	Scope         *WorkerKVNamespace_Scope `protobuf:"varint,3,opt,name=scope,proto3,enum=common.WorkerKVNamespace_Scope,oneof" json:"scope,omitempty"`
+	SyncToMaster  *bool                    `protobuf:"varint,4,opt,name=sync_to_master,json=syncToMaster,proto3,oneof" json:"sync_to_master,omitempty"` // 定期同步到 master，worker 迁移到其他 client 后可以恢复
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerKVNamespace) Reset() {
+	*x = WorkerKVNamespace{}
+	mi := &file_common_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerKVNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerKVNamespace) ProtoMessage() {}
+
+func (x *WorkerKVNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerKVNamespace.ProtoReflect.Descriptor instead.
+func (*WorkerKVNamespace) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WorkerKVNamespace) GetBinding() string {
+	if x != nil && x.Binding != nil {
+		return *x.Binding
+	}
+	return ""
+}
+
+func (x *WorkerKVNamespace) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *WorkerKVNamespace) GetScope() WorkerKVNamespace_Scope {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return WorkerKVNamespace_SCOPE_UNSPECIFIED
+}
+
+func (x *WorkerKVNamespace) GetSyncToMaster() bool {
+	if x != nil && x.SyncToMaster != nil {
+		return *x.SyncToMaster
+	}
+	return false
+}
+
+type WorkerKVEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *string                `protobuf:"bytes,1,opt,name=key,proto3,oneof" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Revision      *int64                 `protobuf:"varint,3,opt,name=revision,proto3,oneof" json:"revision,omitempty"` // 最后写入时间，unix 毫秒，同步时 revision 大的一方生效
+	Deleted       *bool                  `protobuf:"varint,4,opt,name=deleted,proto3,oneof" json:"deleted,omitempty"`   // key 已被删除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerKVEntry) Reset() {
+	*x = WorkerKVEntry{}
+	mi := &file_common_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerKVEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerKVEntry) ProtoMessage() {}
+
+func (x *WorkerKVEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerKVEntry.ProtoReflect.Descriptor instead.
+func (*WorkerKVEntry) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WorkerKVEntry) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *WorkerKVEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *WorkerKVEntry) GetRevision() int64 {
+	if x != nil && x.Revision != nil {
+		return *x.Revision
+	}
+	return 0
+}
+
+func (x *WorkerKVEntry) GetDeleted() bool {
+	if x != nil && x.Deleted != nil {
+		return *x.Deleted
+	}
+	return false
+}
+
+type WorkerResourceLimits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CpuMillicores *int64                 `protobuf:"varint,1,opt,name=cpu_millicores,json=cpuMillicores,proto3,oneof" json:"cpu_millicores,omitempty"` // 1000 为一个 CPU 核心，0 为不限制，需要 cgroups v2
+	MemoryBytes   *int64                 `protobuf:"varint,2,opt,name=memory_bytes,json=memoryBytes,proto3,oneof" json:"memory_bytes,omitempty"`       // 0 为不限制，无 cgroups v2 时使用 rlimit
+	RunAsUid      *uint32                `protobuf:"varint,3,opt,name=run_as_uid,json=runAsUid,proto3,oneof" json:"run_as_uid,omitempty"`              // 以指定的非特权用户运行 workerd，0 为不切换用户
+	RunAsGid      *uint32                `protobuf:"varint,4,opt,name=run_as_gid,json=runAsGid,proto3,oneof" json:"run_as_gid,omitempty"`
+	KillOnOom     *bool                  `protobuf:"varint,5,opt,name=kill_on_oom,json=killOnOom,proto3,oneof" json:"kill_on_oom,omitempty"` // 超出内存限制被杀死后不再重启，状态为 oom_killed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerResourceLimits) Reset() {
+	*x = WorkerResourceLimits{}
+	mi := &file_common_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerResourceLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerResourceLimits) ProtoMessage() {}
+
+func (x *WorkerResourceLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerResourceLimits.ProtoReflect.Descriptor instead.
+func (*WorkerResourceLimits) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WorkerResourceLimits) GetCpuMillicores() int64 {
+	if x != nil && x.CpuMillicores != nil {
+		return *x.CpuMillicores
+	}
+	return 0
+}
+
+func (x *WorkerResourceLimits) GetMemoryBytes() int64 {
+	if x != nil && x.MemoryBytes != nil {
+		return *x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *WorkerResourceLimits) GetRunAsUid() uint32 {
+	if x != nil && x.RunAsUid != nil {
+		return *x.RunAsUid
+	}
+	return 0
+}
+
+func (x *WorkerResourceLimits) GetRunAsGid() uint32 {
+	if x != nil && x.RunAsGid != nil {
+		return *x.RunAsGid
+	}
+	return 0
+}
+
+func (x *WorkerResourceLimits) GetKillOnOom() bool {
+	if x != nil && x.KillOnOom != nil {
+		return *x.KillOnOom
+	}
+	return false
+}
+
+type WorkerCron struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Id             *string                 `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Cron           *string                 `protobuf:"bytes,2,opt,name=cron,proto3,oneof" json:"cron,omitempty"` // 标准 5 位 cron 表达式，不支持秒
+	Type           *WorkerCron_TriggerType `protobuf:"varint,3,opt,name=type,proto3,enum=common.WorkerCron_TriggerType,oneof" json:"type,omitempty"`
+	Path           *string                 `protobuf:"bytes,4,opt,name=path,proto3,oneof" json:"path,omitempty"`                                            // 仅 TRIGGER_TYPE_HTTP 使用，默认为 /
+	TimeoutSeconds *int32                  `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"` // 单次调用超时时间，默认 30s
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkerCron) Reset() {
+	*x = WorkerCron{}
+	mi := &file_common_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerCron) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerCron) ProtoMessage() {}
+
+func (x *WorkerCron) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerCron.ProtoReflect.Descriptor instead.
+func (*WorkerCron) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WorkerCron) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *WorkerCron) GetCron() string {
+	if x != nil && x.Cron != nil {
+		return *x.Cron
+	}
+	return ""
+}
+
+func (x *WorkerCron) GetType() WorkerCron_TriggerType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return WorkerCron_TRIGGER_TYPE_UNSPECIFIED
+}
+
+func (x *WorkerCron) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *WorkerCron) GetTimeoutSeconds() int32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
+	}
+	return 0
+}
+
+type WorkerCronInvocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	WorkerId      *string                `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3,oneof" json:"worker_id,omitempty"`
+	ClientId      *string                `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	CronId        *string                `protobuf:"bytes,4,opt,name=cron_id,json=cronId,proto3,oneof" json:"cron_id,omitempty"`
+	Cron          *string                `protobuf:"bytes,5,opt,name=cron,proto3,oneof" json:"cron,omitempty"`
+	TriggeredAt   *int64                 `protobuf:"varint,6,opt,name=triggered_at,json=triggeredAt,proto3,oneof" json:"triggered_at,omitempty"` // 毫秒时间戳
+	DurationMs    *int64                 `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`
+	Success       *bool                  `protobuf:"varint,8,opt,name=success,proto3,oneof" json:"success,omitempty"`
+	StatusCode    *int32                 `protobuf:"varint,9,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`
+	Result        *string                `protobuf:"bytes,10,opt,name=result,proto3,oneof" json:"result,omitempty"` // 截断后的响应内容
+	Error         *string                `protobuf:"bytes,11,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerCronInvocation) Reset() {
+	*x = WorkerCronInvocation{}
+	mi := &file_common_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerCronInvocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerCronInvocation) ProtoMessage() {}
+
+func (x *WorkerCronInvocation) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerCronInvocation.ProtoReflect.Descriptor instead.
+func (*WorkerCronInvocation) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WorkerCronInvocation) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *WorkerCronInvocation) GetWorkerId() string {
+	if x != nil && x.WorkerId != nil {
+		return *x.WorkerId
+	}
+	return ""
+}
+
+func (x *WorkerCronInvocation) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *WorkerCronInvocation) GetCronId() string {
+	if x != nil && x.CronId != nil {
+		return *x.CronId
+	}
+	return ""
+}
+
+func (x *WorkerCronInvocation) GetCron() string {
+	if x != nil && x.Cron != nil {
+		return *x.Cron
+	}
+	return ""
+}
+
+func (x *WorkerCronInvocation) GetTriggeredAt() int64 {
+	if x != nil && x.TriggeredAt != nil {
+		return *x.TriggeredAt
+	}
+	return 0
+}
+
+func (x *WorkerCronInvocation) GetDurationMs() int64 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+func (x *WorkerCronInvocation) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *WorkerCronInvocation) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+func (x *WorkerCronInvocation) GetResult() string {
+	if x != nil && x.Result != nil {
+		return *x.Result
+	}
+	return ""
+}
+
+func (x *WorkerCronInvocation) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+// master 上托管的 workerd 二进制，用于无法访问外网的 client 安装
+type WorkerdArtifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Version       *string                `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	Os            *string                `protobuf:"bytes,3,opt,name=os,proto3,oneof" json:"os,omitempty"`
+	Arch          *string                `protobuf:"bytes,4,opt,name=arch,proto3,oneof" json:"arch,omitempty"`
+	Sha256        *string                `protobuf:"bytes,5,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"` // gz 文件的 sha256
+	Size          *int64                 `protobuf:"varint,6,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	CreatedAt     *int64                 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"` // 毫秒时间戳
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerdArtifact) Reset() {
+	*x = WorkerdArtifact{}
+	mi := &file_common_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerdArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerdArtifact) ProtoMessage() {}
+
+func (x *WorkerdArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerdArtifact.ProtoReflect.Descriptor instead.
+func (*WorkerdArtifact) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WorkerdArtifact) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *WorkerdArtifact) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return ""
+}
+
+func (x *WorkerdArtifact) GetOs() string {
+	if x != nil && x.Os != nil {
+		return *x.Os
+	}
+	return ""
+}
+
+func (x *WorkerdArtifact) GetArch() string {
+	if x != nil && x.Arch != nil {
+		return *x.Arch
+	}
+	return ""
+}
+
+func (x *WorkerdArtifact) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+func (x *WorkerdArtifact) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *WorkerdArtifact) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+// master 上记录的 PTY 会话，录像为 asciicast v2 格式
+type PTYSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	SessionId     *string                `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	ClientId      *string                `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	UserId        *uint32                `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	UserName      *string                `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`     // 发起会话的用户
+	StartedAt     *int64                 `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"` // 毫秒时间戳
+	EndedAt       *int64                 `protobuf:"varint,7,opt,name=ended_at,json=endedAt,proto3,oneof" json:"ended_at,omitempty"`       // 毫秒时间戳，为 0 表示会话仍在进行
+	Width         *int32                 `protobuf:"varint,8,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height        *int32                 `protobuf:"varint,9,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	Size          *int64                 `protobuf:"varint,10,opt,name=size,proto3,oneof" json:"size,omitempty"` // 录像文件大小
+	Live          *bool                  `protobuf:"varint,11,opt,name=live,proto3,oneof" json:"live,omitempty"` // 会话是否仍在 master 上运行
+	ShareMode     *PTYSession_ShareMode  `protobuf:"varint,12,opt,name=share_mode,json=shareMode,proto3,enum=common.PTYSession_ShareMode,oneof" json:"share_mode,omitempty"`
+	Viewers       []string               `protobuf:"bytes,13,rep,name=viewers,proto3" json:"viewers,omitempty"` // 当前连接的用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PTYSession) Reset() {
+	*x = PTYSession{}
+	mi := &file_common_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PTYSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PTYSession) ProtoMessage() {}
+
+func (x *PTYSession) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PTYSession.ProtoReflect.Descriptor instead.
+func (*PTYSession) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PTYSession) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *PTYSession) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *PTYSession) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *PTYSession) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *PTYSession) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *PTYSession) GetStartedAt() int64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
+}
+
+func (x *PTYSession) GetEndedAt() int64 {
+	if x != nil && x.EndedAt != nil {
+		return *x.EndedAt
+	}
+	return 0
+}
+
+func (x *PTYSession) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *PTYSession) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *PTYSession) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *PTYSession) GetLive() bool {
+	if x != nil && x.Live != nil {
+		return *x.Live
+	}
+	return false
+}
+
+func (x *PTYSession) GetShareMode() PTYSession_ShareMode {
+	if x != nil && x.ShareMode != nil {
+		return *x.ShareMode
+	}
+	return PTYSession_SHARE_MODE_UNSPECIFIED
+}
+
+func (x *PTYSession) GetViewers() []string {
+	if x != nil {
+		return x.Viewers
+	}
+	return nil
+}
+
+// 在节点上执行一次命令的结果
+type ExecResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClientId        *string                `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	ExitCode        *int32                 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"` // 进程未能启动或超时时为 -1
+	Stdout          []byte                 `protobuf:"bytes,3,opt,name=stdout,proto3,oneof" json:"stdout,omitempty"`
+	Stderr          []byte                 `protobuf:"bytes,4,opt,name=stderr,proto3,oneof" json:"stderr,omitempty"`
+	StdoutTruncated *bool                  `protobuf:"varint,5,opt,name=stdout_truncated,json=stdoutTruncated,proto3,oneof" json:"stdout_truncated,omitempty"`
+	StderrTruncated *bool                  `protobuf:"varint,6,opt,name=stderr_truncated,json=stderrTruncated,proto3,oneof" json:"stderr_truncated,omitempty"`
+	DurationMs      *int64                 `protobuf:"varint,7,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`
+	TimedOut        *bool                  `protobuf:"varint,8,opt,name=timed_out,json=timedOut,proto3,oneof" json:"timed_out,omitempty"`
+	Error           *string                `protobuf:"bytes,9,opt,name=error,proto3,oneof" json:"error,omitempty"` // 权限、连接或启动失败时的错误信息
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExecResult) Reset() {
+	*x = ExecResult{}
+	mi := &file_common_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecResult) ProtoMessage() {}
+
+func (x *ExecResult) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecResult.ProtoReflect.Descriptor instead.
+func (*ExecResult) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExecResult) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ExecResult) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecResult) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *ExecResult) GetStderr() []byte {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *ExecResult) GetStdoutTruncated() bool {
+	if x != nil && x.StdoutTruncated != nil {
+		return *x.StdoutTruncated
+	}
+	return false
+}
+
+func (x *ExecResult) GetStderrTruncated() bool {
+	if x != nil && x.StderrTruncated != nil {
+		return *x.StderrTruncated
+	}
+	return false
+}
+
+func (x *ExecResult) GetDurationMs() int64 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+func (x *ExecResult) GetTimedOut() bool {
+	if x != nil && x.TimedOut != nil {
+		return *x.TimedOut
+	}
+	return false
+}
+
+func (x *ExecResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+// 在节点上执行命令的审计记录，输出只保留开头部分
+type ExecRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	ClientId      *string                `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`
+	UserId        *uint32                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	UserName      *string                `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
+	Command       *string                `protobuf:"bytes,5,opt,name=command,proto3,oneof" json:"command,omitempty"`
+	Args          []string               `protobuf:"bytes,6,rep,name=args,proto3" json:"args,omitempty"`
+	WorkDir       *string                `protobuf:"bytes,7,opt,name=work_dir,json=workDir,proto3,oneof" json:"work_dir,omitempty"`
+	ExitCode      *int32                 `protobuf:"varint,8,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	TimedOut      *bool                  `protobuf:"varint,9,opt,name=timed_out,json=timedOut,proto3,oneof" json:"timed_out,omitempty"`
+	Stdout        []byte                 `protobuf:"bytes,10,opt,name=stdout,proto3,oneof" json:"stdout,omitempty"`
+	Stderr        []byte                 `protobuf:"bytes,11,opt,name=stderr,proto3,oneof" json:"stderr,omitempty"`
+	Error         *string                `protobuf:"bytes,12,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	StartedAt     *int64                 `protobuf:"varint,13,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"` // 毫秒时间戳
+	DurationMs    *int64                 `protobuf:"varint,14,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecRecord) Reset() {
+	*x = ExecRecord{}
+	mi := &file_common_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRecord) ProtoMessage() {}
+
+func (x *ExecRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRecord.ProtoReflect.Descriptor instead.
+func (*ExecRecord) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExecRecord) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *ExecRecord) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *ExecRecord) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ExecRecord) GetUserName() string {
+	if x != nil && x.UserName != nil {
+		return *x.UserName
+	}
+	return ""
+}
+
+func (x *ExecRecord) GetCommand() string {
+	if x != nil && x.Command != nil {
+		return *x.Command
+	}
+	return ""
+}
+
+func (x *ExecRecord) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ExecRecord) GetWorkDir() string {
+	if x != nil && x.WorkDir != nil {
+		return *x.WorkDir
+	}
+	return ""
+}
+
+func (x *ExecRecord) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecRecord) GetTimedOut() bool {
+	if x != nil && x.TimedOut != nil {
+		return *x.TimedOut
+	}
+	return false
+}
+
+func (x *ExecRecord) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *ExecRecord) GetStderr() []byte {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *ExecRecord) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ExecRecord) GetStartedAt() int64 {
+	if x != nil && x.StartedAt != nil {
+		return *x.StartedAt
+	}
+	return 0
+}
+
+func (x *ExecRecord) GetDurationMs() int64 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+type FileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Size          *int64                 `protobuf:"varint,2,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	Mode          *string                `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	ModTime       *int64                 `protobuf:"varint,4,opt,name=mod_time,json=modTime,proto3,oneof" json:"mod_time,omitempty"` // 毫秒时间戳
+	IsDir         *bool                  `protobuf:"varint,5,opt,name=is_dir,json=isDir,proto3,oneof" json:"is_dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	mi := &file_common_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FileInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *FileInfo) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *FileInfo) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *FileInfo) GetModTime() int64 {
+	if x != nil && x.ModTime != nil {
+		return *x.ModTime
+	}
+	return 0
+}
+
+func (x *FileInfo) GetIsDir() bool {
+	if x != nil && x.IsDir != nil {
+		return *x.IsDir
+	}
+	return false
+}
+
+type LogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *int64                 `protobuf:"varint,1,opt,name=time,proto3,oneof" json:"time,omitempty"` // 毫秒时间戳
+	Level         *string                `protobuf:"bytes,2,opt,name=level,proto3,oneof" json:"level,omitempty"`
+	Pkg           *string                `protobuf:"bytes,3,opt,name=pkg,proto3,oneof" json:"pkg,omitempty"`
+	Caller        *string                `protobuf:"bytes,4,opt,name=caller,proto3,oneof" json:"caller,omitempty"`
+	Message       *string                `protobuf:"bytes,5,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Fields        map[string]string      `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	mi := &file_common_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LogEntry) GetTime() int64 {
+	if x != nil && x.Time != nil {
+		return *x.Time
+	}
+	return 0
+}
+
+func (x *LogEntry) GetLevel() string {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return ""
+}
+
+func (x *LogEntry) GetPkg() string {
+	if x != nil && x.Pkg != nil {
+		return *x.Pkg
+	}
+	return ""
+}
+
+func (x *LogEntry) GetCaller() string {
+	if x != nil && x.Caller != nil {
+		return *x.Caller
+	}
+	return ""
+}
+
+func (x *LogEntry) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *LogEntry) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// one WorkerList for one workerd instance
+type WorkerList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workers       []*Worker              `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+	Nodename      *string                `protobuf:"bytes,2,opt,name=nodename,proto3,oneof" json:"nodename,omitempty"` // workerd runner host name, for HA
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerList) Reset() {
+	*x = WorkerList{}
+	mi := &file_common_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerList) ProtoMessage() {}
+
+func (x *WorkerList) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerList.ProtoReflect.Descriptor instead.
+func (*WorkerList) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WorkerList) GetWorkers() []*Worker {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+func (x *WorkerList) GetNodename() string {
+	if x != nil && x.Nodename != nil {
+		return *x.Nodename
+	}
+	return ""
+}
+
+type Socket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Address       *string                `protobuf:"bytes,2,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Socket) Reset() {
+	*x = Socket{}
+	mi := &file_common_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Socket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Socket) ProtoMessage() {}
+
+func (x *Socket) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Socket.ProtoReflect.Descriptor instead.
+func (*Socket) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Socket) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Socket) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+// 发送给通知渠道的事件，只会发送给资源所属用户的渠道
+type NotifyEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          *NotifyEventType       `protobuf:"varint,1,opt,name=type,proto3,enum=common.NotifyEventType,oneof" json:"type,omitempty"`
+	UserId        *uint32                `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	TenantId      *uint32                `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Subject       *string                `protobuf:"bytes,4,opt,name=subject,proto3,oneof" json:"subject,omitempty"` // 事件相关的资源 id，如 client id、隧道名
+	Title         *string                `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Message       *string                `protobuf:"bytes,6,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	Time          *int64                 `protobuf:"varint,7,opt,name=time,proto3,oneof" json:"time,omitempty"` // 毫秒时间戳
+	Fields        map[string]string      `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyEvent) Reset() {
+	*x = NotifyEvent{}
+	mi := &file_common_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyEvent) ProtoMessage() {}
+
+func (x *NotifyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyEvent.ProtoReflect.Descriptor instead.
+func (*NotifyEvent) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NotifyEvent) GetType() NotifyEventType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return NotifyEventType_NOTIFY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *NotifyEvent) GetUserId() uint32 {
 	if x != nil && x.UserId != nil {
 		return *x.UserId
 	}
-	return 0
+	return 0
+}
+
+func (x *NotifyEvent) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *NotifyEvent) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (x *NotifyEvent) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *NotifyEvent) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *NotifyEvent) GetTime() int64 {
+	if x != nil && x.Time != nil {
+		return *x.Time
+	}
+	return 0
+}
+
+func (x *NotifyEvent) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type NotifyWebhookConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           *string                `protobuf:"bytes,1,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Secret        *string                `protobuf:"bytes,2,opt,name=secret,proto3,oneof" json:"secret,omitempty"` // 不为空时在 X-Frpp-Signature 中携带 body 的 HMAC-SHA256 签名
+	Headers       map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyWebhookConfig) Reset() {
+	*x = NotifyWebhookConfig{}
+	mi := &file_common_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyWebhookConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyWebhookConfig) ProtoMessage() {}
+
+func (x *NotifyWebhookConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyWebhookConfig.ProtoReflect.Descriptor instead.
+func (*NotifyWebhookConfig) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *NotifyWebhookConfig) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *NotifyWebhookConfig) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *NotifyWebhookConfig) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
 }
 
-func (x *Worker) GetTenantId() uint32 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+type NotifyEmailConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmtpHost      *string                `protobuf:"bytes,1,opt,name=smtp_host,json=smtpHost,proto3,oneof" json:"smtp_host,omitempty"`
+	SmtpPort      *int32                 `protobuf:"varint,2,opt,name=smtp_port,json=smtpPort,proto3,oneof" json:"smtp_port,omitempty"` // 465 使用 TLS 直连，其他端口在服务端支持时使用 STARTTLS
+	Username      *string                `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	Password      *string                `protobuf:"bytes,4,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	From          *string                `protobuf:"bytes,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To            []string               `protobuf:"bytes,6,rep,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyEmailConfig) Reset() {
+	*x = NotifyEmailConfig{}
+	mi := &file_common_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyEmailConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyEmailConfig) ProtoMessage() {}
+
+func (x *NotifyEmailConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyEmailConfig.ProtoReflect.Descriptor instead.
+func (*NotifyEmailConfig) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NotifyEmailConfig) GetSmtpHost() string {
+	if x != nil && x.SmtpHost != nil {
+		return *x.SmtpHost
+	}
+	return ""
+}
+
+func (x *NotifyEmailConfig) GetSmtpPort() int32 {
+	if x != nil && x.SmtpPort != nil {
+		return *x.SmtpPort
 	}
 	return 0
 }
 
-func (x *Worker) GetSocket() *Socket {
+func (x *NotifyEmailConfig) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *NotifyEmailConfig) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *NotifyEmailConfig) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *NotifyEmailConfig) GetTo() []string {
 	if x != nil {
-		return x.Socket
+		return x.To
 	}
 	return nil
 }
 
-func (x *Worker) GetCodeEntry() string {
-	if x != nil && x.CodeEntry != nil {
-		return *x.CodeEntry
+// Slack/Telegram 风格的 JSON POST，body 为 {"text": "...", "chat_id": "..."}
+type NotifyChatConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           *string                `protobuf:"bytes,1,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	ChatId        *string                `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3,oneof" json:"chat_id,omitempty"` // Telegram 的 chat_id，为空时不携带
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyChatConfig) Reset() {
+	*x = NotifyChatConfig{}
+	mi := &file_common_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyChatConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyChatConfig) ProtoMessage() {}
+
+func (x *NotifyChatConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Worker) GetCode() string {
-	if x != nil && x.Code != nil {
-		return *x.Code
+// Deprecated: Use NotifyChatConfig.ProtoReflect.Descriptor instead.
+func (*NotifyChatConfig) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *NotifyChatConfig) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
 	}
 	return ""
 }
 
-func (x *Worker) GetConfigTemplate() string {
-	if x != nil && x.ConfigTemplate != nil {
-		return *x.ConfigTemplate
+func (x *NotifyChatConfig) GetChatId() string {
+	if x != nil && x.ChatId != nil {
+		return *x.ChatId
 	}
 	return ""
 }
 
-// one WorkerList for one workerd instance
-type WorkerList struct {
+type NotifyChannel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Workers       []*Worker              `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
-	Nodename      *string                `protobuf:"bytes,2,opt,name=nodename,proto3,oneof" json:"nodename,omitempty"` // workerd runner host name, for HA
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type          *NotifyChannel_Type    `protobuf:"varint,3,opt,name=type,proto3,enum=common.NotifyChannel_Type,oneof" json:"type,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,4,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Events        []NotifyEventType      `protobuf:"varint,5,rep,packed,name=events,proto3,enum=common.NotifyEventType" json:"events,omitempty"` // 订阅的事件
+	Webhook       *NotifyWebhookConfig   `protobuf:"bytes,6,opt,name=webhook,proto3,oneof" json:"webhook,omitempty"`
+	Email         *NotifyEmailConfig     `protobuf:"bytes,7,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Chat          *NotifyChatConfig      `protobuf:"bytes,8,opt,name=chat,proto3,oneof" json:"chat,omitempty"`
+	LastError     *string                `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`        // 最近一次发送失败的原因，成功后清空
+	LastSentAt    *int64                 `protobuf:"varint,10,opt,name=last_sent_at,json=lastSentAt,proto3,oneof" json:"last_sent_at,omitempty"` // 毫秒时间戳
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkerList) Reset() {
-	*x = WorkerList{}
-	mi := &file_common_proto_msgTypes[10]
+func (x *NotifyChannel) Reset() {
+	*x = NotifyChannel{}
+	mi := &file_common_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkerList) String() string {
+func (x *NotifyChannel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkerList) ProtoMessage() {}
+func (*NotifyChannel) ProtoMessage() {}
 
-func (x *WorkerList) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[10]
+func (x *NotifyChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,48 +3474,105 @@ func (x *WorkerList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkerList.ProtoReflect.Descriptor instead.
-func (*WorkerList) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use NotifyChannel.ProtoReflect.Descriptor instead.
+func (*NotifyChannel) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *WorkerList) GetWorkers() []*Worker {
+func (x *NotifyChannel) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *NotifyChannel) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *NotifyChannel) GetType() NotifyChannel_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return NotifyChannel_TYPE_UNSPECIFIED
+}
+
+func (x *NotifyChannel) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *NotifyChannel) GetEvents() []NotifyEventType {
 	if x != nil {
-		return x.Workers
+		return x.Events
 	}
 	return nil
 }
 
-func (x *WorkerList) GetNodename() string {
-	if x != nil && x.Nodename != nil {
-		return *x.Nodename
+func (x *NotifyChannel) GetWebhook() *NotifyWebhookConfig {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *NotifyChannel) GetEmail() *NotifyEmailConfig {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *NotifyChannel) GetChat() *NotifyChatConfig {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *NotifyChannel) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
 	}
 	return ""
 }
 
-type Socket struct {
+func (x *NotifyChannel) GetLastSentAt() int64 {
+	if x != nil && x.LastSentAt != nil {
+		return *x.LastSentAt
+	}
+	return 0
+}
+
+type LabelFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Address       *string                `protobuf:"bytes,2,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`                                     // 同一用户下唯一
+	ResourceType  *string                `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty"` // proxy/client/server/worker
+	Selector      *string                `protobuf:"bytes,3,opt,name=selector,proto3,oneof" json:"selector,omitempty"`                             // 例如 env=prod,team in (payments,risk),!deprecated
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Socket) Reset() {
-	*x = Socket{}
-	mi := &file_common_proto_msgTypes[11]
+func (x *LabelFilter) Reset() {
+	*x = LabelFilter{}
+	mi := &file_common_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Socket) String() string {
+func (x *LabelFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Socket) ProtoMessage() {}
+func (*LabelFilter) ProtoMessage() {}
 
-func (x *Socket) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[11]
+func (x *LabelFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,21 +3583,28 @@ func (x *Socket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Socket.ProtoReflect.Descriptor instead.
-func (*Socket) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LabelFilter.ProtoReflect.Descriptor instead.
+func (*LabelFilter) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Socket) GetName() string {
+func (x *LabelFilter) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *Socket) GetAddress() string {
-	if x != nil && x.Address != nil {
-		return *x.Address
+func (x *LabelFilter) GetResourceType() string {
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
+	}
+	return ""
+}
+
+func (x *LabelFilter) GetSelector() string {
+	if x != nil && x.Selector != nil {
+		return *x.Selector
 	}
 	return ""
 }
@@ -1091,7 +3624,7 @@ const file_common_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusH\x00R\x06status\x88\x01\x01\x12\x17\n" +
 	"\x04data\x18\x02 \x01(\tH\x01R\x04data\x88\x01\x01B\t\n" +
 	"\a_statusB\a\n" +
-	"\x05_data\"\xf3\x03\n" +
+	"\x05_data\"\x9b\x05\n" +
 	"\x06Client\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1b\n" +
 	"\x06secret\x18\x02 \x01(\tH\x01R\x06secret\x88\x01\x01\x12\x1b\n" +
@@ -1106,7 +3639,13 @@ const file_common_proto_rawDesc = "" +
 	" \x01(\tH\aR\afrpsUrl\x88\x01\x01\x12!\n" +
 	"\tephemeral\x18\v \x01(\bH\bR\tephemeral\x88\x01\x01\x12%\n" +
 	"\flast_seen_at\x18\f \x01(\x03H\tR\n" +
-	"lastSeenAt\x88\x01\x01B\x05\n" +
+	"lastSeenAt\x88\x01\x01\x12&\n" +
+	"\fpty_disabled\x18\r \x01(\bH\n" +
+	"R\vptyDisabled\x88\x01\x01\x122\n" +
+	"\x06labels\x18\x0e \x03(\v2\x1a.common.Client.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x05\n" +
 	"\x03_idB\t\n" +
 	"\a_secretB\t\n" +
 	"\a_configB\n" +
@@ -1120,20 +3659,25 @@ const file_common_proto_rawDesc = "" +
 	"\t_frps_urlB\f\n" +
 	"\n" +
 	"_ephemeralB\x0f\n" +
-	"\r_last_seen_at\"\xd8\x01\n" +
+	"\r_last_seen_atB\x0f\n" +
+	"\r_pty_disabled\"\xc7\x02\n" +
 	"\x06Server\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1b\n" +
 	"\x06secret\x18\x02 \x01(\tH\x01R\x06secret\x88\x01\x01\x12\x13\n" +
 	"\x02ip\x18\x03 \x01(\tH\x02R\x02ip\x88\x01\x01\x12\x1b\n" +
 	"\x06config\x18\x04 \x01(\tH\x03R\x06config\x88\x01\x01\x12\x1d\n" +
 	"\acomment\x18\x05 \x01(\tH\x04R\acomment\x88\x01\x01\x12\x1b\n" +
-	"\tfrps_urls\x18\x06 \x03(\tR\bfrpsUrlsB\x05\n" +
+	"\tfrps_urls\x18\x06 \x03(\tR\bfrpsUrls\x122\n" +
+	"\x06labels\x18\a \x03(\v2\x1a.common.Server.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x05\n" +
 	"\x03_idB\t\n" +
 	"\a_secretB\x05\n" +
 	"\x03_ipB\t\n" +
 	"\a_configB\n" +
 	"\n" +
-	"\b_comment\"\xd5\x02\n" +
+	"\b_comment\"\x8c\x03\n" +
 	"\x04User\x12\x1b\n" +
 	"\x06UserID\x18\x01 \x01(\x03H\x00R\x06UserID\x88\x01\x01\x12\x1f\n" +
 	"\bTenantID\x18\x02 \x01(\x03H\x01R\bTenantID\x88\x01\x01\x12\x1f\n" +
@@ -1142,7 +3686,8 @@ const file_common_proto_rawDesc = "" +
 	"\x06Status\x18\x05 \x01(\tH\x04R\x06Status\x88\x01\x01\x12\x17\n" +
 	"\x04Role\x18\x06 \x01(\tH\x05R\x04Role\x88\x01\x01\x12\x19\n" +
 	"\x05Token\x18\a \x01(\tH\x06R\x05Token\x88\x01\x01\x12%\n" +
-	"\vRawPassword\x18\b \x01(\tH\aR\vRawPassword\x88\x01\x01B\t\n" +
+	"\vRawPassword\x18\b \x01(\tH\aR\vRawPassword\x88\x01\x01\x12%\n" +
+	"\vPTYDisabled\x18\t \x01(\bH\bR\vPTYDisabled\x88\x01\x01B\t\n" +
 	"\a_UserIDB\v\n" +
 	"\t_TenantIDB\v\n" +
 	"\t_UserNameB\b\n" +
@@ -1150,7 +3695,8 @@ const file_common_proto_rawDesc = "" +
 	"\a_StatusB\a\n" +
 	"\x05_RoleB\b\n" +
 	"\x06_TokenB\x0e\n" +
-	"\f_RawPassword\"\x84\x04\n" +
+	"\f_RawPasswordB\x0e\n" +
+	"\f_PTYDisabled\"\x84\x04\n" +
 	"\tProxyInfo\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12 \n" +
@@ -1172,7 +3718,7 @@ const file_common_proto_rawDesc = "" +
 	"\x12_today_traffic_outB\x15\n" +
 	"\x13_history_traffic_inB\x16\n" +
 	"\x14_history_traffic_outB\r\n" +
-	"\v_first_sync\"\xe4\x02\n" +
+	"\v_first_sync\"\xd8\x03\n" +
 	"\vProxyConfig\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x17\n" +
@@ -1181,7 +3727,11 @@ const file_common_proto_rawDesc = "" +
 	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01\x12\x1b\n" +
 	"\x06config\x18\x06 \x01(\tH\x05R\x06config\x88\x01\x01\x12-\n" +
 	"\x10origin_client_id\x18\a \x01(\tH\x06R\x0eoriginClientId\x88\x01\x01\x12\x1d\n" +
-	"\astopped\x18\b \x01(\bH\aR\astopped\x88\x01\x01B\x05\n" +
+	"\astopped\x18\b \x01(\bH\aR\astopped\x88\x01\x01\x127\n" +
+	"\x06labels\x18\t \x03(\v2\x1f.common.ProxyConfig.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x05\n" +
 	"\x03_idB\a\n" +
 	"\x05_nameB\a\n" +
 	"\x05_typeB\f\n" +
@@ -1192,19 +3742,146 @@ const file_common_proto_rawDesc = "" +
 	"\a_configB\x13\n" +
 	"\x11_origin_client_idB\n" +
 	"\n" +
-	"\b_stopped\"\xd5\x01\n" +
+	"\b_stopped\"\x9c\x03\n" +
+	"\rVisitorConfig\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x03 \x01(\tH\x02R\x04type\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x04 \x01(\tH\x03R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x05 \x01(\tH\x04R\bserverId\x88\x01\x01\x12\x1b\n" +
+	"\x06config\x18\x06 \x01(\tH\x05R\x06config\x88\x01\x01\x12-\n" +
+	"\x10origin_client_id\x18\a \x01(\tH\x06R\x0eoriginClientId\x88\x01\x01\x12\x1d\n" +
+	"\astopped\x18\b \x01(\bH\aR\astopped\x88\x01\x01\x12$\n" +
+	"\vserver_name\x18\t \x01(\tH\bR\n" +
+	"serverName\x88\x01\x01B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_typeB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\t\n" +
+	"\a_configB\x13\n" +
+	"\x11_origin_client_idB\n" +
+	"\n" +
+	"\b_stoppedB\x0e\n" +
+	"\f_server_name\"\xd5\a\n" +
+	"\n" +
+	"ProxyProbe\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"proxy_name\x18\x02 \x01(\tH\x01R\tproxyName\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x03 \x01(\tH\x02R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x04 \x01(\tH\x03R\bserverId\x88\x01\x01\x120\n" +
+	"\x04type\x18\x05 \x01(\x0e2\x17.common.ProxyProbe.TypeH\x04R\x04type\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x06 \x01(\bH\x05R\aenabled\x88\x01\x01\x12\x1b\n" +
+	"\x06target\x18\a \x01(\tH\x06R\x06target\x88\x01\x01\x12 \n" +
+	"\thttp_path\x18\b \x01(\tH\aR\bhttpPath\x88\x01\x01\x12(\n" +
+	"\rexpect_status\x18\t \x01(\x05H\bR\fexpectStatus\x88\x01\x01\x12$\n" +
+	"\vudp_payload\x18\n" +
+	" \x01(\tH\tR\n" +
+	"udpPayload\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"timeout_ms\x18\v \x01(\x05H\n" +
+	"R\ttimeoutMs\x88\x01\x01\x12&\n" +
+	"\flast_success\x18\f \x01(\bH\vR\vlastSuccess\x88\x01\x01\x12+\n" +
+	"\x0flast_latency_ms\x18\r \x01(\x03H\fR\rlastLatencyMs\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\x0e \x01(\tH\rR\tlastError\x88\x01\x01\x12+\n" +
+	"\x0flast_check_time\x18\x0f \x01(\x03H\x0eR\rlastCheckTime\x88\x01\x01\x12 \n" +
+	"\tdial_addr\x18\x10 \x01(\tH\x0fR\bdialAddr\x88\x01\x01\x125\n" +
+	"\x14insecure_skip_verify\x18\x11 \x01(\bH\x10R\x12insecureSkipVerify\x88\x01\x01\"G\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bTYPE_TCP\x10\x01\x12\r\n" +
+	"\tTYPE_HTTP\x10\x02\x12\f\n" +
+	"\bTYPE_UDP\x10\x03B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_proxy_nameB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\a\n" +
+	"\x05_typeB\n" +
+	"\n" +
+	"\b_enabledB\t\n" +
+	"\a_targetB\f\n" +
+	"\n" +
+	"_http_pathB\x10\n" +
+	"\x0e_expect_statusB\x0e\n" +
+	"\f_udp_payloadB\r\n" +
+	"\v_timeout_msB\x0f\n" +
+	"\r_last_successB\x12\n" +
+	"\x10_last_latency_msB\r\n" +
+	"\v_last_errorB\x12\n" +
+	"\x10_last_check_timeB\f\n" +
+	"\n" +
+	"_dial_addrB\x17\n" +
+	"\x15_insecure_skip_verify\"x\n" +
+	"\x13ProxyScheduleWindow\x12\"\n" +
+	"\n" +
+	"start_cron\x18\x01 \x01(\tH\x00R\tstartCron\x88\x01\x01\x12 \n" +
+	"\tstop_cron\x18\x02 \x01(\tH\x01R\bstopCron\x88\x01\x01B\r\n" +
+	"\v_start_cronB\f\n" +
+	"\n" +
+	"_stop_cron\"\xfc\x02\n" +
+	"\rProxySchedule\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\x02 \x01(\tH\x01R\bserverId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"proxy_name\x18\x03 \x01(\tH\x02R\tproxyName\x88\x01\x01\x125\n" +
+	"\awindows\x18\x04 \x03(\v2\x1b.common.ProxyScheduleWindowR\awindows\x12 \n" +
+	"\texpire_at\x18\x05 \x01(\x03H\x03R\bexpireAt\x88\x01\x01\x12'\n" +
+	"\rnext_start_at\x18\x06 \x01(\x03H\x04R\vnextStartAt\x88\x01\x01\x12%\n" +
+	"\fnext_stop_at\x18\a \x01(\x03H\x05R\n" +
+	"nextStopAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\r\n" +
+	"\v_proxy_nameB\f\n" +
+	"\n" +
+	"_expire_atB\x10\n" +
+	"\x0e_next_start_atB\x0f\n" +
+	"\r_next_stop_at\"\xe4\x01\n" +
+	"\x10ProxyProbeResult\x12\x1e\n" +
+	"\bprobe_id\x18\x01 \x01(\rH\x00R\aprobeId\x88\x01\x01\x12\x1d\n" +
+	"\asuccess\x18\x02 \x01(\bH\x01R\asuccess\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"latency_ms\x18\x03 \x01(\x03H\x02R\tlatencyMs\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\x04 \x01(\tH\x03R\x05error\x88\x01\x01\x12\x17\n" +
+	"\x04time\x18\x05 \x01(\x03H\x04R\x04time\x88\x01\x01B\v\n" +
+	"\t_probe_idB\n" +
+	"\n" +
+	"\b_successB\r\n" +
+	"\v_latency_msB\b\n" +
+	"\x06_errorB\a\n" +
+	"\x05_time\"\x81\x04\n" +
 	"\x12ProxyWorkingStatus\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
 	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x1b\n" +
 	"\x06status\x18\x03 \x01(\tH\x02R\x06status\x88\x01\x01\x12\x15\n" +
 	"\x03err\x18\x04 \x01(\tH\x03R\x03err\x88\x01\x01\x12$\n" +
 	"\vremote_addr\x18\x05 \x01(\tH\x04R\n" +
-	"remoteAddr\x88\x01\x01B\a\n" +
+	"remoteAddr\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x06 \x01(\tH\x05R\bclientId\x88\x01\x01\x12 \n" +
+	"\tserver_id\x18\a \x01(\tH\x06R\bserverId\x88\x01\x01\x12-\n" +
+	"\x10origin_client_id\x18\b \x01(\tH\aR\x0eoriginClientId\x88\x01\x01\x12-\n" +
+	"\x10last_change_time\x18\t \x01(\x03H\bR\x0elastChangeTime\x88\x01\x01\x12-\n" +
+	"\x10last_report_time\x18\n" +
+	" \x01(\x03H\tR\x0elastReportTime\x88\x01\x01B\a\n" +
 	"\x05_nameB\a\n" +
 	"\x05_typeB\t\n" +
 	"\a_statusB\x06\n" +
 	"\x04_errB\x0e\n" +
-	"\f_remote_addr\"\x83\x03\n" +
+	"\f_remote_addrB\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_server_idB\x13\n" +
+	"\x11_origin_client_idB\x13\n" +
+	"\x11_last_change_timeB\x13\n" +
+	"\x11_last_report_time\"\x85\x06\n" +
 	"\x06Worker\x12 \n" +
 	"\tworker_id\x18\x01 \x01(\tH\x00R\bworkerId\x88\x01\x01\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x1c\n" +
@@ -1214,7 +3891,16 @@ const file_common_proto_rawDesc = "" +
 	"\n" +
 	"code_entry\x18\x06 \x01(\tH\x05R\tcodeEntry\x88\x01\x01\x12\x17\n" +
 	"\x04code\x18\a \x01(\tH\x06R\x04code\x88\x01\x01\x12,\n" +
-	"\x0fconfig_template\x18\b \x01(\tH\aR\x0econfigTemplate\x88\x01\x01B\f\n" +
+	"\x0fconfig_template\x18\b \x01(\tH\aR\x0econfigTemplate\x88\x01\x01\x12(\n" +
+	"\x05crons\x18\t \x03(\v2\x12.common.WorkerCronR\x05crons\x12J\n" +
+	"\x0fresource_limits\x18\n" +
+	" \x01(\v2\x1c.common.WorkerResourceLimitsH\bR\x0eresourceLimits\x88\x01\x01\x12G\n" +
+	"\x10service_bindings\x18\v \x03(\v2\x1c.common.WorkerServiceBindingR\x0fserviceBindings\x12>\n" +
+	"\rkv_namespaces\x18\f \x03(\v2\x19.common.WorkerKVNamespaceR\fkvNamespaces\x122\n" +
+	"\x06labels\x18\r \x03(\v2\x1a.common.Worker.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
 	"\n" +
 	"_worker_idB\a\n" +
 	"\x05_nameB\n" +
@@ -1225,7 +3911,246 @@ const file_common_proto_rawDesc = "" +
 	"\a_socketB\r\n" +
 	"\v_code_entryB\a\n" +
 	"\x05_codeB\x12\n" +
-	"\x10_config_template\"d\n" +
+	"\x10_config_templateB\x12\n" +
+	"\x10_resource_limits\"h\n" +
+	"\x14WorkerServiceBinding\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
+	"\tworker_id\x18\x02 \x01(\tH\x01R\bworkerId\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_worker_id\"\xb7\x02\n" +
+	"\x11WorkerKVNamespace\x12\x1d\n" +
+	"\abinding\x18\x01 \x01(\tH\x00R\abinding\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x01R\tnamespace\x88\x01\x01\x12:\n" +
+	"\x05scope\x18\x03 \x01(\x0e2\x1f.common.WorkerKVNamespace.ScopeH\x02R\x05scope\x88\x01\x01\x12)\n" +
+	"\x0esync_to_master\x18\x04 \x01(\bH\x03R\fsyncToMaster\x88\x01\x01\"B\n" +
+	"\x05Scope\x12\x15\n" +
+	"\x11SCOPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fSCOPE_WORKER\x10\x01\x12\x10\n" +
+	"\fSCOPE_TENANT\x10\x02B\n" +
+	"\n" +
+	"\b_bindingB\f\n" +
+	"\n" +
+	"_namespaceB\b\n" +
+	"\x06_scopeB\x11\n" +
+	"\x0f_sync_to_master\"\xac\x01\n" +
+	"\rWorkerKVEntry\x12\x15\n" +
+	"\x03key\x18\x01 \x01(\tH\x00R\x03key\x88\x01\x01\x12\x19\n" +
+	"\x05value\x18\x02 \x01(\fH\x01R\x05value\x88\x01\x01\x12\x1f\n" +
+	"\brevision\x18\x03 \x01(\x03H\x02R\brevision\x88\x01\x01\x12\x1d\n" +
+	"\adeleted\x18\x04 \x01(\bH\x03R\adeleted\x88\x01\x01B\x06\n" +
+	"\x04_keyB\b\n" +
+	"\x06_valueB\v\n" +
+	"\t_revisionB\n" +
+	"\n" +
+	"\b_deleted\"\xa7\x02\n" +
+	"\x14WorkerResourceLimits\x12*\n" +
+	"\x0ecpu_millicores\x18\x01 \x01(\x03H\x00R\rcpuMillicores\x88\x01\x01\x12&\n" +
+	"\fmemory_bytes\x18\x02 \x01(\x03H\x01R\vmemoryBytes\x88\x01\x01\x12!\n" +
+	"\n" +
+	"run_as_uid\x18\x03 \x01(\rH\x02R\brunAsUid\x88\x01\x01\x12!\n" +
+	"\n" +
+	"run_as_gid\x18\x04 \x01(\rH\x03R\brunAsGid\x88\x01\x01\x12#\n" +
+	"\vkill_on_oom\x18\x05 \x01(\bH\x04R\tkillOnOom\x88\x01\x01B\x11\n" +
+	"\x0f_cpu_millicoresB\x0f\n" +
+	"\r_memory_bytesB\r\n" +
+	"\v_run_as_uidB\r\n" +
+	"\v_run_as_gidB\x0e\n" +
+	"\f_kill_on_oom\"\xd0\x02\n" +
+	"\n" +
+	"WorkerCron\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04cron\x18\x02 \x01(\tH\x01R\x04cron\x88\x01\x01\x127\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1e.common.WorkerCron.TriggerTypeH\x02R\x04type\x88\x01\x01\x12\x17\n" +
+	"\x04path\x18\x04 \x01(\tH\x03R\x04path\x88\x01\x01\x12,\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x05H\x04R\x0etimeoutSeconds\x88\x01\x01\"^\n" +
+	"\vTriggerType\x12\x1c\n" +
+	"\x18TRIGGER_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TRIGGER_TYPE_SCHEDULED\x10\x01\x12\x15\n" +
+	"\x11TRIGGER_TYPE_HTTP\x10\x02B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_cronB\a\n" +
+	"\x05_typeB\a\n" +
+	"\x05_pathB\x12\n" +
+	"\x10_timeout_seconds\"\xfb\x03\n" +
+	"\x14WorkerCronInvocation\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12 \n" +
+	"\tworker_id\x18\x02 \x01(\tH\x01R\bworkerId\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x03 \x01(\tH\x02R\bclientId\x88\x01\x01\x12\x1c\n" +
+	"\acron_id\x18\x04 \x01(\tH\x03R\x06cronId\x88\x01\x01\x12\x17\n" +
+	"\x04cron\x18\x05 \x01(\tH\x04R\x04cron\x88\x01\x01\x12&\n" +
+	"\ftriggered_at\x18\x06 \x01(\x03H\x05R\vtriggeredAt\x88\x01\x01\x12$\n" +
+	"\vduration_ms\x18\a \x01(\x03H\x06R\n" +
+	"durationMs\x88\x01\x01\x12\x1d\n" +
+	"\asuccess\x18\b \x01(\bH\aR\asuccess\x88\x01\x01\x12$\n" +
+	"\vstatus_code\x18\t \x01(\x05H\bR\n" +
+	"statusCode\x88\x01\x01\x12\x1b\n" +
+	"\x06result\x18\n" +
+	" \x01(\tH\tR\x06result\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\v \x01(\tH\n" +
+	"R\x05error\x88\x01\x01B\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_worker_idB\f\n" +
+	"\n" +
+	"_client_idB\n" +
+	"\n" +
+	"\b_cron_idB\a\n" +
+	"\x05_cronB\x0f\n" +
+	"\r_triggered_atB\x0e\n" +
+	"\f_duration_msB\n" +
+	"\n" +
+	"\b_successB\x0e\n" +
+	"\f_status_codeB\t\n" +
+	"\a_resultB\b\n" +
+	"\x06_error\"\x93\x02\n" +
+	"\x0fWorkerdArtifact\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x01R\aversion\x88\x01\x01\x12\x13\n" +
+	"\x02os\x18\x03 \x01(\tH\x02R\x02os\x88\x01\x01\x12\x17\n" +
+	"\x04arch\x18\x04 \x01(\tH\x03R\x04arch\x88\x01\x01\x12\x1b\n" +
+	"\x06sha256\x18\x05 \x01(\tH\x04R\x06sha256\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x06 \x01(\x03H\x05R\x04size\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03H\x06R\tcreatedAt\x88\x01\x01B\x05\n" +
+	"\x03_idB\n" +
+	"\n" +
+	"\b_versionB\x05\n" +
+	"\x03_osB\a\n" +
+	"\x05_archB\t\n" +
+	"\a_sha256B\a\n" +
+	"\x05_sizeB\r\n" +
+	"\v_created_at\"\xad\x05\n" +
+	"\n" +
+	"PTYSession\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tH\x01R\tsessionId\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x03 \x01(\tH\x02R\bclientId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\rH\x03R\x06userId\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x05 \x01(\tH\x04R\buserName\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\x03H\x05R\tstartedAt\x88\x01\x01\x12\x1e\n" +
+	"\bended_at\x18\a \x01(\x03H\x06R\aendedAt\x88\x01\x01\x12\x19\n" +
+	"\x05width\x18\b \x01(\x05H\aR\x05width\x88\x01\x01\x12\x1b\n" +
+	"\x06height\x18\t \x01(\x05H\bR\x06height\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\n" +
+	" \x01(\x03H\tR\x04size\x88\x01\x01\x12\x17\n" +
+	"\x04live\x18\v \x01(\bH\n" +
+	"R\x04live\x88\x01\x01\x12@\n" +
+	"\n" +
+	"share_mode\x18\f \x01(\x0e2\x1c.common.PTYSession.ShareModeH\vR\tshareMode\x88\x01\x01\x12\x18\n" +
+	"\aviewers\x18\r \x03(\tR\aviewers\"j\n" +
+	"\tShareMode\x12\x1a\n" +
+	"\x16SHARE_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SHARE_MODE_PRIVATE\x10\x01\x12\x13\n" +
+	"\x0fSHARE_MODE_READ\x10\x02\x12\x14\n" +
+	"\x10SHARE_MODE_WRITE\x10\x03B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_session_idB\f\n" +
+	"\n" +
+	"_client_idB\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_user_nameB\r\n" +
+	"\v_started_atB\v\n" +
+	"\t_ended_atB\b\n" +
+	"\x06_widthB\t\n" +
+	"\a_heightB\a\n" +
+	"\x05_sizeB\a\n" +
+	"\x05_liveB\r\n" +
+	"\v_share_mode\"\xd1\x03\n" +
+	"\n" +
+	"ExecResult\x12 \n" +
+	"\tclient_id\x18\x01 \x01(\tH\x00R\bclientId\x88\x01\x01\x12 \n" +
+	"\texit_code\x18\x02 \x01(\x05H\x01R\bexitCode\x88\x01\x01\x12\x1b\n" +
+	"\x06stdout\x18\x03 \x01(\fH\x02R\x06stdout\x88\x01\x01\x12\x1b\n" +
+	"\x06stderr\x18\x04 \x01(\fH\x03R\x06stderr\x88\x01\x01\x12.\n" +
+	"\x10stdout_truncated\x18\x05 \x01(\bH\x04R\x0fstdoutTruncated\x88\x01\x01\x12.\n" +
+	"\x10stderr_truncated\x18\x06 \x01(\bH\x05R\x0fstderrTruncated\x88\x01\x01\x12$\n" +
+	"\vduration_ms\x18\a \x01(\x03H\x06R\n" +
+	"durationMs\x88\x01\x01\x12 \n" +
+	"\ttimed_out\x18\b \x01(\bH\aR\btimedOut\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\t \x01(\tH\bR\x05error\x88\x01\x01B\f\n" +
+	"\n" +
+	"_client_idB\f\n" +
+	"\n" +
+	"_exit_codeB\t\n" +
+	"\a_stdoutB\t\n" +
+	"\a_stderrB\x13\n" +
+	"\x11_stdout_truncatedB\x13\n" +
+	"\x11_stderr_truncatedB\x0e\n" +
+	"\f_duration_msB\f\n" +
+	"\n" +
+	"_timed_outB\b\n" +
+	"\x06_error\"\xdc\x04\n" +
+	"\n" +
+	"ExecRecord\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12 \n" +
+	"\tclient_id\x18\x02 \x01(\tH\x01R\bclientId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\rH\x02R\x06userId\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x04 \x01(\tH\x03R\buserName\x88\x01\x01\x12\x1d\n" +
+	"\acommand\x18\x05 \x01(\tH\x04R\acommand\x88\x01\x01\x12\x12\n" +
+	"\x04args\x18\x06 \x03(\tR\x04args\x12\x1e\n" +
+	"\bwork_dir\x18\a \x01(\tH\x05R\aworkDir\x88\x01\x01\x12 \n" +
+	"\texit_code\x18\b \x01(\x05H\x06R\bexitCode\x88\x01\x01\x12 \n" +
+	"\ttimed_out\x18\t \x01(\bH\aR\btimedOut\x88\x01\x01\x12\x1b\n" +
+	"\x06stdout\x18\n" +
+	" \x01(\fH\bR\x06stdout\x88\x01\x01\x12\x1b\n" +
+	"\x06stderr\x18\v \x01(\fH\tR\x06stderr\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\f \x01(\tH\n" +
+	"R\x05error\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"started_at\x18\r \x01(\x03H\vR\tstartedAt\x88\x01\x01\x12$\n" +
+	"\vduration_ms\x18\x0e \x01(\x03H\fR\n" +
+	"durationMs\x88\x01\x01B\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_client_idB\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_user_nameB\n" +
+	"\n" +
+	"\b_commandB\v\n" +
+	"\t_work_dirB\f\n" +
+	"\n" +
+	"_exit_codeB\f\n" +
+	"\n" +
+	"_timed_outB\t\n" +
+	"\a_stdoutB\t\n" +
+	"\a_stderrB\b\n" +
+	"\x06_errorB\r\n" +
+	"\v_started_atB\x0e\n" +
+	"\f_duration_ms\"\xc4\x01\n" +
+	"\bFileInfo\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x02 \x01(\x03H\x01R\x04size\x88\x01\x01\x12\x17\n" +
+	"\x04mode\x18\x03 \x01(\tH\x02R\x04mode\x88\x01\x01\x12\x1e\n" +
+	"\bmod_time\x18\x04 \x01(\x03H\x03R\amodTime\x88\x01\x01\x12\x1a\n" +
+	"\x06is_dir\x18\x05 \x01(\bH\x04R\x05isDir\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_sizeB\a\n" +
+	"\x05_modeB\v\n" +
+	"\t_mod_timeB\t\n" +
+	"\a_is_dir\"\xb4\x02\n" +
+	"\bLogEntry\x12\x17\n" +
+	"\x04time\x18\x01 \x01(\x03H\x00R\x04time\x88\x01\x01\x12\x19\n" +
+	"\x05level\x18\x02 \x01(\tH\x01R\x05level\x88\x01\x01\x12\x15\n" +
+	"\x03pkg\x18\x03 \x01(\tH\x02R\x03pkg\x88\x01\x01\x12\x1b\n" +
+	"\x06caller\x18\x04 \x01(\tH\x03R\x06caller\x88\x01\x01\x12\x1d\n" +
+	"\amessage\x18\x05 \x01(\tH\x04R\amessage\x88\x01\x01\x124\n" +
+	"\x06fields\x18\x06 \x03(\v2\x1c.common.LogEntry.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_timeB\b\n" +
+	"\x06_levelB\x06\n" +
+	"\x04_pkgB\t\n" +
+	"\a_callerB\n" +
+	"\n" +
+	"\b_message\"d\n" +
 	"\n" +
 	"WorkerList\x12(\n" +
 	"\aworkers\x18\x01 \x03(\v2\x0e.common.WorkerR\aworkers\x12\x1f\n" +
@@ -1236,7 +4161,97 @@ const file_common_proto_rawDesc = "" +
 	"\aaddress\x18\x02 \x01(\tH\x01R\aaddress\x88\x01\x01B\a\n" +
 	"\x05_nameB\n" +
 	"\n" +
-	"\b_address*\xbc\x01\n" +
+	"\b_address\"\xb3\x03\n" +
+	"\vNotifyEvent\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x17.common.NotifyEventTypeH\x00R\x04type\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\rH\x01R\x06userId\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\rH\x02R\btenantId\x88\x01\x01\x12\x1d\n" +
+	"\asubject\x18\x04 \x01(\tH\x03R\asubject\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x05 \x01(\tH\x04R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\amessage\x18\x06 \x01(\tH\x05R\amessage\x88\x01\x01\x12\x17\n" +
+	"\x04time\x18\a \x01(\x03H\x06R\x04time\x88\x01\x01\x127\n" +
+	"\x06fields\x18\b \x03(\v2\x1f.common.NotifyEvent.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05_typeB\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_tenant_idB\n" +
+	"\n" +
+	"\b_subjectB\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_messageB\a\n" +
+	"\x05_time\"\xdc\x01\n" +
+	"\x13NotifyWebhookConfig\x12\x15\n" +
+	"\x03url\x18\x01 \x01(\tH\x00R\x03url\x88\x01\x01\x12\x1b\n" +
+	"\x06secret\x18\x02 \x01(\tH\x01R\x06secret\x88\x01\x01\x12B\n" +
+	"\aheaders\x18\x03 \x03(\v2(.common.NotifyWebhookConfig.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_secret\"\x81\x02\n" +
+	"\x11NotifyEmailConfig\x12 \n" +
+	"\tsmtp_host\x18\x01 \x01(\tH\x00R\bsmtpHost\x88\x01\x01\x12 \n" +
+	"\tsmtp_port\x18\x02 \x01(\x05H\x01R\bsmtpPort\x88\x01\x01\x12\x1f\n" +
+	"\busername\x18\x03 \x01(\tH\x02R\busername\x88\x01\x01\x12\x1f\n" +
+	"\bpassword\x18\x04 \x01(\tH\x03R\bpassword\x88\x01\x01\x12\x17\n" +
+	"\x04from\x18\x05 \x01(\tH\x04R\x04from\x88\x01\x01\x12\x0e\n" +
+	"\x02to\x18\x06 \x03(\tR\x02toB\f\n" +
+	"\n" +
+	"_smtp_hostB\f\n" +
+	"\n" +
+	"_smtp_portB\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_passwordB\a\n" +
+	"\x05_from\"[\n" +
+	"\x10NotifyChatConfig\x12\x15\n" +
+	"\x03url\x18\x01 \x01(\tH\x00R\x03url\x88\x01\x01\x12\x1c\n" +
+	"\achat_id\x18\x02 \x01(\tH\x01R\x06chatId\x88\x01\x01B\x06\n" +
+	"\x04_urlB\n" +
+	"\n" +
+	"\b_chat_id\"\xe5\x04\n" +
+	"\rNotifyChannel\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x01R\x04name\x88\x01\x01\x123\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1a.common.NotifyChannel.TypeH\x02R\x04type\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\x04 \x01(\bH\x03R\aenabled\x88\x01\x01\x12/\n" +
+	"\x06events\x18\x05 \x03(\x0e2\x17.common.NotifyEventTypeR\x06events\x12:\n" +
+	"\awebhook\x18\x06 \x01(\v2\x1b.common.NotifyWebhookConfigH\x04R\awebhook\x88\x01\x01\x124\n" +
+	"\x05email\x18\a \x01(\v2\x19.common.NotifyEmailConfigH\x05R\x05email\x88\x01\x01\x121\n" +
+	"\x04chat\x18\b \x01(\v2\x18.common.NotifyChatConfigH\x06R\x04chat\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tH\aR\tlastError\x88\x01\x01\x12%\n" +
+	"\flast_sent_at\x18\n" +
+	" \x01(\x03H\bR\n" +
+	"lastSentAt\x88\x01\x01\"M\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTYPE_WEBHOOK\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TYPE_EMAIL\x10\x02\x12\r\n" +
+	"\tTYPE_CHAT\x10\x03B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_typeB\n" +
+	"\n" +
+	"\b_enabledB\n" +
+	"\n" +
+	"\b_webhookB\b\n" +
+	"\x06_emailB\a\n" +
+	"\x05_chatB\r\n" +
+	"\v_last_errorB\x0f\n" +
+	"\r_last_sent_at\"\x99\x01\n" +
+	"\vLabelFilter\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12(\n" +
+	"\rresource_type\x18\x02 \x01(\tH\x01R\fresourceType\x88\x01\x01\x12\x1f\n" +
+	"\bselector\x18\x03 \x01(\tH\x02R\bselector\x88\x01\x01B\a\n" +
+	"\x05_nameB\x10\n" +
+	"\x0e_resource_typeB\v\n" +
+	"\t_selector*\xbc\x01\n" +
 	"\bRespCode\x12\x19\n" +
 	"\x15RESP_CODE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11RESP_CODE_SUCCESS\x10\x01\x12\x17\n" +
@@ -1249,7 +4264,17 @@ const file_common_proto_rawDesc = "" +
 	"ClientType\x12\x1b\n" +
 	"\x17CLIENT_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10CLIENT_TYPE_FRPC\x10\x01\x12\x14\n" +
-	"\x10CLIENT_TYPE_FRPS\x10\x02B\aZ\x05../pbb\x06proto3"
+	"\x10CLIENT_TYPE_FRPS\x10\x02*\xf1\x02\n" +
+	"\x0fNotifyEventType\x12!\n" +
+	"\x1dNOTIFY_EVENT_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" NOTIFY_EVENT_TYPE_CLIENT_OFFLINE\x10\x01\x12$\n" +
+	" NOTIFY_EVENT_TYPE_SERVER_OFFLINE\x10\x02\x12!\n" +
+	"\x1dNOTIFY_EVENT_TYPE_PROXY_ERROR\x10\x03\x12'\n" +
+	"#NOTIFY_EVENT_TYPE_WORKER_CRASH_LOOP\x10\x04\x12,\n" +
+	"(NOTIFY_EVENT_TYPE_TRAFFIC_QUOTA_EXCEEDED\x10\x05\x12#\n" +
+	"\x1fNOTIFY_EVENT_TYPE_CLIENT_JOINED\x10\x06\x12'\n" +
+	"#NOTIFY_EVENT_TYPE_PROXY_UNREACHABLE\x10\a\x12'\n" +
+	"#NOTIFY_EVENT_TYPE_WORKER_OOM_KILLED\x10\bB\aZ\x05../pbb\x06proto3"
 
 var (
 	file_common_proto_rawDescOnce sync.Once
@@ -1263,34 +4288,92 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_common_proto_goTypes = []any{
-	(RespCode)(0),              // 0: common.RespCode
-	(ClientType)(0),            // 1: common.ClientType
-	(*Status)(nil),             // 2: common.Status
-	(*CommonRequest)(nil),      // 3: common.CommonRequest
-	(*CommonResponse)(nil),     // 4: common.CommonResponse
-	(*Client)(nil),             // 5: common.Client
-	(*Server)(nil),             // 6: common.Server
-	(*User)(nil),               // 7: common.User
-	(*ProxyInfo)(nil),          // 8: common.ProxyInfo
-	(*ProxyConfig)(nil),        // 9: common.ProxyConfig
-	(*ProxyWorkingStatus)(nil), // 10: common.ProxyWorkingStatus
-	(*Worker)(nil),             // 11: common.Worker
-	(*WorkerList)(nil),         // 12: common.WorkerList
-	(*Socket)(nil),             // 13: common.Socket
+	(RespCode)(0),                // 0: common.RespCode
+	(ClientType)(0),              // 1: common.ClientType
+	(NotifyEventType)(0),         // 2: common.NotifyEventType
+	(ProxyProbe_Type)(0),         // 3: common.ProxyProbe.Type
+	(WorkerKVNamespace_Scope)(0), // 4: common.WorkerKVNamespace.Scope
+	(WorkerCron_TriggerType)(0),  // 5: common.WorkerCron.TriggerType
+	(PTYSession_ShareMode)(0),    // 6: common.PTYSession.ShareMode
+	(NotifyChannel_Type)(0),      // 7: common.NotifyChannel.Type
+	(*Status)(nil),               // 8: common.Status
+	(*CommonRequest)(nil),        // 9: common.CommonRequest
+	(*CommonResponse)(nil),       // 10: common.CommonResponse
+	(*Client)(nil),               // 11: common.Client
+	(*Server)(nil),               // 12: common.Server
+	(*User)(nil),                 // 13: common.User
+	(*ProxyInfo)(nil),            // 14: common.ProxyInfo
+	(*ProxyConfig)(nil),          // 15: common.ProxyConfig
+	(*VisitorConfig)(nil),        // 16: common.VisitorConfig
+	(*ProxyProbe)(nil),           // 17: common.ProxyProbe
+	(*ProxyScheduleWindow)(nil),  // 18: common.ProxyScheduleWindow
+	(*ProxySchedule)(nil),        // 19: common.ProxySchedule
+	(*ProxyProbeResult)(nil),     // 20: common.ProxyProbeResult
+	(*ProxyWorkingStatus)(nil),   // 21: common.ProxyWorkingStatus
+	(*Worker)(nil),               // 22: common.Worker
+	(*WorkerServiceBinding)(nil), // 23: common.WorkerServiceBinding
+	(*WorkerKVNamespace)(nil),    // 24: common.WorkerKVNamespace
+	(*WorkerKVEntry)(nil),        // 25: common.WorkerKVEntry
+	(*WorkerResourceLimits)(nil), // 26: common.WorkerResourceLimits
+	(*WorkerCron)(nil),           // 27: common.WorkerCron
+	(*WorkerCronInvocation)(nil), // 28: common.WorkerCronInvocation
+	(*WorkerdArtifact)(nil),      // 29: common.WorkerdArtifact
+	(*PTYSession)(nil),           // 30: common.PTYSession
+	(*ExecResult)(nil),           // 31: common.ExecResult
+	(*ExecRecord)(nil),           // 32: common.ExecRecord
+	(*FileInfo)(nil),             // 33: common.FileInfo
+	(*LogEntry)(nil),             // 34: common.LogEntry
+	(*WorkerList)(nil),           // 35: common.WorkerList
+	(*Socket)(nil),               // 36: common.Socket
+	(*NotifyEvent)(nil),          // 37: common.NotifyEvent
+	(*NotifyWebhookConfig)(nil),  // 38: common.NotifyWebhookConfig
+	(*NotifyEmailConfig)(nil),    // 39: common.NotifyEmailConfig
+	(*NotifyChatConfig)(nil),     // 40: common.NotifyChatConfig
+	(*NotifyChannel)(nil),        // 41: common.NotifyChannel
+	(*LabelFilter)(nil),          // 42: common.LabelFilter
+	nil,                          // 43: common.Client.LabelsEntry
+	nil,                          // 44: common.Server.LabelsEntry
+	nil,                          // 45: common.ProxyConfig.LabelsEntry
+	nil,                          // 46: common.Worker.LabelsEntry
+	nil,                          // 47: common.LogEntry.FieldsEntry
+	nil,                          // 48: common.NotifyEvent.FieldsEntry
+	nil,                          // 49: common.NotifyWebhookConfig.HeadersEntry
 }
 var file_common_proto_depIdxs = []int32{
 	0,  // 0: common.Status.code:type_name -> common.RespCode
-	2,  // 1: common.CommonResponse.status:type_name -> common.Status
-	13, // 2: common.Worker.socket:type_name -> common.Socket
-	11, // 3: common.WorkerList.workers:type_name -> common.Worker
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	8,  // 1: common.CommonResponse.status:type_name -> common.Status
+	43, // 2: common.Client.labels:type_name -> common.Client.LabelsEntry
+	44, // 3: common.Server.labels:type_name -> common.Server.LabelsEntry
+	45, // 4: common.ProxyConfig.labels:type_name -> common.ProxyConfig.LabelsEntry
+	3,  // 5: common.ProxyProbe.type:type_name -> common.ProxyProbe.Type
+	18, // 6: common.ProxySchedule.windows:type_name -> common.ProxyScheduleWindow
+	36, // 7: common.Worker.socket:type_name -> common.Socket
+	27, // 8: common.Worker.crons:type_name -> common.WorkerCron
+	26, // 9: common.Worker.resource_limits:type_name -> common.WorkerResourceLimits
+	23, // 10: common.Worker.service_bindings:type_name -> common.WorkerServiceBinding
+	24, // 11: common.Worker.kv_namespaces:type_name -> common.WorkerKVNamespace
+	46, // 12: common.Worker.labels:type_name -> common.Worker.LabelsEntry
+	4,  // 13: common.WorkerKVNamespace.scope:type_name -> common.WorkerKVNamespace.Scope
+	5,  // 14: common.WorkerCron.type:type_name -> common.WorkerCron.TriggerType
+	6,  // 15: common.PTYSession.share_mode:type_name -> common.PTYSession.ShareMode
+	47, // 16: common.LogEntry.fields:type_name -> common.LogEntry.FieldsEntry
+	22, // 17: common.WorkerList.workers:type_name -> common.Worker
+	2,  // 18: common.NotifyEvent.type:type_name -> common.NotifyEventType
+	48, // 19: common.NotifyEvent.fields:type_name -> common.NotifyEvent.FieldsEntry
+	49, // 20: common.NotifyWebhookConfig.headers:type_name -> common.NotifyWebhookConfig.HeadersEntry
+	7,  // 21: common.NotifyChannel.type:type_name -> common.NotifyChannel.Type
+	2,  // 22: common.NotifyChannel.events:type_name -> common.NotifyEventType
+	38, // 23: common.NotifyChannel.webhook:type_name -> common.NotifyWebhookConfig
+	39, // 24: common.NotifyChannel.email:type_name -> common.NotifyEmailConfig
+	40, // 25: common.NotifyChannel.chat:type_name -> common.NotifyChatConfig
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -1309,13 +4392,36 @@ func file_common_proto_init() {
 	file_common_proto_msgTypes[9].OneofWrappers = []any{}
 	file_common_proto_msgTypes[10].OneofWrappers = []any{}
 	file_common_proto_msgTypes[11].OneofWrappers = []any{}
+	file_common_proto_msgTypes[12].OneofWrappers = []any{}
+	file_common_proto_msgTypes[13].OneofWrappers = []any{}
+	file_common_proto_msgTypes[14].OneofWrappers = []any{}
+	file_common_proto_msgTypes[15].OneofWrappers = []any{}
+	file_common_proto_msgTypes[16].OneofWrappers = []any{}
+	file_common_proto_msgTypes[17].OneofWrappers = []any{}
+	file_common_proto_msgTypes[18].OneofWrappers = []any{}
+	file_common_proto_msgTypes[19].OneofWrappers = []any{}
+	file_common_proto_msgTypes[20].OneofWrappers = []any{}
+	file_common_proto_msgTypes[21].OneofWrappers = []any{}
+	file_common_proto_msgTypes[22].OneofWrappers = []any{}
+	file_common_proto_msgTypes[23].OneofWrappers = []any{}
+	file_common_proto_msgTypes[24].OneofWrappers = []any{}
+	file_common_proto_msgTypes[25].OneofWrappers = []any{}
+	file_common_proto_msgTypes[26].OneofWrappers = []any{}
+	file_common_proto_msgTypes[27].OneofWrappers = []any{}
+	file_common_proto_msgTypes[28].OneofWrappers = []any{}
+	file_common_proto_msgTypes[29].OneofWrappers = []any{}
+	file_common_proto_msgTypes[30].OneofWrappers = []any{}
+	file_common_proto_msgTypes[31].OneofWrappers = []any{}
+	file_common_proto_msgTypes[32].OneofWrappers = []any{}
+	file_common_proto_msgTypes[33].OneofWrappers = []any{}
+	file_common_proto_msgTypes[34].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      8,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
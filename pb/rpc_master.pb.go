@@ -24,29 +24,33 @@ const (
 type Event int32
 
 const (
-	Event_EVENT_UNSPECIFIED       Event = 0
-	Event_EVENT_REGISTER_CLIENT   Event = 1
-	Event_EVENT_REGISTER_SERVER   Event = 2
-	Event_EVENT_ERROR             Event = 3
-	Event_EVENT_DATA              Event = 4
-	Event_EVENT_UPDATE_FRPC       Event = 5
-	Event_EVENT_REMOVE_FRPC       Event = 6
-	Event_EVENT_UPDATE_FRPS       Event = 7
-	Event_EVENT_REMOVE_FRPS       Event = 8
-	Event_EVENT_PING              Event = 9
-	Event_EVENT_PONG              Event = 10
-	Event_EVENT_STOP_FRPC         Event = 11
-	Event_EVENT_START_FRPC        Event = 12
-	Event_EVENT_STOP_FRPS         Event = 13
-	Event_EVENT_START_FRPS        Event = 14
-	Event_EVENT_START_STREAM_LOG  Event = 15
-	Event_EVENT_STOP_STREAM_LOG   Event = 16
-	Event_EVENT_START_PTY_CONNECT Event = 17
-	Event_EVENT_GET_PROXY_INFO    Event = 18
-	Event_EVENT_CREATE_WORKER     Event = 19
-	Event_EVENT_REMOVE_WORKER     Event = 20
-	Event_EVENT_GET_WORKER_STATUS Event = 21
-	Event_EVENT_INSTALL_WORKERD   Event = 22
+	Event_EVENT_UNSPECIFIED         Event = 0
+	Event_EVENT_REGISTER_CLIENT     Event = 1
+	Event_EVENT_REGISTER_SERVER     Event = 2
+	Event_EVENT_ERROR               Event = 3
+	Event_EVENT_DATA                Event = 4
+	Event_EVENT_UPDATE_FRPC         Event = 5
+	Event_EVENT_REMOVE_FRPC         Event = 6
+	Event_EVENT_UPDATE_FRPS         Event = 7
+	Event_EVENT_REMOVE_FRPS         Event = 8
+	Event_EVENT_PING                Event = 9
+	Event_EVENT_PONG                Event = 10
+	Event_EVENT_STOP_FRPC           Event = 11
+	Event_EVENT_START_FRPC          Event = 12
+	Event_EVENT_STOP_FRPS           Event = 13
+	Event_EVENT_START_FRPS          Event = 14
+	Event_EVENT_START_STREAM_LOG    Event = 15
+	Event_EVENT_STOP_STREAM_LOG     Event = 16
+	Event_EVENT_START_PTY_CONNECT   Event = 17
+	Event_EVENT_GET_PROXY_INFO      Event = 18
+	Event_EVENT_CREATE_WORKER       Event = 19
+	Event_EVENT_REMOVE_WORKER       Event = 20
+	Event_EVENT_GET_WORKER_STATUS   Event = 21
+	Event_EVENT_INSTALL_WORKERD     Event = 22
+	Event_EVENT_EXEC_COMMAND        Event = 23
+	Event_EVENT_START_FILE_TRANSFER Event = 24
+	Event_EVENT_LIST_DIR            Event = 25
+	Event_EVENT_QUERY_LOGS          Event = 26
 )
 
 // Enum value maps for Event.
@@ -75,31 +79,39 @@ var (
 		20: "EVENT_REMOVE_WORKER",
 		21: "EVENT_GET_WORKER_STATUS",
 		22: "EVENT_INSTALL_WORKERD",
+		23: "EVENT_EXEC_COMMAND",
+		24: "EVENT_START_FILE_TRANSFER",
+		25: "EVENT_LIST_DIR",
+		26: "EVENT_QUERY_LOGS",
 	}
 	Event_value = map[string]int32{
-		"EVENT_UNSPECIFIED":       0,
-		"EVENT_REGISTER_CLIENT":   1,
-		"EVENT_REGISTER_SERVER":   2,
-		"EVENT_ERROR":             3,
-		"EVENT_DATA":              4,
-		"EVENT_UPDATE_FRPC":       5,
-		"EVENT_REMOVE_FRPC":       6,
-		"EVENT_UPDATE_FRPS":       7,
-		"EVENT_REMOVE_FRPS":       8,
-		"EVENT_PING":              9,
-		"EVENT_PONG":              10,
-		"EVENT_STOP_FRPC":         11,
-		"EVENT_START_FRPC":        12,
-		"EVENT_STOP_FRPS":         13,
-		"EVENT_START_FRPS":        14,
-		"EVENT_START_STREAM_LOG":  15,
-		"EVENT_STOP_STREAM_LOG":   16,
-		"EVENT_START_PTY_CONNECT": 17,
-		"EVENT_GET_PROXY_INFO":    18,
-		"EVENT_CREATE_WORKER":     19,
-		"EVENT_REMOVE_WORKER":     20,
-		"EVENT_GET_WORKER_STATUS": 21,
-		"EVENT_INSTALL_WORKERD":   22,
+		"EVENT_UNSPECIFIED":         0,
+		"EVENT_REGISTER_CLIENT":     1,
+		"EVENT_REGISTER_SERVER":     2,
+		"EVENT_ERROR":               3,
+		"EVENT_DATA":                4,
+		"EVENT_UPDATE_FRPC":         5,
+		"EVENT_REMOVE_FRPC":         6,
+		"EVENT_UPDATE_FRPS":         7,
+		"EVENT_REMOVE_FRPS":         8,
+		"EVENT_PING":                9,
+		"EVENT_PONG":                10,
+		"EVENT_STOP_FRPC":           11,
+		"EVENT_START_FRPC":          12,
+		"EVENT_STOP_FRPS":           13,
+		"EVENT_START_FRPS":          14,
+		"EVENT_START_STREAM_LOG":    15,
+		"EVENT_STOP_STREAM_LOG":     16,
+		"EVENT_START_PTY_CONNECT":   17,
+		"EVENT_GET_PROXY_INFO":      18,
+		"EVENT_CREATE_WORKER":       19,
+		"EVENT_REMOVE_WORKER":       20,
+		"EVENT_GET_WORKER_STATUS":   21,
+		"EVENT_INSTALL_WORKERD":     22,
+		"EVENT_EXEC_COMMAND":        23,
+		"EVENT_START_FILE_TRANSFER": 24,
+		"EVENT_LIST_DIR":            25,
+		"EVENT_QUERY_LOGS":          26,
 	}
 )
 
@@ -240,6 +252,8 @@ type ServerMessage struct {
 	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	TraceId       string                 `protobuf:"bytes,5,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`                                                                                          // 发起请求的 trace id，client 打印日志时带上
+	TraceContext  map[string]string      `protobuf:"bytes,6,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // opentelemetry 的 trace 传播信息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -302,6 +316,20 @@ func (x *ServerMessage) GetData() []byte {
 	return nil
 }
 
+func (x *ServerMessage) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *ServerMessage) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
 type ClientMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Event         Event                  `protobuf:"varint,1,opt,name=event,proto3,enum=master.Event" json:"event,omitempty"`
@@ -781,6 +809,7 @@ func (x *PushProxyInfoResp) GetStatus() *Status {
 type PushServerStreamLogReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Log           []byte                 `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	Pkg           *string                `protobuf:"bytes,2,opt,name=pkg,proto3,oneof" json:"pkg,omitempty"` // 日志所属的包，master 按订阅者的 pkgs 过滤
 	Base          *ServerBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -823,6 +852,13 @@ func (x *PushServerStreamLogReq) GetLog() []byte {
 	return nil
 }
 
+func (x *PushServerStreamLogReq) GetPkg() string {
+	if x != nil && x.Pkg != nil {
+		return *x.Pkg
+	}
+	return ""
+}
+
 func (x *PushServerStreamLogReq) GetBase() *ServerBase {
 	if x != nil {
 		return x.Base
@@ -833,6 +869,7 @@ func (x *PushServerStreamLogReq) GetBase() *ServerBase {
 type PushClientStreamLogReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Log           []byte                 `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	Pkg           *string                `protobuf:"bytes,2,opt,name=pkg,proto3,oneof" json:"pkg,omitempty"` // 日志所属的包，master 按订阅者的 pkgs 过滤
 	Base          *ClientBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -875,6 +912,13 @@ func (x *PushClientStreamLogReq) GetLog() []byte {
 	return nil
 }
 
+func (x *PushClientStreamLogReq) GetPkg() string {
+	if x != nil && x.Pkg != nil {
+		return *x.Pkg
+	}
+	return ""
+}
+
 func (x *PushClientStreamLogReq) GetBase() *ClientBase {
 	if x != nil {
 		return x.Base
@@ -1108,6 +1152,214 @@ func (x *PTYServerMessage) GetDone() bool {
 	return false
 }
 
+// 下载时 client 发送文件内容，上传时 client 只在结束时回复结果
+type FileTransferClientMessage struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TransferId string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Data       []byte                 `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	Offset     int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // data 在文件中的偏移
+	Done       bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	Sha256     *string                `protobuf:"bytes,5,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"` // 完整文件的 sha256
+	Size       *int64                 `protobuf:"varint,6,opt,name=size,proto3,oneof" json:"size,omitempty"`    // 完整文件的大小
+	Error      *string                `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// Types that are valid to be assigned to Base:
+	//
+	//	*FileTransferClientMessage_ServerBase
+	//	*FileTransferClientMessage_ClientBase
+	Base          isFileTransferClientMessage_Base `protobuf_oneof:"Base"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileTransferClientMessage) Reset() {
+	*x = FileTransferClientMessage{}
+	mi := &file_rpc_master_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileTransferClientMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTransferClientMessage) ProtoMessage() {}
+
+func (x *FileTransferClientMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTransferClientMessage.ProtoReflect.Descriptor instead.
+func (*FileTransferClientMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FileTransferClientMessage) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *FileTransferClientMessage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FileTransferClientMessage) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FileTransferClientMessage) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *FileTransferClientMessage) GetSha256() string {
+	if x != nil && x.Sha256 != nil {
+		return *x.Sha256
+	}
+	return ""
+}
+
+func (x *FileTransferClientMessage) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *FileTransferClientMessage) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *FileTransferClientMessage) GetBase() isFileTransferClientMessage_Base {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *FileTransferClientMessage) GetServerBase() *ServerBase {
+	if x != nil {
+		if x, ok := x.Base.(*FileTransferClientMessage_ServerBase); ok {
+			return x.ServerBase
+		}
+	}
+	return nil
+}
+
+func (x *FileTransferClientMessage) GetClientBase() *ClientBase {
+	if x != nil {
+		if x, ok := x.Base.(*FileTransferClientMessage_ClientBase); ok {
+			return x.ClientBase
+		}
+	}
+	return nil
+}
+
+type isFileTransferClientMessage_Base interface {
+	isFileTransferClientMessage_Base()
+}
+
+type FileTransferClientMessage_ServerBase struct {
+	ServerBase *ServerBase `protobuf:"bytes,254,opt,name=server_base,json=serverBase,proto3,oneof"`
+}
+
+type FileTransferClientMessage_ClientBase struct {
+	ClientBase *ClientBase `protobuf:"bytes,255,opt,name=client_base,json=clientBase,proto3,oneof"`
+}
+
+func (*FileTransferClientMessage_ServerBase) isFileTransferClientMessage_Base() {}
+
+func (*FileTransferClientMessage_ClientBase) isFileTransferClientMessage_Base() {}
+
+// 上传时 master 发送文件内容
+type FileTransferServerMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Done          bool                   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	Error         *string                `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileTransferServerMessage) Reset() {
+	*x = FileTransferServerMessage{}
+	mi := &file_rpc_master_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileTransferServerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTransferServerMessage) ProtoMessage() {}
+
+func (x *FileTransferServerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTransferServerMessage.ProtoReflect.Descriptor instead.
+func (*FileTransferServerMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FileTransferServerMessage) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FileTransferServerMessage) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FileTransferServerMessage) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *FileTransferServerMessage) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
 type ListClientWorkersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *ClientBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
@@ -1117,7 +1369,7 @@ type ListClientWorkersRequest struct {
 
 func (x *ListClientWorkersRequest) Reset() {
 	*x = ListClientWorkersRequest{}
-	mi := &file_rpc_master_proto_msgTypes[17]
+	mi := &file_rpc_master_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1129,7 +1381,7 @@ func (x *ListClientWorkersRequest) String() string {
 func (*ListClientWorkersRequest) ProtoMessage() {}
 
 func (x *ListClientWorkersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[17]
+	mi := &file_rpc_master_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +1394,7 @@ func (x *ListClientWorkersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClientWorkersRequest.ProtoReflect.Descriptor instead.
 func (*ListClientWorkersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{17}
+	return file_rpc_master_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListClientWorkersRequest) GetBase() *ClientBase {
@@ -1162,7 +1414,7 @@ type ListClientWorkersResponse struct {
 
 func (x *ListClientWorkersResponse) Reset() {
 	*x = ListClientWorkersResponse{}
-	mi := &file_rpc_master_proto_msgTypes[18]
+	mi := &file_rpc_master_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1174,7 +1426,7 @@ func (x *ListClientWorkersResponse) String() string {
 func (*ListClientWorkersResponse) ProtoMessage() {}
 
 func (x *ListClientWorkersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_master_proto_msgTypes[18]
+	mi := &file_rpc_master_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,7 +1439,7 @@ func (x *ListClientWorkersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClientWorkersResponse.ProtoReflect.Descriptor instead.
 func (*ListClientWorkersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_master_proto_rawDescGZIP(), []int{18}
+	return file_rpc_master_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListClientWorkersResponse) GetStatus() *Status {
@@ -1204,102 +1456,915 @@ func (x *ListClientWorkersResponse) GetWorkers() []*Worker {
 	return nil
 }
 
-var File_rpc_master_proto protoreflect.FileDescriptor
+type PushWorkerCronInvocationsReq struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Base          *ClientBase             `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
+	Invocations   []*WorkerCronInvocation `protobuf:"bytes,1,rep,name=invocations,proto3" json:"invocations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_rpc_master_proto_rawDesc = "" +
-	"\n" +
-	"\x10rpc_master.proto\x12\x06master\x1a\fcommon.proto\"N\n" +
-	"\n" +
-	"ServerBase\x12\x1b\n" +
-	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12#\n" +
-	"\rserver_secret\x18\x02 \x01(\tR\fserverSecret\"N\n" +
-	"\n" +
-	"ClientBase\x12\x1b\n" +
-	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
-	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"\x84\x01\n" +
-	"\rServerMessage\x12#\n" +
-	"\x05event\x18\x01 \x01(\x0e2\r.master.EventR\x05event\x12\x1b\n" +
-	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x12\n" +
-	"\x04data\x18\x04 \x01(\fR\x04data\"\x9c\x01\n" +
-	"\rClientMessage\x12#\n" +
-	"\x05event\x18\x01 \x01(\x0e2\r.master.EventR\x05event\x12\x1b\n" +
-	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x16\n" +
-	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x12\n" +
-	"\x04data\x18\x05 \x01(\fR\x04data\">\n" +
-	"\x13PullClientConfigReq\x12'\n" +
-	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\"f\n" +
-	"\x14PullClientConfigResp\x12&\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12&\n" +
-	"\x06client\x18\x02 \x01(\v2\x0e.common.ClientR\x06client\">\n" +
-	"\x13PullServerConfigReq\x12'\n" +
-	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\"f\n" +
-	"\x14PullServerConfigResp\x12&\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12&\n" +
-	"\x06server\x18\x02 \x01(\v2\x0e.common.ServerR\x06server\"c\n" +
-	"\x0eFRPAuthRequest\x12\x12\n" +
-	"\x04user\x18\x01 \x01(\tR\x04user\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\x12'\n" +
-	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\"I\n" +
-	"\x0fFRPAuthResponse\x12&\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12\x0e\n" +
-	"\x02ok\x18\x02 \x01(\bR\x02ok\"o\n" +
-	"\x10PushProxyInfoReq\x12'\n" +
-	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\x122\n" +
-	"\vproxy_infos\x18\x01 \x03(\v2\x11.common.ProxyInfoR\n" +
-	"proxyInfos\";\n" +
-	"\x11PushProxyInfoResp\x12&\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\"S\n" +
-	"\x16PushServerStreamLogReq\x12\x10\n" +
-	"\x03log\x18\x01 \x01(\fR\x03log\x12'\n" +
-	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\"S\n" +
-	"\x16PushClientStreamLogReq\x12\x10\n" +
-	"\x03log\x18\x01 \x01(\fR\x03log\x12'\n" +
-	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\"K\n" +
-	"\x11PushStreamLogResp\x12&\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12\x0e\n" +
-	"\x02ok\x18\x02 \x01(\bR\x02ok\"\xdf\x01\n" +
-	"\x10PTYClientMessage\x12\x17\n" +
-	"\x04data\x18\x01 \x01(\fH\x01R\x04data\x88\x01\x01\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
-	"\x04done\x18\x03 \x01(\bR\x04done\x126\n" +
-	"\vserver_base\x18\xfe\x01 \x01(\v2\x12.master.ServerBaseH\x00R\n" +
-	"serverBase\x126\n" +
-	"\vclient_base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseH\x00R\n" +
-	"clientBaseB\x06\n" +
-	"\x04BaseB\a\n" +
-	"\x05_data\"\x95\x01\n" +
-	"\x10PTYServerMessage\x12\x17\n" +
-	"\x04data\x18\x01 \x01(\fH\x00R\x04data\x88\x01\x01\x12\x1b\n" +
-	"\x06height\x18\x02 \x01(\x05H\x01R\x06height\x88\x01\x01\x12\x19\n" +
-	"\x05width\x18\x03 \x01(\x05H\x02R\x05width\x88\x01\x01\x12\x12\n" +
-	"\x04done\x18\x04 \x01(\bR\x04doneB\a\n" +
-	"\x05_dataB\t\n" +
-	"\a_heightB\b\n" +
-	"\x06_width\"C\n" +
-	"\x18ListClientWorkersRequest\x12'\n" +
-	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\"m\n" +
-	"\x19ListClientWorkersResponse\x12&\n" +
-	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12(\n" +
-	"\aworkers\x18\x02 \x03(\v2\x0e.common.WorkerR\aworkers*\x9f\x04\n" +
-	"\x05Event\x12\x15\n" +
-	"\x11EVENT_UNSPECIFIED\x10\x00\x12\x19\n" +
-	"\x15EVENT_REGISTER_CLIENT\x10\x01\x12\x19\n" +
-	"\x15EVENT_REGISTER_SERVER\x10\x02\x12\x0f\n" +
-	"\vEVENT_ERROR\x10\x03\x12\x0e\n" +
-	"\n" +
-	"EVENT_DATA\x10\x04\x12\x15\n" +
-	"\x11EVENT_UPDATE_FRPC\x10\x05\x12\x15\n" +
-	"\x11EVENT_REMOVE_FRPC\x10\x06\x12\x15\n" +
-	"\x11EVENT_UPDATE_FRPS\x10\a\x12\x15\n" +
-	"\x11EVENT_REMOVE_FRPS\x10\b\x12\x0e\n" +
-	"\n" +
-	"EVENT_PING\x10\t\x12\x0e\n" +
-	"\n" +
+func (x *PushWorkerCronInvocationsReq) Reset() {
+	*x = PushWorkerCronInvocationsReq{}
+	mi := &file_rpc_master_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushWorkerCronInvocationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWorkerCronInvocationsReq) ProtoMessage() {}
+
+func (x *PushWorkerCronInvocationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWorkerCronInvocationsReq.ProtoReflect.Descriptor instead.
+func (*PushWorkerCronInvocationsReq) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PushWorkerCronInvocationsReq) GetBase() *ClientBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PushWorkerCronInvocationsReq) GetInvocations() []*WorkerCronInvocation {
+	if x != nil {
+		return x.Invocations
+	}
+	return nil
+}
+
+type PushWorkerCronInvocationsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushWorkerCronInvocationsResp) Reset() {
+	*x = PushWorkerCronInvocationsResp{}
+	mi := &file_rpc_master_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushWorkerCronInvocationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWorkerCronInvocationsResp) ProtoMessage() {}
+
+func (x *PushWorkerCronInvocationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWorkerCronInvocationsResp.ProtoReflect.Descriptor instead.
+func (*PushWorkerCronInvocationsResp) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PushWorkerCronInvocationsResp) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PushWorkerKVReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *ClientBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
+	WorkerId      string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Binding       string                 `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
+	Entries       []*WorkerKVEntry       `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"` // namespace 的完整内容，以及上次同步后删除的 key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushWorkerKVReq) Reset() {
+	*x = PushWorkerKVReq{}
+	mi := &file_rpc_master_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushWorkerKVReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWorkerKVReq) ProtoMessage() {}
+
+func (x *PushWorkerKVReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWorkerKVReq.ProtoReflect.Descriptor instead.
+func (*PushWorkerKVReq) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PushWorkerKVReq) GetBase() *ClientBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PushWorkerKVReq) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *PushWorkerKVReq) GetBinding() string {
+	if x != nil {
+		return x.Binding
+	}
+	return ""
+}
+
+func (x *PushWorkerKVReq) GetEntries() []*WorkerKVEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PushWorkerKVResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Entries       []*WorkerKVEntry       `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"` // master 上比 client 新的 key，client 需要写入或删除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushWorkerKVResp) Reset() {
+	*x = PushWorkerKVResp{}
+	mi := &file_rpc_master_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushWorkerKVResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWorkerKVResp) ProtoMessage() {}
+
+func (x *PushWorkerKVResp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWorkerKVResp.ProtoReflect.Descriptor instead.
+func (*PushWorkerKVResp) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PushWorkerKVResp) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PushWorkerKVResp) GetEntries() []*WorkerKVEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PullWorkerKVReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *ClientBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
+	WorkerId      string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Binding       string                 `protobuf:"bytes,2,opt,name=binding,proto3" json:"binding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullWorkerKVReq) Reset() {
+	*x = PullWorkerKVReq{}
+	mi := &file_rpc_master_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullWorkerKVReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullWorkerKVReq) ProtoMessage() {}
+
+func (x *PullWorkerKVReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullWorkerKVReq.ProtoReflect.Descriptor instead.
+func (*PullWorkerKVReq) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PullWorkerKVReq) GetBase() *ClientBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PullWorkerKVReq) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *PullWorkerKVReq) GetBinding() string {
+	if x != nil {
+		return x.Binding
+	}
+	return ""
+}
+
+type PullWorkerKVResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Entries       []*WorkerKVEntry       `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullWorkerKVResp) Reset() {
+	*x = PullWorkerKVResp{}
+	mi := &file_rpc_master_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullWorkerKVResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullWorkerKVResp) ProtoMessage() {}
+
+func (x *PullWorkerKVResp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullWorkerKVResp.ProtoReflect.Descriptor instead.
+func (*PullWorkerKVResp) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PullWorkerKVResp) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PullWorkerKVResp) GetEntries() []*WorkerKVEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PushWorkerStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *ClientBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
+	WorkerId      string                 `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	RestartCount  int32                  `protobuf:"varint,3,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"` // 连续快速退出的次数
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                                    // 最近一次退出的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushWorkerStatusReq) Reset() {
+	*x = PushWorkerStatusReq{}
+	mi := &file_rpc_master_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushWorkerStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWorkerStatusReq) ProtoMessage() {}
+
+func (x *PushWorkerStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWorkerStatusReq.ProtoReflect.Descriptor instead.
+func (*PushWorkerStatusReq) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PushWorkerStatusReq) GetBase() *ClientBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PushWorkerStatusReq) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *PushWorkerStatusReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PushWorkerStatusReq) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *PushWorkerStatusReq) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PushWorkerStatusResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushWorkerStatusResp) Reset() {
+	*x = PushWorkerStatusResp{}
+	mi := &file_rpc_master_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushWorkerStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushWorkerStatusResp) ProtoMessage() {}
+
+func (x *PushWorkerStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushWorkerStatusResp.ProtoReflect.Descriptor instead.
+func (*PushWorkerStatusResp) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PushWorkerStatusResp) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PushProxyStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *ClientBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
+	Statuses      []*ProxyWorkingStatus  `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"` // client 上所有 proxy 的状态，name 不带用户名前缀
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushProxyStatusReq) Reset() {
+	*x = PushProxyStatusReq{}
+	mi := &file_rpc_master_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushProxyStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushProxyStatusReq) ProtoMessage() {}
+
+func (x *PushProxyStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushProxyStatusReq.ProtoReflect.Descriptor instead.
+func (*PushProxyStatusReq) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PushProxyStatusReq) GetBase() *ClientBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PushProxyStatusReq) GetStatuses() []*ProxyWorkingStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type PushProxyStatusResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushProxyStatusResp) Reset() {
+	*x = PushProxyStatusResp{}
+	mi := &file_rpc_master_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushProxyStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushProxyStatusResp) ProtoMessage() {}
+
+func (x *PushProxyStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushProxyStatusResp.ProtoReflect.Descriptor instead.
+func (*PushProxyStatusResp) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PushProxyStatusResp) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PullProxyProbesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *ServerBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullProxyProbesReq) Reset() {
+	*x = PullProxyProbesReq{}
+	mi := &file_rpc_master_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullProxyProbesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullProxyProbesReq) ProtoMessage() {}
+
+func (x *PullProxyProbesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullProxyProbesReq.ProtoReflect.Descriptor instead.
+func (*PullProxyProbesReq) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PullProxyProbesReq) GetBase() *ServerBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type PullProxyProbesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Probes        []*ProxyProbe          `protobuf:"bytes,2,rep,name=probes,proto3" json:"probes,omitempty"` // target 已由 master 推导
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullProxyProbesResp) Reset() {
+	*x = PullProxyProbesResp{}
+	mi := &file_rpc_master_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullProxyProbesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullProxyProbesResp) ProtoMessage() {}
+
+func (x *PullProxyProbesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullProxyProbesResp.ProtoReflect.Descriptor instead.
+func (*PullProxyProbesResp) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PullProxyProbesResp) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PullProxyProbesResp) GetProbes() []*ProxyProbe {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+type PushProxyProbeResultsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *ServerBase            `protobuf:"bytes,255,opt,name=base,proto3" json:"base,omitempty"`
+	Results       []*ProxyProbeResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushProxyProbeResultsReq) Reset() {
+	*x = PushProxyProbeResultsReq{}
+	mi := &file_rpc_master_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushProxyProbeResultsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushProxyProbeResultsReq) ProtoMessage() {}
+
+func (x *PushProxyProbeResultsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushProxyProbeResultsReq.ProtoReflect.Descriptor instead.
+func (*PushProxyProbeResultsReq) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PushProxyProbeResultsReq) GetBase() *ServerBase {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PushProxyProbeResultsReq) GetResults() []*ProxyProbeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PushProxyProbeResultsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *Status                `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushProxyProbeResultsResp) Reset() {
+	*x = PushProxyProbeResultsResp{}
+	mi := &file_rpc_master_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushProxyProbeResultsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushProxyProbeResultsResp) ProtoMessage() {}
+
+func (x *PushProxyProbeResultsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_master_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushProxyProbeResultsResp.ProtoReflect.Descriptor instead.
+func (*PushProxyProbeResultsResp) Descriptor() ([]byte, []int) {
+	return file_rpc_master_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PushProxyProbeResultsResp) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+var File_rpc_master_proto protoreflect.FileDescriptor
+
+const file_rpc_master_proto_rawDesc = "" +
+	"\n" +
+	"\x10rpc_master.proto\x12\x06master\x1a\fcommon.proto\"N\n" +
+	"\n" +
+	"ServerBase\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12#\n" +
+	"\rserver_secret\x18\x02 \x01(\tR\fserverSecret\"N\n" +
+	"\n" +
+	"ClientBase\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"\xae\x02\n" +
+	"\rServerMessage\x12#\n" +
+	"\x05event\x18\x01 \x01(\x0e2\r.master.EventR\x05event\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x19\n" +
+	"\btrace_id\x18\x05 \x01(\tR\atraceId\x12L\n" +
+	"\rtrace_context\x18\x06 \x03(\v2'.master.ServerMessage.TraceContextEntryR\ftraceContext\x1a?\n" +
+	"\x11TraceContextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9c\x01\n" +
+	"\rClientMessage\x12#\n" +
+	"\x05event\x18\x01 \x01(\x0e2\r.master.EventR\x05event\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\">\n" +
+	"\x13PullClientConfigReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\"f\n" +
+	"\x14PullClientConfigResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12&\n" +
+	"\x06client\x18\x02 \x01(\v2\x0e.common.ClientR\x06client\">\n" +
+	"\x13PullServerConfigReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\"f\n" +
+	"\x14PullServerConfigResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12&\n" +
+	"\x06server\x18\x02 \x01(\v2\x0e.common.ServerR\x06server\"c\n" +
+	"\x0eFRPAuthRequest\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\"I\n" +
+	"\x0fFRPAuthResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\"o\n" +
+	"\x10PushProxyInfoReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\x122\n" +
+	"\vproxy_infos\x18\x01 \x03(\v2\x11.common.ProxyInfoR\n" +
+	"proxyInfos\";\n" +
+	"\x11PushProxyInfoResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\"r\n" +
+	"\x16PushServerStreamLogReq\x12\x10\n" +
+	"\x03log\x18\x01 \x01(\fR\x03log\x12\x15\n" +
+	"\x03pkg\x18\x02 \x01(\tH\x00R\x03pkg\x88\x01\x01\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04baseB\x06\n" +
+	"\x04_pkg\"r\n" +
+	"\x16PushClientStreamLogReq\x12\x10\n" +
+	"\x03log\x18\x01 \x01(\fR\x03log\x12\x15\n" +
+	"\x03pkg\x18\x02 \x01(\tH\x00R\x03pkg\x88\x01\x01\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04baseB\x06\n" +
+	"\x04_pkg\"K\n" +
+	"\x11PushStreamLogResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\"\xdf\x01\n" +
+	"\x10PTYClientMessage\x12\x17\n" +
+	"\x04data\x18\x01 \x01(\fH\x01R\x04data\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\x126\n" +
+	"\vserver_base\x18\xfe\x01 \x01(\v2\x12.master.ServerBaseH\x00R\n" +
+	"serverBase\x126\n" +
+	"\vclient_base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseH\x00R\n" +
+	"clientBaseB\x06\n" +
+	"\x04BaseB\a\n" +
+	"\x05_data\"\x95\x01\n" +
+	"\x10PTYServerMessage\x12\x17\n" +
+	"\x04data\x18\x01 \x01(\fH\x00R\x04data\x88\x01\x01\x12\x1b\n" +
+	"\x06height\x18\x02 \x01(\x05H\x01R\x06height\x88\x01\x01\x12\x19\n" +
+	"\x05width\x18\x03 \x01(\x05H\x02R\x05width\x88\x01\x01\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04doneB\a\n" +
+	"\x05_dataB\t\n" +
+	"\a_heightB\b\n" +
+	"\x06_width\"\xf1\x02\n" +
+	"\x19FileTransferClientMessage\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12\x17\n" +
+	"\x04data\x18\x02 \x01(\fH\x01R\x04data\x88\x01\x01\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\x12\x1b\n" +
+	"\x06sha256\x18\x05 \x01(\tH\x02R\x06sha256\x88\x01\x01\x12\x17\n" +
+	"\x04size\x18\x06 \x01(\x03H\x03R\x04size\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\a \x01(\tH\x04R\x05error\x88\x01\x01\x126\n" +
+	"\vserver_base\x18\xfe\x01 \x01(\v2\x12.master.ServerBaseH\x00R\n" +
+	"serverBase\x126\n" +
+	"\vclient_base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseH\x00R\n" +
+	"clientBaseB\x06\n" +
+	"\x04BaseB\a\n" +
+	"\x05_dataB\t\n" +
+	"\a_sha256B\a\n" +
+	"\x05_sizeB\b\n" +
+	"\x06_error\"\x8e\x01\n" +
+	"\x19FileTransferServerMessage\x12\x17\n" +
+	"\x04data\x18\x01 \x01(\fH\x00R\x04data\x88\x01\x01\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\x12\x19\n" +
+	"\x05error\x18\x04 \x01(\tH\x01R\x05error\x88\x01\x01B\a\n" +
+	"\x05_dataB\b\n" +
+	"\x06_error\"C\n" +
+	"\x18ListClientWorkersRequest\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\"m\n" +
+	"\x19ListClientWorkersResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12(\n" +
+	"\aworkers\x18\x02 \x03(\v2\x0e.common.WorkerR\aworkers\"\x87\x01\n" +
+	"\x1cPushWorkerCronInvocationsReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\x12>\n" +
+	"\vinvocations\x18\x01 \x03(\v2\x1c.common.WorkerCronInvocationR\vinvocations\"G\n" +
+	"\x1dPushWorkerCronInvocationsResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\"\xa2\x01\n" +
+	"\x0fPushWorkerKVReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\x12\x1b\n" +
+	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x18\n" +
+	"\abinding\x18\x02 \x01(\tR\abinding\x12/\n" +
+	"\aentries\x18\x03 \x03(\v2\x15.common.WorkerKVEntryR\aentries\"k\n" +
+	"\x10PushWorkerKVResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12/\n" +
+	"\aentries\x18\x02 \x03(\v2\x15.common.WorkerKVEntryR\aentries\"q\n" +
+	"\x0fPullWorkerKVReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\x12\x1b\n" +
+	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x18\n" +
+	"\abinding\x18\x02 \x01(\tR\abinding\"k\n" +
+	"\x10PullWorkerKVResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12/\n" +
+	"\aentries\x18\x02 \x03(\v2\x15.common.WorkerKVEntryR\aentries\"\xae\x01\n" +
+	"\x13PushWorkerStatusReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\x12\x1b\n" +
+	"\tworker_id\x18\x01 \x01(\tR\bworkerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rrestart_count\x18\x03 \x01(\x05R\frestartCount\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\">\n" +
+	"\x14PushWorkerStatusResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\"u\n" +
+	"\x12PushProxyStatusReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ClientBaseR\x04base\x126\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x1a.common.ProxyWorkingStatusR\bstatuses\"=\n" +
+	"\x13PushProxyStatusResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\"=\n" +
+	"\x12PullProxyProbesReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\"i\n" +
+	"\x13PullProxyProbesResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status\x12*\n" +
+	"\x06probes\x18\x02 \x03(\v2\x12.common.ProxyProbeR\x06probes\"w\n" +
+	"\x18PushProxyProbeResultsReq\x12'\n" +
+	"\x04base\x18\xff\x01 \x01(\v2\x12.master.ServerBaseR\x04base\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.common.ProxyProbeResultR\aresults\"C\n" +
+	"\x19PushProxyProbeResultsResp\x12&\n" +
+	"\x06status\x18\x01 \x01(\v2\x0e.common.StatusR\x06status*\x80\x05\n" +
+	"\x05Event\x12\x15\n" +
+	"\x11EVENT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15EVENT_REGISTER_CLIENT\x10\x01\x12\x19\n" +
+	"\x15EVENT_REGISTER_SERVER\x10\x02\x12\x0f\n" +
+	"\vEVENT_ERROR\x10\x03\x12\x0e\n" +
+	"\n" +
+	"EVENT_DATA\x10\x04\x12\x15\n" +
+	"\x11EVENT_UPDATE_FRPC\x10\x05\x12\x15\n" +
+	"\x11EVENT_REMOVE_FRPC\x10\x06\x12\x15\n" +
+	"\x11EVENT_UPDATE_FRPS\x10\a\x12\x15\n" +
+	"\x11EVENT_REMOVE_FRPS\x10\b\x12\x0e\n" +
+	"\n" +
+	"EVENT_PING\x10\t\x12\x0e\n" +
+	"\n" +
 	"EVENT_PONG\x10\n" +
 	"\x12\x13\n" +
 	"\x0fEVENT_STOP_FRPC\x10\v\x12\x14\n" +
@@ -1313,7 +2378,12 @@ const file_rpc_master_proto_rawDesc = "" +
 	"\x13EVENT_CREATE_WORKER\x10\x13\x12\x17\n" +
 	"\x13EVENT_REMOVE_WORKER\x10\x14\x12\x1b\n" +
 	"\x17EVENT_GET_WORKER_STATUS\x10\x15\x12\x19\n" +
-	"\x15EVENT_INSTALL_WORKERD\x10\x162\xb1\x05\n" +
+	"\x15EVENT_INSTALL_WORKERD\x10\x16\x12\x16\n" +
+	"\x12EVENT_EXEC_COMMAND\x10\x17\x12\x1d\n" +
+	"\x19EVENT_START_FILE_TRANSFER\x10\x18\x12\x12\n" +
+	"\x0eEVENT_LIST_DIR\x10\x19\x12\x14\n" +
+	"\x10EVENT_QUERY_LOGS\x10\x1a2\xc0\n" +
+	"\n" +
 	"\x06Master\x12>\n" +
 	"\n" +
 	"ServerSend\x12\x15.master.ClientMessage\x1a\x15.master.ServerMessage(\x010\x01\x12M\n" +
@@ -1325,7 +2395,15 @@ const file_rpc_master_proto_rawDesc = "" +
 	"\x13PushClientStreamLog\x12\x1e.master.PushClientStreamLogReq\x1a\x19.master.PushStreamLogResp(\x01\x12R\n" +
 	"\x13PushServerStreamLog\x12\x1e.master.PushServerStreamLogReq\x1a\x19.master.PushStreamLogResp(\x01\x12D\n" +
 	"\n" +
-	"PTYConnect\x12\x18.master.PTYClientMessage\x1a\x18.master.PTYServerMessage(\x010\x01B\aZ\x05../pbb\x06proto3"
+	"PTYConnect\x12\x18.master.PTYClientMessage\x1a\x18.master.PTYServerMessage(\x010\x01\x12X\n" +
+	"\fFileTransfer\x12!.master.FileTransferClientMessage\x1a!.master.FileTransferServerMessage(\x010\x01\x12h\n" +
+	"\x19PushWorkerCronInvocations\x12$.master.PushWorkerCronInvocationsReq\x1a%.master.PushWorkerCronInvocationsResp\x12A\n" +
+	"\fPushWorkerKV\x12\x17.master.PushWorkerKVReq\x1a\x18.master.PushWorkerKVResp\x12A\n" +
+	"\fPullWorkerKV\x12\x17.master.PullWorkerKVReq\x1a\x18.master.PullWorkerKVResp\x12M\n" +
+	"\x10PushWorkerStatus\x12\x1b.master.PushWorkerStatusReq\x1a\x1c.master.PushWorkerStatusResp\x12J\n" +
+	"\x0fPushProxyStatus\x12\x1a.master.PushProxyStatusReq\x1a\x1b.master.PushProxyStatusResp\x12J\n" +
+	"\x0fPullProxyProbes\x12\x1a.master.PullProxyProbesReq\x1a\x1b.master.PullProxyProbesResp\x12\\\n" +
+	"\x15PushProxyProbeResults\x12 .master.PushProxyProbeResultsReq\x1a!.master.PushProxyProbeResultsRespB\aZ\x05../pbb\x06proto3"
 
 var (
 	file_rpc_master_proto_rawDescOnce sync.Once
@@ -1340,79 +2418,141 @@ func file_rpc_master_proto_rawDescGZIP() []byte {
 }
 
 var file_rpc_master_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rpc_master_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_rpc_master_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_rpc_master_proto_goTypes = []any{
-	(Event)(0),                        // 0: master.Event
-	(*ServerBase)(nil),                // 1: master.ServerBase
-	(*ClientBase)(nil),                // 2: master.ClientBase
-	(*ServerMessage)(nil),             // 3: master.ServerMessage
-	(*ClientMessage)(nil),             // 4: master.ClientMessage
-	(*PullClientConfigReq)(nil),       // 5: master.PullClientConfigReq
-	(*PullClientConfigResp)(nil),      // 6: master.PullClientConfigResp
-	(*PullServerConfigReq)(nil),       // 7: master.PullServerConfigReq
-	(*PullServerConfigResp)(nil),      // 8: master.PullServerConfigResp
-	(*FRPAuthRequest)(nil),            // 9: master.FRPAuthRequest
-	(*FRPAuthResponse)(nil),           // 10: master.FRPAuthResponse
-	(*PushProxyInfoReq)(nil),          // 11: master.PushProxyInfoReq
-	(*PushProxyInfoResp)(nil),         // 12: master.PushProxyInfoResp
-	(*PushServerStreamLogReq)(nil),    // 13: master.PushServerStreamLogReq
-	(*PushClientStreamLogReq)(nil),    // 14: master.PushClientStreamLogReq
-	(*PushStreamLogResp)(nil),         // 15: master.PushStreamLogResp
-	(*PTYClientMessage)(nil),          // 16: master.PTYClientMessage
-	(*PTYServerMessage)(nil),          // 17: master.PTYServerMessage
-	(*ListClientWorkersRequest)(nil),  // 18: master.ListClientWorkersRequest
-	(*ListClientWorkersResponse)(nil), // 19: master.ListClientWorkersResponse
-	(*Status)(nil),                    // 20: common.Status
-	(*Client)(nil),                    // 21: common.Client
-	(*Server)(nil),                    // 22: common.Server
-	(*ProxyInfo)(nil),                 // 23: common.ProxyInfo
-	(*Worker)(nil),                    // 24: common.Worker
+	(Event)(0),                            // 0: master.Event
+	(*ServerBase)(nil),                    // 1: master.ServerBase
+	(*ClientBase)(nil),                    // 2: master.ClientBase
+	(*ServerMessage)(nil),                 // 3: master.ServerMessage
+	(*ClientMessage)(nil),                 // 4: master.ClientMessage
+	(*PullClientConfigReq)(nil),           // 5: master.PullClientConfigReq
+	(*PullClientConfigResp)(nil),          // 6: master.PullClientConfigResp
+	(*PullServerConfigReq)(nil),           // 7: master.PullServerConfigReq
+	(*PullServerConfigResp)(nil),          // 8: master.PullServerConfigResp
+	(*FRPAuthRequest)(nil),                // 9: master.FRPAuthRequest
+	(*FRPAuthResponse)(nil),               // 10: master.FRPAuthResponse
+	(*PushProxyInfoReq)(nil),              // 11: master.PushProxyInfoReq
+	(*PushProxyInfoResp)(nil),             // 12: master.PushProxyInfoResp
+	(*PushServerStreamLogReq)(nil),        // 13: master.PushServerStreamLogReq
+	(*PushClientStreamLogReq)(nil),        // 14: master.PushClientStreamLogReq
+	(*PushStreamLogResp)(nil),             // 15: master.PushStreamLogResp
+	(*PTYClientMessage)(nil),              // 16: master.PTYClientMessage
+	(*PTYServerMessage)(nil),              // 17: master.PTYServerMessage
+	(*FileTransferClientMessage)(nil),     // 18: master.FileTransferClientMessage
+	(*FileTransferServerMessage)(nil),     // 19: master.FileTransferServerMessage
+	(*ListClientWorkersRequest)(nil),      // 20: master.ListClientWorkersRequest
+	(*ListClientWorkersResponse)(nil),     // 21: master.ListClientWorkersResponse
+	(*PushWorkerCronInvocationsReq)(nil),  // 22: master.PushWorkerCronInvocationsReq
+	(*PushWorkerCronInvocationsResp)(nil), // 23: master.PushWorkerCronInvocationsResp
+	(*PushWorkerKVReq)(nil),               // 24: master.PushWorkerKVReq
+	(*PushWorkerKVResp)(nil),              // 25: master.PushWorkerKVResp
+	(*PullWorkerKVReq)(nil),               // 26: master.PullWorkerKVReq
+	(*PullWorkerKVResp)(nil),              // 27: master.PullWorkerKVResp
+	(*PushWorkerStatusReq)(nil),           // 28: master.PushWorkerStatusReq
+	(*PushWorkerStatusResp)(nil),          // 29: master.PushWorkerStatusResp
+	(*PushProxyStatusReq)(nil),            // 30: master.PushProxyStatusReq
+	(*PushProxyStatusResp)(nil),           // 31: master.PushProxyStatusResp
+	(*PullProxyProbesReq)(nil),            // 32: master.PullProxyProbesReq
+	(*PullProxyProbesResp)(nil),           // 33: master.PullProxyProbesResp
+	(*PushProxyProbeResultsReq)(nil),      // 34: master.PushProxyProbeResultsReq
+	(*PushProxyProbeResultsResp)(nil),     // 35: master.PushProxyProbeResultsResp
+	nil,                                   // 36: master.ServerMessage.TraceContextEntry
+	(*Status)(nil),                        // 37: common.Status
+	(*Client)(nil),                        // 38: common.Client
+	(*Server)(nil),                        // 39: common.Server
+	(*ProxyInfo)(nil),                     // 40: common.ProxyInfo
+	(*Worker)(nil),                        // 41: common.Worker
+	(*WorkerCronInvocation)(nil),          // 42: common.WorkerCronInvocation
+	(*WorkerKVEntry)(nil),                 // 43: common.WorkerKVEntry
+	(*ProxyWorkingStatus)(nil),            // 44: common.ProxyWorkingStatus
+	(*ProxyProbe)(nil),                    // 45: common.ProxyProbe
+	(*ProxyProbeResult)(nil),              // 46: common.ProxyProbeResult
 }
 var file_rpc_master_proto_depIdxs = []int32{
 	0,  // 0: master.ServerMessage.event:type_name -> master.Event
-	0,  // 1: master.ClientMessage.event:type_name -> master.Event
-	2,  // 2: master.PullClientConfigReq.base:type_name -> master.ClientBase
-	20, // 3: master.PullClientConfigResp.status:type_name -> common.Status
-	21, // 4: master.PullClientConfigResp.client:type_name -> common.Client
-	1,  // 5: master.PullServerConfigReq.base:type_name -> master.ServerBase
-	20, // 6: master.PullServerConfigResp.status:type_name -> common.Status
-	22, // 7: master.PullServerConfigResp.server:type_name -> common.Server
-	1,  // 8: master.FRPAuthRequest.base:type_name -> master.ServerBase
-	20, // 9: master.FRPAuthResponse.status:type_name -> common.Status
-	1,  // 10: master.PushProxyInfoReq.base:type_name -> master.ServerBase
-	23, // 11: master.PushProxyInfoReq.proxy_infos:type_name -> common.ProxyInfo
-	20, // 12: master.PushProxyInfoResp.status:type_name -> common.Status
-	1,  // 13: master.PushServerStreamLogReq.base:type_name -> master.ServerBase
-	2,  // 14: master.PushClientStreamLogReq.base:type_name -> master.ClientBase
-	20, // 15: master.PushStreamLogResp.status:type_name -> common.Status
-	1,  // 16: master.PTYClientMessage.server_base:type_name -> master.ServerBase
-	2,  // 17: master.PTYClientMessage.client_base:type_name -> master.ClientBase
-	2,  // 18: master.ListClientWorkersRequest.base:type_name -> master.ClientBase
-	20, // 19: master.ListClientWorkersResponse.status:type_name -> common.Status
-	24, // 20: master.ListClientWorkersResponse.workers:type_name -> common.Worker
-	4,  // 21: master.Master.ServerSend:input_type -> master.ClientMessage
-	5,  // 22: master.Master.PullClientConfig:input_type -> master.PullClientConfigReq
-	7,  // 23: master.Master.PullServerConfig:input_type -> master.PullServerConfigReq
-	18, // 24: master.Master.ListClientWorkers:input_type -> master.ListClientWorkersRequest
-	9,  // 25: master.Master.FRPCAuth:input_type -> master.FRPAuthRequest
-	11, // 26: master.Master.PushProxyInfo:input_type -> master.PushProxyInfoReq
-	14, // 27: master.Master.PushClientStreamLog:input_type -> master.PushClientStreamLogReq
-	13, // 28: master.Master.PushServerStreamLog:input_type -> master.PushServerStreamLogReq
-	16, // 29: master.Master.PTYConnect:input_type -> master.PTYClientMessage
-	3,  // 30: master.Master.ServerSend:output_type -> master.ServerMessage
-	6,  // 31: master.Master.PullClientConfig:output_type -> master.PullClientConfigResp
-	8,  // 32: master.Master.PullServerConfig:output_type -> master.PullServerConfigResp
-	19, // 33: master.Master.ListClientWorkers:output_type -> master.ListClientWorkersResponse
-	10, // 34: master.Master.FRPCAuth:output_type -> master.FRPAuthResponse
-	12, // 35: master.Master.PushProxyInfo:output_type -> master.PushProxyInfoResp
-	15, // 36: master.Master.PushClientStreamLog:output_type -> master.PushStreamLogResp
-	15, // 37: master.Master.PushServerStreamLog:output_type -> master.PushStreamLogResp
-	17, // 38: master.Master.PTYConnect:output_type -> master.PTYServerMessage
-	30, // [30:39] is the sub-list for method output_type
-	21, // [21:30] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	36, // 1: master.ServerMessage.trace_context:type_name -> master.ServerMessage.TraceContextEntry
+	0,  // 2: master.ClientMessage.event:type_name -> master.Event
+	2,  // 3: master.PullClientConfigReq.base:type_name -> master.ClientBase
+	37, // 4: master.PullClientConfigResp.status:type_name -> common.Status
+	38, // 5: master.PullClientConfigResp.client:type_name -> common.Client
+	1,  // 6: master.PullServerConfigReq.base:type_name -> master.ServerBase
+	37, // 7: master.PullServerConfigResp.status:type_name -> common.Status
+	39, // 8: master.PullServerConfigResp.server:type_name -> common.Server
+	1,  // 9: master.FRPAuthRequest.base:type_name -> master.ServerBase
+	37, // 10: master.FRPAuthResponse.status:type_name -> common.Status
+	1,  // 11: master.PushProxyInfoReq.base:type_name -> master.ServerBase
+	40, // 12: master.PushProxyInfoReq.proxy_infos:type_name -> common.ProxyInfo
+	37, // 13: master.PushProxyInfoResp.status:type_name -> common.Status
+	1,  // 14: master.PushServerStreamLogReq.base:type_name -> master.ServerBase
+	2,  // 15: master.PushClientStreamLogReq.base:type_name -> master.ClientBase
+	37, // 16: master.PushStreamLogResp.status:type_name -> common.Status
+	1,  // 17: master.PTYClientMessage.server_base:type_name -> master.ServerBase
+	2,  // 18: master.PTYClientMessage.client_base:type_name -> master.ClientBase
+	1,  // 19: master.FileTransferClientMessage.server_base:type_name -> master.ServerBase
+	2,  // 20: master.FileTransferClientMessage.client_base:type_name -> master.ClientBase
+	2,  // 21: master.ListClientWorkersRequest.base:type_name -> master.ClientBase
+	37, // 22: master.ListClientWorkersResponse.status:type_name -> common.Status
+	41, // 23: master.ListClientWorkersResponse.workers:type_name -> common.Worker
+	2,  // 24: master.PushWorkerCronInvocationsReq.base:type_name -> master.ClientBase
+	42, // 25: master.PushWorkerCronInvocationsReq.invocations:type_name -> common.WorkerCronInvocation
+	37, // 26: master.PushWorkerCronInvocationsResp.status:type_name -> common.Status
+	2,  // 27: master.PushWorkerKVReq.base:type_name -> master.ClientBase
+	43, // 28: master.PushWorkerKVReq.entries:type_name -> common.WorkerKVEntry
+	37, // 29: master.PushWorkerKVResp.status:type_name -> common.Status
+	43, // 30: master.PushWorkerKVResp.entries:type_name -> common.WorkerKVEntry
+	2,  // 31: master.PullWorkerKVReq.base:type_name -> master.ClientBase
+	37, // 32: master.PullWorkerKVResp.status:type_name -> common.Status
+	43, // 33: master.PullWorkerKVResp.entries:type_name -> common.WorkerKVEntry
+	2,  // 34: master.PushWorkerStatusReq.base:type_name -> master.ClientBase
+	37, // 35: master.PushWorkerStatusResp.status:type_name -> common.Status
+	2,  // 36: master.PushProxyStatusReq.base:type_name -> master.ClientBase
+	44, // 37: master.PushProxyStatusReq.statuses:type_name -> common.ProxyWorkingStatus
+	37, // 38: master.PushProxyStatusResp.status:type_name -> common.Status
+	1,  // 39: master.PullProxyProbesReq.base:type_name -> master.ServerBase
+	37, // 40: master.PullProxyProbesResp.status:type_name -> common.Status
+	45, // 41: master.PullProxyProbesResp.probes:type_name -> common.ProxyProbe
+	1,  // 42: master.PushProxyProbeResultsReq.base:type_name -> master.ServerBase
+	46, // 43: master.PushProxyProbeResultsReq.results:type_name -> common.ProxyProbeResult
+	37, // 44: master.PushProxyProbeResultsResp.status:type_name -> common.Status
+	4,  // 45: master.Master.ServerSend:input_type -> master.ClientMessage
+	5,  // 46: master.Master.PullClientConfig:input_type -> master.PullClientConfigReq
+	7,  // 47: master.Master.PullServerConfig:input_type -> master.PullServerConfigReq
+	20, // 48: master.Master.ListClientWorkers:input_type -> master.ListClientWorkersRequest
+	9,  // 49: master.Master.FRPCAuth:input_type -> master.FRPAuthRequest
+	11, // 50: master.Master.PushProxyInfo:input_type -> master.PushProxyInfoReq
+	14, // 51: master.Master.PushClientStreamLog:input_type -> master.PushClientStreamLogReq
+	13, // 52: master.Master.PushServerStreamLog:input_type -> master.PushServerStreamLogReq
+	16, // 53: master.Master.PTYConnect:input_type -> master.PTYClientMessage
+	18, // 54: master.Master.FileTransfer:input_type -> master.FileTransferClientMessage
+	22, // 55: master.Master.PushWorkerCronInvocations:input_type -> master.PushWorkerCronInvocationsReq
+	24, // 56: master.Master.PushWorkerKV:input_type -> master.PushWorkerKVReq
+	26, // 57: master.Master.PullWorkerKV:input_type -> master.PullWorkerKVReq
+	28, // 58: master.Master.PushWorkerStatus:input_type -> master.PushWorkerStatusReq
+	30, // 59: master.Master.PushProxyStatus:input_type -> master.PushProxyStatusReq
+	32, // 60: master.Master.PullProxyProbes:input_type -> master.PullProxyProbesReq
+	34, // 61: master.Master.PushProxyProbeResults:input_type -> master.PushProxyProbeResultsReq
+	3,  // 62: master.Master.ServerSend:output_type -> master.ServerMessage
+	6,  // 63: master.Master.PullClientConfig:output_type -> master.PullClientConfigResp
+	8,  // 64: master.Master.PullServerConfig:output_type -> master.PullServerConfigResp
+	21, // 65: master.Master.ListClientWorkers:output_type -> master.ListClientWorkersResponse
+	10, // 66: master.Master.FRPCAuth:output_type -> master.FRPAuthResponse
+	12, // 67: master.Master.PushProxyInfo:output_type -> master.PushProxyInfoResp
+	15, // 68: master.Master.PushClientStreamLog:output_type -> master.PushStreamLogResp
+	15, // 69: master.Master.PushServerStreamLog:output_type -> master.PushStreamLogResp
+	17, // 70: master.Master.PTYConnect:output_type -> master.PTYServerMessage
+	19, // 71: master.Master.FileTransfer:output_type -> master.FileTransferServerMessage
+	23, // 72: master.Master.PushWorkerCronInvocations:output_type -> master.PushWorkerCronInvocationsResp
+	25, // 73: master.Master.PushWorkerKV:output_type -> master.PushWorkerKVResp
+	27, // 74: master.Master.PullWorkerKV:output_type -> master.PullWorkerKVResp
+	29, // 75: master.Master.PushWorkerStatus:output_type -> master.PushWorkerStatusResp
+	31, // 76: master.Master.PushProxyStatus:output_type -> master.PushProxyStatusResp
+	33, // 77: master.Master.PullProxyProbes:output_type -> master.PullProxyProbesResp
+	35, // 78: master.Master.PushProxyProbeResults:output_type -> master.PushProxyProbeResultsResp
+	62, // [62:79] is the sub-list for method output_type
+	45, // [45:62] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_rpc_master_proto_init() }
@@ -1421,18 +2561,25 @@ func file_rpc_master_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_rpc_master_proto_msgTypes[12].OneofWrappers = []any{}
+	file_rpc_master_proto_msgTypes[13].OneofWrappers = []any{}
 	file_rpc_master_proto_msgTypes[15].OneofWrappers = []any{
 		(*PTYClientMessage_ServerBase)(nil),
 		(*PTYClientMessage_ClientBase)(nil),
 	}
 	file_rpc_master_proto_msgTypes[16].OneofWrappers = []any{}
+	file_rpc_master_proto_msgTypes[17].OneofWrappers = []any{
+		(*FileTransferClientMessage_ServerBase)(nil),
+		(*FileTransferClientMessage_ClientBase)(nil),
+	}
+	file_rpc_master_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_master_proto_rawDesc), len(file_rpc_master_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
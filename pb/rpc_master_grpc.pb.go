@@ -19,15 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Master_ServerSend_FullMethodName          = "/master.Master/ServerSend"
-	Master_PullClientConfig_FullMethodName    = "/master.Master/PullClientConfig"
-	Master_PullServerConfig_FullMethodName    = "/master.Master/PullServerConfig"
-	Master_ListClientWorkers_FullMethodName   = "/master.Master/ListClientWorkers"
-	Master_FRPCAuth_FullMethodName            = "/master.Master/FRPCAuth"
-	Master_PushProxyInfo_FullMethodName       = "/master.Master/PushProxyInfo"
-	Master_PushClientStreamLog_FullMethodName = "/master.Master/PushClientStreamLog"
-	Master_PushServerStreamLog_FullMethodName = "/master.Master/PushServerStreamLog"
-	Master_PTYConnect_FullMethodName          = "/master.Master/PTYConnect"
+	Master_ServerSend_FullMethodName                = "/master.Master/ServerSend"
+	Master_PullClientConfig_FullMethodName          = "/master.Master/PullClientConfig"
+	Master_PullServerConfig_FullMethodName          = "/master.Master/PullServerConfig"
+	Master_ListClientWorkers_FullMethodName         = "/master.Master/ListClientWorkers"
+	Master_FRPCAuth_FullMethodName                  = "/master.Master/FRPCAuth"
+	Master_PushProxyInfo_FullMethodName             = "/master.Master/PushProxyInfo"
+	Master_PushClientStreamLog_FullMethodName       = "/master.Master/PushClientStreamLog"
+	Master_PushServerStreamLog_FullMethodName       = "/master.Master/PushServerStreamLog"
+	Master_PTYConnect_FullMethodName                = "/master.Master/PTYConnect"
+	Master_FileTransfer_FullMethodName              = "/master.Master/FileTransfer"
+	Master_PushWorkerCronInvocations_FullMethodName = "/master.Master/PushWorkerCronInvocations"
+	Master_PushWorkerKV_FullMethodName              = "/master.Master/PushWorkerKV"
+	Master_PullWorkerKV_FullMethodName              = "/master.Master/PullWorkerKV"
+	Master_PushWorkerStatus_FullMethodName          = "/master.Master/PushWorkerStatus"
+	Master_PushProxyStatus_FullMethodName           = "/master.Master/PushProxyStatus"
+	Master_PullProxyProbes_FullMethodName           = "/master.Master/PullProxyProbes"
+	Master_PushProxyProbeResults_FullMethodName     = "/master.Master/PushProxyProbeResults"
 )
 
 // MasterClient is the client API for Master service.
@@ -43,6 +51,14 @@ type MasterClient interface {
 	PushClientStreamLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[PushClientStreamLogReq, PushStreamLogResp], error)
 	PushServerStreamLog(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[PushServerStreamLogReq, PushStreamLogResp], error)
 	PTYConnect(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[PTYClientMessage, PTYServerMessage], error)
+	FileTransfer(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[FileTransferClientMessage, FileTransferServerMessage], error)
+	PushWorkerCronInvocations(ctx context.Context, in *PushWorkerCronInvocationsReq, opts ...grpc.CallOption) (*PushWorkerCronInvocationsResp, error)
+	PushWorkerKV(ctx context.Context, in *PushWorkerKVReq, opts ...grpc.CallOption) (*PushWorkerKVResp, error)
+	PullWorkerKV(ctx context.Context, in *PullWorkerKVReq, opts ...grpc.CallOption) (*PullWorkerKVResp, error)
+	PushWorkerStatus(ctx context.Context, in *PushWorkerStatusReq, opts ...grpc.CallOption) (*PushWorkerStatusResp, error)
+	PushProxyStatus(ctx context.Context, in *PushProxyStatusReq, opts ...grpc.CallOption) (*PushProxyStatusResp, error)
+	PullProxyProbes(ctx context.Context, in *PullProxyProbesReq, opts ...grpc.CallOption) (*PullProxyProbesResp, error)
+	PushProxyProbeResults(ctx context.Context, in *PushProxyProbeResultsReq, opts ...grpc.CallOption) (*PushProxyProbeResultsResp, error)
 }
 
 type masterClient struct {
@@ -155,6 +171,89 @@ func (c *masterClient) PTYConnect(ctx context.Context, opts ...grpc.CallOption)
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Master_PTYConnectClient = grpc.BidiStreamingClient[PTYClientMessage, PTYServerMessage]
 
+func (c *masterClient) FileTransfer(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[FileTransferClientMessage, FileTransferServerMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Master_ServiceDesc.Streams[4], Master_FileTransfer_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FileTransferClientMessage, FileTransferServerMessage]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Master_FileTransferClient = grpc.BidiStreamingClient[FileTransferClientMessage, FileTransferServerMessage]
+
+func (c *masterClient) PushWorkerCronInvocations(ctx context.Context, in *PushWorkerCronInvocationsReq, opts ...grpc.CallOption) (*PushWorkerCronInvocationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushWorkerCronInvocationsResp)
+	err := c.cc.Invoke(ctx, Master_PushWorkerCronInvocations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) PushWorkerKV(ctx context.Context, in *PushWorkerKVReq, opts ...grpc.CallOption) (*PushWorkerKVResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushWorkerKVResp)
+	err := c.cc.Invoke(ctx, Master_PushWorkerKV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) PullWorkerKV(ctx context.Context, in *PullWorkerKVReq, opts ...grpc.CallOption) (*PullWorkerKVResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullWorkerKVResp)
+	err := c.cc.Invoke(ctx, Master_PullWorkerKV_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) PushWorkerStatus(ctx context.Context, in *PushWorkerStatusReq, opts ...grpc.CallOption) (*PushWorkerStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushWorkerStatusResp)
+	err := c.cc.Invoke(ctx, Master_PushWorkerStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) PushProxyStatus(ctx context.Context, in *PushProxyStatusReq, opts ...grpc.CallOption) (*PushProxyStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushProxyStatusResp)
+	err := c.cc.Invoke(ctx, Master_PushProxyStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) PullProxyProbes(ctx context.Context, in *PullProxyProbesReq, opts ...grpc.CallOption) (*PullProxyProbesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullProxyProbesResp)
+	err := c.cc.Invoke(ctx, Master_PullProxyProbes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *masterClient) PushProxyProbeResults(ctx context.Context, in *PushProxyProbeResultsReq, opts ...grpc.CallOption) (*PushProxyProbeResultsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushProxyProbeResultsResp)
+	err := c.cc.Invoke(ctx, Master_PushProxyProbeResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MasterServer is the server API for Master service.
 // All implementations must embed UnimplementedMasterServer
 // for forward compatibility.
@@ -168,6 +267,14 @@ type MasterServer interface {
 	PushClientStreamLog(grpc.ClientStreamingServer[PushClientStreamLogReq, PushStreamLogResp]) error
 	PushServerStreamLog(grpc.ClientStreamingServer[PushServerStreamLogReq, PushStreamLogResp]) error
 	PTYConnect(grpc.BidiStreamingServer[PTYClientMessage, PTYServerMessage]) error
+	FileTransfer(grpc.BidiStreamingServer[FileTransferClientMessage, FileTransferServerMessage]) error
+	PushWorkerCronInvocations(context.Context, *PushWorkerCronInvocationsReq) (*PushWorkerCronInvocationsResp, error)
+	PushWorkerKV(context.Context, *PushWorkerKVReq) (*PushWorkerKVResp, error)
+	PullWorkerKV(context.Context, *PullWorkerKVReq) (*PullWorkerKVResp, error)
+	PushWorkerStatus(context.Context, *PushWorkerStatusReq) (*PushWorkerStatusResp, error)
+	PushProxyStatus(context.Context, *PushProxyStatusReq) (*PushProxyStatusResp, error)
+	PullProxyProbes(context.Context, *PullProxyProbesReq) (*PullProxyProbesResp, error)
+	PushProxyProbeResults(context.Context, *PushProxyProbeResultsReq) (*PushProxyProbeResultsResp, error)
 	mustEmbedUnimplementedMasterServer()
 }
 
@@ -205,6 +312,30 @@ func (UnimplementedMasterServer) PushServerStreamLog(grpc.ClientStreamingServer[
 func (UnimplementedMasterServer) PTYConnect(grpc.BidiStreamingServer[PTYClientMessage, PTYServerMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method PTYConnect not implemented")
 }
+func (UnimplementedMasterServer) FileTransfer(grpc.BidiStreamingServer[FileTransferClientMessage, FileTransferServerMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method FileTransfer not implemented")
+}
+func (UnimplementedMasterServer) PushWorkerCronInvocations(context.Context, *PushWorkerCronInvocationsReq) (*PushWorkerCronInvocationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushWorkerCronInvocations not implemented")
+}
+func (UnimplementedMasterServer) PushWorkerKV(context.Context, *PushWorkerKVReq) (*PushWorkerKVResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushWorkerKV not implemented")
+}
+func (UnimplementedMasterServer) PullWorkerKV(context.Context, *PullWorkerKVReq) (*PullWorkerKVResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullWorkerKV not implemented")
+}
+func (UnimplementedMasterServer) PushWorkerStatus(context.Context, *PushWorkerStatusReq) (*PushWorkerStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushWorkerStatus not implemented")
+}
+func (UnimplementedMasterServer) PushProxyStatus(context.Context, *PushProxyStatusReq) (*PushProxyStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushProxyStatus not implemented")
+}
+func (UnimplementedMasterServer) PullProxyProbes(context.Context, *PullProxyProbesReq) (*PullProxyProbesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullProxyProbes not implemented")
+}
+func (UnimplementedMasterServer) PushProxyProbeResults(context.Context, *PushProxyProbeResultsReq) (*PushProxyProbeResultsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushProxyProbeResults not implemented")
+}
 func (UnimplementedMasterServer) mustEmbedUnimplementedMasterServer() {}
 func (UnimplementedMasterServer) testEmbeddedByValue()                {}
 
@@ -344,6 +475,139 @@ func _Master_PTYConnect_Handler(srv interface{}, stream grpc.ServerStream) error
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Master_PTYConnectServer = grpc.BidiStreamingServer[PTYClientMessage, PTYServerMessage]
 
+func _Master_FileTransfer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MasterServer).FileTransfer(&grpc.GenericServerStream[FileTransferClientMessage, FileTransferServerMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Master_FileTransferServer = grpc.BidiStreamingServer[FileTransferClientMessage, FileTransferServerMessage]
+
+func _Master_PushWorkerCronInvocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushWorkerCronInvocationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).PushWorkerCronInvocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Master_PushWorkerCronInvocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).PushWorkerCronInvocations(ctx, req.(*PushWorkerCronInvocationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_PushWorkerKV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushWorkerKVReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).PushWorkerKV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Master_PushWorkerKV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).PushWorkerKV(ctx, req.(*PushWorkerKVReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_PullWorkerKV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullWorkerKVReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).PullWorkerKV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Master_PullWorkerKV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).PullWorkerKV(ctx, req.(*PullWorkerKVReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_PushWorkerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushWorkerStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).PushWorkerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Master_PushWorkerStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).PushWorkerStatus(ctx, req.(*PushWorkerStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_PushProxyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushProxyStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).PushProxyStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Master_PushProxyStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).PushProxyStatus(ctx, req.(*PushProxyStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_PullProxyProbes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullProxyProbesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).PullProxyProbes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Master_PullProxyProbes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).PullProxyProbes(ctx, req.(*PullProxyProbesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_PushProxyProbeResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushProxyProbeResultsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).PushProxyProbeResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Master_PushProxyProbeResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).PushProxyProbeResults(ctx, req.(*PushProxyProbeResultsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Master_ServiceDesc is the grpc.ServiceDesc for Master service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -371,6 +635,34 @@ var Master_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PushProxyInfo",
 			Handler:    _Master_PushProxyInfo_Handler,
 		},
+		{
+			MethodName: "PushWorkerCronInvocations",
+			Handler:    _Master_PushWorkerCronInvocations_Handler,
+		},
+		{
+			MethodName: "PushWorkerKV",
+			Handler:    _Master_PushWorkerKV_Handler,
+		},
+		{
+			MethodName: "PullWorkerKV",
+			Handler:    _Master_PullWorkerKV_Handler,
+		},
+		{
+			MethodName: "PushWorkerStatus",
+			Handler:    _Master_PushWorkerStatus_Handler,
+		},
+		{
+			MethodName: "PushProxyStatus",
+			Handler:    _Master_PushProxyStatus_Handler,
+		},
+		{
+			MethodName: "PullProxyProbes",
+			Handler:    _Master_PullProxyProbes_Handler,
+		},
+		{
+			MethodName: "PushProxyProbeResults",
+			Handler:    _Master_PushProxyProbeResults_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -395,6 +687,12 @@ var Master_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "FileTransfer",
+			Handler:       _Master_FileTransfer_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "rpc_master.proto",
 }
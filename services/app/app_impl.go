@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/services/watcher"
 	"github.com/casbin/casbin/v2"
 	"google.golang.org/grpc/credentials"
 )
@@ -13,6 +14,8 @@ type application struct {
 	masterCli        MasterClient
 
 	shellPTYMgr       ShellPTYMgr
+	fileTransferMgr   FileTransferMgr
+	notifyMgr         NotifyMgr
 	clientLogManager  ClientLogManager
 	clientRPCHandler  ClientRPCHandler
 	dbManager         DBManager
@@ -28,6 +31,17 @@ type application struct {
 	enforcer          *casbin.Enforcer
 	workerExecManager WorkerExecManager
 	workersManager    WorkersManager
+	taskManager       watcher.Client
+}
+
+// GetTaskManager implements Application.
+func (a *application) GetTaskManager() watcher.Client {
+	return a.taskManager
+}
+
+// SetTaskManager implements Application.
+func (a *application) SetTaskManager(t watcher.Client) {
+	a.taskManager = t
 }
 
 // GetWorkersManager implements Application.
@@ -195,6 +209,26 @@ func (a *application) GetShellPTYMgr() ShellPTYMgr {
 	return a.shellPTYMgr
 }
 
+// GetFileTransferMgr implements Application.
+func (a *application) GetFileTransferMgr() FileTransferMgr {
+	return a.fileTransferMgr
+}
+
+// SetFileTransferMgr implements Application.
+func (a *application) SetFileTransferMgr(fileTransferMgr FileTransferMgr) {
+	a.fileTransferMgr = fileTransferMgr
+}
+
+// GetNotifyMgr implements Application.
+func (a *application) GetNotifyMgr() NotifyMgr {
+	return a.notifyMgr
+}
+
+// SetNotifyMgr implements Application.
+func (a *application) SetNotifyMgr(notifyMgr NotifyMgr) {
+	a.notifyMgr = notifyMgr
+}
+
 // GetStreamLogHookMgr implements Application.
 func (a *application) GetStreamLogHookMgr() StreamLogHookMgr {
 	return a.streamLogHookMgr
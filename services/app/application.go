@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/VaalaCat/frp-panel/conf"
+	"github.com/VaalaCat/frp-panel/services/watcher"
 	"github.com/casbin/casbin/v2"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/credentials"
@@ -15,6 +16,10 @@ type Application interface {
 	SetStreamLogHookMgr(StreamLogHookMgr)
 	GetShellPTYMgr() ShellPTYMgr
 	SetShellPTYMgr(ShellPTYMgr)
+	GetFileTransferMgr() FileTransferMgr
+	SetFileTransferMgr(FileTransferMgr)
+	GetNotifyMgr() NotifyMgr
+	SetNotifyMgr(NotifyMgr)
 	GetClientLogManager() ClientLogManager
 	SetClientLogManager(ClientLogManager)
 	GetDBManager() DBManager
@@ -47,6 +52,8 @@ type Application interface {
 	SetWorkerExecManager(WorkerExecManager)
 	GetWorkersManager() WorkersManager
 	SetWorkersManager(WorkersManager)
+	GetTaskManager() watcher.Client
+	SetTaskManager(watcher.Client)
 }
 
 type Context struct {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/VaalaCat/frp-panel/common"
 	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/telemetry"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/protobuf/proto"
@@ -48,11 +49,14 @@ func WrapperServerMsg[T common.ReqType, U common.RespType](appInstance Applicati
 		return nil
 	}
 
-	ctx := context.Background()
+	ctx, span := telemetry.StartServerMessageSpan(logger.WithTraceID(context.Background(), req.GetTraceId()), req)
+	defer span.End()
+
 	appCtx := NewContext(ctx, appInstance)
 	resp, err := handler(appCtx, r)
 	if err != nil {
-		logger.Logger(context.Background()).WithError(err).Errorf("handler error")
+		telemetry.RecordError(span, err)
+		logger.Logger(ctx).WithError(err).Errorf("handler error")
 		return &pb.ClientMessage{
 			Event: pb.Event_EVENT_ERROR,
 			Data:  []byte(err.Error()),
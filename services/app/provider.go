@@ -17,7 +17,7 @@ import (
 
 // biz/common/stream_log.go
 type StreamLogHookMgr interface {
-	AddStream(send func(msg string), closeSend func())
+	AddStream(send func(msg, pkg string), closeSend func())
 	SetPkgs(pkgs []string)
 	Close()
 	Lock()
@@ -59,11 +59,35 @@ type ShellPTYMgr interface {
 	Add(sessionID string, conn pb.Master_PTYConnectServer)
 	IsSessionDone(sessionID string) bool
 	SetSessionDone(sessionID string)
+	LiveSessions() SyncMap[string, PTYLiveSession]
+}
+
+// biz/master/shell/live_session.go
+type PTYLiveSession interface {
+	Info() *pb.PTYSession
+	Terminate(reason string)
+}
+
+// biz/master/file/mgr.go
+type FileTransferMgr interface {
+	SyncMap[string, pb.Master_FileTransferServer]
+	Add(transferID string, conn pb.Master_FileTransferServer)
+	IsTransferDone(transferID string) bool
+	SetTransferDone(transferID string)
+}
+
+// biz/master/notify/mgr.go
+type NotifyMgr interface {
+	Notify(ctx *Context, event *pb.NotifyEvent)
+	Stop()
 }
 
 // biz/master/streamlog/collect_log.go
 type ClientLogManager interface {
-	SyncMap[string, chan string]
+	Subscribe(clientId string, pkgs []string) (subId string, ch <-chan string, total int)
+	Unsubscribe(clientId string, subId string) (remain int)
+	Publish(clientId string, pkg *string, log string) bool
+	SubscribedPkgs(clientId string) []string
 	GetClientLock(clientId string) *sync.Mutex
 }
 
@@ -176,6 +200,8 @@ type PermissionManager interface {
 // services/workerd/exec_manager.go
 type WorkerExecManager interface {
 	RunCmd(workerId string, cwd string, argv []string)
+	RunCmdWithLimits(workerId string, cwd string, argv []string, limits *pb.WorkerResourceLimits)
+	GetCmdStatus(workerId string) (defs.WorkerStatus, bool)
 	ExitCmd(workerId string)
 	ExitAllCmd()
 	UpdateBinaryPath(path string)
@@ -183,6 +209,7 @@ type WorkerExecManager interface {
 
 // services/workerd/workerd.go
 type WorkerController interface {
+	GetWorker() *pb.Worker
 	RunWorker(c *Context)
 	StopWorker(c *Context)
 	// GetWorkerStatus(c *Context) defs.WorkerStatus
@@ -198,5 +225,7 @@ type WorkersManager interface {
 	StopWorker(ctx *Context, id string) error
 	GetWorkerStatus(ctx *Context, id string) (defs.WorkerStatus, error)
 	// install workerd bin to workerd bin path, if not specified, use default path /usr/local/bin/workerd
-	InstallWorkerd(ctx *Context, url string, path string) (string, error)
+	InstallWorkerd(ctx *Context, req *pb.InstallWorkerdRequest, path string) (string, error)
+	// workerd version of current binary, empty if unknown
+	GetWorkerdVersion(ctx *Context) string
 }
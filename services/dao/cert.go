@@ -29,7 +29,7 @@ func (q *queryImpl) InitCert(template *x509.Certificate) *tls.Config {
 		if err != nil {
 			logger.Logger(ctx).Fatal(err)
 		}
-		if err = q.ctx.GetApp().GetDBManager().GetDefaultDB().Create(&models.Cert{
+		if err = q.defaultDB().Create(&models.Cert{
 			Name:     "default",
 			CertFile: certPem,
 			CaFile:   certPem,
@@ -80,7 +80,7 @@ func GenX509Info(template *x509.Certificate) (certPem []byte, keyPem []byte, err
 }
 
 func (q *queryImpl) CountCerts() (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Cert{}).Count(&count).Error
 	if err != nil {
@@ -91,7 +91,7 @@ func (q *queryImpl) CountCerts() (int64, error) {
 
 func (q *queryImpl) GetDefaultKeyPair() (keyPem []byte, certPem []byte, err error) {
 	resp := &models.Cert{}
-	err = q.ctx.GetApp().GetDBManager().GetDefaultDB().Model(&models.Cert{}).
+	err = q.defaultDB().Model(&models.Cert{}).
 		Where(&models.Cert{Name: "default"}).First(resp).Error
 	if err != nil {
 		return nil, nil, err
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/samber/lo"
 	"gorm.io/gorm"
@@ -13,7 +14,7 @@ func (q *queryImpl) ValidateClientSecret(clientID, clientSecret string) (*models
 	if clientID == "" || clientSecret == "" {
 		return nil, fmt.Errorf("invalid client id or client secret")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	c := &models.Client{}
 	err := db.
 		Where(&models.Client{ClientEntity: &models.ClientEntity{
@@ -33,7 +34,7 @@ func (q *queryImpl) AdminGetClientByClientID(clientID string) (*models.Client, e
 	if clientID == "" {
 		return nil, fmt.Errorf("invalid client id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	c := &models.Client{}
 	err := db.
 		Where(&models.Client{ClientEntity: &models.ClientEntity{
@@ -50,7 +51,7 @@ func (q *queryImpl) GetClientByClientID(userInfo models.UserInfo, clientID strin
 	if clientID == "" {
 		return nil, fmt.Errorf("invalid client id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	c := &models.Client{}
 	err := db.
 		Where(&models.Client{ClientEntity: &models.ClientEntity{
@@ -70,7 +71,7 @@ func (q *queryImpl) GetClientsByClientIDs(userInfo models.UserInfo, clientIDs []
 		return nil, fmt.Errorf("invalid client ids")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	cs := []*models.Client{}
 	err := db.Where("client_id IN ?", clientIDs).Find(&cs).Error
 	if err != nil {
@@ -81,7 +82,7 @@ func (q *queryImpl) GetClientsByClientIDs(userInfo models.UserInfo, clientIDs []
 }
 
 func (q *queryImpl) GetClientByFilter(userInfo models.UserInfo, client *models.ClientEntity, shadow *bool) (*models.ClientEntity, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	filter := &models.ClientEntity{}
 	if len(client.ClientID) != 0 {
 		filter.ClientID = client.ClientID
@@ -117,7 +118,7 @@ func (q *queryImpl) GetClientByOriginClientID(originClientID string) (*models.Cl
 	if originClientID == "" {
 		return nil, fmt.Errorf("invalid origin client id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	c := &models.Client{}
 	err := db.
 		Where(&models.Client{ClientEntity: &models.ClientEntity{
@@ -136,7 +137,7 @@ func (q *queryImpl) CreateClient(userInfo models.UserInfo, client *models.Client
 	c := &models.Client{
 		ClientEntity: client,
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Create(c).Error
 }
 
@@ -144,7 +145,7 @@ func (q *queryImpl) DeleteClient(userInfo models.UserInfo, clientID string) erro
 	if clientID == "" {
 		return fmt.Errorf("invalid client id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Unscoped().Where(&models.Client{
 		ClientEntity: &models.ClientEntity{
 			ClientID: clientID,
@@ -164,7 +165,7 @@ func (q *queryImpl) UpdateClient(userInfo models.UserInfo, client *models.Client
 	c := &models.Client{
 		ClientEntity: client,
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Where(&models.Client{
 		ClientEntity: &models.ClientEntity{
 			UserID:   userInfo.GetUserID(),
@@ -178,7 +179,7 @@ func (q *queryImpl) ListClients(userInfo models.UserInfo, page, pageSize int) ([
 		return nil, fmt.Errorf("invalid page or page size")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	offset := (page - 1) * pageSize
 
 	var clients []*models.Client
@@ -209,7 +210,7 @@ func (q *queryImpl) ListClientsWithKeyword(userInfo models.UserInfo, page, pageS
 		return nil, fmt.Errorf("invalid page or page size or keyword")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	offset := (page - 1) * pageSize
 
 	var clients []*models.Client
@@ -230,7 +231,7 @@ func (q *queryImpl) ListClientsWithKeyword(userInfo models.UserInfo, page, pageS
 }
 
 func (q *queryImpl) GetAllClients(userInfo models.UserInfo) ([]*models.ClientEntity, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var clients []*models.Client
 	err := db.Where(&models.Client{
 		ClientEntity: &models.ClientEntity{
@@ -248,7 +249,7 @@ func (q *queryImpl) GetAllClients(userInfo models.UserInfo) ([]*models.ClientEnt
 }
 
 func (q *queryImpl) CountClients(userInfo models.UserInfo) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Client{}).Where(&models.Client{
 		ClientEntity: &models.ClientEntity{
@@ -264,7 +265,7 @@ func (q *queryImpl) CountClients(userInfo models.UserInfo) (int64, error) {
 }
 
 func (q *queryImpl) CountClientsWithKeyword(userInfo models.UserInfo, keyword string) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Client{}).Where(&models.Client{
 		ClientEntity: &models.ClientEntity{
@@ -279,8 +280,52 @@ func (q *queryImpl) CountClientsWithKeyword(userInfo models.UserInfo, keyword st
 	return count, nil
 }
 
+// ListClientsWithLabelSelector keyword 为空时只按 selector 过滤
+func (q *queryImpl) ListClientsWithLabelSelector(userInfo models.UserInfo, page, pageSize int, keyword string, selector models.LabelSelector) ([]*models.ClientEntity, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	db := q.defaultDB()
+	offset := (page - 1) * pageSize
+
+	var clients []*models.Client
+	err := q.clientsWithLabelSelector(db, userInfo, keyword, selector).
+		Offset(offset).Limit(pageSize).Find(&clients).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(clients, func(c *models.Client, _ int) *models.ClientEntity {
+		return c.ClientEntity
+	}), nil
+}
+
+func (q *queryImpl) CountClientsWithLabelSelector(userInfo models.UserInfo, keyword string, selector models.LabelSelector) (int64, error) {
+	db := q.defaultDB()
+	var count int64
+	err := q.clientsWithLabelSelector(db.Model(&models.Client{}), userInfo, keyword, selector).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *queryImpl) clientsWithLabelSelector(db *gorm.DB, userInfo models.UserInfo, keyword string, selector models.LabelSelector) *gorm.DB {
+	db = db.Where(&models.Client{ClientEntity: &models.ClientEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+	}}).
+		Where(normalClientFilter(q.defaultDB())).
+		Scopes(q.labelSelectorScope(userInfo, defs.LabelResourceClient, selector, "clients.client_id", ""))
+	if len(keyword) > 0 {
+		db = db.Where("client_id like ?", "%"+keyword+"%")
+	}
+	return db
+}
+
 func (q *queryImpl) CountConfiguredClients(userInfo models.UserInfo) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Client{}).
 		Where(&models.Client{
@@ -297,7 +342,7 @@ func (q *queryImpl) CountConfiguredClients(userInfo models.UserInfo) (int64, err
 }
 
 func (q *queryImpl) CountClientsInShadow(userInfo models.UserInfo, clientID string) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Client{}).
 		Where(&models.Client{
@@ -314,7 +359,7 @@ func (q *queryImpl) CountClientsInShadow(userInfo models.UserInfo, clientID stri
 }
 
 func (q *queryImpl) GetClientIDsInShadowByClientID(userInfo models.UserInfo, clientID string) ([]string, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var clients []*models.Client
 	err := db.Where(&models.Client{
 		ClientEntity: &models.ClientEntity{
@@ -331,7 +376,7 @@ func (q *queryImpl) GetClientIDsInShadowByClientID(userInfo models.UserInfo, cli
 }
 
 func (q *queryImpl) AdminGetClientIDsInShadowByClientID(clientID string) ([]string, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var clients []*models.Client
 	err := db.Where(&models.Client{
 		ClientEntity: &models.ClientEntity{
@@ -346,7 +391,7 @@ func (q *queryImpl) AdminGetClientIDsInShadowByClientID(clientID string) ([]stri
 }
 
 func (q *queryImpl) AdminUpdateClientLastSeen(clientID string) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Model(&models.Client{
 		ClientEntity: &models.ClientEntity{
 			ClientID: clientID,
@@ -372,3 +417,8 @@ func normalClientFilter(db *gorm.DB) *gorm.DB {
 			),
 		)
 }
+
+func (q *queryImpl) AdminUpdateClientPTYDisabled(clientID string, disabled bool) error {
+	db := q.defaultDB()
+	return db.Model(&models.Client{}).Where("client_id = ?", clientID).Update("pty_disabled", disabled).Error
+}
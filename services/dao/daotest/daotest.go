@@ -0,0 +1,31 @@
+// Package daotest 提供基于 sqlite 的测试用 app，供需要访问数据库的测试使用
+package daotest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// NewApp 返回已初始化数据库的 app，每个测试使用独立的 sqlite 文件
+func NewApp(t testing.TB) (app.Application, *gorm.DB) {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbm := models.NewDBManager(defs.DBTypeSQLite3)
+	dbm.SetDB(defs.DBTypeSQLite3, defs.DBRoleDefault, db)
+	dbm.Init()
+
+	appInstance := app.NewApp()
+	appInstance.SetDBManager(dbm)
+	return appInstance, db
+}
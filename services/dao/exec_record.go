@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+)
+
+func (q *queryImpl) AdminCreateExecRecord(record *models.ExecRecord) error {
+	db := q.defaultDB()
+	return db.Create(record).Error
+}
+
+func (q *queryImpl) ListExecRecords(userInfo models.UserInfo, clientID string, page, pageSize int) ([]*models.ExecRecord, error) {
+	return q.listExecRecords(&models.ExecRecord{
+		ClientID: clientID,
+		UserID:   uint32(userInfo.GetUserID()),
+		TenantID: uint32(userInfo.GetTenantID()),
+	}, page, pageSize)
+}
+
+func (q *queryImpl) CountExecRecords(userInfo models.UserInfo, clientID string) (int64, error) {
+	return q.countExecRecords(&models.ExecRecord{
+		ClientID: clientID,
+		UserID:   uint32(userInfo.GetUserID()),
+		TenantID: uint32(userInfo.GetTenantID()),
+	})
+}
+
+func (q *queryImpl) AdminListExecRecords(clientID string, page, pageSize int) ([]*models.ExecRecord, error) {
+	return q.listExecRecords(&models.ExecRecord{ClientID: clientID}, page, pageSize)
+}
+
+func (q *queryImpl) AdminCountExecRecords(clientID string) (int64, error) {
+	return q.countExecRecords(&models.ExecRecord{ClientID: clientID})
+}
+
+func (q *queryImpl) listExecRecords(cond *models.ExecRecord, page, pageSize int) ([]*models.ExecRecord, error) {
+	if page < 1 || pageSize < 1 || pageSize > 100 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	db := q.defaultDB()
+	offset := (page - 1) * pageSize
+
+	var records []*models.ExecRecord
+	if err := db.Where(cond).Order("started_at desc").Offset(offset).Limit(pageSize).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (q *queryImpl) countExecRecords(cond *models.ExecRecord) (int64, error) {
+	db := q.defaultDB()
+	var count int64
+	if err := db.Model(&models.ExecRecord{}).Where(cond).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// AdminDeleteExecRecordsBefore 删除在 before 之前执行的命令记录
+func (q *queryImpl) AdminDeleteExecRecordsBefore(before time.Time) (int64, error) {
+	db := q.defaultDB()
+	result := db.Unscoped().Where("started_at < ?", before).Delete(&models.ExecRecord{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,18 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/dao/daotest"
+	"gorm.io/gorm"
+)
+
+// newTestQuery 每个测试使用独立的 sqlite 文件
+func newTestQuery(t *testing.T) (*queryImpl, *gorm.DB) {
+	t.Helper()
+
+	appInstance, db := daotest.NewApp(t)
+	return NewQuery(app.NewContext(context.Background(), appInstance)), db
+}
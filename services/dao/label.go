@@ -0,0 +1,216 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// GetLabelsByResources 批量获取资源的标签，没有标签的资源不会出现在结果中
+func (q *queryImpl) GetLabelsByResources(userInfo models.UserInfo, resourceType defs.LabelResourceType, refs []models.LabelResourceRef) (map[models.LabelResourceRef]map[string]string, error) {
+	result := map[models.LabelResourceRef]map[string]string{}
+	if len(refs) == 0 {
+		return result, nil
+	}
+
+	db := q.defaultDB()
+	labels := []*models.Label{}
+	err := db.Where(&models.Label{LabelEntity: &models.LabelEntity{
+		UserID:       userInfo.GetUserID(),
+		TenantID:     userInfo.GetTenantID(),
+		ResourceType: resourceType,
+	}}).
+		Where("resource_id IN ?", lo.Uniq(lo.Map(refs, func(ref models.LabelResourceRef, _ int) string { return ref.ID }))).
+		Find(&labels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := lo.SliceToMap(refs, func(ref models.LabelResourceRef) (models.LabelResourceRef, struct{}) { return ref, struct{}{} })
+	for _, label := range labels {
+		ref := models.LabelResourceRef{ID: label.ResourceID, Name: label.ResourceName}
+		if _, ok := wanted[ref]; !ok {
+			continue
+		}
+		if result[ref] == nil {
+			result[ref] = map[string]string{}
+		}
+		result[ref][label.Key] = label.Value
+	}
+	return result, nil
+}
+
+func (q *queryImpl) GetLabels(userInfo models.UserInfo, resourceType defs.LabelResourceType, ref models.LabelResourceRef) (map[string]string, error) {
+	labels, err := q.GetLabelsByResources(userInfo, resourceType, []models.LabelResourceRef{ref})
+	if err != nil {
+		return nil, err
+	}
+	if labels[ref] == nil {
+		return map[string]string{}, nil
+	}
+	return labels[ref], nil
+}
+
+// UpdateLabels 设置和删除资源的标签，replace 为 true 时先清空原有标签，返回更新后的全部标签
+func (q *queryImpl) UpdateLabels(userInfo models.UserInfo, resourceType defs.LabelResourceType, ref models.LabelResourceRef,
+	labels map[string]string, removeKeys []string, replace bool) (map[string]string, error) {
+	if len(ref.ID) == 0 {
+		return nil, fmt.Errorf("invalid resource id")
+	}
+
+	db := q.defaultDB()
+	filter := &models.LabelEntity{
+		UserID:       userInfo.GetUserID(),
+		TenantID:     userInfo.GetTenantID(),
+		ResourceType: resourceType,
+		ResourceID:   ref.ID,
+		ResourceName: ref.Name,
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// ResourceName 为空时 struct 条件会忽略它，所以显式指定
+		scoped := func() *gorm.DB {
+			return tx.Unscoped().Where(&models.Label{LabelEntity: filter}).Where("resource_name = ?", ref.Name)
+		}
+
+		if replace {
+			if err := scoped().Delete(&models.Label{}).Error; err != nil {
+				return err
+			}
+		} else if len(removeKeys) > 0 {
+			if err := scoped().Where("label_key IN ?", removeKeys).Delete(&models.Label{}).Error; err != nil {
+				return err
+			}
+		}
+
+		for key, value := range labels {
+			existed := &models.Label{}
+			err := scoped().Where("label_key = ?", key).First(existed).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			if err == nil {
+				if err := tx.Model(existed).Update("label_value", value).Error; err != nil {
+					return err
+				}
+				continue
+			}
+
+			entity := *filter
+			entity.Key, entity.Value = key, value
+			if err := tx.Create(&models.Label{LabelEntity: &entity}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return q.GetLabels(userInfo, resourceType, ref)
+}
+
+// DeleteLabels 删除资源的全部标签，资源被删除时调用
+func (q *queryImpl) DeleteLabels(userInfo models.UserInfo, resourceType defs.LabelResourceType, ref models.LabelResourceRef) error {
+	_, err := q.UpdateLabels(userInfo, resourceType, ref, nil, nil, true)
+	return err
+}
+
+// DeleteLabelsByResourceIDs 删除资源的全部标签，对 proxy 会删除这些 client 上所有 proxy 的标签
+func (q *queryImpl) DeleteLabelsByResourceIDs(userInfo models.UserInfo, resourceType defs.LabelResourceType, resourceIDs []string) error {
+	if len(resourceIDs) == 0 {
+		return nil
+	}
+	db := q.defaultDB()
+	return db.Unscoped().Where(&models.Label{LabelEntity: &models.LabelEntity{
+		UserID:       userInfo.GetUserID(),
+		TenantID:     userInfo.GetTenantID(),
+		ResourceType: resourceType,
+	}}).Where("resource_id IN ?", resourceIDs).Delete(&models.Label{}).Error
+}
+
+// labelSelectorScope 用 exists 子查询按 selector 过滤资源表
+// idColumn 为资源表中对应 Label.ResourceID 的列，nameColumn 仅 proxy 使用
+func (q *queryImpl) labelSelectorScope(userInfo models.UserInfo, resourceType defs.LabelResourceType, selector models.LabelSelector,
+	idColumn, nameColumn string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, req := range selector {
+			sub := q.defaultDB().Model(&models.Label{}).Select("1").
+				Where(&models.Label{LabelEntity: &models.LabelEntity{
+					UserID:       userInfo.GetUserID(),
+					TenantID:     userInfo.GetTenantID(),
+					ResourceType: resourceType,
+					Key:          req.Key,
+				}}).
+				Where(fmt.Sprintf("labels.resource_id = %s", idColumn))
+			if len(nameColumn) > 0 {
+				sub = sub.Where(fmt.Sprintf("labels.resource_name = %s", nameColumn))
+			}
+			if len(req.Values) > 0 {
+				sub = sub.Where("labels.label_value IN ?", req.Values)
+			}
+
+			switch req.Operator {
+			case models.LabelOperatorEquals, models.LabelOperatorIn, models.LabelOperatorExists:
+				db = db.Where("EXISTS (?)", sub)
+			default:
+				db = db.Where("NOT EXISTS (?)", sub)
+			}
+		}
+		return db
+	}
+}
+
+func (q *queryImpl) SaveLabelFilter(userInfo models.UserInfo, filter *models.LabelFilterEntity) error {
+	db := q.defaultDB()
+	filter.UserID = userInfo.GetUserID()
+	filter.TenantID = userInfo.GetTenantID()
+
+	existed := &models.LabelFilter{}
+	err := db.Where(&models.LabelFilter{LabelFilterEntity: &models.LabelFilterEntity{
+		UserID:   filter.UserID,
+		TenantID: filter.TenantID,
+		Name:     filter.Name,
+	}}).First(existed).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil {
+		return db.Model(existed).Updates(map[string]any{
+			"resource_type": filter.ResourceType,
+			"selector":      filter.Selector,
+		}).Error
+	}
+	return db.Create(&models.LabelFilter{LabelFilterEntity: filter}).Error
+}
+
+func (q *queryImpl) ListLabelFilters(userInfo models.UserInfo, resourceType defs.LabelResourceType) ([]*models.LabelFilter, error) {
+	db := q.defaultDB()
+	filters := []*models.LabelFilter{}
+	err := db.Where(&models.LabelFilter{LabelFilterEntity: &models.LabelFilterEntity{
+		UserID:       userInfo.GetUserID(),
+		TenantID:     userInfo.GetTenantID(),
+		ResourceType: resourceType,
+	}}).Order("name").Find(&filters).Error
+	if err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+func (q *queryImpl) DeleteLabelFilter(userInfo models.UserInfo, name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("invalid filter name")
+	}
+	db := q.defaultDB()
+	return db.Unscoped().Where(&models.LabelFilter{LabelFilterEntity: &models.LabelFilterEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+		Name:     name,
+	}}).Delete(&models.LabelFilter{}).Error
+}
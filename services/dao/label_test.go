@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelSelectorMatchesResourcesWithoutLabel(t *testing.T) {
+	q, _ := newTestQuery(t)
+	userInfo := &models.UserEntity{UserID: 2, TenantID: 1}
+
+	for _, id := range []string{"s-prod", "s-dev", "s-none"} {
+		assert.NoError(t, q.CreateServer(userInfo, &models.ServerEntity{ServerID: id}))
+	}
+	_, err := q.UpdateLabels(userInfo, defs.LabelResourceServer, models.LabelResourceRef{ID: "s-prod"}, map[string]string{"env": "prod"}, nil, false)
+	assert.NoError(t, err)
+	_, err = q.UpdateLabels(userInfo, defs.LabelResourceServer, models.LabelResourceRef{ID: "s-dev"}, map[string]string{"env": "dev"}, nil, false)
+	assert.NoError(t, err)
+
+	list := func(selector string) []string {
+		t.Helper()
+		parsed, err := models.ParseLabelSelector(selector)
+		assert.NoError(t, err)
+		servers, err := q.ListServersWithLabelSelector(userInfo, 1, 10, "", parsed)
+		assert.NoError(t, err)
+		return lo.Map(servers, func(s *models.ServerEntity, _ int) string { return s.ServerID })
+	}
+
+	assert.ElementsMatch(t, []string{"s-dev", "s-none"}, list("env!=prod"))
+	assert.ElementsMatch(t, []string{"s-dev", "s-none"}, list("env notin (prod)"))
+	assert.ElementsMatch(t, []string{"s-none"}, list("!env"))
+	assert.ElementsMatch(t, []string{"s-prod"}, list("env=prod"))
+	assert.ElementsMatch(t, []string{"s-prod", "s-dev"}, list("env"))
+}
@@ -0,0 +1,92 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+)
+
+func (q *queryImpl) CreateNotifyChannel(userInfo models.UserInfo, channel *models.NotifyChannel) error {
+	channel.UserID = uint32(userInfo.GetUserID())
+	channel.TenantID = uint32(userInfo.GetTenantID())
+
+	db := q.defaultDB()
+	return db.Create(channel).Error
+}
+
+func (q *queryImpl) UpdateNotifyChannel(userInfo models.UserInfo, channel *models.NotifyChannel) error {
+	if channel.ID == 0 {
+		return fmt.Errorf("invalid notify channel id")
+	}
+	channel.UserID = uint32(userInfo.GetUserID())
+	channel.TenantID = uint32(userInfo.GetTenantID())
+
+	db := q.defaultDB()
+	return db.Where(&models.NotifyChannel{
+		UserID:   channel.UserID,
+		TenantID: channel.TenantID,
+	}).Select("*").Updates(channel).Error
+}
+
+func (q *queryImpl) DeleteNotifyChannel(userInfo models.UserInfo, id uint) error {
+	if id == 0 {
+		return fmt.Errorf("invalid notify channel id")
+	}
+
+	db := q.defaultDB()
+	return db.Where(&models.NotifyChannel{
+		UserID:   uint32(userInfo.GetUserID()),
+		TenantID: uint32(userInfo.GetTenantID()),
+	}).Delete(&models.NotifyChannel{}, id).Error
+}
+
+func (q *queryImpl) GetNotifyChannel(userInfo models.UserInfo, id uint) (*models.NotifyChannel, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("invalid notify channel id")
+	}
+
+	db := q.defaultDB()
+	channel := &models.NotifyChannel{}
+	if err := db.Where(&models.NotifyChannel{
+		UserID:   uint32(userInfo.GetUserID()),
+		TenantID: uint32(userInfo.GetTenantID()),
+	}).First(channel, id).Error; err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (q *queryImpl) ListNotifyChannels(userInfo models.UserInfo) ([]*models.NotifyChannel, error) {
+	db := q.defaultDB()
+	channels := []*models.NotifyChannel{}
+	if err := db.Where(&models.NotifyChannel{
+		UserID:   uint32(userInfo.GetUserID()),
+		TenantID: uint32(userInfo.GetTenantID()),
+	}).Order("id").Find(&channels).Error; err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+// AdminListEnabledNotifyChannels 返回用户所有启用的通知渠道，用于发送事件
+func (q *queryImpl) AdminListEnabledNotifyChannels(userID, tenantID uint32) ([]*models.NotifyChannel, error) {
+	db := q.defaultDB()
+	channels := []*models.NotifyChannel{}
+	if err := db.Where(&models.NotifyChannel{
+		UserID:   userID,
+		TenantID: tenantID,
+		Enabled:  true,
+	}).Find(&channels).Error; err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+func (q *queryImpl) AdminUpdateNotifyChannelResult(id uint, sentAt time.Time, lastError string) error {
+	db := q.defaultDB()
+	return db.Model(&models.NotifyChannel{}).Where("id = ?", id).Updates(map[string]any{
+		"last_sent_at": sentAt,
+		"last_error":   lastError,
+	}).Error
+}
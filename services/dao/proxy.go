@@ -2,10 +2,12 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/utils"
@@ -20,7 +22,7 @@ func (q *queryImpl) GetProxyStatsByClientID(userInfo models.UserInfo, clientID s
 	if clientID == "" {
 		return nil, fmt.Errorf("invalid client id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	list := []*models.ProxyStats{}
 	err := db.
 		Where(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{
@@ -56,7 +58,7 @@ func (q *queryImpl) GetProxyStatsByServerID(userInfo models.UserInfo, serverID s
 	if serverID == "" {
 		return nil, fmt.Errorf("invalid server id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	list := []*models.ProxyStats{}
 	err := db.
 		Where(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{
@@ -82,7 +84,7 @@ func (q *queryImpl) AdminUpdateProxyStats(srv *models.ServerEntity, inputs []*pb
 		return fmt.Errorf("invalid server id")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Transaction(func(tx *gorm.DB) error {
 
 		queryResults := make([]interface{}, 3)
@@ -202,7 +204,7 @@ func (q *queryImpl) AdminUpdateProxyStats(srv *models.ServerEntity, inputs []*pb
 }
 
 func (q *queryImpl) AdminGetTenantProxyStats(tenantID int) ([]*models.ProxyStatsEntity, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	list := []*models.ProxyStats{}
 	err := db.
 		Where(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{
@@ -231,14 +233,14 @@ func (q *queryImpl) AdminGetAllProxyStats(tx *gorm.DB) ([]*models.ProxyStatsEnti
 }
 
 func (q *queryImpl) AdminCreateProxyConfig(proxyCfg *models.ProxyConfig) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Create(proxyCfg).Error
 }
 
 // RebuildProxyConfigFromClient rebuild proxy from client
 // skip stopped proxy
 func (q *queryImpl) RebuildProxyConfigFromClient(userInfo models.UserInfo, client *models.Client) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 
 	pxyCfgs, err := utils.LoadProxiesFromContent(client.ConfigContent)
 	if err != nil {
@@ -280,7 +282,7 @@ func (q *queryImpl) RebuildProxyConfigFromClient(userInfo models.UserInfo, clien
 }
 
 func (q *queryImpl) AdminGetProxyConfigByClientIDAndName(clientID string, name string) (*models.ProxyConfig, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	proxyCfg := &models.ProxyConfig{}
 	err := db.
 		Where(&models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
@@ -298,7 +300,7 @@ func (q *queryImpl) GetProxyConfigsByClientID(userInfo models.UserInfo, clientID
 	if clientID == "" {
 		return nil, fmt.Errorf("invalid client id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	list := []*models.ProxyConfig{}
 	err := db.
 		Where(&models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
@@ -316,7 +318,7 @@ func (q *queryImpl) GetProxyConfigsByClientID(userInfo models.UserInfo, clientID
 }
 
 func (q *queryImpl) GetProxyConfigByFilter(userInfo models.UserInfo, proxyConfig *models.ProxyConfigEntity) (*models.ProxyConfig, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	filter := &models.ProxyConfigEntity{}
 
 	if len(proxyConfig.ClientID) != 0 {
@@ -353,7 +355,7 @@ func (q *queryImpl) ListProxyConfigsWithFilters(userInfo models.UserInfo, page,
 		return nil, fmt.Errorf("invalid page or page size")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	offset := (page - 1) * pageSize
 
 	filters.UserID = userInfo.GetUserID()
@@ -371,7 +373,7 @@ func (q *queryImpl) ListProxyConfigsWithFilters(userInfo models.UserInfo, page,
 }
 
 func (q *queryImpl) AdminListProxyConfigsWithFilters(filters *models.ProxyConfigEntity) ([]*models.ProxyConfig, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 
 	var proxyConfigs []*models.ProxyConfig
 	err := db.Where(&models.ProxyConfig{
@@ -389,7 +391,7 @@ func (q *queryImpl) ListProxyConfigsWithFiltersAndKeyword(userInfo models.UserIn
 		return nil, fmt.Errorf("invalid page or page size or keyword")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	offset := (page - 1) * pageSize
 
 	filters.UserID = userInfo.GetUserID()
@@ -415,7 +417,7 @@ func (q *queryImpl) ListProxyConfigs(userInfo models.UserInfo, page, pageSize in
 }
 
 func (q *queryImpl) CreateProxyConfig(userInfo models.UserInfo, proxyCfg *models.ProxyConfigEntity) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	proxyCfg.UserID = userInfo.GetUserID()
 	proxyCfg.TenantID = userInfo.GetTenantID()
 	return db.Create(&models.ProxyConfig{ProxyConfigEntity: proxyCfg}).Error
@@ -425,7 +427,7 @@ func (q *queryImpl) UpdateProxyConfig(userInfo models.UserInfo, proxyCfg *models
 	if proxyCfg.ID == 0 {
 		return fmt.Errorf("invalid proxy config id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	proxyCfg.UserID = userInfo.GetUserID()
 	proxyCfg.TenantID = userInfo.GetTenantID()
 	return db.Where(&models.ProxyConfig{
@@ -444,7 +446,7 @@ func (q *queryImpl) DeleteProxyConfig(userInfo models.UserInfo, clientID, name s
 	if clientID == "" || name == "" {
 		return fmt.Errorf("invalid client id or name")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Unscoped().
 		Where(&models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
 			UserID:   userInfo.GetUserID(),
@@ -459,7 +461,7 @@ func (q *queryImpl) DeleteProxyConfigsByClientIDOrOriginClientID(userInfo models
 	if clientID == "" {
 		return fmt.Errorf("invalid client id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Unscoped().
 		Where(
 			db.Where(&models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
@@ -481,7 +483,7 @@ func (q *queryImpl) DeleteProxyConfigsByClientID(userInfo models.UserInfo, clien
 	if clientID == "" {
 		return fmt.Errorf("invalid client id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Unscoped().
 		Where(&models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
 			UserID:   userInfo.GetUserID(),
@@ -495,7 +497,7 @@ func (q *queryImpl) GetProxyConfigByOriginClientIDAndName(userInfo models.UserIn
 	if clientID == "" || name == "" {
 		return nil, fmt.Errorf("invalid client id or name")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	item := &models.ProxyConfig{}
 	err := db.
 		Where(&models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
@@ -516,7 +518,7 @@ func (q *queryImpl) CountProxyConfigs(userInfo models.UserInfo) (int64, error) {
 }
 
 func (q *queryImpl) CountProxyConfigsWithFilters(userInfo models.UserInfo, filters *models.ProxyConfigEntity) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	filters.UserID = userInfo.GetUserID()
 	filters.TenantID = userInfo.GetTenantID()
 
@@ -535,7 +537,7 @@ func (q *queryImpl) CountProxyConfigsWithFiltersAndKeyword(userInfo models.UserI
 		return q.CountProxyConfigsWithFilters(userInfo, filters)
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	filters.UserID = userInfo.GetUserID()
 	filters.TenantID = userInfo.GetTenantID()
 
@@ -549,8 +551,50 @@ func (q *queryImpl) CountProxyConfigsWithFiltersAndKeyword(userInfo models.UserI
 	return count, nil
 }
 
+// ListProxyConfigsWithLabelSelector keyword 为空时只按 filters 和 selector 过滤
+// proxy 的标签通过 client id 和 proxy 名称关联
+func (q *queryImpl) ListProxyConfigsWithLabelSelector(userInfo models.UserInfo, page, pageSize int, filters *models.ProxyConfigEntity, keyword string, selector models.LabelSelector) ([]*models.ProxyConfig, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	db := q.defaultDB()
+	offset := (page - 1) * pageSize
+
+	var proxyConfigs []*models.ProxyConfig
+	err := q.proxyConfigsWithLabelSelector(db, userInfo, filters, keyword, selector).
+		Offset(offset).Limit(pageSize).Find(&proxyConfigs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return proxyConfigs, nil
+}
+
+func (q *queryImpl) CountProxyConfigsWithLabelSelector(userInfo models.UserInfo, filters *models.ProxyConfigEntity, keyword string, selector models.LabelSelector) (int64, error) {
+	db := q.defaultDB()
+	var count int64
+	err := q.proxyConfigsWithLabelSelector(db.Model(&models.ProxyConfig{}), userInfo, filters, keyword, selector).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *queryImpl) proxyConfigsWithLabelSelector(db *gorm.DB, userInfo models.UserInfo, filters *models.ProxyConfigEntity, keyword string, selector models.LabelSelector) *gorm.DB {
+	filters.UserID = userInfo.GetUserID()
+	filters.TenantID = userInfo.GetTenantID()
+
+	db = db.Where(&models.ProxyConfig{ProxyConfigEntity: filters}).
+		Scopes(q.labelSelectorScope(userInfo, defs.LabelResourceProxy, selector, "proxy_config.client_id", "proxy_config.name"))
+	if len(keyword) > 0 {
+		db = db.Where("proxy_config.name like ?", "%"+keyword+"%")
+	}
+	return db
+}
+
 func (q *queryImpl) GetProxyConfigsByWorkerId(userInfo models.UserInfo, workerID string) ([]*models.ProxyConfig, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	items := []*models.ProxyConfig{}
 
 	err := db.
@@ -566,3 +610,140 @@ func (q *queryImpl) GetProxyConfigsByWorkerId(userInfo models.UserInfo, workerID
 	}
 	return items, nil
 }
+
+// GetAllProxyConfigs 返回用户的所有 proxy 配置
+func (q *queryImpl) GetAllProxyConfigs(userInfo models.UserInfo) ([]*models.ProxyConfig, error) {
+	db := q.defaultDB()
+	items := []*models.ProxyConfig{}
+
+	err := db.
+		Where(&models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
+			UserID:   userInfo.GetUserID(),
+			TenantID: userInfo.GetTenantID(),
+		}}).
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// MoveProxyRecords 把 proxy 在 from 上的剩余记录迁移到 to，包括停止状态的配置、流量统计、探测配置、定时配置和标签，并清理旧的运行状态
+// 换 server 时今日流量计入历史流量，新 server 上的统计从 0 开始累加
+// 统计归属于 server 的所有者，与 AdminUpdateProxyStats 一致
+func (q *queryImpl) MoveProxyRecords(userInfo models.UserInfo, name string, from, to *models.ClientEntity, toServer *models.ServerEntity) error {
+	if len(name) == 0 || from == nil || to == nil || toServer == nil {
+		return fmt.Errorf("invalid proxy name, client or server")
+	}
+
+	db := q.defaultDB()
+	return db.Transaction(func(tx *gorm.DB) error {
+		// 运行中的 proxy 已经随 client 配置重建，这里只剩停止状态的配置
+		if err := tx.Model(&models.ProxyConfig{}).
+			Where(&models.ProxyConfig{ProxyConfigEntity: &models.ProxyConfigEntity{
+				UserID:   userInfo.GetUserID(),
+				TenantID: userInfo.GetTenantID(),
+				ClientID: from.ClientID,
+				Name:     name,
+			}}).
+			Updates(map[string]any{
+				"server_id":        to.ServerID,
+				"client_id":        to.ClientID,
+				"origin_client_id": to.OriginClientID,
+			}).Error; err != nil {
+			return err
+		}
+
+		stats := &models.ProxyStats{}
+		err := tx.Where(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{
+			UserID:   userInfo.GetUserID(),
+			TenantID: userInfo.GetTenantID(),
+			ServerID: from.ServerID,
+			Name:     name,
+		}}).Or(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{
+			UserID:   0,
+			TenantID: userInfo.GetTenantID(),
+			ServerID: from.ServerID,
+			Name:     name,
+		}}).First(stats).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err == nil && from.ServerID != to.ServerID {
+			// 新 server 上可能残留同名 proxy 的统计，先删除避免重复
+			if err := tx.Where(&models.ProxyStats{ProxyStatsEntity: &models.ProxyStatsEntity{
+				UserID:   toServer.UserID,
+				ServerID: toServer.ServerID,
+				Name:     name,
+			}}).Delete(&models.ProxyStats{}).Error; err != nil {
+				return err
+			}
+		}
+		if err == nil {
+			// 同一 server 上 frps 会继续累计今日流量
+			if from.ServerID != to.ServerID {
+				stats.HistoryTrafficIn += stats.TodayTrafficIn
+				stats.HistoryTrafficOut += stats.TodayTrafficOut
+				stats.TodayTrafficIn, stats.TodayTrafficOut = 0, 0
+			}
+			stats.UserID, stats.ServerID, stats.ClientID, stats.OriginClientID = toServer.UserID, to.ServerID, to.ClientID, to.OriginClientID
+			if err := tx.Save(stats).Error; err != nil {
+				return err
+			}
+		}
+
+		labelFilter := func(clientID string) *models.Label {
+			return &models.Label{LabelEntity: &models.LabelEntity{
+				UserID:       userInfo.GetUserID(),
+				TenantID:     userInfo.GetTenantID(),
+				ResourceType: defs.LabelResourceProxy,
+				ResourceID:   clientID,
+				ResourceName: name,
+			}}
+		}
+		if err := tx.Unscoped().Where(labelFilter(to.ClientID)).Delete(&models.Label{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Label{}).Where(labelFilter(from.ClientID)).
+			Update("resource_id", to.ClientID).Error; err != nil {
+			return err
+		}
+
+		scheduleFilter := func(clientID string) *models.ProxySchedule {
+			return &models.ProxySchedule{ProxyScheduleEntity: &models.ProxyScheduleEntity{
+				UserID:    userInfo.GetUserID(),
+				TenantID:  userInfo.GetTenantID(),
+				ClientID:  clientID,
+				ProxyName: name,
+			}}
+		}
+		if err := tx.Unscoped().Where(scheduleFilter(to.ClientID)).Delete(&models.ProxySchedule{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.ProxySchedule{}).Where(scheduleFilter(from.ClientID)).
+			Updates(map[string]any{"server_id": to.ServerID, "client_id": to.ClientID}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.ProxyProbe{}).
+			Where(&models.ProxyProbe{ProxyProbeEntity: &models.ProxyProbeEntity{
+				UserID:    userInfo.GetUserID(),
+				TenantID:  userInfo.GetTenantID(),
+				ServerID:  from.ServerID,
+				ClientID:  from.ClientID,
+				ProxyName: name,
+			}}).
+			Updates(map[string]any{"server_id": to.ServerID, "client_id": to.ClientID}).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().
+			Where(&models.ProxyWorkingStatus{ProxyWorkingStatusEntity: &models.ProxyWorkingStatusEntity{
+				UserID:   userInfo.GetUserID(),
+				TenantID: userInfo.GetTenantID(),
+				ClientID: from.ClientID,
+				Name:     name,
+			}}).
+			Delete(&models.ProxyWorkingStatus{}).Error
+	})
+}
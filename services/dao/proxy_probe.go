@@ -0,0 +1,186 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
+)
+
+// UpsertProxyProbe 每个隧道只有一个探测配置，已存在时更新配置并保留探测结果
+func (q *queryImpl) UpsertProxyProbe(userInfo models.UserInfo, probe *models.ProxyProbeEntity) (*models.ProxyProbe, error) {
+	db := q.defaultDB()
+	probe.UserID = userInfo.GetUserID()
+	probe.TenantID = userInfo.GetTenantID()
+
+	item, err := q.GetProxyProbe(userInfo, probe.ServerID, probe.ClientID, probe.ProxyName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		item = &models.ProxyProbe{ProxyProbeEntity: probe}
+		if err := db.Create(item).Error; err != nil {
+			return nil, err
+		}
+		return item, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	item.Type = probe.Type
+	item.Enabled = probe.Enabled
+	item.HTTPPath = probe.HTTPPath
+	item.ExpectStatus = probe.ExpectStatus
+	item.UDPPayload = probe.UDPPayload
+	item.TimeoutMs = probe.TimeoutMs
+	item.InsecureSkipVerify = probe.InsecureSkipVerify
+	if err := db.Save(item).Error; err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (q *queryImpl) GetProxyProbe(userInfo models.UserInfo, serverID, clientID, proxyName string) (*models.ProxyProbe, error) {
+	if serverID == "" || clientID == "" || proxyName == "" {
+		return nil, fmt.Errorf("invalid server id, client id or proxy name")
+	}
+	db := q.defaultDB()
+	item := &models.ProxyProbe{}
+	err := db.Where(&models.ProxyProbe{ProxyProbeEntity: &models.ProxyProbeEntity{
+		UserID:    userInfo.GetUserID(),
+		TenantID:  userInfo.GetTenantID(),
+		ServerID:  serverID,
+		ClientID:  clientID,
+		ProxyName: proxyName,
+	}}).First(item).Error
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (q *queryImpl) DeleteProxyProbe(userInfo models.UserInfo, serverID, clientID, proxyName string) error {
+	item, err := q.GetProxyProbe(userInfo, serverID, clientID, proxyName)
+	if err != nil {
+		return err
+	}
+
+	db := q.defaultDB()
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&models.ProxyProbeResult{ProbeID: item.ID}).Delete(&models.ProxyProbeResult{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(item).Error
+	})
+}
+
+func (q *queryImpl) ListProxyProbes(userInfo models.UserInfo, page, pageSize int, filters *models.ProxyProbeEntity) ([]*models.ProxyProbe, int64, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, 0, fmt.Errorf("invalid page or page size")
+	}
+
+	filters.UserID = userInfo.GetUserID()
+	filters.TenantID = userInfo.GetTenantID()
+
+	db := q.defaultDB().Model(&models.ProxyProbe{}).Where(&models.ProxyProbe{ProxyProbeEntity: filters})
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	list := []*models.ProxyProbe{}
+	if err := db.Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+// ListProxyProbeResults 按时间倒序返回最近 limit 条探测结果
+func (q *queryImpl) ListProxyProbeResults(probeID uint, limit int) ([]*models.ProxyProbeResult, error) {
+	db := q.defaultDB()
+	list := []*models.ProxyProbeResult{}
+	err := db.Where(&models.ProxyProbeResult{ProbeID: probeID}).
+		Order("created_at desc").Limit(limit).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *queryImpl) AdminListEnabledProxyProbesByServerID(serverID string) ([]*models.ProxyProbe, error) {
+	if serverID == "" {
+		return nil, fmt.Errorf("invalid server id")
+	}
+	db := q.defaultDB()
+	list := []*models.ProxyProbe{}
+	err := db.Where(&models.ProxyProbe{ProxyProbeEntity: &models.ProxyProbeEntity{
+		ServerID: serverID,
+		Enabled:  true,
+	}}).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// AdminSaveProxyProbeResult 记录一次探测结果并更新探测配置上的最近结果，返回更新前的探测配置
+func (q *queryImpl) AdminSaveProxyProbeResult(serverID string, result *models.ProxyProbeResult) (*models.ProxyProbe, error) {
+	db := q.defaultDB()
+	old := &models.ProxyProbe{}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(&models.ProxyProbe{
+			Model:            &gorm.Model{ID: result.ProbeID},
+			ProxyProbeEntity: &models.ProxyProbeEntity{ServerID: serverID},
+		}).First(old).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(result).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.ProxyProbe{}).Where("id = ?", result.ProbeID).Updates(map[string]any{
+			"last_success":    result.Success,
+			"last_latency_ms": result.LatencyMs,
+			"last_error":      result.Error,
+			"last_check_at":   result.CreatedAt,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return old, nil
+}
+
+func (q *queryImpl) AdminDeleteProxyProbeResultsBefore(before time.Time) error {
+	db := q.defaultDB()
+	return db.Where("created_at < ?", before).Delete(&models.ProxyProbeResult{}).Error
+}
+
+func (q *queryImpl) DeleteProxyProbesByClientIDs(userInfo models.UserInfo, clientIDs []string) error {
+	if len(clientIDs) == 0 {
+		return fmt.Errorf("invalid client ids")
+	}
+	db := q.defaultDB()
+	return db.Transaction(func(tx *gorm.DB) error {
+		probeIDs := []uint{}
+		if err := tx.Model(&models.ProxyProbe{}).
+			Where(&models.ProxyProbe{ProxyProbeEntity: &models.ProxyProbeEntity{
+				UserID:   userInfo.GetUserID(),
+				TenantID: userInfo.GetTenantID(),
+			}}).
+			Where("client_id IN ?", clientIDs).
+			Pluck("id", &probeIDs).Error; err != nil {
+			return err
+		}
+		if len(probeIDs) == 0 {
+			return nil
+		}
+		if err := tx.Where("probe_id IN ?", probeIDs).Delete(&models.ProxyProbeResult{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&models.ProxyProbe{}, probeIDs).Error
+	})
+}
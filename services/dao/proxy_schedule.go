@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
+)
+
+// UpsertProxySchedule 每个隧道只有一个定时配置，已存在时覆盖 windows 和过期时间
+func (q *queryImpl) UpsertProxySchedule(userInfo models.UserInfo, schedule *models.ProxyScheduleEntity) (*models.ProxySchedule, error) {
+	db := q.defaultDB()
+	schedule.UserID = userInfo.GetUserID()
+	schedule.TenantID = userInfo.GetTenantID()
+
+	item, err := q.GetProxySchedule(userInfo, schedule.ClientID, schedule.ProxyName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		item = &models.ProxySchedule{ProxyScheduleEntity: schedule}
+		if err := db.Create(item).Error; err != nil {
+			return nil, err
+		}
+		return item, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	item.ServerID = schedule.ServerID
+	item.Windows = schedule.Windows
+	item.ExpireAt = schedule.ExpireAt
+	if err := db.Save(item).Error; err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (q *queryImpl) GetProxySchedule(userInfo models.UserInfo, clientID, proxyName string) (*models.ProxySchedule, error) {
+	if clientID == "" || proxyName == "" {
+		return nil, fmt.Errorf("invalid client id or proxy name")
+	}
+	db := q.defaultDB()
+	item := &models.ProxySchedule{}
+	err := db.Where(&models.ProxySchedule{ProxyScheduleEntity: &models.ProxyScheduleEntity{
+		UserID:    userInfo.GetUserID(),
+		TenantID:  userInfo.GetTenantID(),
+		ClientID:  clientID,
+		ProxyName: proxyName,
+	}}).First(item).Error
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (q *queryImpl) DeleteProxySchedule(userInfo models.UserInfo, clientID, proxyName string) error {
+	item, err := q.GetProxySchedule(userInfo, clientID, proxyName)
+	if err != nil {
+		return err
+	}
+	db := q.defaultDB()
+	return db.Unscoped().Delete(item).Error
+}
+
+func (q *queryImpl) DeleteProxySchedulesByClientIDs(userInfo models.UserInfo, clientIDs []string) error {
+	if len(clientIDs) == 0 {
+		return fmt.Errorf("invalid client ids")
+	}
+	db := q.defaultDB()
+	return db.Unscoped().Where(&models.ProxySchedule{ProxyScheduleEntity: &models.ProxyScheduleEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+	}}).Where("client_id IN ?", clientIDs).Delete(&models.ProxySchedule{}).Error
+}
+
+// AdminListProxySchedules master 启动时加载全部定时配置注册到调度器
+func (q *queryImpl) AdminListProxySchedules() ([]*models.ProxySchedule, error) {
+	db := q.defaultDB()
+	list := []*models.ProxySchedule{}
+	if err := db.Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *queryImpl) AdminGetProxySchedule(clientID, proxyName string) (*models.ProxySchedule, error) {
+	if clientID == "" || proxyName == "" {
+		return nil, fmt.Errorf("invalid client id or proxy name")
+	}
+	db := q.defaultDB()
+	item := &models.ProxySchedule{}
+	err := db.Where(&models.ProxySchedule{ProxyScheduleEntity: &models.ProxyScheduleEntity{
+		ClientID:  clientID,
+		ProxyName: proxyName,
+	}}).First(item).Error
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
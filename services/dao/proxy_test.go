@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func createTestProxyStats(t *testing.T, db *gorm.DB, stats *models.ProxyStatsEntity) {
+	t.Helper()
+	assert.NoError(t, db.Create(&models.ProxyStats{ProxyStatsEntity: stats}).Error)
+}
+
+func TestMoveProxyRecordsCarriesStats(t *testing.T) {
+	userInfo := &models.UserEntity{UserID: 2, TenantID: 1}
+	from := &models.ClientEntity{ClientID: "c1.s1", OriginClientID: "c1", ServerID: "s1"}
+
+	tests := []struct {
+		name string
+		to   *models.ClientEntity
+		// 迁移后目标上的统计
+		wantTodayIn, wantTodayOut     int64
+		wantHistoryIn, wantHistoryOut int64
+	}{
+		{
+			// 换 server 后今日流量计入历史，由新 server 重新累计
+			name:        "another server",
+			to:          &models.ClientEntity{ClientID: "c2.s2", OriginClientID: "c2", ServerID: "s2"},
+			wantTodayIn: 0, wantTodayOut: 0, wantHistoryIn: 110, wantHistoryOut: 220,
+		},
+		{
+			// 同一 server 上 frps 会继续累计今日流量
+			name:        "same server",
+			to:          &models.ClientEntity{ClientID: "c2.s1", OriginClientID: "c2", ServerID: "s1"},
+			wantTodayIn: 10, wantTodayOut: 20, wantHistoryIn: 100, wantHistoryOut: 200,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, db := newTestQuery(t)
+
+			createTestProxyStats(t, db, &models.ProxyStatsEntity{
+				ServerID: "s1", ClientID: from.ClientID, OriginClientID: from.OriginClientID, Name: "web",
+				UserID: 2, TenantID: 1, TodayTrafficIn: 10, TodayTrafficOut: 20, HistoryTrafficIn: 100, HistoryTrafficOut: 200,
+			})
+			// 新 server 上残留的同名统计和其他 proxy 的统计
+			createTestProxyStats(t, db, &models.ProxyStatsEntity{
+				ServerID: "s2", ClientID: "old", Name: "web", UserID: 2, TenantID: 1, HistoryTrafficIn: 999,
+			})
+			createTestProxyStats(t, db, &models.ProxyStatsEntity{
+				ServerID: "s1", ClientID: from.ClientID, Name: "other", UserID: 2, TenantID: 1, HistoryTrafficIn: 1,
+			})
+
+			assert.NoError(t, q.MoveProxyRecords(userInfo, "web", from, tt.to, &models.ServerEntity{ServerID: tt.to.ServerID, UserID: 2}))
+
+			// 换 server 时新 server 上的残留统计被删除，只剩迁移过来的一条
+			var stats []*models.ProxyStats
+			assert.NoError(t, db.Where("name = ? AND server_id = ?", "web", tt.to.ServerID).Find(&stats).Error)
+			if !assert.Len(t, stats, 1) {
+				return
+			}
+			got := stats[0]
+			assert.Equal(t, tt.to.ServerID, got.ServerID)
+			assert.Equal(t, tt.to.ClientID, got.ClientID)
+			assert.Equal(t, tt.to.OriginClientID, got.OriginClientID)
+			assert.Equal(t, tt.wantTodayIn, got.TodayTrafficIn)
+			assert.Equal(t, tt.wantTodayOut, got.TodayTrafficOut)
+			assert.Equal(t, tt.wantHistoryIn, got.HistoryTrafficIn)
+			assert.Equal(t, tt.wantHistoryOut, got.HistoryTrafficOut)
+
+			var remain int64
+			assert.NoError(t, db.Model(&models.ProxyStats{}).Where("name = ? AND client_id = ?", "web", from.ClientID).Count(&remain).Error)
+			assert.Zero(t, remain)
+
+			other := &models.ProxyStats{}
+			assert.NoError(t, db.Where("name = ?", "other").First(other).Error)
+			assert.Equal(t, "s1", other.ServerID)
+			assert.Equal(t, from.ClientID, other.ClientID)
+		})
+	}
+}
+
+func TestMoveProxyRecordsWithoutStats(t *testing.T) {
+	q, db := newTestQuery(t)
+	userInfo := &models.UserEntity{UserID: 2, TenantID: 1}
+
+	from := &models.ClientEntity{ClientID: "c1", ServerID: "s1"}
+	to := &models.ClientEntity{ClientID: "c2", ServerID: "s2"}
+	assert.NoError(t, q.MoveProxyRecords(userInfo, "web", from, to, &models.ServerEntity{ServerID: "s2", UserID: 2}))
+
+	var count int64
+	assert.NoError(t, db.Model(&models.ProxyStats{}).Count(&count).Error)
+	assert.Zero(t, count)
+
+	assert.Error(t, q.MoveProxyRecords(userInfo, "", from, to, &models.ServerEntity{ServerID: "s2"}))
+}
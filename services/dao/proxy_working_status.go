@@ -0,0 +1,185 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+// AdminSyncProxyWorkingStatus 用一次上报覆盖 clientIDs 下所有 proxy 的状态，没有上报的 proxy 会被删除
+// 只写入内容变化的记录，其余记录只批量更新上报时间，返回 status 或 err 发生变化的记录
+func (q *queryImpl) AdminSyncProxyWorkingStatus(clientIDs []string, inputs []*models.ProxyWorkingStatusEntity) ([]*models.ProxyWorkingStatusEntity, error) {
+	if len(clientIDs) == 0 {
+		return nil, fmt.Errorf("invalid client ids")
+	}
+
+	changed := []*models.ProxyWorkingStatusEntity{}
+	db := q.defaultDB()
+	err := db.Transaction(func(tx *gorm.DB) error {
+		oldList := []*models.ProxyWorkingStatus{}
+		if err := tx.Where("client_id IN ?", clientIDs).Find(&oldList).Error; err != nil {
+			return err
+		}
+		oldMap := lo.SliceToMap(oldList, func(item *models.ProxyWorkingStatus) (string, *models.ProxyWorkingStatus) {
+			return item.ClientID + "/" + item.Name, item
+		})
+
+		now := time.Now()
+		results := []*models.ProxyWorkingStatus{}
+		reportedIDs := []uint{}
+		for _, input := range inputs {
+			key := input.ClientID + "/" + input.Name
+			item := &models.ProxyWorkingStatus{ProxyWorkingStatusEntity: input}
+			item.LastReportAt = now
+			item.LastChangeAt = now
+
+			if old, ok := oldMap[key]; ok {
+				delete(oldMap, key)
+				// 内容没有变化时只需要更新上报时间，统一在后面批量更新
+				if old.SameReport(input) {
+					reportedIDs = append(reportedIDs, old.ID)
+					continue
+				}
+				item.Model = old.Model
+				if old.Status == input.Status && old.Err == input.Err {
+					item.LastChangeAt = old.LastChangeAt
+				} else {
+					changed = append(changed, input)
+				}
+			} else {
+				changed = append(changed, input)
+			}
+			results = append(results, item)
+		}
+
+		if len(oldMap) > 0 {
+			if err := tx.Unscoped().Delete(&models.ProxyWorkingStatus{},
+				lo.Map(lo.Values(oldMap), func(item *models.ProxyWorkingStatus, _ int) uint {
+					return item.ID
+				})).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(reportedIDs) > 0 {
+			if err := tx.Model(&models.ProxyWorkingStatus{}).Where("id IN ?", reportedIDs).
+				UpdateColumn("last_report_at", now).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(results) > 0 {
+			return tx.Save(results).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+func (q *queryImpl) proxyWorkingStatusQuery(userInfo models.UserInfo, filters *models.ProxyWorkingStatusEntity, keyword string) *gorm.DB {
+	filters.UserID = userInfo.GetUserID()
+	filters.TenantID = userInfo.GetTenantID()
+
+	db := q.defaultDB().Model(&models.ProxyWorkingStatus{})
+	if len(filters.OriginClientID) > 0 {
+		originClientID := filters.OriginClientID
+		filters.OriginClientID = ""
+		db = db.Where(q.defaultDB().Where("client_id = ?", originClientID).Or("origin_client_id = ?", originClientID))
+	}
+	if len(keyword) > 0 {
+		db = db.Where("name like ?", "%"+keyword+"%")
+	}
+
+	staleBefore := time.Now().Add(-defs.ProxyStatusStaleDuration)
+	switch filters.Status {
+	case "":
+	case defs.ProxyStatusUnknown:
+		filters.Status = ""
+		db = db.Where("last_report_at < ?", staleBefore)
+	default:
+		db = db.Where("last_report_at >= ?", staleBefore)
+	}
+
+	return db.Where(&models.ProxyWorkingStatus{ProxyWorkingStatusEntity: filters})
+}
+
+func (q *queryImpl) ListProxyWorkingStatusWithFilters(userInfo models.UserInfo, page, pageSize int, filters *models.ProxyWorkingStatusEntity, keyword string) ([]*models.ProxyWorkingStatus, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	list := []*models.ProxyWorkingStatus{}
+	err := q.proxyWorkingStatusQuery(userInfo, filters, keyword).
+		Order("client_id, name").
+		Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (q *queryImpl) CountProxyWorkingStatusWithFilters(userInfo models.UserInfo, filters *models.ProxyWorkingStatusEntity, keyword string) (int64, error) {
+	var count int64
+	err := q.proxyWorkingStatusQuery(userInfo, filters, keyword).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountProxyWorkingStatusByStatus 按状态统计 proxy 数量，超时未上报的计入 defs.ProxyStatusUnknown
+func (q *queryImpl) CountProxyWorkingStatusByStatus(userInfo models.UserInfo, filters *models.ProxyWorkingStatusEntity, keyword string) (map[string]int32, error) {
+	type statusCount struct {
+		Status string
+		Count  int32
+	}
+
+	staleBefore := time.Now().Add(-defs.ProxyStatusStaleDuration)
+	rows := []statusCount{}
+	err := q.proxyWorkingStatusQuery(userInfo, lo.ToPtr(*filters), keyword).
+		Where("last_report_at >= ?", staleBefore).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var staleCount int64
+	err = q.proxyWorkingStatusQuery(userInfo, lo.ToPtr(*filters), keyword).
+		Where("last_report_at < ?", staleBefore).
+		Count(&staleCount).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := lo.SliceToMap(rows, func(item statusCount) (string, int32) {
+		return item.Status, item.Count
+	})
+	if staleCount > 0 {
+		counts[defs.ProxyStatusUnknown] += int32(staleCount)
+	}
+	return counts, nil
+}
+
+func (q *queryImpl) DeleteProxyWorkingStatusByClientIDOrOriginClientID(userInfo models.UserInfo, clientID string) error {
+	if clientID == "" {
+		return fmt.Errorf("invalid client id")
+	}
+	db := q.defaultDB()
+	return db.Unscoped().
+		Where(&models.ProxyWorkingStatus{ProxyWorkingStatusEntity: &models.ProxyWorkingStatusEntity{
+			UserID:   userInfo.GetUserID(),
+			TenantID: userInfo.GetTenantID(),
+		}}).
+		Where(db.Where("client_id = ?", clientID).Or("origin_client_id = ?", clientID)).
+		Delete(&models.ProxyWorkingStatus{}).Error
+}
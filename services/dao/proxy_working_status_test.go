@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminSyncProxyWorkingStatusWritesOnlyChangedRows(t *testing.T) {
+	q, db := newTestQuery(t)
+
+	report := func(status string) []*models.ProxyWorkingStatusEntity {
+		return []*models.ProxyWorkingStatusEntity{
+			{ClientID: "c1", Name: "a", ServerID: "s1", Status: "running"},
+			{ClientID: "c1", Name: "b", ServerID: "s1", Status: status},
+		}
+	}
+
+	changed, err := q.AdminSyncProxyWorkingStatus([]string{"c1"}, report("running"))
+	assert.NoError(t, err)
+	assert.Len(t, changed, 2)
+
+	first := map[string]*models.ProxyWorkingStatus{}
+	var rows []*models.ProxyWorkingStatus
+	assert.NoError(t, db.Find(&rows).Error)
+	for _, r := range rows {
+		first[r.Name] = r
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	changed, err = q.AdminSyncProxyWorkingStatus([]string{"c1"}, report("start error"))
+	assert.NoError(t, err)
+	assert.Len(t, changed, 1)
+	assert.Equal(t, "b", changed[0].Name)
+
+	rows = nil
+	assert.NoError(t, db.Find(&rows).Error)
+	assert.Len(t, rows, 2)
+	for _, r := range rows {
+		assert.True(t, r.LastReportAt.After(first[r.Name].LastReportAt), "last report time of [%s] should be refreshed", r.Name)
+		if r.Name == "a" {
+			// 未变化的记录没有被整行保存，updated_at 保持不变
+			assert.True(t, r.LastChangeAt.Equal(first["a"].LastChangeAt))
+			assert.True(t, r.UpdatedAt.Equal(first["a"].UpdatedAt))
+		} else {
+			assert.Equal(t, "start error", r.Status)
+			assert.True(t, r.LastChangeAt.After(first["b"].LastChangeAt))
+		}
+	}
+
+	// 没有上报的 proxy 被删除
+	changed, err = q.AdminSyncProxyWorkingStatus([]string{"c1"}, report("start error")[:1])
+	assert.NoError(t, err)
+	assert.Empty(t, changed)
+	var count int64
+	assert.NoError(t, db.Model(&models.ProxyWorkingStatus{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+)
+
+func (q *queryImpl) AdminCreatePTYSession(session *models.PTYSession) error {
+	db := q.defaultDB()
+	return db.Create(session).Error
+}
+
+func (q *queryImpl) AdminUpdatePTYSession(session *models.PTYSession) error {
+	db := q.defaultDB()
+	return db.Save(session).Error
+}
+
+func (q *queryImpl) GetPTYSession(userInfo models.UserInfo, sessionID string) (*models.PTYSession, error) {
+	if len(sessionID) == 0 {
+		return nil, fmt.Errorf("invalid session id")
+	}
+
+	db := q.defaultDB()
+	session := &models.PTYSession{}
+	if err := db.Where(&models.PTYSession{
+		SessionID: sessionID,
+		UserID:    uint32(userInfo.GetUserID()),
+		TenantID:  uint32(userInfo.GetTenantID()),
+	}).First(session).Error; err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (q *queryImpl) AdminGetPTYSession(sessionID string) (*models.PTYSession, error) {
+	if len(sessionID) == 0 {
+		return nil, fmt.Errorf("invalid session id")
+	}
+
+	db := q.defaultDB()
+	session := &models.PTYSession{}
+	if err := db.Where(&models.PTYSession{SessionID: sessionID}).First(session).Error; err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (q *queryImpl) ListPTYSessions(userInfo models.UserInfo, clientID string, page, pageSize int) ([]*models.PTYSession, error) {
+	return q.listPTYSessions(&models.PTYSession{
+		ClientID: clientID,
+		UserID:   uint32(userInfo.GetUserID()),
+		TenantID: uint32(userInfo.GetTenantID()),
+	}, page, pageSize)
+}
+
+func (q *queryImpl) CountPTYSessions(userInfo models.UserInfo, clientID string) (int64, error) {
+	return q.countPTYSessions(&models.PTYSession{
+		ClientID: clientID,
+		UserID:   uint32(userInfo.GetUserID()),
+		TenantID: uint32(userInfo.GetTenantID()),
+	})
+}
+
+func (q *queryImpl) AdminListPTYSessions(clientID string, page, pageSize int) ([]*models.PTYSession, error) {
+	return q.listPTYSessions(&models.PTYSession{ClientID: clientID}, page, pageSize)
+}
+
+func (q *queryImpl) AdminCountPTYSessions(clientID string) (int64, error) {
+	return q.countPTYSessions(&models.PTYSession{ClientID: clientID})
+}
+
+func (q *queryImpl) listPTYSessions(cond *models.PTYSession, page, pageSize int) ([]*models.PTYSession, error) {
+	if page < 1 || pageSize < 1 || pageSize > 100 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	db := q.defaultDB()
+	offset := (page - 1) * pageSize
+
+	var sessions []*models.PTYSession
+	if err := db.Where(cond).Order("started_at desc").Offset(offset).Limit(pageSize).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (q *queryImpl) countPTYSessions(cond *models.PTYSession) (int64, error) {
+	db := q.defaultDB()
+	var count int64
+	if err := db.Model(&models.PTYSession{}).Where(cond).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// AdminListPTYSessionsBefore 返回在 before 之前结束的会话，用于清理过期录像
+func (q *queryImpl) AdminListPTYSessionsBefore(before time.Time) ([]*models.PTYSession, error) {
+	db := q.defaultDB()
+	var sessions []*models.PTYSession
+	if err := db.Where("started_at < ?", before).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (q *queryImpl) AdminDeletePTYSessions(ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	db := q.defaultDB()
+	return db.Unscoped().Where("id IN ?", ids).Delete(&models.PTYSession{}).Error
+}
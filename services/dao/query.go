@@ -1,6 +1,11 @@
 package dao
 
-import "github.com/VaalaCat/frp-panel/services/app"
+import (
+	"context"
+
+	"github.com/VaalaCat/frp-panel/services/app"
+	"gorm.io/gorm"
+)
 
 type Query interface{}
 
@@ -13,3 +18,8 @@ func NewQuery(ctx *app.Context) *queryImpl {
 		ctx: ctx,
 	}
 }
+
+// defaultDB 带上调用方的 context 用于链路追踪，请求结束后查询不会被取消
+func (q *queryImpl) defaultDB() *gorm.DB {
+	return q.ctx.GetApp().GetDBManager().GetDefaultDB().WithContext(context.WithoutCancel(q.ctx))
+}
@@ -7,10 +7,11 @@ import (
 	"github.com/VaalaCat/frp-panel/models"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 func (q *queryImpl) InitDefaultServer(serverIP string) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	db.Where(&models.Server{
 		ServerEntity: &models.ServerEntity{
 			ServerID: defs.DefaultServerID,
@@ -25,7 +26,7 @@ func (q *queryImpl) InitDefaultServer(serverIP string) {
 }
 
 func (q *queryImpl) GetDefaultServer() (*models.ServerEntity, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	c := &models.Server{}
 	err := db.
 		Where(&models.Server{ServerEntity: &models.ServerEntity{
@@ -39,7 +40,7 @@ func (q *queryImpl) GetDefaultServer() (*models.ServerEntity, error) {
 }
 
 func (q *queryImpl) UpdateDefaultServer(c *models.Server) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	c.ServerID = defs.DefaultServerID
 	err := db.Where(&models.Server{
 		ServerEntity: &models.ServerEntity{
@@ -55,7 +56,7 @@ func (q *queryImpl) ValidateServerSecret(serverID string, secret string) (*model
 	if serverID == "" || secret == "" {
 		return nil, fmt.Errorf("invalid request")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	c := &models.Server{}
 	err := db.
 		Where(&models.Server{ServerEntity: &models.ServerEntity{
@@ -75,7 +76,7 @@ func (q *queryImpl) AdminGetServerByServerID(serverID string) (*models.ServerEnt
 	if serverID == "" {
 		return nil, fmt.Errorf("invalid server id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	c := &models.Server{}
 	err := db.
 		Where(&models.Server{ServerEntity: &models.ServerEntity{
@@ -95,7 +96,7 @@ func (q *queryImpl) GetServerByServerID(userInfo models.UserInfo, serverID strin
 	if userInfo.GetUserID() == defs.DefaultAdminUserID && serverID == defs.DefaultServerID {
 		return q.GetDefaultServer()
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	c := &models.Server{}
 	err := db.
 		Where(&models.Server{ServerEntity: &models.ServerEntity{
@@ -116,7 +117,7 @@ func (q *queryImpl) CreateServer(userInfo models.UserInfo, server *models.Server
 	c := &models.Server{
 		ServerEntity: server,
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Create(c).Error
 }
 
@@ -124,7 +125,7 @@ func (q *queryImpl) DeleteServer(userInfo models.UserInfo, serverID string) erro
 	if serverID == "" {
 		return fmt.Errorf("invalid server id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Unscoped().Where(
 		&models.Server{
 			ServerEntity: &models.ServerEntity{
@@ -146,7 +147,7 @@ func (q *queryImpl) UpdateServer(userInfo models.UserInfo, server *models.Server
 	if userInfo.GetUserID() == defs.DefaultAdminUserID && server.ServerID == defs.DefaultServerID {
 		return q.UpdateDefaultServer(c)
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Where(
 		&models.Server{
 			ServerEntity: &models.ServerEntity{
@@ -162,7 +163,7 @@ func (q *queryImpl) ListServers(userInfo models.UserInfo, page, pageSize int) ([
 		return nil, fmt.Errorf("invalid page or page size")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	offset := (page - 1) * pageSize
 
 	var servers []*models.Server
@@ -187,12 +188,38 @@ func (q *queryImpl) ListServers(userInfo models.UserInfo, page, pageSize int) ([
 	}), nil
 }
 
+// GetAllServers 返回用户的所有 server，包含默认 server
+func (q *queryImpl) GetAllServers(userInfo models.UserInfo) ([]*models.ServerEntity, error) {
+	db := q.defaultDB()
+
+	var servers []*models.Server
+	err := db.Where(
+		&models.Server{
+			ServerEntity: &models.ServerEntity{
+				UserID:   userInfo.GetUserID(),
+				TenantID: userInfo.GetTenantID(),
+			},
+		},
+	).Or(&models.Server{
+		ServerEntity: &models.ServerEntity{
+			ServerID: defs.DefaultServerID,
+		},
+	}).Find(&servers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(servers, func(c *models.Server, _ int) *models.ServerEntity {
+		return c.ServerEntity
+	}), nil
+}
+
 func (q *queryImpl) ListServersWithKeyword(userInfo models.UserInfo, page, pageSize int, keyword string) ([]*models.ServerEntity, error) {
 	if page < 1 || pageSize < 1 || len(keyword) == 0 {
 		return nil, fmt.Errorf("invalid page or page size or keyword")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	offset := (page - 1) * pageSize
 
 	var servers []*models.Server
@@ -215,7 +242,7 @@ func (q *queryImpl) ListServersWithKeyword(userInfo models.UserInfo, page, pageS
 }
 
 func (q *queryImpl) CountServers(userInfo models.UserInfo) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Server{}).Where(
 		&models.Server{
@@ -232,7 +259,7 @@ func (q *queryImpl) CountServers(userInfo models.UserInfo) (int64, error) {
 }
 
 func (q *queryImpl) CountServersWithKeyword(userInfo models.UserInfo, keyword string) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Server{}).Where(
 		&models.Server{
@@ -248,8 +275,55 @@ func (q *queryImpl) CountServersWithKeyword(userInfo models.UserInfo, keyword st
 	return count, nil
 }
 
+// ListServersWithLabelSelector keyword 为空时只按 selector 过滤，与 ListServers 一样包含默认 server
+func (q *queryImpl) ListServersWithLabelSelector(userInfo models.UserInfo, page, pageSize int, keyword string, selector models.LabelSelector) ([]*models.ServerEntity, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	db := q.defaultDB()
+	offset := (page - 1) * pageSize
+
+	var servers []*models.Server
+	err := q.serversWithLabelSelector(db, userInfo, keyword, selector).
+		Offset(offset).Limit(pageSize).Find(&servers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(servers, func(c *models.Server, _ int) *models.ServerEntity {
+		return c.ServerEntity
+	}), nil
+}
+
+func (q *queryImpl) CountServersWithLabelSelector(userInfo models.UserInfo, keyword string, selector models.LabelSelector) (int64, error) {
+	db := q.defaultDB()
+	var count int64
+	err := q.serversWithLabelSelector(db.Model(&models.Server{}), userInfo, keyword, selector).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *queryImpl) serversWithLabelSelector(db *gorm.DB, userInfo models.UserInfo, keyword string, selector models.LabelSelector) *gorm.DB {
+	owned := q.defaultDB().Where(&models.Server{ServerEntity: &models.ServerEntity{
+		UserID:   userInfo.GetUserID(),
+		TenantID: userInfo.GetTenantID(),
+	}}).Or(&models.Server{ServerEntity: &models.ServerEntity{
+		ServerID: defs.DefaultServerID,
+	}})
+
+	db = db.Where(owned).
+		Scopes(q.labelSelectorScope(userInfo, defs.LabelResourceServer, selector, "servers.server_id", ""))
+	if len(keyword) > 0 {
+		db = db.Where("server_id like ?", "%"+keyword+"%")
+	}
+	return db
+}
+
 func (q *queryImpl) CountConfiguredServers(userInfo models.UserInfo) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Server{}).Where(
 		&models.Server{
@@ -12,7 +12,7 @@ const (
 )
 
 func (q *queryImpl) AdminSaveTodyStats(s *models.HistoryProxyStats) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Save(s).Error
 }
 
@@ -32,7 +32,7 @@ func (q *queryImpl) AdminMSaveTodyStats(tx *gorm.DB, s []*models.HistoryProxySta
 }
 
 func (q *queryImpl) GetHistoryStatsByProxyID(userInfo models.UserInfo, proxyID int) ([]*models.HistoryProxyStats, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var stats []*models.HistoryProxyStats
 	err := db.Where(&models.HistoryProxyStats{
 		ProxyID:  proxyID,
@@ -46,7 +46,7 @@ func (q *queryImpl) GetHistoryStatsByProxyID(userInfo models.UserInfo, proxyID i
 }
 
 func (q *queryImpl) GetHistoryStatsByClientID(userInfo models.UserInfo, clientID string) ([]*models.HistoryProxyStats, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var stats []*models.HistoryProxyStats
 	err := db.Where(&models.HistoryProxyStats{
 		ClientID: clientID,
@@ -60,7 +60,7 @@ func (q *queryImpl) GetHistoryStatsByClientID(userInfo models.UserInfo, clientID
 }
 
 func (q *queryImpl) GetHistoryStatsByServerID(userInfo models.UserInfo, serverID string) ([]*models.HistoryProxyStats, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var stats []*models.HistoryProxyStats
 	err := db.Where(&models.HistoryProxyStats{
 		ServerID: serverID,
@@ -9,7 +9,7 @@ import (
 )
 
 func (q *queryImpl) AdminGetAllUsers() ([]*models.UserEntity, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	users := make([]*models.User, 0)
 	err := db.Find(&users).Error
 	if err != nil {
@@ -22,7 +22,7 @@ func (q *queryImpl) AdminGetAllUsers() ([]*models.UserEntity, error) {
 }
 
 func (q *queryImpl) AdminCountUsers() (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.User{}).Count(&count).Error
 	if err != nil {
@@ -35,7 +35,7 @@ func (q *queryImpl) GetUserByUserID(userID int) (*models.UserEntity, error) {
 	if userID == 0 {
 		return nil, fmt.Errorf("invalid user id")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	u := &models.User{}
 	err := db.Where(&models.User{
 		UserEntity: &models.UserEntity{
@@ -49,7 +49,7 @@ func (q *queryImpl) GetUserByUserID(userID int) (*models.UserEntity, error) {
 }
 
 func (q *queryImpl) UpdateUser(userInfo models.UserInfo, user *models.UserEntity) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	user.UserID = userInfo.GetUserID()
 	return db.Model(&models.User{}).Where(
 		&models.User{
@@ -63,7 +63,7 @@ func (q *queryImpl) UpdateUser(userInfo models.UserInfo, user *models.UserEntity
 }
 
 func (q *queryImpl) AdminUpdateUser(userInfo models.UserInfo, user *models.UserEntity) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	user.UserID = userInfo.GetUserID()
 	return db.Model(&models.User{}).Where(
 		&models.User{
@@ -80,7 +80,7 @@ func (q *queryImpl) GetUserByUserName(userName string) (*models.UserEntity, erro
 	if userName == "" {
 		return nil, fmt.Errorf("invalid user name")
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	u := &models.User{}
 	err := db.Where(&models.User{
 		UserEntity: &models.UserEntity{
@@ -95,7 +95,7 @@ func (q *queryImpl) GetUserByUserName(userName string) (*models.UserEntity, erro
 
 func (q *queryImpl) CheckUserPassword(userNameOrEmail, password string) (bool, models.UserInfo, error) {
 	var user models.User
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 
 	if err := db.Where(&models.User{
 		UserEntity: &models.UserEntity{
@@ -113,7 +113,7 @@ func (q *queryImpl) CheckUserPassword(userNameOrEmail, password string) (bool, m
 
 func (q *queryImpl) CheckUserNameAndEmail(userName, email string) error {
 	var user models.User
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 
 	if err := db.Where(&models.User{
 		UserEntity: &models.UserEntity{
@@ -133,6 +133,11 @@ func (q *queryImpl) CreateUser(user *models.UserEntity) error {
 	u := &models.User{
 		UserEntity: user,
 	}
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Create(u).Error
 }
+
+func (q *queryImpl) AdminUpdateUserPTYDisabled(userID int, disabled bool) error {
+	db := q.defaultDB()
+	return db.Model(&models.User{}).Where("user_id = ?", userID).Update("pty_disabled", disabled).Error
+}
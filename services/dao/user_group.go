@@ -16,7 +16,7 @@ func (q *queryImpl) CreateGroup(userInfo models.UserInfo, groupID, groupName, co
 		return nil, fmt.Errorf("only admin can create group")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 
 	g := &models.UserGroup{
 		TenantID:  userInfo.GetTenantID(),
@@ -37,7 +37,7 @@ func (q *queryImpl) DeleteGroup(userInfo models.UserInfo, groupID string) error
 		return fmt.Errorf("only admin can delete group")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	return db.Unscoped().Where(&models.UserGroup{
 		TenantID: userInfo.GetTenantID(),
 		GroupID:  groupID,
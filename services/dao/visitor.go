@@ -0,0 +1,268 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/VaalaCat/frp-panel/models"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// RebuildVisitorConfigFromClient rebuild visitor from client
+// skip stopped visitor
+func (q *queryImpl) RebuildVisitorConfigFromClient(userInfo models.UserInfo, client *models.Client) error {
+	db := q.defaultDB()
+
+	visitorCfgs, err := utils.LoadVisitorsFromContent(client.ConfigContent)
+	if err != nil {
+		return err
+	}
+
+	visitorConfigEntities := []*models.VisitorConfig{}
+
+	for _, visitorCfg := range visitorCfgs {
+		item := &models.VisitorConfig{
+			VisitorConfigEntity: &models.VisitorConfigEntity{},
+		}
+		if oldVisitorCfg, err := q.GetVisitorConfigByOriginClientIDAndName(userInfo, client.ClientID, visitorCfg.GetBaseConfig().Name); err == nil {
+			logger.Logger(context.Background()).Warnf("visitor config already exist, will be override, clientID: [%s], name: [%s]",
+				client.ClientID, visitorCfg.GetBaseConfig().Name)
+			item.Model = oldVisitorCfg.Model
+		}
+
+		if err := item.FillClientConfig(client.ClientEntity); err != nil {
+			return err
+		}
+
+		if err := item.FillTypedVisitorConfig(visitorCfg); err != nil {
+			return err
+		}
+
+		visitorConfigEntities = append(visitorConfigEntities, item)
+	}
+
+	if err := q.DeleteVisitorConfigsByClientIDOrOriginClientID(userInfo, client.ClientID); err != nil {
+		return err
+	}
+
+	if len(visitorConfigEntities) == 0 {
+		return nil
+	}
+
+	return db.Save(visitorConfigEntities).Error
+}
+
+func (q *queryImpl) GetVisitorConfigByFilter(userInfo models.UserInfo, visitorConfig *models.VisitorConfigEntity) (*models.VisitorConfig, error) {
+	db := q.defaultDB()
+	filter := &models.VisitorConfigEntity{}
+
+	if len(visitorConfig.ClientID) != 0 {
+		filter.ClientID = visitorConfig.ClientID
+	}
+	if len(visitorConfig.OriginClientID) != 0 {
+		filter.OriginClientID = visitorConfig.OriginClientID
+	}
+	if len(visitorConfig.Name) != 0 {
+		filter.Name = visitorConfig.Name
+	}
+	if len(visitorConfig.Type) != 0 {
+		filter.Type = visitorConfig.Type
+	}
+	if len(visitorConfig.ServerID) != 0 {
+		filter.ServerID = visitorConfig.ServerID
+	}
+
+	filter.UserID = userInfo.GetUserID()
+	filter.TenantID = userInfo.GetTenantID()
+
+	respVisitorCfg := &models.VisitorConfig{}
+	err := db.
+		Where(&models.VisitorConfig{VisitorConfigEntity: filter}).
+		First(respVisitorCfg).Error
+	if err != nil {
+		return nil, err
+	}
+	return respVisitorCfg, nil
+}
+
+func (q *queryImpl) GetVisitorConfigByOriginClientIDAndName(userInfo models.UserInfo, clientID string, name string) (*models.VisitorConfig, error) {
+	if clientID == "" || name == "" {
+		return nil, fmt.Errorf("invalid client id or name")
+	}
+	db := q.defaultDB()
+	item := &models.VisitorConfig{}
+	err := db.
+		Where(&models.VisitorConfig{VisitorConfigEntity: &models.VisitorConfigEntity{
+			UserID:         userInfo.GetUserID(),
+			TenantID:       userInfo.GetTenantID(),
+			OriginClientID: clientID,
+			Name:           name,
+		}}).
+		First(&item).Error
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (q *queryImpl) ListVisitorConfigsWithFilters(userInfo models.UserInfo, page, pageSize int, filters *models.VisitorConfigEntity) ([]*models.VisitorConfig, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	db := q.defaultDB()
+	offset := (page - 1) * pageSize
+
+	filters.UserID = userInfo.GetUserID()
+	filters.TenantID = userInfo.GetTenantID()
+
+	var visitorConfigs []*models.VisitorConfig
+	err := db.Where(&models.VisitorConfig{
+		VisitorConfigEntity: filters,
+	}).Offset(offset).Limit(pageSize).Find(&visitorConfigs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return visitorConfigs, nil
+}
+
+// GetAllVisitorConfigs 返回用户的所有 visitor 配置
+func (q *queryImpl) GetAllVisitorConfigs(userInfo models.UserInfo) ([]*models.VisitorConfig, error) {
+	db := q.defaultDB()
+	items := []*models.VisitorConfig{}
+
+	err := db.
+		Where(&models.VisitorConfig{VisitorConfigEntity: &models.VisitorConfigEntity{
+			UserID:   userInfo.GetUserID(),
+			TenantID: userInfo.GetTenantID(),
+		}}).
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (q *queryImpl) ListVisitorConfigsWithFiltersAndKeyword(userInfo models.UserInfo, page, pageSize int, filters *models.VisitorConfigEntity, keyword string) ([]*models.VisitorConfig, error) {
+	if page < 1 || pageSize < 1 || len(keyword) == 0 {
+		return nil, fmt.Errorf("invalid page or page size or keyword")
+	}
+
+	db := q.defaultDB()
+	offset := (page - 1) * pageSize
+
+	filters.UserID = userInfo.GetUserID()
+	filters.TenantID = userInfo.GetTenantID()
+
+	var visitorConfigs []*models.VisitorConfig
+	err := db.Where(&models.VisitorConfig{
+		VisitorConfigEntity: filters,
+	}).Where("name like ?", "%"+keyword+"%").Offset(offset).Limit(pageSize).Find(&visitorConfigs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return visitorConfigs, nil
+}
+
+func (q *queryImpl) CountVisitorConfigsWithFilters(userInfo models.UserInfo, filters *models.VisitorConfigEntity) (int64, error) {
+	db := q.defaultDB()
+	filters.UserID = userInfo.GetUserID()
+	filters.TenantID = userInfo.GetTenantID()
+
+	var count int64
+	err := db.Model(&models.VisitorConfig{}).Where(&models.VisitorConfig{
+		VisitorConfigEntity: filters,
+	}).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *queryImpl) CountVisitorConfigsWithFiltersAndKeyword(userInfo models.UserInfo, filters *models.VisitorConfigEntity, keyword string) (int64, error) {
+	if len(keyword) == 0 {
+		return q.CountVisitorConfigsWithFilters(userInfo, filters)
+	}
+
+	db := q.defaultDB()
+	filters.UserID = userInfo.GetUserID()
+	filters.TenantID = userInfo.GetTenantID()
+
+	var count int64
+	err := db.Model(&models.VisitorConfig{}).Where(&models.VisitorConfig{
+		VisitorConfigEntity: filters,
+	}).Where("name like ?", "%"+keyword+"%").Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *queryImpl) UpdateVisitorConfig(userInfo models.UserInfo, visitorCfg *models.VisitorConfig) error {
+	if visitorCfg.Model == nil || visitorCfg.ID == 0 {
+		return fmt.Errorf("invalid visitor config id")
+	}
+	db := q.defaultDB()
+	visitorCfg.UserID = userInfo.GetUserID()
+	visitorCfg.TenantID = userInfo.GetTenantID()
+	return db.Where(&models.VisitorConfig{
+		VisitorConfigEntity: &models.VisitorConfigEntity{
+			UserID:   userInfo.GetUserID(),
+			TenantID: userInfo.GetTenantID(),
+		},
+	}).Select("*").Updates(visitorCfg).Error
+}
+
+func (q *queryImpl) DeleteVisitorConfig(userInfo models.UserInfo, clientID, name string) error {
+	if clientID == "" || name == "" {
+		return fmt.Errorf("invalid client id or name")
+	}
+	db := q.defaultDB()
+	return db.Unscoped().
+		Where(&models.VisitorConfig{VisitorConfigEntity: &models.VisitorConfigEntity{
+			UserID:   userInfo.GetUserID(),
+			TenantID: userInfo.GetTenantID(),
+			ClientID: clientID,
+			Name:     name,
+		}}).
+		Delete(&models.VisitorConfig{}).Error
+}
+
+func (q *queryImpl) DeleteVisitorConfigsByClientIDOrOriginClientID(userInfo models.UserInfo, clientID string) error {
+	if clientID == "" {
+		return fmt.Errorf("invalid client id")
+	}
+	db := q.defaultDB()
+	return db.Unscoped().
+		Where(
+			db.Where(&models.VisitorConfig{VisitorConfigEntity: &models.VisitorConfigEntity{
+				UserID:   userInfo.GetUserID(),
+				TenantID: userInfo.GetTenantID(),
+				ClientID: clientID,
+			}}).
+				Or(&models.VisitorConfig{VisitorConfigEntity: &models.VisitorConfigEntity{
+					UserID:         userInfo.GetUserID(),
+					TenantID:       userInfo.GetTenantID(),
+					OriginClientID: clientID,
+				}})).
+		Where(db.Where("stopped is NULL").
+			Or("stopped = ?", false)).
+		Delete(&models.VisitorConfig{}).Error
+}
+
+func (q *queryImpl) DeleteVisitorConfigsByClientID(userInfo models.UserInfo, clientID string) error {
+	if clientID == "" {
+		return fmt.Errorf("invalid client id")
+	}
+	db := q.defaultDB()
+	return db.Unscoped().
+		Where(&models.VisitorConfig{VisitorConfigEntity: &models.VisitorConfigEntity{
+			UserID:   userInfo.GetUserID(),
+			TenantID: userInfo.GetTenantID(),
+			ClientID: clientID,
+		}}).
+		Delete(&models.VisitorConfig{}).Error
+}
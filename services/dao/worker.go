@@ -3,11 +3,13 @@ package dao
 import (
 	"fmt"
 
+	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
 )
 
 func (q *queryImpl) CreateWorker(userInfo models.UserInfo, worker *models.Worker) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 
 	worker.UserId = uint32(userInfo.GetUserID())
 	worker.TenantId = uint32(userInfo.GetTenantID())
@@ -18,7 +20,7 @@ func (q *queryImpl) CreateWorker(userInfo models.UserInfo, worker *models.Worker
 }
 
 func (q *queryImpl) DeleteWorker(userInfo models.UserInfo, workerID string) error {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 
 	return db.Unscoped().Where(&models.Worker{
 		WorkerEntity: &models.WorkerEntity{
@@ -37,7 +39,7 @@ func (q *queryImpl) UpdateWorker(userInfo models.UserInfo, worker *models.Worker
 		return fmt.Errorf("invalid worker id")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 
 	if err := db.Unscoped().Model(&models.Worker{
 		WorkerEntity: &models.WorkerEntity{
@@ -59,7 +61,7 @@ func (q *queryImpl) UpdateWorker(userInfo models.UserInfo, worker *models.Worker
 }
 
 func (q *queryImpl) GetWorkerByWorkerID(userInfo models.UserInfo, workerID string) (*models.Worker, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	w := &models.Worker{}
 	err := db.Where(&models.Worker{
 		WorkerEntity: &models.WorkerEntity{
@@ -79,7 +81,7 @@ func (q *queryImpl) ListWorkers(userInfo models.UserInfo, page, pageSize int) ([
 		return nil, fmt.Errorf("invalid page or page size")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	offset := (page - 1) * pageSize
 
 	var workers []*models.Worker
@@ -96,8 +98,26 @@ func (q *queryImpl) ListWorkers(userInfo models.UserInfo, page, pageSize int) ([
 	return workers, nil
 }
 
+// GetAllWorkers 返回用户的所有 worker，包含部署的 client
+func (q *queryImpl) GetAllWorkers(userInfo models.UserInfo) ([]*models.Worker, error) {
+	db := q.defaultDB()
+
+	var workers []*models.Worker
+	err := db.Where(&models.Worker{
+		WorkerEntity: &models.WorkerEntity{
+			UserId:   uint32(userInfo.GetUserID()),
+			TenantId: uint32(userInfo.GetTenantID()),
+		},
+	}).Preload("Clients").Find(&workers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return workers, nil
+}
+
 func (q *queryImpl) AdminListWorkersByClientID(clientID string) ([]*models.Worker, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	client, err := q.AdminGetClientByClientID(clientID)
 	if err != nil {
 		return nil, err
@@ -116,7 +136,7 @@ func (q *queryImpl) ListWorkersWithKeyword(userInfo models.UserInfo, page, pageS
 		return nil, fmt.Errorf("invalid page or page size or keyword")
 	}
 
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	offset := (page - 1) * pageSize
 
 	var workers []*models.Worker
@@ -135,7 +155,7 @@ func (q *queryImpl) ListWorkersWithKeyword(userInfo models.UserInfo, page, pageS
 }
 
 func (q *queryImpl) CountWorkers(userInfo models.UserInfo) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Worker{}).Where(&models.Worker{
 		WorkerEntity: &models.WorkerEntity{
@@ -150,7 +170,7 @@ func (q *queryImpl) CountWorkers(userInfo models.UserInfo) (int64, error) {
 }
 
 func (q *queryImpl) CountWorkersWithKeyword(userInfo models.UserInfo, keyword string) (int64, error) {
-	db := q.ctx.GetApp().GetDBManager().GetDefaultDB()
+	db := q.defaultDB()
 	var count int64
 	err := db.Model(&models.Worker{}).Where("name like ?", "%"+keyword+"%").
 		Where(&models.Worker{
@@ -164,3 +184,44 @@ func (q *queryImpl) CountWorkersWithKeyword(userInfo models.UserInfo, keyword st
 	}
 	return count, nil
 }
+
+// ListWorkersWithLabelSelector keyword 为空时只按 selector 过滤
+func (q *queryImpl) ListWorkersWithLabelSelector(userInfo models.UserInfo, page, pageSize int, keyword string, selector models.LabelSelector) ([]*models.Worker, error) {
+	if page < 1 || pageSize < 1 || pageSize > 100 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	db := q.defaultDB()
+	offset := (page - 1) * pageSize
+
+	var workers []*models.Worker
+	err := q.workersWithLabelSelector(db, userInfo, keyword, selector).
+		Offset(offset).Limit(pageSize).Preload("Clients").Find(&workers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return workers, nil
+}
+
+func (q *queryImpl) CountWorkersWithLabelSelector(userInfo models.UserInfo, keyword string, selector models.LabelSelector) (int64, error) {
+	db := q.defaultDB()
+	var count int64
+	err := q.workersWithLabelSelector(db.Model(&models.Worker{}), userInfo, keyword, selector).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *queryImpl) workersWithLabelSelector(db *gorm.DB, userInfo models.UserInfo, keyword string, selector models.LabelSelector) *gorm.DB {
+	db = db.Where(&models.Worker{WorkerEntity: &models.WorkerEntity{
+		UserId:   uint32(userInfo.GetUserID()),
+		TenantId: uint32(userInfo.GetTenantID()),
+	}}).
+		Scopes(q.labelSelectorScope(userInfo, defs.LabelResourceWorker, selector, "workers.id", ""))
+	if len(keyword) > 0 {
+		db = db.Where("name like ?", "%"+keyword+"%")
+	}
+	return db
+}
@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/models"
+)
+
+func (q *queryImpl) AdminCreateWorkerCronInvocations(invocations []*models.WorkerCronInvocation) error {
+	if len(invocations) == 0 {
+		return nil
+	}
+
+	db := q.defaultDB()
+	return db.Create(invocations).Error
+}
+
+func (q *queryImpl) ListWorkerCronInvocations(userInfo models.UserInfo, workerID, cronID string, page, pageSize int) ([]*models.WorkerCronInvocation, error) {
+	if page < 1 || pageSize < 1 || pageSize > 100 {
+		return nil, fmt.Errorf("invalid page or page size")
+	}
+
+	db := q.defaultDB()
+	offset := (page - 1) * pageSize
+
+	var invocations []*models.WorkerCronInvocation
+	err := db.Where(&models.WorkerCronInvocation{
+		WorkerID: workerID,
+		CronID:   cronID,
+		UserID:   uint32(userInfo.GetUserID()),
+		TenantID: uint32(userInfo.GetTenantID()),
+	}).Order("triggered_at desc").Offset(offset).Limit(pageSize).Find(&invocations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return invocations, nil
+}
+
+func (q *queryImpl) CountWorkerCronInvocations(userInfo models.UserInfo, workerID, cronID string) (int64, error) {
+	db := q.defaultDB()
+	var count int64
+	err := db.Model(&models.WorkerCronInvocation{}).Where(&models.WorkerCronInvocation{
+		WorkerID: workerID,
+		CronID:   cronID,
+		UserID:   uint32(userInfo.GetUserID()),
+		TenantID: uint32(userInfo.GetTenantID()),
+	}).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (q *queryImpl) AdminDeleteWorkerCronInvocationsBefore(before time.Time) error {
+	db := q.defaultDB()
+	return db.Unscoped().Where("triggered_at < ?", before).Delete(&models.WorkerCronInvocation{}).Error
+}
@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"github.com/VaalaCat/frp-panel/models"
+	"gorm.io/gorm"
+)
+
+// AdminListWorkerKVEntries 返回 namespace 中未删除的 key
+func (q *queryImpl) AdminListWorkerKVEntries(namespace string) ([]*models.WorkerKVEntry, error) {
+	db := q.defaultDB()
+	var entries []*models.WorkerKVEntry
+	if err := db.Where("namespace = ? AND deleted = ?", namespace, false).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// AdminMergeWorkerKVEntries 按 key 合并 client 上传的快照，返回 client 需要更新的 key
+// tenant 范围的 namespace 会被多个 client 同时同步，不能整体替换
+func (q *queryImpl) AdminMergeWorkerKVEntries(namespace string, entries []*models.WorkerKVEntry) ([]*models.WorkerKVEntry, error) {
+	db := q.defaultDB()
+	var toClient []*models.WorkerKVEntry
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var stored []*models.WorkerKVEntry
+		if err := tx.Where("namespace = ?", namespace).Find(&stored).Error; err != nil {
+			return err
+		}
+
+		var toSave []*models.WorkerKVEntry
+		toSave, toClient = models.MergeWorkerKVEntries(stored, entries)
+		for _, e := range toSave {
+			if err := tx.Save(e).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toClient, nil
+}
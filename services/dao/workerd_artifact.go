@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"github.com/VaalaCat/frp-panel/models"
+)
+
+func (q *queryImpl) AdminCreateWorkerdArtifact(artifact *models.WorkerdArtifact) error {
+	db := q.defaultDB()
+	return db.Create(artifact).Error
+}
+
+func (q *queryImpl) AdminGetWorkerdArtifact(id uint) (*models.WorkerdArtifact, error) {
+	db := q.defaultDB()
+	artifact := &models.WorkerdArtifact{}
+	if err := db.Where("id = ?", id).First(artifact).Error; err != nil {
+		return nil, err
+	}
+	return artifact, nil
+}
+
+// AdminGetLatestWorkerdArtifact 返回指定平台最近上传的 workerd
+func (q *queryImpl) AdminGetLatestWorkerdArtifact(os, arch string) (*models.WorkerdArtifact, error) {
+	db := q.defaultDB()
+	artifact := &models.WorkerdArtifact{}
+	if err := db.Where(&models.WorkerdArtifact{OS: os, Arch: arch}).Order("id desc").First(artifact).Error; err != nil {
+		return nil, err
+	}
+	return artifact, nil
+}
+
+func (q *queryImpl) AdminListWorkerdArtifacts(os, arch string) ([]*models.WorkerdArtifact, error) {
+	db := q.defaultDB()
+	var artifacts []*models.WorkerdArtifact
+	if err := db.Where(&models.WorkerdArtifact{OS: os, Arch: arch}).Order("id desc").Find(&artifacts).Error; err != nil {
+		return nil, err
+	}
+	return artifacts, nil
+}
+
+func (q *queryImpl) AdminDeleteWorkerdArtifact(id uint) error {
+	db := q.defaultDB()
+	return db.Unscoped().Where("id = ?", id).Delete(&models.WorkerdArtifact{}).Error
+}
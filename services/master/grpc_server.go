@@ -7,6 +7,10 @@ import (
 	"net"
 
 	"github.com/VaalaCat/frp-panel/biz/master/client"
+	"github.com/VaalaCat/frp-panel/biz/master/file"
+	"github.com/VaalaCat/frp-panel/biz/master/notify"
+	"github.com/VaalaCat/frp-panel/biz/master/probe"
+	"github.com/VaalaCat/frp-panel/biz/master/proxy"
 	masterserver "github.com/VaalaCat/frp-panel/biz/master/server"
 	"github.com/VaalaCat/frp-panel/biz/master/shell"
 	"github.com/VaalaCat/frp-panel/biz/master/streamlog"
@@ -18,6 +22,7 @@ import (
 	"github.com/VaalaCat/frp-panel/services/dao"
 	"github.com/VaalaCat/frp-panel/services/rpc"
 	"github.com/VaalaCat/frp-panel/utils/logger"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
@@ -53,7 +58,7 @@ func (s *server) ListClientWorkers(ctx context.Context, req *pb.ListClientWorker
 func newRpcServer(appInstance app.Application, creds credentials.TransportCredentials) *grpc.Server {
 	// s := grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
 	// s := grpc.NewServer(grpc.Creds(creds))
-	s := grpc.NewServer(grpc.Creds(creds))
+	s := grpc.NewServer(grpc.Creds(creds), grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	pb.RegisterMasterServer(s, &server{
 		appInstance: appInstance,
 	})
@@ -97,7 +102,11 @@ func (s *server) ServerSend(sender pb.Master_ServerSendServer) error {
 	ctx := app.NewContext(context.Background(), s.appInstance)
 
 	logger.Logger(ctx).Infof("server get a client connected")
-	var done chan bool
+	var (
+		done    chan bool
+		cliType string
+		cliID   string
+	)
 	for {
 		req, err := sender.Recv()
 		if err == io.EOF {
@@ -110,8 +119,6 @@ func (s *server) ServerSend(sender pb.Master_ServerSendServer) error {
 			return err
 		}
 
-		cliType := ""
-
 		if req.GetEvent() == pb.Event_EVENT_REGISTER_CLIENT || req.GetEvent() == pb.Event_EVENT_REGISTER_SERVER {
 			if len(req.GetSecret()) == 0 {
 				logger.Logger(ctx).Errorf("rpc auth token is empty")
@@ -164,6 +171,7 @@ func (s *server) ServerSend(sender pb.Master_ServerSendServer) error {
 				}
 			}
 
+			cliID = req.GetClientId()
 			s.appInstance.GetClientsManager().Set(req.GetClientId(), cliType, sender)
 			done = rpc.Recv(s.appInstance, req.GetClientId())
 			sender.Send(&pb.ServerMessage{
@@ -176,6 +184,11 @@ func (s *server) ServerSend(sender pb.Master_ServerSendServer) error {
 		}
 	}
 	<-done
+
+	// 节点重连后旧连接才结束时不视为下线
+	if conn := s.appInstance.GetClientsManager().Get(cliID); conn == nil || conn.Conn == sender {
+		notify.NodeOffline(ctx, cliType, cliID)
+	}
 	return nil
 }
 
@@ -196,3 +209,49 @@ func (s *server) PushServerStreamLog(sender pb.Master_PushServerStreamLogServer)
 func (s *server) PTYConnect(sender pb.Master_PTYConnectServer) error {
 	return shell.PTYConnect(app.NewContext(context.Background(), s.appInstance), sender)
 }
+
+func (s *server) FileTransfer(sender pb.Master_FileTransferServer) error {
+	return file.FileTransfer(app.NewContext(context.Background(), s.appInstance), sender)
+}
+
+// PushWorkerCronInvocations implements pb.MasterServer.
+func (s *server) PushWorkerCronInvocations(ctx context.Context, req *pb.PushWorkerCronInvocationsReq) (*pb.PushWorkerCronInvocationsResp, error) {
+	logger.Logger(ctx).Infof("push worker cron invocations, clientID: [%s], count: [%d]", req.GetBase().GetClientId(), len(req.GetInvocations()))
+	return worker.PushWorkerCronInvocations(app.NewContext(ctx, s.appInstance), req)
+}
+
+// PushWorkerKV implements pb.MasterServer.
+func (s *server) PushWorkerKV(ctx context.Context, req *pb.PushWorkerKVReq) (*pb.PushWorkerKVResp, error) {
+	logger.Logger(ctx).Infof("push worker kv, clientID: [%s], workerID: [%s], binding: [%s]", req.GetBase().GetClientId(), req.GetWorkerId(), req.GetBinding())
+	return worker.PushWorkerKV(app.NewContext(ctx, s.appInstance), req)
+}
+
+// PullWorkerKV implements pb.MasterServer.
+func (s *server) PullWorkerKV(ctx context.Context, req *pb.PullWorkerKVReq) (*pb.PullWorkerKVResp, error) {
+	logger.Logger(ctx).Infof("pull worker kv, clientID: [%s], workerID: [%s], binding: [%s]", req.GetBase().GetClientId(), req.GetWorkerId(), req.GetBinding())
+	return worker.PullWorkerKV(app.NewContext(ctx, s.appInstance), req)
+}
+
+// PushWorkerStatus implements pb.MasterServer.
+func (s *server) PushWorkerStatus(ctx context.Context, req *pb.PushWorkerStatusReq) (*pb.PushWorkerStatusResp, error) {
+	logger.Logger(ctx).Infof("push worker status, clientID: [%s], workerID: [%s], status: [%s]", req.GetBase().GetClientId(), req.GetWorkerId(), req.GetStatus())
+	return worker.PushWorkerStatus(app.NewContext(ctx, s.appInstance), req)
+}
+
+// PushProxyStatus implements pb.MasterServer.
+func (s *server) PushProxyStatus(ctx context.Context, req *pb.PushProxyStatusReq) (*pb.PushProxyStatusResp, error) {
+	logger.Logger(ctx).Infof("push proxy status, clientID: [%s], count: [%d]", req.GetBase().GetClientId(), len(req.GetStatuses()))
+	return proxy.PushProxyStatus(app.NewContext(ctx, s.appInstance), req)
+}
+
+// PullProxyProbes implements pb.MasterServer.
+func (s *server) PullProxyProbes(ctx context.Context, req *pb.PullProxyProbesReq) (*pb.PullProxyProbesResp, error) {
+	logger.Logger(ctx).Infof("pull proxy probes, serverID: [%s]", req.GetBase().GetServerId())
+	return probe.PullProxyProbes(app.NewContext(ctx, s.appInstance), req)
+}
+
+// PushProxyProbeResults implements pb.MasterServer.
+func (s *server) PushProxyProbeResults(ctx context.Context, req *pb.PushProxyProbeResultsReq) (*pb.PushProxyProbeResultsResp, error) {
+	logger.Logger(ctx).Infof("push proxy probe results, serverID: [%s], count: [%d]", req.GetBase().GetServerId(), len(req.GetResults()))
+	return probe.PushProxyProbeResults(app.NewContext(ctx, s.appInstance), req)
+}
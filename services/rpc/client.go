@@ -8,6 +8,7 @@ import (
 	"github.com/VaalaCat/frp-panel/common"
 	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/telemetry"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
@@ -29,6 +30,15 @@ func CallClientWrapper[R common.RespType](c *app.Context, clientID string, event
 }
 
 func CallClient(ctx *app.Context, clientID string, event pb.Event, msg proto.Message) (*pb.ClientMessage, error) {
+	spanCtx, span := telemetry.StartCallClientSpan(ctx, clientID, event)
+	defer span.End()
+
+	resp, err := callClient(ctx, clientID, event, msg, telemetry.Inject(spanCtx))
+	telemetry.RecordError(span, err)
+	return resp, err
+}
+
+func callClient(ctx *app.Context, clientID string, event pb.Event, msg proto.Message, traceContext map[string]string) (*pb.ClientMessage, error) {
 	sender := ctx.GetApp().GetClientsManager().Get(clientID)
 	if sender == nil {
 		logger.Logger(ctx).Errorf("cannot get client, id: [%s]", clientID)
@@ -42,10 +52,12 @@ func CallClient(ctx *app.Context, clientID string, event pb.Event, msg proto.Mes
 	}
 
 	req := &pb.ServerMessage{
-		Event:     event,
-		Data:      data,
-		SessionId: uuid.New().String(),
-		ClientId:  clientID,
+		Event:        event,
+		Data:         data,
+		SessionId:    uuid.New().String(),
+		ClientId:     clientID,
+		TraceId:      logger.GetTraceID(ctx),
+		TraceContext: traceContext,
 	}
 
 	ctx.GetApp().GetClientRecvMap().Store(req.SessionId, make(chan *pb.ClientMessage))
@@ -14,6 +14,7 @@ import (
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/VaalaCat/frp-panel/utils/wsgrpc"
 	"github.com/imroc/req/v3"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/proto"
@@ -44,7 +45,7 @@ func newMasterCli(appInstance app.Application) pb.MasterClient {
 	connInfo := conf.GetRPCConnInfo(appInstance.GetConfig())
 	ctx := context.Background()
 
-	opt := []grpc.DialOption{}
+	opt := []grpc.DialOption{grpc.WithStatsHandler(otelgrpc.NewClientHandler())}
 
 	switch connInfo.Scheme {
 	case conf.GRPC:
@@ -161,3 +162,75 @@ func GetClient(cfg conf.Config, clientID, joinToken string) (*pb.GetClientRespon
 	}
 	return resp, nil
 }
+
+func ImportConfig(cfg conf.Config, token string, importReq *pb.ImportConfigRequest) (*pb.ImportConfigResponse, error) {
+	resp := &pb.ImportConfigResponse{}
+	if err := CallMasterAPI(cfg, token, "/api/v1/migrate/import", importReq, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ExportConfig(cfg conf.Config, token string, exportReq *pb.ExportConfigRequest) (*pb.ExportConfigResponse, error) {
+	resp := &pb.ExportConfigResponse{}
+	if err := CallMasterAPI(cfg, token, "/api/v1/migrate/export", exportReq, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ExportArchive(cfg conf.Config, token string, exportReq *pb.ExportArchiveRequest) (*pb.ExportArchiveResponse, error) {
+	resp := &pb.ExportArchiveResponse{}
+	if err := CallMasterAPI(cfg, token, "/api/v1/migrate/export_archive", exportReq, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func ApplyManifest(cfg conf.Config, token string, applyReq *pb.ApplyManifestRequest) (*pb.ApplyManifestResponse, error) {
+	resp := &pb.ApplyManifestResponse{}
+	if err := CallMasterAPI(cfg, token, "/api/v1/migrate/apply", applyReq, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type apiResponse interface {
+	proto.Message
+	GetStatus() *pb.Status
+}
+
+// CallMasterAPI 使用 token 以 protobuf 格式调用 master api，返回非成功状态时转为 error
+func CallMasterAPI(cfg conf.Config, token, path string, apiReq proto.Message, apiResp apiResponse) error {
+	apiEndpoint := conf.GetAPIURL(cfg)
+	c := httpCli()
+
+	rawReq, err := proto.Marshal(apiReq)
+	if err != nil {
+		return err
+	}
+
+	r, err := c.R().SetHeader("Content-Type", "application/x-protobuf").
+		SetHeader(defs.AuthorizationKey, token).
+		SetBodyBytes(rawReq).Post(apiEndpoint + path)
+	if err != nil {
+		return err
+	}
+
+	if err := proto.Unmarshal(r.Bytes(), apiResp); err != nil && r.IsSuccessState() {
+		return err
+	}
+
+	status := apiResp.GetStatus()
+	if !r.IsSuccessState() {
+		if len(status.GetMessage()) > 0 {
+			return errors.New(status.GetMessage())
+		}
+		return fmt.Errorf("request failed, http status: [%s]", r.Status)
+	}
+	// 部分 handler 成功时不返回 status
+	if status != nil && status.GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		return errors.New(status.GetMessage())
+	}
+	return nil
+}
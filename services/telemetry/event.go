@@ -0,0 +1,31 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartCallClientSpan master 下发事件给节点时使用
+func StartCallClientSpan(ctx context.Context, clientID string, event pb.Event) (context.Context, trace.Span) {
+	return StartSpan(ctx, "CallClient "+event.String(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("frpp.client_id", clientID),
+			attribute.String("frpp.event", event.String()),
+		))
+}
+
+// StartServerMessageSpan 节点处理 master 下发的事件时使用，父节点为 master 侧的 CallClient span
+func StartServerMessageSpan(ctx context.Context, req *pb.ServerMessage) (context.Context, trace.Span) {
+	ctx = Extract(ctx, req.GetTraceContext())
+	return Tracer().Start(ctx, "HandleServerMessage "+req.GetEvent().String(),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("frpp.client_id", req.GetClientId()),
+			attribute.String("frpp.event", req.GetEvent().String()),
+			attribute.String("frpp.session_id", req.GetSessionId()),
+		))
+}
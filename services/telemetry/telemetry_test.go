@@ -0,0 +1,111 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/gin-gonic/gin"
+	"github.com/glebarez/sqlite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/gorm"
+)
+
+// setupTestTracer 使用内存中的 recorder 替换全局的 TracerProvider，测试结束后恢复
+func setupTestTracer(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	oldProvider, oldPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(oldProvider)
+		otel.SetTextMapPropagator(oldPropagator)
+	})
+	return recorder
+}
+
+func spanNames(spans []sdktrace.ReadOnlySpan) []string {
+	return lo.Map(spans, func(s sdktrace.ReadOnlySpan, _ int) string { return s.Name() })
+}
+
+func TestCallClientSpanPropagatesToNode(t *testing.T) {
+	recorder := setupTestTracer(t)
+
+	ctx, callSpan := StartCallClientSpan(context.Background(), "c1", pb.Event_EVENT_UPDATE_FRPC)
+	carrier := Inject(ctx)
+	callSpan.End()
+	assert.NotEmpty(t, carrier["traceparent"])
+
+	_, handleSpan := StartServerMessageSpan(context.Background(), &pb.ServerMessage{
+		Event:        pb.Event_EVENT_UPDATE_FRPC,
+		ClientId:     "c1",
+		TraceContext: carrier,
+	})
+	handleSpan.End()
+
+	spans := recorder.Ended()
+	assert.Equal(t, []string{"CallClient EVENT_UPDATE_FRPC", "HandleServerMessage EVENT_UPDATE_FRPC"}, spanNames(spans))
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+	assert.Contains(t, spans[1].Attributes(), attribute.String("frpp.client_id", "c1"))
+}
+
+func TestGormPluginOnlyTracesWithParentSpan(t *testing.T) {
+	recorder := setupTestTracer(t)
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.Use(NewGormPlugin()))
+
+	type item struct {
+		ID   uint
+		Name string
+	}
+	assert.NoError(t, db.AutoMigrate(&item{}))
+
+	// 没有父 span 的后台查询不记录
+	assert.NoError(t, db.Create(&item{Name: "a"}).Error)
+	assert.Empty(t, recorder.Ended())
+
+	ctx, parent := StartSpan(context.Background(), "parent")
+	assert.NoError(t, db.WithContext(ctx).Where("name = ?", "a").First(&item{}).Error)
+	parent.End()
+
+	spans := recorder.Ended()
+	assert.Equal(t, []string{"gorm.query", "parent"}, spanNames(spans))
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("db.sql.table", "items"))
+}
+
+func TestGinMiddlewareNamesSpanByRoute(t *testing.T) {
+	recorder := setupTestTracer(t)
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(GinMiddleware())
+	router.GET("/api/v1/client/:id", func(c *gin.Context) {
+		// handler 中通过 gin.Context 创建的 span 挂在请求 span 下
+		_, span := StartSpan(c, "handler")
+		span.End()
+		c.Status(http.StatusInternalServerError)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/client/c1", nil))
+
+	spans := recorder.Ended()
+	assert.Equal(t, []string{"handler", "GET /api/v1/client/:id"}, spanNames(spans))
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Contains(t, spans[1].Attributes(), attribute.Int("http.response.status_code", http.StatusInternalServerError))
+	assert.Equal(t, "Error", spans[1].Status().Code.String())
+}
@@ -2,10 +2,12 @@ package watcher
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/VaalaCat/frp-panel/utils/logger"
 	"github.com/go-co-op/gocron/v2"
+	"github.com/samber/lo"
 )
 
 type Client interface {
@@ -13,6 +15,14 @@ type Client interface {
 	Stop()
 	AddDurationTask(time.Duration, any, ...any) error
 	AddCronTask(string, any, ...any) error
+	// AddTaggedCronTask 添加带标签的 cron 任务，支持 5 位和带秒的 6 位，表达式错误时只返回 error，不会退出进程
+	AddTaggedCronTask(tag string, cron string, function any, parameters ...any) error
+	AddTaggedDurationTask(tag string, duration time.Duration, function any, parameters ...any) error
+	// AddTaggedOneTimeTask 在 at 执行一次，at 已经过去时立即执行
+	AddTaggedOneTimeTask(tag string, at time.Time, function any, parameters ...any) error
+	RemoveTaggedTasks(tag string)
+	// NextTaggedRun 返回带该标签的任务中最近的下一次执行时间，没有任务时返回 false
+	NextTaggedRun(tag string) (time.Time, bool)
 }
 
 type client struct {
@@ -51,6 +61,78 @@ func (c *client) AddCronTask(cron string, function any, parameters ...any) error
 	return err
 }
 
+func (c *client) AddTaggedDurationTask(tag string, duration time.Duration, function any, parameters ...any) error {
+	_, err := c.s.NewJob(
+		gocron.DurationJob(duration),
+		gocron.NewTask(function, parameters...),
+		gocron.WithTags(tag),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		logger.Logger(context.Background()).WithError(err).Errorf("create tagged task error, tag: [%s]", tag)
+	}
+	return err
+}
+
+func (c *client) AddTaggedCronTask(tag string, cron string, function any, parameters ...any) error {
+	_, err := c.s.NewJob(
+		gocron.CronJob(cron, cronWithSeconds(cron)),
+		gocron.NewTask(function, parameters...),
+		gocron.WithTags(tag),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		logger.Logger(context.Background()).WithError(err).Errorf("create tagged task error, tag: [%s], cron: [%s]", tag, cron)
+	}
+	return err
+}
+
+// cronWithSeconds 去掉 CRON_TZ= 或 TZ= 时区前缀后有 6 个字段即为带秒的 cron
+func cronWithSeconds(cron string) bool {
+	fields := strings.Fields(cron)
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		fields = fields[1:]
+	}
+	return len(fields) == 6
+}
+
+func (c *client) AddTaggedOneTimeTask(tag string, at time.Time, function any, parameters ...any) error {
+	startAt := gocron.OneTimeJobStartImmediately()
+	if at.After(time.Now()) {
+		startAt = gocron.OneTimeJobStartDateTime(at)
+	}
+	_, err := c.s.NewJob(
+		gocron.OneTimeJob(startAt),
+		gocron.NewTask(function, parameters...),
+		gocron.WithTags(tag),
+	)
+	if err != nil {
+		logger.Logger(context.Background()).WithError(err).Errorf("create tagged task error, tag: [%s], at: [%s]", tag, at)
+	}
+	return err
+}
+
+func (c *client) RemoveTaggedTasks(tag string) {
+	c.s.RemoveByTags(tag)
+}
+
+func (c *client) NextTaggedRun(tag string) (time.Time, bool) {
+	var next time.Time
+	for _, job := range c.s.Jobs() {
+		if !lo.Contains(job.Tags(), tag) {
+			continue
+		}
+		run, err := job.NextRun()
+		if err != nil || run.IsZero() {
+			continue
+		}
+		if next.IsZero() || run.Before(next) {
+			next = run
+		}
+	}
+	return next, !next.IsZero()
+}
+
 func (c *client) Run() {
 	ctx := context.Background()
 	logger.Logger(ctx).Infof("start to run scheduler")
@@ -0,0 +1,24 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddTaggedCronTask(t *testing.T) {
+	c := NewClient()
+	defer c.Stop()
+
+	for _, cron := range []string{
+		"0 9 * * *",
+		"0 0 9 * * *",
+		"CRON_TZ=Asia/Shanghai 0 9 * * *",
+		"CRON_TZ=Asia/Shanghai 30 0 9 * * *",
+	} {
+		assert.NoError(t, c.AddTaggedCronTask("test", cron, func() {}), cron)
+	}
+	for _, cron := range []string{"", "0 9 * *", "0 0 0 9 * * *", "61 * * * *"} {
+		assert.Error(t, c.AddTaggedCronTask("test", cron, func() {}), cron)
+	}
+}
@@ -0,0 +1,165 @@
+package workerd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/watcher"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func CronTaskTag(workerId string) string {
+	return defs.WorkerCronTaskTagPrefix + workerId
+}
+
+// ScheduleWorkerCrons 将 worker 的定时触发注册到 client 的任务调度器，重复调用会覆盖之前的注册
+func ScheduleWorkerCrons(ctx *app.Context, scheduler watcher.Client, worker *pb.Worker) error {
+	if scheduler == nil {
+		return nil
+	}
+
+	scheduler.RemoveTaggedTasks(CronTaskTag(worker.GetWorkerId()))
+
+	var lastErr error
+	for _, cron := range worker.GetCrons() {
+		// 自定义模板中没有 scheduled shim，调用会一直失败，不注册
+		if cron.GetType() == pb.WorkerCron_TRIGGER_TYPE_SCHEDULED && !IsDefaultConfigTemplate(worker.GetConfigTemplate()) {
+			lastErr = fmt.Errorf("scheduled cron trigger is not supported with custom config template, cronId: [%s]", cron.GetId())
+			logger.Logger(ctx).WithError(lastErr).Errorf("skip worker cron, workerId: [%s]", worker.GetWorkerId())
+			continue
+		}
+		if err := scheduler.AddTaggedCronTask(CronTaskTag(worker.GetWorkerId()), cron.GetCron(),
+			RunWorkerCron, ctx.GetApp(), worker, cron); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("schedule worker cron failed, workerId: [%s], cronId: [%s], cron: [%s]",
+				worker.GetWorkerId(), cron.GetId(), cron.GetCron())
+			lastErr = err
+			continue
+		}
+		logger.Logger(ctx).Infof("schedule worker cron success, workerId: [%s], cronId: [%s], cron: [%s]",
+			worker.GetWorkerId(), cron.GetId(), cron.GetCron())
+	}
+
+	return lastErr
+}
+
+func UnscheduleWorkerCrons(scheduler watcher.Client, workerId string) {
+	if scheduler == nil {
+		return
+	}
+	scheduler.RemoveTaggedTasks(CronTaskTag(workerId))
+}
+
+// RunWorkerCron 由调度器调用，执行一次定时触发并将结果上报给 master
+func RunWorkerCron(appInstance app.Application, worker *pb.Worker, cron *pb.WorkerCron) {
+	ctx := app.NewContext(context.Background(), appInstance)
+
+	invocation := InvokeWorkerCron(ctx, worker, cron)
+	if !invocation.GetSuccess() {
+		logger.Logger(ctx).Warnf("worker cron invocation failed, workerId: [%s], cronId: [%s], status: [%d], err: [%s]",
+			worker.GetWorkerId(), cron.GetId(), invocation.GetStatusCode(), invocation.GetError())
+	}
+
+	cfg := appInstance.GetConfig()
+	resp, err := appInstance.GetMasterCli().Call().PushWorkerCronInvocations(ctx, &pb.PushWorkerCronInvocationsReq{
+		Base: &pb.ClientBase{
+			ClientId:     cfg.Client.ID,
+			ClientSecret: cfg.Client.Secret,
+		},
+		Invocations: []*pb.WorkerCronInvocation{invocation},
+	})
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("push worker cron invocation failed, workerId: [%s], cronId: [%s]", worker.GetWorkerId(), cron.GetId())
+		return
+	}
+	if resp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		logger.Logger(ctx).Errorf("push worker cron invocation failed, workerId: [%s], cronId: [%s], resp: [%s]",
+			worker.GetWorkerId(), cron.GetId(), resp.GetStatus().GetMessage())
+	}
+}
+
+// InvokeWorkerCron 通过 worker 的 socket 调用 scheduled handler 或 POST 到配置的 path
+func InvokeWorkerCron(ctx *app.Context, worker *pb.Worker, cron *pb.WorkerCron) *pb.WorkerCronInvocation {
+	startAt := time.Now()
+	invocation := &pb.WorkerCronInvocation{
+		WorkerId:    lo.ToPtr(worker.GetWorkerId()),
+		ClientId:    lo.ToPtr(ctx.GetApp().GetConfig().Client.ID),
+		CronId:      lo.ToPtr(cron.GetId()),
+		Cron:        lo.ToPtr(cron.GetCron()),
+		TriggeredAt: lo.ToPtr(startAt.UnixMilli()),
+	}
+
+	timeout := defs.WorkerCronDefaultTimeout
+	if cron.GetTimeoutSeconds() > 0 {
+		timeout = time.Duration(cron.GetTimeoutSeconds()) * time.Second
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := newWorkerCronRequest(reqCtx, ctx.GetApp().GetConfig().Client.Secret, worker, cron)
+	if err != nil {
+		invocation.Error = lo.ToPtr(err.Error())
+		invocation.DurationMs = lo.ToPtr(time.Since(startAt).Milliseconds())
+		return invocation
+	}
+
+	socketPath := WorkerSocketPath(worker)
+	httpCli := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+
+	resp, err := httpCli.Do(req)
+	if err != nil {
+		invocation.Error = lo.ToPtr(err.Error())
+		invocation.DurationMs = lo.ToPtr(time.Since(startAt).Milliseconds())
+		return invocation
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, defs.WorkerCronResultMaxBytes))
+	if err != nil {
+		invocation.Error = lo.ToPtr(err.Error())
+	}
+
+	invocation.DurationMs = lo.ToPtr(time.Since(startAt).Milliseconds())
+	invocation.StatusCode = lo.ToPtr(int32(resp.StatusCode))
+	invocation.Result = lo.ToPtr(string(body))
+	invocation.Success = lo.ToPtr(err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300)
+	return invocation
+}
+
+func newWorkerCronRequest(ctx context.Context, clientSecret string, worker *pb.Worker, cron *pb.WorkerCron) (*http.Request, error) {
+	switch cron.GetType() {
+	case pb.WorkerCron_TRIGGER_TYPE_SCHEDULED:
+		reqUrl := fmt.Sprintf("http://%s%s?cron=%s", worker.GetWorkerId(), defs.WorkerScheduledPath, url.QueryEscape(cron.GetCron()))
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(defs.WorkerScheduledTokenHeader, ScheduledToken(clientSecret, worker.GetWorkerId()))
+		return req, nil
+	case pb.WorkerCron_TRIGGER_TYPE_HTTP:
+		path := cron.GetPath()
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		return http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("http://%s%s", worker.GetWorkerId(), path), nil)
+	default:
+		return nil, fmt.Errorf("unsupported cron trigger type: %s", cron.GetType().String())
+	}
+}
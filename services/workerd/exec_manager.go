@@ -5,9 +5,10 @@ package workerd
 import (
 	"context"
 	"os/exec"
-	"syscall"
 	"time"
 
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
@@ -18,92 +19,180 @@ type workerExecManager struct {
 	//用于外层循坏的退出
 	signMap *utils.SyncMap[string, bool]
 	//用于执行cancel函数
-	chanMap *utils.SyncMap[string, chan struct{}]
+	chanMap *utils.SyncMap[string, *execCmd]
 	// 可执行文件路径
 	binaryPath string
 	// 默认参数
 	defaultArgs []string
+	// 进程运行状态
+	statusMap *utils.SyncMap[string, defs.WorkerStatus]
+	// cgroup 根目录，为空时不使用 cgroup
+	cgroupRoot string
+	// 进程进入 crash loop 或被 OOM 杀死时回调
+	statusReporter StatusReporter
+}
+
+// execCmd 正在运行的命令，exit 通知退出，released 在进程退出且 id 被释放后关闭
+type execCmd struct {
+	exit     chan struct{}
+	released chan struct{}
+}
+
+type ExecManagerOpt func(*workerExecManager)
+
+// WithCgroupRoot 设置用于限制 workerd 资源的 cgroup v2 目录
+func WithCgroupRoot(root string) ExecManagerOpt {
+	return func(m *workerExecManager) {
+		m.cgroupRoot = root
+	}
+}
+
+// WithStatusReporter 设置进程状态变化的回调，在进入 crash loop 和被 OOM 杀死后不再重启时调用
+func WithStatusReporter(reporter StatusReporter) ExecManagerOpt {
+	return func(m *workerExecManager) {
+		m.statusReporter = reporter
+	}
 }
 
 // var ExecManager *execManager
 
-func NewExecManager(binPath string, defaultArgs []string) app.WorkerExecManager {
+func NewExecManager(binPath string, defaultArgs []string, opts ...ExecManagerOpt) app.WorkerExecManager {
 
 	if len(defaultArgs) == 0 {
 		defaultArgs = []string{"--watch", "--verbose"}
 	}
 
-	return &workerExecManager{
+	m := &workerExecManager{
 		signMap:     new(utils.SyncMap[string, bool]),
-		chanMap:     new(utils.SyncMap[string, chan struct{}]),
+		chanMap:     new(utils.SyncMap[string, *execCmd]),
+		statusMap:   new(utils.SyncMap[string, defs.WorkerStatus]),
 		binaryPath:  binPath,
 		defaultArgs: defaultArgs,
 	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
 }
 
 func (m *workerExecManager) RunCmd(uid string, cwd string, argv []string) {
+	m.RunCmdWithLimits(uid, cwd, argv, nil)
+}
+
+// GetCmdStatus 返回命令的运行状态，命令从未运行过时返回 false
+func (m *workerExecManager) GetCmdStatus(uid string) (defs.WorkerStatus, bool) {
+	return m.statusMap.Load(uid)
+}
+
+func (m *workerExecManager) RunCmdWithLimits(uid string, cwd string, argv []string, limits *pb.WorkerResourceLimits) {
 	ctx := context.Background()
 	logger.Logger(context.Background()).Infof("start to run command, command id: [%s], argv: %s", uid, utils.MarshalForJson(argv))
-	if _, ok := m.chanMap.Load(uid); ok {
+	// exit 带缓冲，等待退出的协程已经结束时 ExitCmd 也不会阻塞
+	c := &execCmd{exit: make(chan struct{}, 1), released: make(chan struct{})}
+	if _, loaded := m.chanMap.LoadOrStore(uid, c); loaded {
 		logger.Logger(ctx).Infof("command id: [%s] is already running, ignore", uid)
 		return
 	}
-
-	c := make(chan struct{})
-	m.chanMap.Store(uid, c)
+	m.signMap.Delete(uid)
+	m.statusMap.Store(uid, defs.WorkerStatus_Running)
 
 	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
 
 	go func(ctx context.Context, uid string, argv []string, m *workerExecManager) {
 		defer func(uid string, m *workerExecManager) {
 			m.signMap.Delete(uid)
+			close(done)
 		}(uid, m)
 
 		logger.Logger(ctx).Infof("command id: [%s] is running!", uid)
 
+		quickExits := 0
 		for {
+			var exitErr error
+			startAt := time.Now()
+
 			args := []string{}
 
 			args = append(args, m.defaultArgs...)
 			args = append(args, argv...)
 
+			sandbox := newWorkerSandbox(ctx, m.cgroupRoot, uid, limits)
+
 			cmd := exec.CommandContext(ctx, m.binaryPath, args...)
 			cmd.Dir = cwd
-			cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: false}
+			sandbox.Prepare(cmd)
 			cmd.Stdout = logger.LoggerWriter("workerd", logrus.InfoLevel)
 			cmd.Stderr = logger.LoggerWriter("workerd", logrus.ErrorLevel)
-			if err := cmd.Run(); err != nil {
-				logger.Logger(ctx).WithError(err).Errorf("command id: [%s] run failed, binary path: [%s], args: %s", uid, m.binaryPath, utils.MarshalForJson(args))
+			if err := cmd.Start(); err != nil {
+				exitErr = err
+				logger.Logger(ctx).WithError(err).Errorf("command id: [%s] start failed, binary path: [%s], args: %s", uid, m.binaryPath, utils.MarshalForJson(args))
+			} else {
+				sandbox.AfterStart(ctx)
+				if err := cmd.Wait(); err != nil {
+					exitErr = err
+					logger.Logger(ctx).WithError(err).Errorf("command id: [%s] run failed, binary path: [%s], args: %s", uid, m.binaryPath, utils.MarshalForJson(args))
+				}
 			}
 
+			oomKilled := sandbox.OOMKilled()
+			sandbox.Cleanup()
+
 			if exit, ok := m.signMap.Load(uid); ok && exit {
 				return
 			}
-			time.Sleep(3 * time.Second)
+
+			if oomKilled && limits.GetKillOnOom() {
+				logger.Logger(ctx).Errorf("command id: [%s] is killed by oom, stop restarting", uid)
+				m.statusMap.Store(uid, defs.WorkerStatus_OOMKilled)
+				if m.statusReporter != nil {
+					go m.statusReporter(uid, defs.WorkerStatus_OOMKilled, quickExits, "killed by oom, stop restarting")
+				}
+				return
+			}
+
+			quickExits = m.checkCrashLoop(ctx, uid, quickExits, time.Since(startAt), exitErr)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(3 * time.Second):
+			}
 		}
 	}(ctx, uid, argv, m)
 
 	go func(cancel context.CancelFunc, uid string, m *workerExecManager) {
 		defer func(uid string, m *workerExecManager) {
 			m.chanMap.Delete(uid)
+			close(c.released)
 		}(uid, m)
 
-		if channel, ok := m.chanMap.Load(uid); ok {
-			<-channel
+		select {
+		case <-c.exit:
 			m.signMap.Store(uid, true)
+			m.statusMap.Delete(uid)
+			cancel()
+			// 等进程退出后再释放 id，避免新进程和旧进程同时运行
+			<-done
+		case <-done:
+			// 循环自行结束（如 OOM 后不再重启），释放 id 以便再次运行
 			cancel()
-			return
-		} else {
-			logger.Logger(ctx).Errorf("command id: [%s] is not running!", uid)
-			return
 		}
 	}(cancel, uid, m)
 }
 
+// ExitCmd 通知命令退出，并等待进程退出、id 被释放后返回，之后可以立即用同一个 id 重新运行
 func (m *workerExecManager) ExitCmd(uid string) {
-	if channel, ok := m.chanMap.Load(uid); ok {
-		channel <- struct{}{}
+	c, ok := m.chanMap.Load(uid)
+	if !ok {
+		return
+	}
+	select {
+	case c.exit <- struct{}{}:
+	default:
 	}
+	<-c.released
 }
 
 func (m *workerExecManager) ExitAllCmd() {
@@ -115,3 +204,29 @@ func (m *workerExecManager) ExitAllCmd() {
 func (m *workerExecManager) UpdateBinaryPath(path string) {
 	m.binaryPath = path
 }
+
+// checkCrashLoop 统计连续快速退出的次数，达到阈值时标记为 crash loop 并上报，返回新的计数
+func (m *workerExecManager) checkCrashLoop(ctx context.Context, uid string, quickExits int, uptime time.Duration, exitErr error) int {
+	if uptime >= defs.WorkerCrashLoopMinUptime {
+		if status, ok := m.statusMap.Load(uid); ok && status == defs.WorkerStatus_CrashLoop {
+			m.statusMap.Store(uid, defs.WorkerStatus_Running)
+		}
+		return 0
+	}
+
+	quickExits++
+	if quickExits != defs.WorkerCrashLoopThreshold {
+		return quickExits
+	}
+
+	reason := "process exited without error"
+	if exitErr != nil {
+		reason = exitErr.Error()
+	}
+	logger.Logger(ctx).Errorf("command id: [%s] exited [%d] times in a row shortly after start, it is crash looping, last error: [%s]", uid, quickExits, reason)
+	m.statusMap.Store(uid, defs.WorkerStatus_CrashLoop)
+	if m.statusReporter != nil {
+		go m.statusReporter(uid, defs.WorkerStatus_CrashLoop, quickExits, reason)
+	}
+	return quickExits
+}
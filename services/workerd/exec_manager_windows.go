@@ -5,6 +5,8 @@ package workerd
 import (
 	"context"
 
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 )
@@ -29,12 +31,33 @@ func (w *workerExecManager) RunCmd(workerId string, cwd string, argv []string) {
 	logger.Logger(ctx).Errorf("windows has not implemented functions")
 }
 
+// RunCmdWithLimits implements app.WorkerExecManager.
+func (w *workerExecManager) RunCmdWithLimits(workerId string, cwd string, argv []string, limits *pb.WorkerResourceLimits) {
+	ctx := context.Background()
+	logger.Logger(ctx).Errorf("windows has not implemented functions")
+}
+
+// GetCmdStatus implements app.WorkerExecManager.
+func (w *workerExecManager) GetCmdStatus(workerId string) (defs.WorkerStatus, bool) {
+	return defs.WorkerStatus_Unknown, false
+}
+
 // UpdateBinaryPath implements app.WorkerExecManager.
 func (w *workerExecManager) UpdateBinaryPath(path string) {
 	ctx := context.Background()
 	logger.Logger(ctx).Errorf("windows has not implemented functions")
 }
 
-func NewExecManager(binPath string, defaultArgs []string) app.WorkerExecManager {
+type ExecManagerOpt func(*workerExecManager)
+
+func WithCgroupRoot(root string) ExecManagerOpt {
+	return func(*workerExecManager) {}
+}
+
+func WithStatusReporter(reporter StatusReporter) ExecManagerOpt {
+	return func(*workerExecManager) {}
+}
+
+func NewExecManager(binPath string, defaultArgs []string, opts ...ExecManagerOpt) app.WorkerExecManager {
 	return &workerExecManager{}
 }
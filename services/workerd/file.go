@@ -2,6 +2,7 @@ package workerd
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 
 	"github.com/VaalaCat/frp-panel/defs"
@@ -15,6 +16,13 @@ func WriteWorkerCodeToFile(ctx context.Context, worker *pb.Worker, workerdCWD st
 		string(worker.GetCode()))
 }
 
+func WriteScheduledShimToFile(ctx context.Context, worker *pb.Worker, workerdCWD string, token string) error {
+	return utils.WriteFile(
+		filepath.Join(WorkerCodeRootPath(ctx, worker, workerdCWD), defs.WorkerScheduledShimEntry),
+		fmt.Sprintf(defs.WorkerScheduledShimTemplate, worker.GetCodeEntry(),
+			defs.WorkerScheduledPath, defs.WorkerScheduledTokenHeader, token))
+}
+
 func CodeFilePath(ctx context.Context, worker *pb.Worker, workerdCWD string) string {
 	return filepath.Join(
 		WorkerCWDPath(ctx, worker, workerdCWD),
@@ -42,3 +50,18 @@ func ConfigFilePath(ctx context.Context, worker *pb.Worker, workerdCWD string) s
 		defs.CapFileName,
 	)
 }
+
+func SharedWorkerCWDPath(ctx context.Context, workerdCWD string) string {
+	return filepath.Join(workerdCWD, defs.WorkerInfoPath)
+}
+
+func SharedConfigFilePath(ctx context.Context, workerdCWD string) string {
+	return filepath.Join(
+		SharedWorkerCWDPath(ctx, workerdCWD),
+		defs.CapFileName,
+	)
+}
+
+func KVNamespacePath(ctx context.Context, workerdCWD string, nsID string) string {
+	return filepath.Join(workerdCWD, defs.WorkerKVPath, nsID)
+}
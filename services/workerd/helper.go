@@ -2,6 +2,9 @@ package workerd
 
 import (
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/VaalaCat/frp-panel/defs"
@@ -33,6 +36,9 @@ func FillWorkerValue(worker *pb.Worker, UserID uint, opt ...Opt) {
 		worker.ConfigTemplate = lo.ToPtr(string(defs.DefaultConfigTemplate))
 	}
 
+	FillWorkerCronsValue(worker.GetCrons())
+	FillWorkerKVNamespacesValue(worker.GetKvNamespaces())
+
 	worker.Socket = &pb.Socket{
 		Name:    lo.ToPtr(worker.GetWorkerId()),
 		Address: lo.ToPtr(fmt.Sprintf(defs.DefaultSocketTemplate, worker.GetWorkerId())),
@@ -43,7 +49,97 @@ func FillWorkerValue(worker *pb.Worker, UserID uint, opt ...Opt) {
 	}
 }
 
+func FillWorkerCronsValue(crons []*pb.WorkerCron) {
+	for _, cron := range crons {
+		if len(cron.GetId()) == 0 {
+			cron.Id = lo.ToPtr(utils.GenerateUUID())
+		}
+		if cron.GetType() == pb.WorkerCron_TRIGGER_TYPE_UNSPECIFIED {
+			cron.Type = pb.WorkerCron_TRIGGER_TYPE_SCHEDULED.Enum()
+		}
+	}
+}
+
+func FillWorkerKVNamespacesValue(namespaces []*pb.WorkerKVNamespace) {
+	for _, ns := range namespaces {
+		if len(ns.GetNamespace()) == 0 {
+			ns.Namespace = lo.ToPtr(defs.WorkerKVDefaultNamespace)
+		}
+		if ns.GetScope() == pb.WorkerKVNamespace_SCOPE_UNSPECIFIED {
+			ns.Scope = pb.WorkerKVNamespace_SCOPE_WORKER.Enum()
+		}
+	}
+}
+
 func SafeWorkerID(id string) string {
 	replacer := strings.NewReplacer("/", "", ".", "", "-", "")
 	return replacer.Replace(id)
 }
+
+func HasScheduledCron(worker *pb.Worker) bool {
+	return lo.ContainsBy(worker.GetCrons(), func(c *pb.WorkerCron) bool {
+		return c.GetType() == pb.WorkerCron_TRIGGER_TYPE_SCHEDULED
+	})
+}
+
+// ScheduledToken scheduled shim 使用的调用凭证，避免通过 ingress 从外部触发 scheduled handler
+func ScheduledToken(clientSecret string, workerId string) string {
+	return utils.SHA1(fmt.Sprintf("%s:%s", clientSecret, workerId))
+}
+
+// WorkerSocketPath 将 workerd 的 socket 地址转换为 unix socket 的拨号地址
+func WorkerSocketPath(worker *pb.Worker) string {
+	addr := worker.GetSocket().GetAddress()
+	if strings.HasPrefix(addr, "unix-abstract:") {
+		return fmt.Sprintf("@%s", strings.TrimPrefix(addr, "unix-abstract:"))
+	}
+	return strings.TrimPrefix(addr, "unix:")
+}
+
+// CanShareProcess 设置了资源限制或自定义配置模板的 worker 需要独占 workerd 进程
+// 自定义模板描述的是完整的 workerd 配置，无法合并进共享配置，这类 worker 总是单独运行
+func CanShareProcess(worker *pb.Worker) bool {
+	limits := worker.GetResourceLimits()
+	if limits.GetCpuMillicores() > 0 || limits.GetMemoryBytes() > 0 || limits.GetRunAsUid() > 0 {
+		return false
+	}
+	return IsDefaultConfigTemplate(worker.GetConfigTemplate())
+}
+
+// KVNamespaceID kv namespace 在 client 和 master 上的唯一标识，tenant 范围的 namespace 在同租户 worker 间共享
+func KVNamespaceID(worker *pb.Worker, ns *pb.WorkerKVNamespace) string {
+	name := ns.GetNamespace()
+	if len(name) == 0 {
+		name = defs.WorkerKVDefaultNamespace
+	}
+	if ns.GetScope() == pb.WorkerKVNamespace_SCOPE_TENANT {
+		return fmt.Sprintf("tenant-%d-%s", worker.GetTenantId(), name)
+	}
+	return fmt.Sprintf("worker-%s-%s", worker.GetWorkerId(), name)
+}
+
+func KVServiceName(nsID string) string {
+	return "kv-" + nsID
+}
+
+func ServiceBindingName(workerId string) string {
+	return "svc-" + SafeWorkerID(workerId)
+}
+
+// ChownWorkerDir 以指定用户运行的 worker 需要能读写自己的工作目录，目录由 client 以 root 创建
+func ChownWorkerDir(dir string, limits *pb.WorkerResourceLimits) error {
+	uid := limits.GetRunAsUid()
+	if uid == 0 {
+		return nil
+	}
+	gid := limits.GetRunAsGid()
+	if gid == 0 {
+		gid = uid
+	}
+	return filepath.WalkDir(dir, func(p string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		return os.Lchown(p, int(uid), int(gid))
+	})
+}
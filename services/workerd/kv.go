@@ -0,0 +1,284 @@
+package workerd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/watcher"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+func KVTaskTag(workerId string) string {
+	return defs.WorkerKVTaskTagPrefix + workerId
+}
+
+// PrepareWorkerKV 创建 kv namespace 的存储目录，开启同步且本地没有数据时从 master 恢复
+func PrepareWorkerKV(ctx *app.Context, worker *pb.Worker, workerdCwd string) error {
+	for _, ns := range worker.GetKvNamespaces() {
+		nsPath := KVNamespacePath(ctx, workerdCwd, KVNamespaceID(worker, ns))
+		if err := os.MkdirAll(nsPath, 0o750); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("create kv dir failed, path: [%s]", nsPath)
+			return err
+		}
+
+		if ns.GetSyncToMaster() {
+			if files, err := os.ReadDir(nsPath); err == nil && len(files) == 0 {
+				if err := restoreWorkerKV(ctx, worker, ns, nsPath); err != nil {
+					logger.Logger(ctx).WithError(err).Errorf("restore kv from master failed, workerId: [%s], binding: [%s]",
+						worker.GetWorkerId(), ns.GetBinding())
+				}
+			}
+		}
+
+		if err := ChownWorkerDir(nsPath, worker.GetResourceLimits()); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("chown kv dir failed, path: [%s]", nsPath)
+			return err
+		}
+	}
+	return nil
+}
+
+func restoreWorkerKV(ctx *app.Context, worker *pb.Worker, ns *pb.WorkerKVNamespace, nsPath string) error {
+	cfg := ctx.GetApp().GetConfig()
+	resp, err := ctx.GetApp().GetMasterCli().Call().PullWorkerKV(ctx, &pb.PullWorkerKVReq{
+		Base: &pb.ClientBase{
+			ClientId:     cfg.Client.ID,
+			ClientSecret: cfg.Client.Secret,
+		},
+		WorkerId: worker.GetWorkerId(),
+		Binding:  ns.GetBinding(),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+		return fmt.Errorf("pull kv failed: %s", resp.GetStatus().GetMessage())
+	}
+
+	if err := applyWorkerKV(nsPath, resp.GetEntries()); err != nil {
+		return err
+	}
+
+	logger.Logger(ctx).Infof("restore kv from master success, workerId: [%s], binding: [%s], entries: [%d]",
+		worker.GetWorkerId(), ns.GetBinding(), len(resp.GetEntries()))
+	return nil
+}
+
+// ScheduleWorkerKVSync 定期把开启同步的 namespace 上传到 master
+func ScheduleWorkerKVSync(ctx *app.Context, scheduler watcher.Client, worker *pb.Worker, workerdCwd string) error {
+	if scheduler == nil {
+		return nil
+	}
+
+	scheduler.RemoveTaggedTasks(KVTaskTag(worker.GetWorkerId()))
+
+	if !lo.ContainsBy(worker.GetKvNamespaces(), func(ns *pb.WorkerKVNamespace) bool { return ns.GetSyncToMaster() }) {
+		return nil
+	}
+
+	return scheduler.AddTaggedDurationTask(KVTaskTag(worker.GetWorkerId()), defs.WorkerKVSyncInterval,
+		SyncWorkerKV, ctx.GetApp(), worker, workerdCwd)
+}
+
+func UnscheduleWorkerKVSync(scheduler watcher.Client, workerId string) {
+	if scheduler == nil {
+		return
+	}
+	scheduler.RemoveTaggedTasks(KVTaskTag(workerId))
+}
+
+// SyncWorkerKV 由调度器调用，上传 namespace 的快照和上次同步后删除的 key，master 按 key 合并后返回更新的 key
+// 以文件修改时间作为 revision，多个 client 写同一个 key 时最后写入的生效
+func SyncWorkerKV(appInstance app.Application, worker *pb.Worker, workerdCwd string) {
+	ctx := app.NewContext(context.Background(), appInstance)
+	cfg := appInstance.GetConfig()
+
+	for _, ns := range worker.GetKvNamespaces() {
+		if !ns.GetSyncToMaster() {
+			continue
+		}
+
+		nsPath := KVNamespacePath(ctx, workerdCwd, KVNamespaceID(worker, ns))
+		entries, err := readWorkerKV(nsPath)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("read kv failed, workerId: [%s], binding: [%s]", worker.GetWorkerId(), ns.GetBinding())
+			continue
+		}
+		entries = append(entries, deletedWorkerKV(nsPath, entries)...)
+
+		resp, err := appInstance.GetMasterCli().Call().PushWorkerKV(ctx, &pb.PushWorkerKVReq{
+			Base: &pb.ClientBase{
+				ClientId:     cfg.Client.ID,
+				ClientSecret: cfg.Client.Secret,
+			},
+			WorkerId: worker.GetWorkerId(),
+			Binding:  ns.GetBinding(),
+			Entries:  entries,
+		})
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("push kv failed, workerId: [%s], binding: [%s]", worker.GetWorkerId(), ns.GetBinding())
+			continue
+		}
+		if resp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+			logger.Logger(ctx).Errorf("push kv failed, workerId: [%s], binding: [%s], resp: [%s]",
+				worker.GetWorkerId(), ns.GetBinding(), resp.GetStatus().GetMessage())
+			continue
+		}
+
+		if err := applyWorkerKV(nsPath, resp.GetEntries()); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("apply kv from master failed, workerId: [%s], binding: [%s]", worker.GetWorkerId(), ns.GetBinding())
+			continue
+		}
+		if err := ChownWorkerDir(nsPath, worker.GetResourceLimits()); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("chown kv dir failed, path: [%s]", nsPath)
+		}
+
+		// 记录同步后本地存在的 key，下次同步时据此发现被删除的 key
+		synced, err := readWorkerKV(nsPath)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("read kv failed, workerId: [%s], binding: [%s]", worker.GetWorkerId(), ns.GetBinding())
+			continue
+		}
+		if err := saveSyncedWorkerKV(nsPath, synced); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("save kv sync state failed, path: [%s]", nsPath)
+		}
+	}
+}
+
+// readWorkerKV workerd 的 disk 服务以文件保存每个 key，key 中的 / 对应子目录，每一级文件名为转义后的 key
+func readWorkerKV(nsPath string) ([]*pb.WorkerKVEntry, error) {
+	var (
+		entries   []*pb.WorkerKVEntry
+		totalSize int
+	)
+	err := filepath.WalkDir(nsPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		value, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		totalSize += len(value)
+		if totalSize > defs.WorkerKVSyncMaxBytes {
+			return fmt.Errorf("kv namespace is larger than [%d] bytes, skip sync", defs.WorkerKVSyncMaxBytes)
+		}
+
+		rel, err := filepath.Rel(nsPath, p)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, &pb.WorkerKVEntry{
+			Key:      lo.ToPtr(kvPathToKey(rel)),
+			Value:    value,
+			Revision: lo.ToPtr(info.ModTime().UnixMilli()),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// applyWorkerKV 写入或删除 master 返回的 key，并把文件修改时间设为 revision，下次同步时不会被当作本地修改
+func applyWorkerKV(nsPath string, entries []*pb.WorkerKVEntry) error {
+	for _, entry := range entries {
+		filePath, ok := kvKeyToPath(nsPath, entry.GetKey())
+		if !ok {
+			continue
+		}
+
+		if entry.GetDeleted() {
+			if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filePath, entry.GetValue(), 0o640); err != nil {
+			return err
+		}
+		revision := time.UnixMilli(entry.GetRevision())
+		if err := os.Chtimes(filePath, revision, revision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deletedWorkerKV 上次同步后存在、现在已经不存在的 key 作为删除上报
+func deletedWorkerKV(nsPath string, entries []*pb.WorkerKVEntry) []*pb.WorkerKVEntry {
+	synced := map[string]int64{}
+	content, err := os.ReadFile(kvSyncStatePath(nsPath))
+	if err != nil {
+		return nil
+	}
+	if err := json.Unmarshal(content, &synced); err != nil {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	for _, entry := range entries {
+		delete(synced, entry.GetKey())
+	}
+	return lo.MapToSlice(synced, func(key string, _ int64) *pb.WorkerKVEntry {
+		return &pb.WorkerKVEntry{Key: lo.ToPtr(key), Revision: lo.ToPtr(now), Deleted: lo.ToPtr(true)}
+	})
+}
+
+func saveSyncedWorkerKV(nsPath string, entries []*pb.WorkerKVEntry) error {
+	synced := lo.SliceToMap(entries, func(e *pb.WorkerKVEntry) (string, int64) { return e.GetKey(), e.GetRevision() })
+	content, err := json.Marshal(synced)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(kvSyncStatePath(nsPath), content, 0o600)
+}
+
+// kvSyncStatePath 同步状态放在 namespace 目录外，不会被当作 key
+func kvSyncStatePath(nsPath string) string {
+	return nsPath + ".synced.json"
+}
+
+func kvPathToKey(rel string) string {
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	for i, part := range parts {
+		if key, err := url.PathUnescape(part); err == nil {
+			parts[i] = key
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// kvKeyToPath 拒绝空的和 . .. 路径段，避免 master 下发的 key 写到 namespace 目录之外
+func kvKeyToPath(nsPath, key string) (string, bool) {
+	parts := strings.Split(key, "/")
+	for i, part := range parts {
+		if len(part) == 0 || part == "." || part == ".." {
+			return "", false
+		}
+		parts[i] = url.PathEscape(part)
+	}
+	return filepath.Join(append([]string{nsPath}, parts...)...), true
+}
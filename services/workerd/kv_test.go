@@ -0,0 +1,54 @@
+package workerd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerKVNestedKeys(t *testing.T) {
+	nsPath := t.TempDir()
+
+	err := applyWorkerKV(nsPath, []*pb.WorkerKVEntry{
+		{Key: lo.ToPtr("plain"), Value: []byte("1"), Revision: lo.ToPtr(int64(1700000000000))},
+		{Key: lo.ToPtr("users/alice"), Value: []byte("2"), Revision: lo.ToPtr(int64(1700000001000))},
+		{Key: lo.ToPtr("a b/c?d"), Value: []byte("3"), Revision: lo.ToPtr(int64(1700000002000))},
+		{Key: lo.ToPtr("../escape"), Value: []byte("4"), Revision: lo.ToPtr(int64(1700000003000))},
+		{Key: lo.ToPtr("x//y"), Value: []byte("5"), Revision: lo.ToPtr(int64(1700000004000))},
+	})
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(filepath.Dir(nsPath), "escape"))
+	assert.True(t, os.IsNotExist(err), "key with .. must not be written outside the namespace")
+
+	entries, err := readWorkerKV(nsPath)
+	assert.NoError(t, err)
+	got := lo.SliceToMap(entries, func(e *pb.WorkerKVEntry) (string, string) { return e.GetKey(), string(e.GetValue()) })
+	assert.Equal(t, map[string]string{"plain": "1", "users/alice": "2", "a b/c?d": "3"}, got)
+
+	revisions := lo.SliceToMap(entries, func(e *pb.WorkerKVEntry) (string, int64) { return e.GetKey(), e.GetRevision() })
+	assert.Equal(t, int64(1700000001000), revisions["users/alice"])
+}
+
+func TestWorkerKVDeletedKeys(t *testing.T) {
+	nsPath := filepath.Join(t.TempDir(), "ns")
+	assert.NoError(t, applyWorkerKV(nsPath, []*pb.WorkerKVEntry{
+		{Key: lo.ToPtr("keep"), Value: []byte("1"), Revision: lo.ToPtr(int64(1))},
+		{Key: lo.ToPtr("dir/drop"), Value: []byte("2"), Revision: lo.ToPtr(int64(1))},
+	}))
+	entries, err := readWorkerKV(nsPath)
+	assert.NoError(t, err)
+	assert.NoError(t, saveSyncedWorkerKV(nsPath, entries))
+
+	assert.NoError(t, os.Remove(filepath.Join(nsPath, "dir", "drop")))
+	entries, err = readWorkerKV(nsPath)
+	assert.NoError(t, err)
+
+	deleted := deletedWorkerKV(nsPath, entries)
+	assert.Len(t, deleted, 1)
+	assert.Equal(t, "dir/drop", deleted[0].GetKey())
+	assert.True(t, deleted[0].GetDeleted())
+}
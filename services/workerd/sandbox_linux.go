@@ -0,0 +1,207 @@
+//go:build linux
+
+package workerd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+const (
+	cgroupMountPath = "/sys/fs/cgroup"
+	cgroupCPUPeriod = 100000
+)
+
+// workerSandbox 为单次 workerd 进程运行设置资源限制，优先使用 cgroups v2，不可用时回退到 rlimit
+type workerSandbox struct {
+	workerId   string
+	limits     *pb.WorkerResourceLimits
+	cgroupPath string
+	cgroupFd   int
+}
+
+func newWorkerSandbox(ctx context.Context, cgroupRoot string, workerId string, limits *pb.WorkerResourceLimits) *workerSandbox {
+	s := &workerSandbox{
+		workerId: workerId,
+		limits:   limits,
+		cgroupFd: -1,
+	}
+
+	if !s.needCgroup() || len(cgroupRoot) == 0 {
+		return s
+	}
+
+	if err := s.setupCgroup(cgroupRoot); err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("cannot setup cgroup for worker [%s], fallback to rlimit", workerId)
+		s.cleanupCgroup()
+	}
+	return s
+}
+
+func (s *workerSandbox) needCgroup() bool {
+	return s.limits.GetCpuMillicores() > 0 || s.limits.GetMemoryBytes() > 0
+}
+
+func (s *workerSandbox) setupCgroup(cgroupRoot string) error {
+	if _, err := os.Stat(filepath.Join(cgroupMountPath, "cgroup.controllers")); err != nil {
+		return errors.New("cgroups v2 is not available")
+	}
+
+	if !strings.HasPrefix(filepath.Clean(cgroupRoot), cgroupMountPath+"/") {
+		return fmt.Errorf("cgroup root [%s] is not under [%s]", cgroupRoot, cgroupMountPath)
+	}
+
+	if err := os.MkdirAll(cgroupRoot, 0o755); err != nil {
+		return err
+	}
+
+	// 从挂载点开始逐级开启 cpu 和 memory 控制器
+	rel, _ := filepath.Rel(cgroupMountPath, filepath.Clean(cgroupRoot))
+	dir := cgroupMountPath
+	for _, part := range append([]string{""}, strings.Split(rel, string(filepath.Separator))...) {
+		dir = filepath.Join(dir, part)
+		if err := os.WriteFile(filepath.Join(dir, "cgroup.subtree_control"), []byte("+cpu +memory"), 0o644); err != nil {
+			return fmt.Errorf("enable controllers in [%s] failed: %w", dir, err)
+		}
+	}
+
+	s.cgroupPath = filepath.Join(cgroupRoot, "worker-"+SafeWorkerID(s.workerId))
+	if err := os.MkdirAll(s.cgroupPath, 0o755); err != nil {
+		return err
+	}
+
+	if cpu := s.limits.GetCpuMillicores(); cpu > 0 {
+		quota := cpu * cgroupCPUPeriod / 1000
+		if err := s.writeCgroupFile("cpu.max", fmt.Sprintf("%d %d", quota, cgroupCPUPeriod)); err != nil {
+			return err
+		}
+	}
+
+	if mem := s.limits.GetMemoryBytes(); mem > 0 {
+		if err := s.writeCgroupFile("memory.max", strconv.FormatInt(mem, 10)); err != nil {
+			return err
+		}
+		if _, err := os.Stat(filepath.Join(s.cgroupPath, "memory.swap.max")); err == nil {
+			if err := s.writeCgroupFile("memory.swap.max", "0"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.limits.GetKillOnOom() {
+		if err := s.writeCgroupFile("memory.oom.group", "1"); err != nil {
+			return err
+		}
+	}
+
+	fd, err := syscall.Open(s.cgroupPath, syscall.O_DIRECTORY|syscall.O_RDONLY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	s.cgroupFd = fd
+	return nil
+}
+
+func (s *workerSandbox) writeCgroupFile(name string, content string) error {
+	return os.WriteFile(filepath.Join(s.cgroupPath, name), []byte(content), 0o644)
+}
+
+// Prepare 在进程启动前设置进程组、运行用户和 cgroup
+func (s *workerSandbox) Prepare(cmd *exec.Cmd) {
+	attr := &syscall.SysProcAttr{Setpgid: true}
+
+	if uid := s.limits.GetRunAsUid(); uid > 0 {
+		gid := s.limits.GetRunAsGid()
+		if gid == 0 {
+			gid = uid
+		}
+		attr.Credential = &syscall.Credential{Uid: uid, Gid: gid}
+	}
+
+	if s.cgroupFd >= 0 {
+		attr.UseCgroupFD = true
+		attr.CgroupFD = s.cgroupFd
+	}
+
+	cmd.SysProcAttr = attr
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+
+	s.wrapWithRlimit(cmd)
+}
+
+// wrapWithRlimit 无 cgroup 时通过 sh 设置 RLIMIT_DATA 后再 exec workerd，保证限制在 workerd 启动前生效
+func (s *workerSandbox) wrapWithRlimit(cmd *exec.Cmd) {
+	mem := s.limits.GetMemoryBytes()
+	if s.cgroupFd >= 0 || mem <= 0 {
+		return
+	}
+
+	shPath, err := exec.LookPath("sh")
+	if err != nil {
+		logger.Logger(context.Background()).WithError(err).Errorf("cannot find sh, memory limit of worker [%s] is ignored", s.workerId)
+		return
+	}
+
+	// ulimit -d 的单位是 KiB
+	limitKiB := strconv.FormatInt(max(mem/1024, 1), 10)
+	cmd.Args = append([]string{"sh", "-c", `ulimit -d "$1" || exit 1; shift; exec "$@"`, "sh", limitKiB, cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = shPath
+}
+
+// AfterStart rlimit 无法限制 CPU 使用率，没有 cgroup 时给出提示
+func (s *workerSandbox) AfterStart(ctx context.Context) {
+	if s.cgroupFd < 0 && s.limits.GetCpuMillicores() > 0 {
+		logger.Logger(ctx).Warnf("cpu limit of worker [%s] is ignored, cgroups v2 is not available", s.workerId)
+	}
+}
+
+// OOMKilled 进程退出后，检查是否因为超出内存限制被杀死
+func (s *workerSandbox) OOMKilled() bool {
+	if len(s.cgroupPath) == 0 {
+		return false
+	}
+
+	f, err := os.Open(filepath.Join(s.cgroupPath, "memory.events"))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "oom_kill" {
+			count, _ := strconv.Atoi(fields[1])
+			return count > 0
+		}
+	}
+	return false
+}
+
+func (s *workerSandbox) Cleanup() {
+	s.cleanupCgroup()
+}
+
+func (s *workerSandbox) cleanupCgroup() {
+	if s.cgroupFd >= 0 {
+		syscall.Close(s.cgroupFd)
+		s.cgroupFd = -1
+	}
+	if len(s.cgroupPath) > 0 {
+		os.Remove(s.cgroupPath)
+		s.cgroupPath = ""
+	}
+}
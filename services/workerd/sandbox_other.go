@@ -0,0 +1,49 @@
+//go:build !linux && !windows
+
+package workerd
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// workerSandbox 非 linux 平台没有 cgroups 和 prlimit，仅支持进程组与运行用户
+type workerSandbox struct {
+	workerId string
+	limits   *pb.WorkerResourceLimits
+}
+
+func newWorkerSandbox(ctx context.Context, cgroupRoot string, workerId string, limits *pb.WorkerResourceLimits) *workerSandbox {
+	return &workerSandbox{workerId: workerId, limits: limits}
+}
+
+func (s *workerSandbox) Prepare(cmd *exec.Cmd) {
+	attr := &syscall.SysProcAttr{Setpgid: true}
+
+	if uid := s.limits.GetRunAsUid(); uid > 0 {
+		gid := s.limits.GetRunAsGid()
+		if gid == 0 {
+			gid = uid
+		}
+		attr.Credential = &syscall.Credential{Uid: uid, Gid: gid}
+	}
+
+	cmd.SysProcAttr = attr
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
+
+func (s *workerSandbox) AfterStart(ctx context.Context) {
+	if s.limits.GetCpuMillicores() > 0 || s.limits.GetMemoryBytes() > 0 {
+		logger.Logger(ctx).Warnf("resource limits of worker [%s] are not supported on this platform", s.workerId)
+	}
+}
+
+func (s *workerSandbox) OOMKilled() bool { return false }
+
+func (s *workerSandbox) Cleanup() {}
@@ -0,0 +1,95 @@
+package workerd
+
+import (
+	"os"
+	"sync"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+	"github.com/samber/lo"
+)
+
+// sharedWorkerd 使用同一个 workerd 进程承载 client 上的多个 worker
+// worker 变化时重新生成配置文件，由 workerd --watch 热加载
+type sharedWorkerd struct {
+	mu         sync.Mutex
+	workerdCwd string
+	workers    map[string]*pb.Worker
+}
+
+func newSharedWorkerd(workerdCwd string) *sharedWorkerd {
+	return &sharedWorkerd{
+		workerdCwd: workerdCwd,
+		workers:    map[string]*pb.Worker{},
+	}
+}
+
+func (s *sharedWorkerd) Has(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.workers[id]
+	return ok
+}
+
+func (s *sharedWorkerd) Add(ctx *app.Context, worker *pb.Worker) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.workers[worker.GetWorkerId()] = worker
+	return s.reload(ctx)
+}
+
+func (s *sharedWorkerd) Remove(ctx *app.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.workers, id)
+	return s.reload(ctx)
+}
+
+func (s *sharedWorkerd) RemoveAll(ctx *app.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.workers = map[string]*pb.Worker{}
+	if err := s.reload(ctx); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("stop shared workerd failed")
+	}
+}
+
+// Status 共享进程中 worker 的状态即为共享进程的状态
+func (s *sharedWorkerd) Status(ctx *app.Context) (defs.WorkerStatus, error) {
+	ok, err := utils.ProcessExistsBySelf(SharedConfigFilePath(ctx, s.workerdCwd))
+	if err != nil {
+		return defs.WorkerStatus_Unknown, err
+	}
+	if ok {
+		return defs.WorkerStatus_Running, nil
+	}
+	return defs.WorkerStatus_Inactive, nil
+}
+
+func (s *sharedWorkerd) reload(ctx *app.Context) error {
+	execMgr := ctx.GetApp().GetWorkerExecManager()
+	configPath := SharedConfigFilePath(ctx, s.workerdCwd)
+
+	if len(s.workers) == 0 {
+		logger.Logger(ctx).Infof("no worker left in shared workerd, stop it")
+		execMgr.ExitCmd(defs.SharedWorkerdCmdID)
+		if err := os.Remove(configPath); err != nil && !os.IsNotExist(err) {
+			logger.Logger(ctx).WithError(err).Errorf("remove shared workerd config failed, path: [%s]", configPath)
+		}
+		return nil
+	}
+
+	if err := GenSharedCapnpConfig(ctx, s.workerdCwd, &pb.WorkerList{Workers: lo.Values(s.workers)}); err != nil {
+		return err
+	}
+
+	// 已经在运行时 RunCmd 不会重复启动，配置变化由 --watch 重新加载
+	execMgr.RunCmd(defs.SharedWorkerdCmdID, SharedWorkerCWDPath(ctx, s.workerdCwd), []string{configPath})
+	return nil
+}
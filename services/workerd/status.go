@@ -0,0 +1,44 @@
+package workerd
+
+import (
+	"context"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
+	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+// StatusReporter 在 workerd 进程状态变化时调用，workerId 为共享进程时是 defs.SharedWorkerdCmdID
+type StatusReporter func(workerId string, status defs.WorkerStatus, restarts int, reason string)
+
+// NewMasterStatusReporter 返回把进程状态上报给 master 的 StatusReporter
+func NewMasterStatusReporter(appInstance app.Application) StatusReporter {
+	return func(workerId string, status defs.WorkerStatus, restarts int, reason string) {
+		ctx := app.NewContext(context.Background(), appInstance)
+		cli := appInstance.GetMasterCli()
+		if cli == nil {
+			logger.Logger(ctx).Warnf("master client is not ready, skip reporting worker status, workerId: [%s], status: [%s]", workerId, status)
+			return
+		}
+
+		cfg := appInstance.GetConfig()
+		resp, err := cli.Call().PushWorkerStatus(ctx, &pb.PushWorkerStatusReq{
+			Base: &pb.ClientBase{
+				ClientId:     cfg.Client.ID,
+				ClientSecret: cfg.Client.Secret,
+			},
+			WorkerId:     workerId,
+			Status:       string(status),
+			RestartCount: int32(restarts),
+			Error:        reason,
+		})
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("push worker status failed, workerId: [%s], status: [%s]", workerId, status)
+			return
+		}
+		if resp.GetStatus().GetCode() != pb.RespCode_RESP_CODE_SUCCESS {
+			logger.Logger(ctx).Errorf("push worker status failed, workerId: [%s], status: [%s], resp: [%s]", workerId, status, resp.GetStatus().GetMessage())
+		}
+	}
+}
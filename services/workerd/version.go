@@ -0,0 +1,30 @@
+package workerd
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/VaalaCat/frp-panel/utils/logger"
+)
+
+const workerdVersionTimeout = 5 * time.Second
+
+// ProbeWorkerdVersion 执行 workerd --version 获取版本，失败时返回空字符串
+func ProbeWorkerdVersion(ctx context.Context, binPath string) string {
+	if len(binPath) == 0 {
+		return ""
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, workerdVersionTimeout)
+	defer cancel()
+
+	output, err := exec.CommandContext(probeCtx, binPath, "--version").Output()
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Warnf("cannot get workerd version, binary path: [%s]", binPath)
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(string(output)), "workerd"))
+}
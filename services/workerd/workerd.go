@@ -33,12 +33,17 @@ func (w *workerdController) RunWorker(c *app.Context) {
 	}
 
 	execMgr := c.GetApp().GetWorkerExecManager()
-	execMgr.RunCmd(
+	execMgr.RunCmdWithLimits(
 		w.worker.GetWorkerId(), WorkerCWDPath(c, w.worker, w.workerdCwd),
 		[]string{ConfigFilePath(c, w.worker, w.workerdCwd)},
+		w.worker.GetResourceLimits(),
 	)
 }
 
+func (w *workerdController) GetWorker() *pb.Worker {
+	return w.worker
+}
+
 func (w *workerdController) StopWorker(c *app.Context) {
 	execMgr := c.GetApp().GetWorkerExecManager()
 	execMgr.ExitCmd(w.worker.GetWorkerId())
@@ -67,11 +72,29 @@ func (w *workerdController) Init(c *app.Context) error {
 		return err
 	}
 
+	if err := PrepareWorkerKV(c, w.worker, w.workerdCwd); err != nil {
+		logger.Logger(c).WithError(err).Errorf("prepare worker kv failed, workerId: [%s]", w.worker.GetWorkerId())
+		return err
+	}
+
+	if HasScheduledCron(w.worker) {
+		token := ScheduledToken(c.GetApp().GetConfig().Client.Secret, w.worker.GetWorkerId())
+		if err := WriteScheduledShimToFile(c, w.worker, w.workerdCwd, token); err != nil {
+			logger.Logger(c).WithError(err).Errorf("write worker scheduled shim failed, workerId: [%s]", w.worker.GetWorkerId())
+			return err
+		}
+	}
+
 	if err := GenCapnpConfig(c, w.workerdCwd, &pb.WorkerList{Workers: []*pb.Worker{w.worker}}); err != nil {
 		logger.Logger(c).WithError(err).Errorf("gen worker capnp config failed, workerId: [%s]", w.worker.GetWorkerId())
 		return err
 	}
 
+	if err := ChownWorkerDir(WorkerCWDPath(c, w.worker, w.workerdCwd), w.worker.GetResourceLimits()); err != nil {
+		logger.Logger(c).WithError(err).Errorf("chown worker dir failed, workerId: [%s]", w.worker.GetWorkerId())
+		return err
+	}
+
 	logger.Logger(c).Infof("init worker success, workerId: [%s], code path: [%s]", w.worker.GetWorkerId(), workerCodePath)
 
 	return nil
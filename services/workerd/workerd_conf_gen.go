@@ -2,9 +2,13 @@ package workerd
 
 import (
 	"bytes"
+	"context"
 	"errors"
+	"fmt"
 	"html/template"
+	"path"
 	"path/filepath"
+	"sort"
 
 	"github.com/VaalaCat/frp-panel/defs"
 	"github.com/VaalaCat/frp-panel/pb"
@@ -12,28 +16,100 @@ import (
 	"github.com/samber/lo"
 )
 
-func BuildCapfile(workers []*pb.Worker) map[string]string {
+type capfileData struct {
+	*pb.Worker
+	ScheduledShimEntry string
+	// CodeDir 共享进程模式下 worker 代码目录相对于配置文件的路径
+	CodeDir string
+
+	Bindings         []capfileBinding
+	ExternalServices []capfileService
+	DiskServices     []capfileService
+}
+
+type capfileBinding struct {
+	Name    string
+	Kind    string
+	Service string
+}
+
+type capfileService struct {
+	Name    string
+	Address string
+	Path    string
+}
+
+type sharedCapfileData struct {
+	Workers          []*capfileData
+	ExternalServices []capfileService
+	DiskServices     []capfileService
+}
+
+func newCapfileData(worker *pb.Worker, workerdDir string) *capfileData {
+	tmpWorker := &pb.Worker{
+		WorkerId:  lo.ToPtr(SafeWorkerID(worker.GetWorkerId())),
+		UserId:    lo.ToPtr(worker.GetUserId()),
+		CodeEntry: lo.ToPtr(worker.GetCodeEntry()),
+		Socket: &pb.Socket{
+			Name:    lo.ToPtr(worker.GetWorkerId()),
+			Address: lo.ToPtr(worker.GetSocket().GetAddress()),
+		},
+		ConfigTemplate: lo.ToPtr(worker.GetConfigTemplate()),
+	}
+
+	data := &capfileData{
+		Worker:  tmpWorker,
+		CodeDir: path.Join(worker.GetWorkerId(), defs.WorkerCodePath),
+	}
+	if HasScheduledCron(worker) {
+		data.ScheduledShimEntry = defs.WorkerScheduledShimEntry
+	}
+
+	// 被绑定的 worker 部署在同一个 client 上，通过它的 socket 访问
+	for _, binding := range worker.GetServiceBindings() {
+		svc := capfileService{
+			Name:    ServiceBindingName(binding.GetWorkerId()),
+			Address: fmt.Sprintf(defs.DefaultSocketTemplate, binding.GetWorkerId()),
+		}
+		data.ExternalServices = append(data.ExternalServices, svc)
+		data.Bindings = append(data.Bindings, capfileBinding{Name: binding.GetName(), Kind: "service", Service: svc.Name})
+	}
+
+	for _, ns := range worker.GetKvNamespaces() {
+		nsID := KVNamespaceID(worker, ns)
+		svc := capfileService{
+			Name: KVServiceName(nsID),
+			Path: KVNamespacePath(context.Background(), workerdDir, nsID),
+		}
+		data.DiskServices = append(data.DiskServices, svc)
+		data.Bindings = append(data.Bindings, capfileBinding{Name: ns.GetBinding(), Kind: "kvNamespace", Service: svc.Name})
+	}
+
+	data.ExternalServices = lo.UniqBy(data.ExternalServices, func(s capfileService) string { return s.Name })
+	data.DiskServices = lo.UniqBy(data.DiskServices, func(s capfileService) string { return s.Name })
+	return data
+}
+
+// IsDefaultConfigTemplate 空模板和历史版本的默认模板都视为默认模板
+func IsDefaultConfigTemplate(tmpl string) bool {
+	return len(tmpl) == 0 || tmpl == defs.DefaultConfigTemplate ||
+		lo.Contains(defs.LegacyDefaultConfigTemplates, tmpl)
+}
+
+func BuildCapfile(workers []*pb.Worker, workerdDir string) map[string]string {
 	if len(workers) == 0 {
 		return map[string]string{}
 	}
 
 	results := map[string]string{}
 	for _, worker := range workers {
-		tmpWorker := &pb.Worker{
-			WorkerId:  lo.ToPtr(SafeWorkerID(worker.GetWorkerId())),
-			UserId:    lo.ToPtr(worker.GetUserId()),
-			CodeEntry: lo.ToPtr(worker.GetCodeEntry()),
-			Socket: &pb.Socket{
-				Name:    lo.ToPtr(worker.GetWorkerId()),
-				Address: lo.ToPtr(worker.GetSocket().GetAddress()),
-			},
-			ConfigTemplate: lo.ToPtr(worker.GetConfigTemplate()),
-		}
+		data := newCapfileData(worker, workerdDir)
+		tmpWorker := data.Worker
 
 		writer := new(bytes.Buffer)
 		capTemplate := template.New("capfile")
 		workerTemplate := tmpWorker.GetConfigTemplate()
-		if workerTemplate == "" {
+		if IsDefaultConfigTemplate(workerTemplate) {
 			workerTemplate = defs.DefaultConfigTemplate
 		}
 
@@ -41,18 +117,47 @@ func BuildCapfile(workers []*pb.Worker) map[string]string {
 		if err != nil {
 			panic(err)
 		}
-		capTemplate.Execute(writer, tmpWorker)
+		capTemplate.Execute(writer, data)
 
 		results[worker.GetWorkerId()] = writer.String()
 	}
 	return results
 }
 
+// BuildSharedCapfile 生成由单个 workerd 进程承载多个 worker 的配置，每个 worker 仍使用自己的 socket
+func BuildSharedCapfile(workers []*pb.Worker, workerdDir string) (string, error) {
+	sorted := append([]*pb.Worker{}, workers...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetWorkerId() < sorted[j].GetWorkerId()
+	})
+
+	data := &sharedCapfileData{
+		Workers: lo.Map(sorted, func(w *pb.Worker, _ int) *capfileData { return newCapfileData(w, workerdDir) }),
+	}
+	for _, w := range data.Workers {
+		data.ExternalServices = append(data.ExternalServices, w.ExternalServices...)
+		data.DiskServices = append(data.DiskServices, w.DiskServices...)
+	}
+	data.ExternalServices = lo.UniqBy(data.ExternalServices, func(s capfileService) string { return s.Name })
+	data.DiskServices = lo.UniqBy(data.DiskServices, func(s capfileService) string { return s.Name })
+
+	capTemplate, err := template.New("shared-capfile").Parse(defs.SharedConfigTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	writer := new(bytes.Buffer)
+	if err := capTemplate.Execute(writer, data); err != nil {
+		return "", err
+	}
+	return writer.String(), nil
+}
+
 func GenWorkerConfig(worker *pb.Worker, dir string) error {
 	if worker == nil || worker.GetWorkerId() == "" {
 		return errors.New("error worker")
 	}
-	fileMap := BuildCapfile([]*pb.Worker{worker})
+	fileMap := BuildCapfile([]*pb.Worker{worker}, dir)
 
 	fileContent, ok := fileMap[worker.GetWorkerId()]
 	if !ok {
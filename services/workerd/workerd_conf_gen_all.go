@@ -14,7 +14,7 @@ import (
 func GenCapnpConfig(ctx context.Context, workerdDir string, workerList *pb.WorkerList) error {
 	var hasError bool
 	for _, worker := range workerList.Workers {
-		fileMap := BuildCapfile([]*pb.Worker{worker})
+		fileMap := BuildCapfile([]*pb.Worker{worker}, workerdDir)
 
 		if fileContent, ok := fileMap[worker.GetWorkerId()]; ok {
 			err := utils.WriteFile(
@@ -35,3 +35,21 @@ func GenCapnpConfig(ctx context.Context, workerdDir string, workerList *pb.Worke
 	}
 	return nil
 }
+
+// GenSharedCapnpConfig 为共享 workerd 进程生成包含所有 worker 的配置文件
+func GenSharedCapnpConfig(ctx context.Context, workerdDir string, workerList *pb.WorkerList) error {
+	fileContent, err := BuildSharedCapfile(workerList.GetWorkers(), workerdDir)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("failed to build shared capfile")
+		return err
+	}
+
+	if err := utils.WriteFile(SharedConfigFilePath(ctx, workerdDir), fileContent); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("failed to write shared capfile")
+		return err
+	}
+
+	logger.Logger(ctx).Infof("GenSharedCapnpConfig success, workerList: %+v",
+		lo.SliceToMap(workerList.GetWorkers(), func(w *pb.Worker) (string, bool) { return w.GetWorkerId(), true }))
+	return nil
+}
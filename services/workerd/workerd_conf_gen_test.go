@@ -84,10 +84,124 @@ const vtest1Worker :Workerd.Worker = (
 );`, result["test1"])
 			},
 		},
+		{
+			name: "scheduled cron",
+			wokers: []*pb.Worker{
+				{
+					WorkerId:  lo.ToPtr("cron"),
+					CodeEntry: lo.ToPtr("entry.js"),
+					Socket: &pb.Socket{
+						Address: lo.ToPtr("unix:/cron/test.sock"),
+					},
+					Crons: []*pb.WorkerCron{
+						{Cron: lo.ToPtr("*/5 * * * *"), Type: pb.WorkerCron_TRIGGER_TYPE_SCHEDULED.Enum()},
+					},
+				},
+			},
+			expect: func(t *testing.T, result map[string]string) {
+				assert.Contains(t, result["cron"], `  modules = [
+    (name = "__frpp_scheduled.js", esModule = embed "src/__frpp_scheduled.js"),
+    (name = "entry.js", esModule = embed "src/entry.js"),
+  ],`)
+			},
+		},
+		{
+			name: "service bindings and kv",
+			wokers: []*pb.Worker{
+				{
+					WorkerId:  lo.ToPtr("bind"),
+					CodeEntry: lo.ToPtr("entry.js"),
+					Socket: &pb.Socket{
+						Address: lo.ToPtr("unix:/bind/test.sock"),
+					},
+					ServiceBindings: []*pb.WorkerServiceBinding{
+						{Name: lo.ToPtr("AUTH"), WorkerId: lo.ToPtr("auth-worker")},
+					},
+					KvNamespaces: []*pb.WorkerKVNamespace{
+						{Binding: lo.ToPtr("CACHE"), Namespace: lo.ToPtr("default"), Scope: pb.WorkerKVNamespace_SCOPE_WORKER.Enum()},
+					},
+				},
+			},
+			expect: func(t *testing.T, result map[string]string) {
+				assert.Contains(t, result["bind"], `  services = [
+    (name = "bind", worker = .vbindWorker),
+    (name = "svc-authworker", external = (address = "unix-abstract:/tmp/frpp-worker-auth-worker.sock", http = ())),
+    (name = "kv-worker-bind-default", disk = (path = "kv/worker-bind-default", writable = true)),
+  ],`)
+				assert.Contains(t, result["bind"], `  compatibilityDate = "2023-04-03",
+  bindings = [
+    (name = "AUTH", service = "svc-authworker"),
+    (name = "CACHE", kvNamespace = "kv-worker-bind-default"),
+  ],
+);`)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.expect(t, BuildCapfile(tt.wokers))
+			tt.expect(t, BuildCapfile(tt.wokers, ""))
 		})
 	}
 }
+
+func TestBuildSharedCapfile(t *testing.T) {
+	result, err := BuildSharedCapfile([]*pb.Worker{
+		{
+			WorkerId:  lo.ToPtr("b-worker"),
+			CodeEntry: lo.ToPtr("entry.js"),
+			Socket: &pb.Socket{
+				Address: lo.ToPtr("unix:/b/test.sock"),
+			},
+			Crons: []*pb.WorkerCron{
+				{Cron: lo.ToPtr("*/5 * * * *"), Type: pb.WorkerCron_TRIGGER_TYPE_SCHEDULED.Enum()},
+			},
+		},
+		{
+			WorkerId:  lo.ToPtr("a-worker"),
+			CodeEntry: lo.ToPtr("entry.js"),
+			Socket: &pb.Socket{
+				Address: lo.ToPtr("unix:/a/test.sock"),
+			},
+		},
+	}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, `using Workerd = import "/workerd/workerd.capnp";
+
+const config :Workerd.Config = (
+  services = [
+    (name = "aworker", worker = .vaworkerWorker),
+    (name = "bworker", worker = .vbworkerWorker),
+  ],
+
+  sockets = [
+    (
+      name = "aworker",
+      address = "unix:/a/test.sock",
+      http=(),
+      service="aworker"
+    ),
+    (
+      name = "bworker",
+      address = "unix:/b/test.sock",
+      http=(),
+      service="bworker"
+    ),
+  ]
+);
+
+const vaworkerWorker :Workerd.Worker = (
+  modules = [
+    (name = "entry.js", esModule = embed "a-worker/src/entry.js"),
+  ],
+  compatibilityDate = "2023-04-03",
+);
+
+const vbworkerWorker :Workerd.Worker = (
+  modules = [
+    (name = "__frpp_scheduled.js", esModule = embed "b-worker/src/__frpp_scheduled.js"),
+    (name = "entry.js", esModule = embed "b-worker/src/entry.js"),
+  ],
+  compatibilityDate = "2023-04-03",
+);
+`, result)
+}
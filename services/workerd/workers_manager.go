@@ -2,22 +2,51 @@ package workerd
 
 import (
 	"fmt"
+	"os"
 	"runtime"
+	"strings"
+	"sync"
 
+	"github.com/VaalaCat/frp-panel/conf"
 	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/VaalaCat/frp-panel/pb"
 	"github.com/VaalaCat/frp-panel/services/app"
+	"github.com/VaalaCat/frp-panel/services/watcher"
 	"github.com/VaalaCat/frp-panel/utils"
 	"github.com/VaalaCat/frp-panel/utils/logger"
 )
 
 type workersManager struct {
-	workers *utils.SyncMap[string, app.WorkerController]
+	workers   *utils.SyncMap[string, app.WorkerController]
+	scheduler watcher.Client
+	// 共享 workerd 进程，为 nil 时每个 worker 使用独立进程
+	shared *sharedWorkerd
+
+	versionMu      sync.Mutex
+	workerdVersion string
+	versionProbed  bool
+}
+
+type WorkersManagerOpt func(*workersManager)
+
+// WithSharedWorkerd 让可以共享进程的 worker 运行在同一个 workerd 进程中
+func WithSharedWorkerd(workerdCwd string) WorkersManagerOpt {
+	return func(m *workersManager) {
+		m.shared = newSharedWorkerd(workerdCwd)
+	}
 }
 
-func NewWorkersManager() *workersManager {
-	return &workersManager{
-		workers: &utils.SyncMap[string, app.WorkerController]{},
+func NewWorkersManager(scheduler watcher.Client, opts ...WorkersManagerOpt) *workersManager {
+	m := &workersManager{
+		workers:   &utils.SyncMap[string, app.WorkerController]{},
+		scheduler: scheduler,
 	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
 }
 
 func (m *workersManager) GetWorker(ctx *app.Context, id string) (app.WorkerController, bool) {
@@ -30,7 +59,34 @@ func (m *workersManager) RunWorker(ctx *app.Context, id string, worker app.Worke
 		return fmt.Errorf("function features are not enabled")
 	}
 
-	worker.RunWorker(ctx)
+	if m.shared != nil && CanShareProcess(worker.GetWorker()) {
+		if err := worker.Init(ctx); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("init worker failed, id: [%s]", id)
+			return err
+		}
+		if err := m.shared.Add(ctx, worker.GetWorker()); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("add worker to shared workerd failed, id: [%s]", id)
+			return err
+		}
+	} else {
+		if m.shared != nil {
+			logger.Logger(ctx).Infof("worker has resource limits or custom config template, run it in a dedicated workerd process, id: [%s]", id)
+		}
+		if m.shared != nil && m.shared.Has(id) {
+			// worker 不再满足共享条件时从共享进程中移出
+			if err := m.shared.Remove(ctx, id); err != nil {
+				logger.Logger(ctx).WithError(err).Errorf("remove worker from shared workerd failed, id: [%s]", id)
+			}
+		}
+		worker.RunWorker(ctx)
+	}
+
+	if err := ScheduleWorkerCrons(ctx, m.scheduler, worker.GetWorker()); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("schedule worker crons failed, id: [%s]", id)
+	}
+	if err := ScheduleWorkerKVSync(ctx, m.scheduler, worker.GetWorker(), ctx.GetApp().GetConfig().Client.Worker.WorkerdWorkDir); err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("schedule worker kv sync failed, id: [%s]", id)
+	}
 
 	m.workers.Store(id, worker)
 	return nil
@@ -41,13 +97,30 @@ func (m *workersManager) StopWorker(ctx *app.Context, id string) error {
 	if !ok {
 		return fmt.Errorf("cannot find worker, id: %s", id)
 	}
-	worker.StopWorker(ctx)
+	UnscheduleWorkerCrons(m.scheduler, id)
+	UnscheduleWorkerKVSync(m.scheduler, id)
+	// worker 可能被迁移到其他 client，停止前同步一次 kv
+	SyncWorkerKV(ctx.GetApp(), worker.GetWorker(), ctx.GetApp().GetConfig().Client.Worker.WorkerdWorkDir)
+	if m.shared != nil && m.shared.Has(id) {
+		if err := m.shared.Remove(ctx, id); err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("remove worker from shared workerd failed, id: [%s]", id)
+		}
+		worker.GarbageCollect()
+	} else {
+		worker.StopWorker(ctx)
+	}
 	m.workers.Delete(id)
 	return nil
 }
 
 func (m *workersManager) StopAllWorkers(ctx *app.Context) {
+	if m.shared != nil {
+		m.shared.RemoveAll(ctx)
+	}
+
 	m.workers.Range(func(k string, v app.WorkerController) bool {
+		UnscheduleWorkerCrons(m.scheduler, k)
+		UnscheduleWorkerKVSync(m.scheduler, k)
 		v.StopWorker(ctx)
 		return true
 	})
@@ -59,6 +132,16 @@ func (m *workersManager) StopAllWorkers(ctx *app.Context) {
 }
 
 func (m *workersManager) GetWorkerStatus(ctx *app.Context, id string) (defs.WorkerStatus, error) {
+	if execMgr := ctx.GetApp().GetWorkerExecManager(); execMgr != nil {
+		if status, ok := execMgr.GetCmdStatus(id); ok && (status == defs.WorkerStatus_OOMKilled || status == defs.WorkerStatus_CrashLoop) {
+			return status, nil
+		}
+	}
+
+	if m.shared != nil && m.shared.Has(id) {
+		return m.shared.Status(ctx)
+	}
+
 	ok, err := utils.ProcessExistsBySelf(id)
 	if err != nil {
 		return defs.WorkerStatus_Unknown, err
@@ -69,7 +152,70 @@ func (m *workersManager) GetWorkerStatus(ctx *app.Context, id string) (defs.Work
 	return defs.WorkerStatus_Inactive, nil
 }
 
-func (m *workersManager) InstallWorkerd(ctx *app.Context, url string, installDir string) (string, error) {
+func (m *workersManager) InstallWorkerd(ctx *app.Context, req *pb.InstallWorkerdRequest, installDir string) (string, error) {
+	var (
+		downloadUrl string
+		headers     map[string]string
+		proxyUrl    string
+		err         error
+	)
+
+	if req.GetArtifactId() > 0 {
+		// master 托管的 workerd，不经过 github 代理
+		cfg := ctx.GetApp().GetConfig()
+		downloadUrl = fmt.Sprintf("%s%s/%d", strings.TrimSuffix(conf.GetAPIURL(cfg), "/"), defs.WorkerdArtifactPath, req.GetArtifactId())
+		headers = map[string]string{
+			defs.HeaderClientID:     cfg.Client.ID,
+			defs.HeaderClientSecret: cfg.Client.Secret,
+		}
+	} else {
+		if downloadUrl, err = githubWorkerdURL(ctx, req.GetDownloadUrl()); err != nil {
+			return "", err
+		}
+		proxyUrl = ctx.GetApp().GetConfig().HTTP_PROXY
+	}
+
+	path, err := utils.DownloadFileWithHeaders(ctx, downloadUrl, proxyUrl, headers)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("failed to download workerd, url: %s", downloadUrl)
+		return "", err
+	}
+	defer os.Remove(path)
+
+	if expect := req.GetSha256(); len(expect) > 0 {
+		actual, err := utils.SHA256File(path)
+		if err != nil {
+			logger.Logger(ctx).WithError(err).Errorf("failed to calculate sha256 of workerd, path: %s", path)
+			return "", err
+		}
+		if !strings.EqualFold(expect, actual) {
+			logger.Logger(ctx).Errorf("workerd sha256 mismatch, expect: %s, actual: %s", expect, actual)
+			return "", fmt.Errorf("workerd sha256 mismatch, expect: %s, actual: %s", expect, actual)
+		}
+	}
+
+	if len(installDir) == 0 {
+		installDir = "/usr/local/bin"
+	}
+
+	finalPath, err := utils.ExtractGZTo(path, "workerd", installDir)
+	if err != nil {
+		logger.Logger(ctx).WithError(err).Errorf("failed to extract workerd, path: %s", path)
+		return "", err
+	}
+
+	version := ProbeWorkerdVersion(ctx, finalPath)
+	if len(version) == 0 {
+		version = req.GetVersion()
+	}
+	m.setWorkerdVersion(version)
+
+	logger.Logger(ctx).Infof("workerd installed successfully, path: %s, version: %s", finalPath, version)
+
+	return finalPath, nil
+}
+
+func githubWorkerdURL(ctx *app.Context, url string) (string, error) {
 	arch := runtime.GOARCH
 	os := runtime.GOOS
 
@@ -102,25 +248,25 @@ func (m *workersManager) InstallWorkerd(ctx *app.Context, url string, installDir
 		}
 	}
 
-	proxyUrl := ctx.GetApp().GetConfig().HTTP_PROXY
-
-	path, err := utils.DownloadFile(ctx, downloadUrl, proxyUrl)
-	if err != nil {
-		logger.Logger(ctx).WithError(err).Errorf("failed to download workerd, url: %s", downloadUrl)
-		return "", err
-	}
+	return downloadUrl, nil
+}
 
-	if len(installDir) == 0 {
-		installDir = "/usr/local/bin"
-	}
+// GetWorkerdVersion 返回当前使用的 workerd 版本，首次调用时从配置的二进制中获取
+func (m *workersManager) GetWorkerdVersion(ctx *app.Context) string {
+	m.versionMu.Lock()
+	defer m.versionMu.Unlock()
 
-	finalPath, err := utils.ExtractGZTo(path, "workerd", installDir)
-	if err != nil {
-		logger.Logger(ctx).WithError(err).Errorf("failed to extract workerd, path: %s", path)
-		return "", err
+	if !m.versionProbed {
+		m.workerdVersion = ProbeWorkerdVersion(ctx, ctx.GetApp().GetConfig().Client.Worker.WorkerdBinaryPath)
+		m.versionProbed = true
 	}
+	return m.workerdVersion
+}
 
-	logger.Logger(ctx).Infof("workerd installed successfully, path: %s", finalPath)
+func (m *workersManager) setWorkerdVersion(version string) {
+	m.versionMu.Lock()
+	defer m.versionMu.Unlock()
 
-	return finalPath, nil
+	m.workerdVersion = version
+	m.versionProbed = true
 }
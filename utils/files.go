@@ -90,6 +90,11 @@ var TmpFileDir = path.Join(os.TempDir(), "vaala-frp-panel-download")
 
 // DownloadFile 下载文件到一个临时文件，返回临时文件路径
 func DownloadFile(ctx context.Context, url string, proxyUrl string) (string, error) {
+	return DownloadFileWithHeaders(ctx, url, proxyUrl, nil)
+}
+
+// DownloadFileWithHeaders 下载文件，headers 会附加到每个分片请求上
+func DownloadFileWithHeaders(ctx context.Context, url string, proxyUrl string, headers map[string]string) (string, error) {
 	os.MkdirAll(TmpFileDir, 0777)
 
 	tmpPath, err := os.MkdirTemp(TmpFileDir, "downloads")
@@ -104,6 +109,9 @@ func DownloadFile(ctx context.Context, url string, proxyUrl string) (string, err
 	if len(proxyUrl) > 0 {
 		cli = cli.SetProxyURL(proxyUrl)
 	}
+	if len(headers) > 0 {
+		cli = cli.SetCommonHeaders(headers)
+	}
 
 	err = cli.NewParallelDownload(url).
 		SetConcurrency(5).
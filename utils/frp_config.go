@@ -1,9 +1,13 @@
 package utils
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 
+	"github.com/BurntSushi/toml"
 	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"sigs.k8s.io/yaml"
 )
 
 func NewBaseFRPServerConfig(port int, token string) *v1.ServerConfig {
@@ -68,3 +72,64 @@ func TransformVisitorConfigurerToMap(origin v1.VisitorConfigurer) (key string, r
 func NewProxyKey(clientID, serverID, proxyName string) string {
 	return fmt.Sprintf("%s/%s/%s", clientID, serverID, proxyName)
 }
+
+// MarshalFRPConfig 把 frp 配置按 json 字段名输出为 toml、yaml 或 json，输出内容可以直接被 frpc/frps 加载
+func MarshalFRPConfig(cfg any, format string) ([]byte, error) {
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case "json":
+		buf := &bytes.Buffer{}
+		if err := json.Indent(buf, raw, "", "  "); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case "yaml", "yml":
+		return yaml.JSONToYAML(raw)
+	case "toml":
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var obj any
+		if err := decoder.Decode(&obj); err != nil {
+			return nil, err
+		}
+		buf := &bytes.Buffer{}
+		if err := toml.NewEncoder(buf).Encode(normalizeTOMLValue(obj)); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported config format: [%s]", format)
+	}
+}
+
+// normalizeTOMLValue toml 不支持 null，整数需要还原为 int64 避免输出成浮点数
+func normalizeTOMLValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			if item == nil {
+				delete(val, k)
+				continue
+			}
+			val[k] = normalizeTOMLValue(item)
+		}
+		return val
+	case []any:
+		for i, item := range val {
+			val[i] = normalizeTOMLValue(item)
+		}
+		return val
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		f, _ := val.Float64()
+		return f
+	default:
+		return val
+	}
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fatedier/frp/pkg/config"
+	"github.com/fatedier/frp/pkg/config/types"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalFRPConfigRoundTrip(t *testing.T) {
+	cliCfg := &v1.ClientConfig{
+		ClientCommonConfig: *NewBaseFRPClientConfig("example.com", 7000, "token"),
+		Proxies: []v1.TypedProxyConfig{
+			{Type: "tcp", ProxyConfigurer: &v1.TCPProxyConfig{
+				ProxyBaseConfig: v1.ProxyBaseConfig{
+					Name:        "ssh",
+					Type:        "tcp",
+					Annotations: map[string]string{"owner": "ops"},
+					Transport:   v1.ProxyTransport{UseEncryption: true, BandwidthLimit: lo.Must(types.NewBandwidthQuantity("1MB"))},
+					ProxyBackend: v1.ProxyBackend{
+						LocalIP:   "127.0.0.1",
+						LocalPort: 22,
+					},
+				},
+				RemotePort: 6000,
+			}},
+			{Type: "http", ProxyConfigurer: &v1.HTTPProxyConfig{
+				ProxyBaseConfig: v1.ProxyBaseConfig{
+					Name:         "web",
+					Type:         "http",
+					ProxyBackend: v1.ProxyBackend{LocalPort: 8080},
+				},
+				DomainConfig: v1.DomainConfig{CustomDomains: []string{"a.example.com", "b.example.com"}},
+				Locations:    []string{"/api"},
+			}},
+		},
+		Visitors: []v1.TypedVisitorConfig{
+			{Type: "stcp", VisitorConfigurer: &v1.STCPVisitorConfig{
+				VisitorBaseConfig: v1.VisitorBaseConfig{
+					Name:       "ssh-visitor",
+					Type:       "stcp",
+					SecretKey:  "secret",
+					ServerName: "ssh",
+					BindPort:   9000,
+				},
+			}},
+		},
+	}
+	svrCfg := NewBaseFRPServerUserAuthConfig(7000, []v1.HTTPPluginOptions{
+		{Name: "auth", Addr: "127.0.0.1:8999", Path: "/auth", Ops: []string{"Login", "NewProxy"}},
+	})
+	svrCfg.VhostHTTPPort = 80
+	svrCfg.MaxPortsPerClient = 10
+	svrCfg.AllowPorts = []types.PortsRange{{Start: 6000, End: 7000}, {Single: 8000}}
+
+	for _, format := range []string{"toml", "yaml", "json"} {
+		t.Run(format, func(t *testing.T) {
+			raw, err := MarshalFRPConfig(cliCfg, format)
+			assert.NoError(t, err)
+			gotCli := &v1.ClientConfig{}
+			assert.NoError(t, config.LoadConfigure(raw, gotCli, true), string(raw))
+			assert.JSONEq(t, mustJSON(t, cliCfg), mustJSON(t, gotCli))
+
+			raw, err = MarshalFRPConfig(svrCfg, format)
+			assert.NoError(t, err)
+			gotSvr := &v1.ServerConfig{}
+			assert.NoError(t, config.LoadConfigure(raw, gotSvr, true), string(raw))
+			assert.JSONEq(t, mustJSON(t, svrCfg), mustJSON(t, gotSvr))
+		})
+	}
+
+	_, err := MarshalFRPConfig(cliCfg, "ini")
+	assert.Error(t, err)
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	return string(lo.Must(json.Marshal(v)))
+}
@@ -1,9 +1,15 @@
 package utils
 
 import (
+	"bytes"
+	"fmt"
+	"sort"
+
 	"github.com/fatedier/frp/pkg/config"
+	"github.com/fatedier/frp/pkg/config/legacy"
 	v1 "github.com/fatedier/frp/pkg/config/v1"
 	"github.com/samber/lo"
+	"gopkg.in/ini.v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -112,3 +118,82 @@ func LoadServerConfig(content []byte, strict bool) (*v1.ServerConfig, error) {
 
 	return svrCfg, nil
 }
+
+func IsLegacyINIConfig(content []byte) bool {
+	return config.DetectLegacyINIFormat(content)
+}
+
+// IsLegacyServerINIConfig ini 格式中 frps 的 [common] 段一定会配置监听端口
+func IsLegacyServerINIConfig(content []byte) bool {
+	f, err := ini.Load(content)
+	if err != nil {
+		return false
+	}
+	common, err := f.GetSection("common")
+	if err != nil {
+		return false
+	}
+	return common.HasKey("bind_port") || common.HasKey("kcp_bind_port") || common.HasKey("quic_bind_port") ||
+		common.HasKey("vhost_http_port") || common.HasKey("vhost_https_port")
+}
+
+// LoadLegacyClientConfig 解析 ini 格式的 frpc 配置并转换为 v1 格式，proxy 名称不带 user 前缀
+// 配置来自用户上传，不渲染模板，否则可以通过 {{ .Envs.XXX }} 读取 master 的环境变量
+func LoadLegacyClientConfig(content []byte) (*v1.ClientConfig, error) {
+	if err := checkNoTemplate(content); err != nil {
+		return nil, err
+	}
+
+	legacyCommon, err := legacy.UnmarshalClientConfFromIni(content)
+	if err != nil {
+		return nil, err
+	}
+
+	legacyProxyCfgs, legacyVisitorCfgs, err := legacy.LoadAllProxyConfsFromIni("", content, legacyCommon.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	allCfg := &v1.ClientConfig{ClientCommonConfig: *legacy.Convert_ClientCommonConf_To_v1(&legacyCommon)}
+	allCfg.Complete()
+
+	for _, name := range sortedKeys(legacyProxyCfgs) {
+		c := legacy.Convert_ProxyConf_To_v1(legacyProxyCfgs[name])
+		allCfg.Proxies = append(allCfg.Proxies, v1.TypedProxyConfig{Type: c.GetBaseConfig().Type, ProxyConfigurer: c})
+	}
+	for _, name := range sortedKeys(legacyVisitorCfgs) {
+		c := legacy.Convert_VisitorConf_To_v1(legacyVisitorCfgs[name])
+		allCfg.Visitors = append(allCfg.Visitors, v1.TypedVisitorConfig{Type: c.GetBaseConfig().Type, VisitorConfigurer: c})
+	}
+	return allCfg, nil
+}
+
+// LoadLegacyServerConfig 解析 ini 格式的 frps 配置并转换为 v1 格式，同样不渲染模板
+func LoadLegacyServerConfig(content []byte) (*v1.ServerConfig, error) {
+	if err := checkNoTemplate(content); err != nil {
+		return nil, err
+	}
+
+	legacyCfg, err := legacy.UnmarshalServerConfFromIni(content)
+	if err != nil {
+		return nil, err
+	}
+
+	svrCfg := legacy.Convert_ServerCommonConf_To_v1(&legacyCfg)
+	svrCfg.Complete()
+	return svrCfg, nil
+}
+
+// checkNoTemplate 不渲染的模板会原样写入配置，直接拒绝比导入后才发现配置不可用更清楚
+func checkNoTemplate(content []byte) error {
+	if bytes.Contains(content, []byte("{{")) {
+		return fmt.Errorf("template in ini config is not supported")
+	}
+	return nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"strings"
 	"testing"
 
 	v1 "github.com/fatedier/frp/pkg/config/v1"
@@ -21,3 +22,62 @@ remotePort = 6000`)
 	}
 	t.Errorf("%+v", allCfg)
 }
+
+func TestLoadLegacyConfigDoesNotRenderTemplate(t *testing.T) {
+	t.Setenv("FRPP_TEST_SECRET", "leaked")
+
+	clientContent := []byte(`[common]
+server_addr = 127.0.0.1
+server_port = 7000
+token = {{ .Envs.FRPP_TEST_SECRET }}
+`)
+	cliCfg, err := LoadLegacyClientConfig(clientContent)
+	if err == nil {
+		t.Fatalf("expect error for template in client config, got token: [%s]", cliCfg.Auth.Token)
+	}
+	if strings.Contains(err.Error(), "leaked") {
+		t.Errorf("env value leaked in error: %v", err)
+	}
+
+	serverContent := []byte(`[common]
+bind_port = 7000
+token = {{ .Envs.FRPP_TEST_SECRET }}
+`)
+	svrCfg, err := LoadLegacyServerConfig(serverContent)
+	if err == nil {
+		t.Fatalf("expect error for template in server config, got token: [%s]", svrCfg.Auth.Token)
+	}
+	if strings.Contains(err.Error(), "leaked") {
+		t.Errorf("env value leaked in error: %v", err)
+	}
+}
+
+func TestLoadLegacyConfig(t *testing.T) {
+	cliCfg, err := LoadLegacyClientConfig([]byte(`[common]
+server_addr = 127.0.0.1
+server_port = 7000
+token = abc
+
+[ssh]
+type = tcp
+local_port = 22
+remote_port = 6000
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cliCfg.Auth.Token != "abc" || len(cliCfg.Proxies) != 1 || cliCfg.Proxies[0].GetBaseConfig().Name != "ssh" {
+		t.Errorf("unexpected client config: %+v", cliCfg)
+	}
+
+	svrCfg, err := LoadLegacyServerConfig([]byte(`[common]
+bind_port = 7000
+token = abc
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if svrCfg.BindPort != 7000 || svrCfg.Auth.Token != "abc" {
+		t.Errorf("unexpected server config: %+v", svrCfg)
+	}
+}
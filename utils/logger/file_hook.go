@@ -0,0 +1,250 @@
+package logger
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	fileLogMaxLine = 1024 * 1024
+)
+
+// 节点 ID 中不能出现在文件名里的字符替换为 _
+var fileLogNameReplacer = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+var (
+	fileLogHook     *FileLogHook
+	fileLogHookOnce sync.Once
+)
+
+// LogRecord 落盘的日志格式，每行一条 json
+type LogRecord struct {
+	Time    time.Time         `json:"time"`
+	Level   string            `json:"level"`
+	Pkg     string            `json:"pkg,omitempty"`
+	Caller  string            `json:"caller,omitempty"`
+	Message string            `json:"msg"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+type LogQuery struct {
+	Start   time.Time
+	End     time.Time
+	Level   logrus.Level // 返回该级别及更严重的日志
+	Pkgs    []string
+	Keyword string
+	Limit   int
+}
+
+// FileLogHook 把日志写入磁盘，超过 maxBytes 时轮转一次，磁盘占用最多约为 2*maxBytes
+type FileLogHook struct {
+	mu       sync.Mutex
+	path     string
+	maxBytes int64
+	file     *os.File
+	size     int64
+}
+
+// NewFileLogHook 日志文件名带上节点 ID，多个节点共用同一个目录时互不影响
+func NewFileLogHook(dir string, nodeID string, maxBytes int64) (*FileLogHook, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	h := &FileLogHook{
+		path:     filepath.Join(dir, fileLogName(nodeID)),
+		maxBytes: maxBytes,
+	}
+	if err := h.open(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// EnableFileLog 为全局 logger 开启日志落盘，只有第一次调用生效
+func EnableFileLog(dir string, nodeID string, maxBytes int64) error {
+	var err error
+	fileLogHookOnce.Do(func() {
+		var h *FileLogHook
+		h, err = NewFileLogHook(dir, nodeID, maxBytes)
+		if err != nil {
+			return
+		}
+		fileLogHook = h
+		Instance().AddHook(h)
+	})
+	return err
+}
+
+func fileLogName(nodeID string) string {
+	if len(nodeID) == 0 {
+		return "frpp.log"
+	}
+	return "frpp-" + fileLogNameReplacer.ReplaceAllString(nodeID, "_") + ".log"
+}
+
+// QueryFileLog 查询落盘的日志，未开启落盘时返回错误
+func QueryFileLog(q LogQuery) ([]*LogRecord, bool, error) {
+	if fileLogHook == nil {
+		return nil, false, fmt.Errorf("log persistence is not enabled")
+	}
+	return fileLogHook.Query(q)
+}
+
+func (h *FileLogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *FileLogHook) Fire(entry *logrus.Entry) error {
+	record := &LogRecord{
+		Time:    entry.Time,
+		Level:   entry.Level.String(),
+		Message: strings.TrimRight(entry.Message, "\n"),
+	}
+	if entry.HasCaller() {
+		record.Caller = fmt.Sprintf("%s:%d",
+			filepath.Join(filepath.Base(filepath.Dir(entry.Caller.File)), filepath.Base(entry.Caller.File)), entry.Caller.Line)
+	}
+	for k, v := range entry.Data {
+		if k == "pkg" {
+			record.Pkg = fmt.Sprint(v)
+			continue
+		}
+		if record.Fields == nil {
+			record.Fields = map[string]string{}
+		}
+		record.Fields[k] = fmt.Sprint(v)
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.maxBytes > 0 && h.size+int64(len(line)) > h.maxBytes {
+		if err := h.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := h.file.Write(line)
+	h.size += int64(n)
+	return err
+}
+
+// Query 按时间顺序返回最新的 limit 条匹配日志，第二个返回值表示是否还有更早的匹配
+func (h *FileLogHook) Query(q LogQuery) ([]*LogRecord, bool, error) {
+	pkgs := map[string]bool{}
+	for _, p := range q.Pkgs {
+		if len(p) > 0 {
+			pkgs[p] = true
+		}
+	}
+	keyword := strings.ToLower(q.Keyword)
+
+	var (
+		result    = make([]*LogRecord, 0)
+		truncated bool
+	)
+	match := func(r *LogRecord) bool {
+		if !q.Start.IsZero() && r.Time.Before(q.Start) {
+			return false
+		}
+		if !q.End.IsZero() && r.Time.After(q.End) {
+			return false
+		}
+		if lv, err := logrus.ParseLevel(r.Level); err == nil && lv > q.Level {
+			return false
+		}
+		if len(pkgs) > 0 && !pkgs[r.Pkg] {
+			return false
+		}
+		if len(keyword) > 0 && !r.containsKeyword(keyword) {
+			return false
+		}
+		return true
+	}
+
+	for _, path := range []string{h.path + ".1", h.path} {
+		f, err := os.Open(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 0, 64*1024), fileLogMaxLine)
+		for scanner.Scan() {
+			r := &LogRecord{}
+			if err := json.Unmarshal(scanner.Bytes(), r); err != nil || !match(r) {
+				continue
+			}
+			result = append(result, r)
+			// 只保留最新的部分，避免匹配过多时占用大量内存
+			if q.Limit > 0 && len(result) >= 2*q.Limit {
+				result = append(make([]*LogRecord, 0, 2*q.Limit), result[len(result)-q.Limit:]...)
+				truncated = true
+			}
+		}
+		f.Close()
+	}
+
+	if q.Limit > 0 && len(result) > q.Limit {
+		result = result[len(result)-q.Limit:]
+		truncated = true
+	}
+	return result, truncated, nil
+}
+
+// containsKeyword 在日志内容和字段值中查找关键字，keyword 需要是小写
+func (r *LogRecord) containsKeyword(keyword string) bool {
+	if strings.Contains(strings.ToLower(r.Message), keyword) {
+		return true
+	}
+	for _, v := range r.Fields {
+		if strings.Contains(strings.ToLower(v), keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *FileLogHook) open() error {
+	file, err := os.OpenFile(h.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	h.file = file
+	h.size = info.Size()
+	return nil
+}
+
+func (h *FileLogHook) rotate() error {
+	if err := h.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(h.path, h.path+".1"); err != nil {
+		return err
+	}
+	return h.open()
+}
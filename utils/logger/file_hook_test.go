@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func fireTestLog(t *testing.T, h *FileLogHook, at time.Time, level logrus.Level, msg string, data logrus.Fields) {
+	t.Helper()
+
+	entry := logrus.NewEntry(logrus.New()).WithFields(data)
+	entry.Time, entry.Level, entry.Message = at, level, msg
+	assert.NoError(t, h.Fire(entry))
+}
+
+func TestFileLogHookQueryRotation(t *testing.T) {
+	dir := t.TempDir()
+	// 每个文件大约能放下 10 条日志
+	h, err := NewFileLogHook(dir, "client/1", 800)
+	assert.NoError(t, err)
+	assert.FileExists(t, dir+"/frpp-client_1.log")
+
+	base := time.Now().Add(-time.Hour)
+	for i := 0; i < 30; i++ {
+		fireTestLog(t, h, base.Add(time.Duration(i)*time.Second), logrus.InfoLevel, fmt.Sprintf("line %02d", i), nil)
+	}
+	assert.FileExists(t, dir+"/frpp-client_1.log.1")
+
+	records, truncated, err := h.Query(LogQuery{Level: logrus.TraceLevel})
+	assert.NoError(t, err)
+	assert.False(t, truncated)
+	// 只轮转一次，最早的日志被丢弃，剩下的按时间顺序返回并以最新一条结束
+	assert.Less(t, len(records), 30)
+	assert.Greater(t, len(records), 5)
+	assert.Equal(t, "line 29", records[len(records)-1].Message)
+	assert.True(t, lo.IsSortedByKey(records, func(r *LogRecord) int64 { return r.Time.UnixNano() }))
+
+	info, err := os.Stat(dir + "/frpp-client_1.log")
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, info.Size(), int64(800))
+}
+
+func TestFileLogHookQueryFilters(t *testing.T) {
+	h, err := NewFileLogHook(t.TempDir(), "", 0)
+	assert.NoError(t, err)
+
+	base := time.Now().Add(-time.Hour)
+	fireTestLog(t, h, base, logrus.DebugLevel, "debug", logrus.Fields{"pkg": "frpc"})
+	fireTestLog(t, h, base.Add(time.Second), logrus.InfoLevel, "info", logrus.Fields{"pkg": "frps"})
+	fireTestLog(t, h, base.Add(2*time.Second), logrus.WarnLevel, "warn", logrus.Fields{"pkg": "frpc", "proxy": "Web-SSH"})
+	fireTestLog(t, h, base.Add(3*time.Second), logrus.ErrorLevel, "error", logrus.Fields{"pkg": "master"})
+
+	messages := func(q LogQuery) []string {
+		records, _, err := h.Query(q)
+		assert.NoError(t, err)
+		return lo.Map(records, func(r *LogRecord, _ int) string { return r.Message })
+	}
+
+	assert.Equal(t, []string{"warn", "error"}, messages(LogQuery{Level: logrus.WarnLevel}))
+	assert.Equal(t, []string{"debug", "warn"}, messages(LogQuery{Level: logrus.TraceLevel, Pkgs: []string{"frpc", ""}}))
+	assert.Equal(t, []string{"info", "warn"}, messages(LogQuery{Level: logrus.TraceLevel,
+		Start: base.Add(time.Second), End: base.Add(2 * time.Second)}))
+	// 关键字不区分大小写，也会匹配字段值
+	assert.Equal(t, []string{"warn"}, messages(LogQuery{Level: logrus.TraceLevel, Keyword: "web-ssh"}))
+
+	records, truncated, err := h.Query(LogQuery{Level: logrus.TraceLevel, Limit: 3})
+	assert.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Equal(t, []string{"info", "warn", "error"}, lo.Map(records, func(r *LogRecord, _ int) string { return r.Message }))
+	assert.Equal(t, "frps", records[0].Pkg)
+	assert.Equal(t, map[string]string{"proxy": "Web-SSH"}, records[1].Fields)
+
+	_, truncated, err = h.Query(LogQuery{Level: logrus.TraceLevel, Limit: 4})
+	assert.NoError(t, err)
+	assert.False(t, truncated)
+}
@@ -4,6 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -135,3 +139,25 @@ func (f *CustomFormatter) Format(entry *logrus.Entry) ([]byte, error) {
 
 	return b.Bytes(), nil
 }
+
+// JSONFormatter 每行输出一条 json，便于日志系统采集
+type JSONFormatter struct {
+	logrus.JSONFormatter
+}
+
+func NewJSONFormatter() *JSONFormatter {
+	return &JSONFormatter{
+		JSONFormatter: logrus.JSONFormatter{
+			TimestampFormat: time.RFC3339Nano,
+			CallerPrettyfier: func(frame *runtime.Frame) (function string, file string) {
+				return "", filepath.Join(filepath.Base(filepath.Dir(frame.File)), filepath.Base(frame.File)) + ":" + strconv.Itoa(frame.Line)
+			},
+		},
+	}
+}
+
+func (f *JSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	// frp 的日志自带换行
+	entry.Message = strings.TrimRight(entry.Message, "\n")
+	return f.JSONFormatter.Format(entry)
+}
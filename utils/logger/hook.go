@@ -12,9 +12,14 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+type streamLog struct {
+	msg string
+	pkg string
+}
+
 type StreamLogHook struct {
-	ch            chan string
-	handler       func(msg string)
+	ch            chan streamLog
+	handler       func(msg, pkg string)
 	stopFunc      func()
 	streamEnabled bool
 	stdio         io.Writer
@@ -22,10 +27,10 @@ type StreamLogHook struct {
 	pkgs          map[string]bool // 只传输指定包的日志
 }
 
-func NewStreamLogHook(handler func(msg string), stopFunc func(), pkgs ...string) *StreamLogHook {
+func NewStreamLogHook(handler func(msg, pkg string), stopFunc func(), pkgs ...string) *StreamLogHook {
 	pkgs = lo.FilterMap(pkgs, func(v string, _ int) (string, bool) { return v, len(v) > 0 })
 	return &StreamLogHook{
-		ch:            make(chan string, 4096),
+		ch:            make(chan streamLog, 4096),
 		handler:       handler,
 		streamEnabled: true,
 		stdio:         bufio.NewWriter(os.Stdout),
@@ -40,9 +45,9 @@ func (s *StreamLogHook) Fire(entry *logrus.Entry) error {
 		return nil
 	}
 
+	pkgName, ok := entry.Data["pkg"].(string)
 	// 有过滤时需要过滤
 	if len(s.pkgs) > 0 {
-		pkgName, ok := entry.Data["pkg"].(string)
 		if !ok {
 			return nil
 		}
@@ -52,7 +57,7 @@ func (s *StreamLogHook) Fire(entry *logrus.Entry) error {
 	}
 
 	str, _ := entry.String()
-	s.ch <- str
+	s.ch <- streamLog{msg: str, pkg: pkgName}
 	return nil
 }
 
@@ -80,7 +85,7 @@ func (s *StreamLogHook) Send() {
 		if !ok {
 			return
 		}
-		s.handler(msg)
+		s.handler(msg.msg, msg.pkg)
 	}
 }
 
@@ -29,6 +29,7 @@ func InitLogger() {
 	Instance().SetReportCaller(true)
 	Instance().SetFormatter(NewCustomFormatter(false, true))
 	Instance().AddHook(NewStackTraceHook())
+	Instance().AddHook(NewTraceIDHook())
 
 	logrus.SetReportCaller(true)
 	logrus.SetFormatter(NewCustomFormatter(false, true))
@@ -64,6 +65,16 @@ func UpdateLoggerOpt(frpLogLevel string, logrusLevel string) {
 	initFrpLogger(frpLv)
 }
 
+// SetLogFormat format 为 json 时输出 json 格式日志，其余情况使用默认的文本格式
+func SetLogFormat(format string) {
+	var formatter logrus.Formatter = NewCustomFormatter(false, true)
+	if strings.ToLower(format) == "json" {
+		formatter = NewJSONFormatter()
+	}
+	Instance().SetFormatter(formatter)
+	logrus.SetFormatter(formatter)
+}
+
 func NewCallerPrettyfier(projectRoot, projectPkg string) func(frame *runtime.Frame) (function string, file string) {
 	return func(frame *runtime.Frame) (function string, file string) {
 		file = frame.File
@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/sirupsen/logrus"
+)
+
+// TraceIDField 日志中 trace id 的字段名
+const TraceIDField = "trace_id"
+
+type traceIDCtxKey struct{}
+
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	if len(traceID) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, traceIDCtxKey{}, traceID)
+}
+
+// GetTraceID gin.Context 的 Value 只能取到 c.Set 设置的 string key，因此再按 defs.TraceIDKey 查一次，见 middleware.TraceID
+func GetTraceID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if traceID, ok := ctx.Value(traceIDCtxKey{}).(string); ok {
+		return traceID
+	}
+	traceID, _ := ctx.Value(defs.TraceIDKey).(string)
+	return traceID
+}
+
+// TraceIDHook 把 context 中的 trace id 写入日志字段，需要通过 Logger(ctx) 打印日志
+type TraceIDHook struct{}
+
+func NewTraceIDHook() *TraceIDHook {
+	return &TraceIDHook{}
+}
+
+func (hook *TraceIDHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (hook *TraceIDHook) Fire(entry *logrus.Entry) error {
+	if traceID := GetTraceID(entry.Context); len(traceID) > 0 {
+		entry.Data[TraceIDField] = traceID
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package logger
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/VaalaCat/frp-panel/defs"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTraceID(t *testing.T) {
+	assert.Equal(t, "", GetTraceID(context.Background()))
+	assert.Equal(t, "abc", GetTraceID(WithTraceID(context.Background(), "abc")))
+
+	// 其他包用同名 string key 写入的值不会被当作 trace id
+	assert.Equal(t, "", GetTraceID(context.WithValue(context.Background(), TraceIDField, "abc")))
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Set(defs.TraceIDKey, "from-gin")
+	assert.Equal(t, "from-gin", GetTraceID(c))
+}
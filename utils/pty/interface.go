@@ -7,3 +7,15 @@ type PTYInterface interface {
 	Setsize(cols, rows uint32) error
 	Close() error
 }
+
+// Options 终端启动参数，零值表示使用默认 shell 和当前用户
+type Options struct {
+	// Shell shell 路径或名称，为空时自动查找
+	Shell string
+	// User 以该用户身份运行 shell，为空时使用当前用户，仅支持类 unix 系统
+	User string
+	// WorkDir 工作目录，为空时使用用户的 home 目录
+	WorkDir string
+	// Env 传给 shell 的环境变量，格式为 KEY=VALUE
+	Env []string
+}
@@ -4,8 +4,12 @@ package pty
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"os/exec"
+	"os/user"
+	"strconv"
+	"sync"
 	"syscall"
 
 	opty "github.com/creack/pty"
@@ -16,32 +20,98 @@ var _ PTYInterface = (*Pty)(nil)
 var defaultShells = []string{"fish", "zsh", "bash", "sh"}
 
 type Pty struct {
-	tty    *os.File
-	cmd    *exec.Cmd
-	closed bool
+	tty       *os.File
+	cmd       *exec.Cmd
+	closeOnce sync.Once
+	closeErr  error
 }
 
 func DownloadDependency() error {
 	return nil
 }
 
-func Start() (PTYInterface, error) {
-	var shellPath string
-	for i := 0; i < len(defaultShells); i++ {
-		shellPath, _ = exec.LookPath(defaultShells[i])
-		if shellPath != "" {
-			break
-		}
-	}
-	if shellPath == "" {
-		return nil, errors.New("none of the default shells was found")
+func Start(opt Options) (PTYInterface, error) {
+	shellPath, err := lookupShell(opt.Shell)
+	if err != nil {
+		return nil, err
 	}
+
 	cmd := exec.Command(shellPath)
-	cmd.Env = append(os.Environ(), "TERM=xterm")
+	cmd.Env = append(opt.Env, "TERM=xterm", "SHELL="+shellPath)
+	cmd.Dir = opt.WorkDir
+
+	if err := SetCommandUser(cmd, opt.User); err != nil {
+		return nil, err
+	}
+
 	tty, err := opty.Start(cmd)
 	return &Pty{tty: tty, cmd: cmd}, err
 }
 
+// SetCommandUser 让 cmd 以 userName 的身份运行，userName 为空时使用当前用户
+// cmd.Dir 为空时设置为用户的 home 目录
+func SetCommandUser(cmd *exec.Cmd, userName string) error {
+	if len(userName) == 0 {
+		if len(cmd.Dir) == 0 {
+			cmd.Dir, _ = os.UserHomeDir()
+		}
+		return nil
+	}
+
+	u, err := user.Lookup(userName)
+	if err != nil {
+		return fmt.Errorf("lookup user [%s] failed: %w", userName, err)
+	}
+	cred, err := userCredential(u)
+	if err != nil {
+		return err
+	}
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Credential = cred
+	cmd.Env = append(cmd.Env, "HOME="+u.HomeDir, "USER="+u.Username, "LOGNAME="+u.Username)
+	if len(cmd.Dir) == 0 {
+		cmd.Dir = u.HomeDir
+	}
+	return nil
+}
+
+func lookupShell(shell string) (string, error) {
+	if len(shell) > 0 {
+		return exec.LookPath(shell)
+	}
+
+	for i := 0; i < len(defaultShells); i++ {
+		if shellPath, _ := exec.LookPath(defaultShells[i]); shellPath != "" {
+			return shellPath, nil
+		}
+	}
+	return "", errors.New("none of the default shells was found")
+}
+
+func userCredential(u *user.User) (*syscall.Credential, error) {
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uid [%s]: %w", u.Uid, err)
+	}
+	gid, err := strconv.ParseUint(u.Gid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gid [%s]: %w", u.Gid, err)
+	}
+
+	cred := &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid)}
+	if groupIds, err := u.GroupIds(); err == nil {
+		for _, g := range groupIds {
+			if id, err := strconv.ParseUint(g, 10, 32); err == nil {
+				cred.Groups = append(cred.Groups, uint32(id))
+			}
+		}
+	}
+	return cred, nil
+}
+
 func (pty *Pty) Write(p []byte) (n int, err error) {
 	return pty.tty.Write(p)
 }
@@ -76,11 +146,15 @@ func (pty *Pty) killChildProcess(c *exec.Cmd) error {
 	return c.Wait()
 }
 
+// Close 可以被多个协程重复调用，只有第一次会真正关闭
 func (pty *Pty) Close() error {
-	if pty.closed {
-		return nil
-	}
-	pty.closed = true
+	pty.closeOnce.Do(func() {
+		pty.closeErr = pty.close()
+	})
+	return pty.closeErr
+}
+
+func (pty *Pty) close() error {
 	if err := pty.tty.Close(); err != nil {
 		return err
 	}
@@ -3,11 +3,13 @@
 package pty
 
 import (
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"sync"
 
 	"github.com/UserExistsError/conpty"
 	"github.com/iamacarpet/go-winpty"
@@ -20,13 +22,15 @@ var _ PTYInterface = (*conPty)(nil)
 var isWin10 = IsWindows10()
 
 type winPTY struct {
-	tty    *winpty.WinPTY
-	closed bool
+	tty       *winpty.WinPTY
+	closeOnce sync.Once
+	closeErr  error
 }
 
 type conPty struct {
-	tty    *conpty.ConPty
-	closed bool
+	tty       *conpty.ConPty
+	closeOnce sync.Once
+	closeErr  error
 }
 
 func IsWindows10() bool {
@@ -58,8 +62,15 @@ func getExecutableFilePath() (string, error) {
 	return filepath.Dir(ex), nil
 }
 
-func Start() (PTYInterface, error) {
-	shellPath, err := exec.LookPath("powershell.exe")
+func Start(opt Options) (PTYInterface, error) {
+	if len(opt.User) > 0 {
+		return nil, errors.New("running shell as another user is not supported on windows")
+	}
+	shell := opt.Shell
+	if shell == "" {
+		shell = "powershell.exe"
+	}
+	shellPath, err := exec.LookPath(shell)
 	if err != nil || shellPath == "" {
 		shellPath = "cmd.exe"
 	}
@@ -67,11 +78,23 @@ func Start() (PTYInterface, error) {
 	if err != nil {
 		return nil, err
 	}
+	workDir := opt.WorkDir
+	if workDir == "" {
+		workDir = path
+	}
+	// 白名单过滤后的环境变量为空时也要传入，nil 会让 shell 继承 agent 的全部环境变量
+	env := append([]string{}, opt.Env...)
+	conptyOpts := []conpty.ConPtyOption{conpty.ConPtyWorkDir(workDir), conpty.ConPtyEnv(env)}
 	if !isWin10 {
-		tty, err := winpty.OpenDefault(path, shellPath)
+		tty, err := winpty.OpenWithOptions(winpty.Options{
+			DLLPrefix: path,
+			Command:   shellPath,
+			Dir:       workDir,
+			Env:       env,
+		})
 		return &winPTY{tty: tty}, err
 	}
-	tty, err := conpty.Start(shellPath, conpty.ConPtyWorkDir(path))
+	tty, err := conpty.Start(shellPath, conptyOpts...)
 	return &conPty{tty: tty}, err
 }
 
@@ -92,11 +115,15 @@ func (w *winPTY) Setsize(cols, rows uint32) error {
 	return nil
 }
 
+// Close 可以被多个协程重复调用，只有第一次会真正关闭
 func (w *winPTY) Close() error {
-	if w.closed {
-		return nil
-	}
-	w.closed = true
+	w.closeOnce.Do(func() {
+		w.closeErr = w.close()
+	})
+	return w.closeErr
+}
+
+func (w *winPTY) close() error {
 	w.tty.Close()
 	return nil
 }
@@ -118,13 +145,25 @@ func (c *conPty) Setsize(cols, rows uint32) error {
 	return nil
 }
 
+// Close 可以被多个协程重复调用，只有第一次会真正关闭
 func (c *conPty) Close() error {
-	if c.closed {
-		return nil
-	}
-	c.closed = true
+	c.closeOnce.Do(func() {
+		c.closeErr = c.close()
+	})
+	return c.closeErr
+}
+
+func (c *conPty) close() error {
 	if err := c.tty.Close(); err != nil {
 		return err
 	}
 	return nil
 }
+
+// SetCommandUser windows 上不支持切换用户
+func SetCommandUser(cmd *exec.Cmd, userName string) error {
+	if len(userName) > 0 {
+		return errors.New("running command as another user is not supported on windows")
+	}
+	return nil
+}
@@ -3,9 +3,11 @@
 package pty
 
 import (
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sync"
 
 	"github.com/UserExistsError/conpty"
 )
@@ -13,8 +15,9 @@ import (
 var _ PTYInterface = (*Pty)(nil)
 
 type Pty struct {
-	tty    *conpty.ConPty
-	closed bool
+	tty       *conpty.ConPty
+	closeOnce sync.Once
+	closeErr  error
 }
 
 func getExecutableFilePath() (string, error) {
@@ -25,8 +28,15 @@ func getExecutableFilePath() (string, error) {
 	return filepath.Dir(ex), nil
 }
 
-func Start() (PTYInterface, error) {
-	shellPath, err := exec.LookPath("powershell.exe")
+func Start(opt Options) (PTYInterface, error) {
+	if len(opt.User) > 0 {
+		return nil, errors.New("running shell as another user is not supported on windows")
+	}
+	shell := opt.Shell
+	if shell == "" {
+		shell = "powershell.exe"
+	}
+	shellPath, err := exec.LookPath(shell)
 	if err != nil || shellPath == "" {
 		shellPath = "cmd.exe"
 	}
@@ -34,7 +44,14 @@ func Start() (PTYInterface, error) {
 	if err != nil {
 		return nil, err
 	}
-	tty, err := conpty.Start(shellPath, conpty.ConPtyWorkDir(path))
+	workDir := opt.WorkDir
+	if workDir == "" {
+		workDir = path
+	}
+	// 白名单过滤后的环境变量为空时也要传入，nil 会让 shell 继承 agent 的全部环境变量
+	env := append([]string{}, opt.Env...)
+	conptyOpts := []conpty.ConPtyOption{conpty.ConPtyWorkDir(workDir), conpty.ConPtyEnv(env)}
+	tty, err := conpty.Start(shellPath, conptyOpts...)
 	return &Pty{tty: tty}, err
 }
 
@@ -54,13 +71,25 @@ func (pty *Pty) Setsize(cols, rows uint32) error {
 	return pty.tty.Resize(int(cols), int(rows))
 }
 
+// Close 可以被多个协程重复调用，只有第一次会真正关闭
 func (pty *Pty) Close() error {
-	if pty.closed {
-		return nil
-	}
-	pty.closed = true
+	pty.closeOnce.Do(func() {
+		pty.closeErr = pty.close()
+	})
+	return pty.closeErr
+}
+
+func (pty *Pty) close() error {
 	if err := pty.tty.Close(); err != nil {
 		return err
 	}
 	return nil
 }
+
+// SetCommandUser windows 上不支持切换用户
+func SetCommandUser(cmd *exec.Cmd, userName string) error {
+	if len(userName) > 0 {
+		return errors.New("running command as another user is not supported on windows")
+	}
+	return nil
+}